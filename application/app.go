@@ -9,6 +9,31 @@ import (
 
 type CommandSet map[string]commands.Command
 
+// lockingCommands modify the state directory and must not run
+// concurrently against it.
+var lockingCommands = map[string]struct{}{
+	"up":             {},
+	"plan":           {},
+	"destroy":        {},
+	"down":           {},
+	"rotate":         {},
+	"validate":       {},
+	"migrate":        {},
+	"state encrypt":  {},
+	"state decrypt":  {},
+	"state rollback": {},
+	"state import":   {},
+	"patches remove": {},
+}
+
+// stateFreeCommands do not need the state from the state backend. bbl
+// version shows the deployments of the local copy of the state, when there
+// is one.
+var stateFreeCommands = map[string]struct{}{
+	"help":    {},
+	"version": {},
+}
+
 type usage interface {
 	Print()
 	PrintCommandUsage(command, message string)
@@ -28,6 +53,52 @@ func New(commands CommandSet, configuration Configuration, usage usage) App {
 	}
 }
 
+// LockingCommand returns the name of the command in args, the arguments
+// that follow the global flags, when it must hold the state directory
+// lock. The lock is taken before the state is pulled and migrated, so it
+// is decided from the raw arguments.
+func LockingCommand(args []string) (string, bool) {
+	if len(args) == 0 {
+		return "", false
+	}
+
+	for _, arg := range args[1:] {
+		switch arg {
+		case "--help", "-h", "--version", "-v":
+			return "", false
+		}
+	}
+
+	if len(args) > 1 {
+		subcommand := fmt.Sprintf("%s %s", args[0], args[1])
+		if _, ok := lockingCommands[subcommand]; ok {
+			return subcommand, true
+		}
+	}
+
+	_, ok := lockingCommands[args[0]]
+	return args[0], ok
+}
+
+// StateCommand returns whether the command in args, the arguments that
+// follow the global flags, reads the state, so that bbl pulls it from the
+// state backend first.
+func StateCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+
+	for _, arg := range args {
+		switch arg {
+		case "--help", "-h", "--version", "-v":
+			return false
+		}
+	}
+
+	_, ok := stateFreeCommands[args[0]]
+	return !ok
+}
+
 func (a App) Run() error {
 	return a.execute()
 }
@@ -71,7 +142,11 @@ func (a App) execute() error {
 		return versionCommand.Execute([]string{}, storage.State{})
 	}
 
-	err = command.CheckFastFails(a.configuration.SubcommandFlags, a.configuration.State)
+	return a.run(command)
+}
+
+func (a App) run(command commands.Command) error {
+	err := command.CheckFastFails(a.configuration.SubcommandFlags, a.configuration.State)
 	if err != nil {
 		return err
 	}
@@ -19,6 +19,7 @@ var _ = Describe("App", func() {
 		versionCmd *fakes.Command
 		someCmd    *fakes.Command
 		errorCmd   *fakes.Command
+		upCmd      *fakes.Command
 		usage      *fakes.Usage
 	)
 
@@ -29,6 +30,8 @@ var _ = Describe("App", func() {
 			"--version": versionCmd,
 			"some":      someCmd,
 			"error":     errorCmd,
+			"up":        upCmd,
+			"state":     someCmd,
 		},
 			configuration,
 			usage,
@@ -39,6 +42,7 @@ var _ = Describe("App", func() {
 		helpCmd = &fakes.Command{}
 		versionCmd = &fakes.Command{}
 		errorCmd = &fakes.Command{}
+		upCmd = &fakes.Command{}
 
 		someCmd = &fakes.Command{}
 		someCmd.ExecuteCall.PassState = true
@@ -225,4 +229,36 @@ var _ = Describe("App", func() {
 			})
 		})
 	})
+
+	Describe("LockingCommand", func() {
+		DescribeTable("returns the commands that modify the state directory", func(args []string, name string, locking bool) {
+			lockName, ok := application.LockingCommand(args)
+			Expect(ok).To(Equal(locking))
+			if locking {
+				Expect(lockName).To(Equal(name))
+			}
+		},
+			Entry("up", []string{"up", "--name", "some-env"}, "up", true),
+			Entry("a subcommand", []string{"state", "rollback", "some-id"}, "state rollback", true),
+			Entry("state import", []string{"state", "import", "some-bundle.tgz"}, "state import", true),
+			Entry("a read only subcommand", []string{"state", "history"}, "", false),
+			Entry("a read only command", []string{"outputs"}, "", false),
+			Entry("help for a command", []string{"up", "--help"}, "", false),
+			Entry("no command", []string{}, "", false),
+		)
+	})
+
+	Describe("StateCommand", func() {
+		DescribeTable("returns the commands that read the state", func(args []string, reads bool) {
+			Expect(application.StateCommand(args)).To(Equal(reads))
+		},
+			Entry("a command that modifies the state", []string{"up", "--name", "some-env"}, true),
+			Entry("a read only command", []string{"lbs"}, true),
+			Entry("a read only subcommand", []string{"state", "history"}, true),
+			Entry("help", []string{"help", "up"}, false),
+			Entry("help for a command", []string{"outputs", "--help"}, false),
+			Entry("version", []string{"version"}, false),
+			Entry("no command", []string{}, false),
+		)
+	})
 })
@@ -168,3 +168,28 @@ func (c Client) GetVPC(vpcName string) (*string, error) {
 
 	return vpcs.Vpcs[0].VpcId, nil
 }
+
+// VMExists reports whether the instance with the given id exists and has
+// not been terminated.
+func (c Client) VMExists(envID, cid string) (bool, error) {
+	output, err := c.ec2Client.DescribeInstances(&awsec2.DescribeInstancesInput{
+		Filters: []*awsec2.Filter{{
+			Name:   awslib.String("instance-id"),
+			Values: []*string{awslib.String(cid)},
+		}},
+	})
+	if err != nil {
+		return false, fmt.Errorf("Describe instance %s: %s", cid, err)
+	}
+
+	for _, reservation := range output.Reservations {
+		for _, instance := range reservation.Instances {
+			if instance.State != nil && awslib.StringValue(instance.State.Name) == awsec2.InstanceStateNameTerminated {
+				continue
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
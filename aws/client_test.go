@@ -267,6 +267,60 @@ var _ = Describe("Client", func() {
 			})
 		})
 	})
+
+	Describe("VMExists", func() {
+		var (
+			client    aws.Client
+			ec2Client *fakes.AWSEC2Client
+		)
+
+		BeforeEach(func() {
+			ec2Client = &fakes.AWSEC2Client{}
+			client = aws.NewClientWithInjectedEC2Client(ec2Client, &fakes.Logger{})
+		})
+
+		It("looks up the instance by id", func() {
+			ec2Client.DescribeInstancesCall.Returns.Output = &awsec2.DescribeInstancesOutput{
+				Reservations: []*awsec2.Reservation{reservationContainingInstance("bosh/0")},
+			}
+
+			exists, err := client.VMExists("some-env-id", "i-some-cid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+
+			Expect(ec2Client.DescribeInstancesCall.Receives.Input).To(Equal(&awsec2.DescribeInstancesInput{
+				Filters: []*awsec2.Filter{{
+					Name:   awslib.String("instance-id"),
+					Values: []*string{awslib.String("i-some-cid")},
+				}},
+			}))
+		})
+
+		Context("when the instance has been terminated", func() {
+			It("returns false", func() {
+				ec2Client.DescribeInstancesCall.Returns.Output = &awsec2.DescribeInstancesOutput{
+					Reservations: []*awsec2.Reservation{{
+						Instances: []*awsec2.Instance{{
+							State: &awsec2.InstanceState{Name: awslib.String("terminated")},
+						}},
+					}},
+				}
+
+				exists, err := client.VMExists("some-env-id", "i-some-cid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(exists).To(BeFalse())
+			})
+		})
+
+		Context("when the describe instances call fails", func() {
+			It("returns an error", func() {
+				ec2Client.DescribeInstancesCall.Returns.Error = errors.New("kiwi")
+
+				_, err := client.VMExists("some-env-id", "i-some-cid")
+				Expect(err).To(MatchError("Describe instance i-some-cid: kiwi"))
+			})
+		})
+	})
 })
 
 func reservationContainingInstance(tag string) *awsec2.Reservation {
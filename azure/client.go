@@ -3,6 +3,7 @@ package azure
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
 	"github.com/Azure/go-autorest/autorest"
@@ -73,3 +74,44 @@ func (c Client) ValidateSafeToDelete(networkName string, envID string) error {
 
 	return nil
 }
+
+// VMExists reports whether the VM that the azure CPI identifies by cid
+// exists. The cid is either "agent_id:<name>;resource_group_name:<group>"
+// or "<storage account>:<name>", in which case the VM is looked up in the
+// resource group of the environment.
+func (c Client) VMExists(envID, cid string) (bool, error) {
+	resourceGroup := fmt.Sprintf("%s-bosh", envID)
+	name := cid[strings.LastIndex(cid, ":")+1:]
+
+	if strings.Contains(cid, ";") {
+		for _, part := range strings.Split(cid, ";") {
+			keyValue := strings.SplitN(part, ":", 2)
+			if len(keyValue) != 2 {
+				continue
+			}
+			switch keyValue[0] {
+			case "agent_id":
+				name = keyValue[1]
+			case "resource_group_name":
+				resourceGroup = keyValue[1]
+			}
+		}
+	}
+
+	instances, err := c.azureVMsClient.List(resourceGroup)
+	if err != nil {
+		return false, fmt.Errorf("List instances: %s", err)
+	}
+
+	if instances.Value == nil {
+		return false, nil
+	}
+
+	for _, instance := range *instances.Value {
+		if instance.Name != nil && *instance.Name == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -165,4 +165,48 @@ var _ = Describe("Client", func() {
 			})
 		})
 	})
+
+	Describe("VMExists", func() {
+		var (
+			azureVMsClient *fakes.AzureVMsClient
+			client         azure.Client
+		)
+
+		BeforeEach(func() {
+			azureVMsClient = &fakes.AzureVMsClient{}
+			client = azure.NewClientWithInjectedVMsClient(azureVMsClient)
+
+			name := "some-vm-name"
+			azureVMsClient.ListCall.Returns.Result = compute.VirtualMachineListResult{
+				Value: &[]compute.VirtualMachine{{Name: &name}},
+			}
+		})
+
+		It("looks for the vm in the resource group of the environment", func() {
+			exists, err := client.VMExists("some-env-id", "some-storage-account:some-vm-name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+
+			Expect(azureVMsClient.ListCall.Receives.ResourceGroup).To(Equal("some-env-id-bosh"))
+		})
+
+		Context("when the cid names the resource group", func() {
+			It("looks for the vm there", func() {
+				exists, err := client.VMExists("some-env-id", "agent_id:some-other-vm;resource_group_name:some-group")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(exists).To(BeFalse())
+
+				Expect(azureVMsClient.ListCall.Receives.ResourceGroup).To(Equal("some-group"))
+			})
+		})
+
+		Context("when listing the vms fails", func() {
+			It("returns an error", func() {
+				azureVMsClient.ListCall.Returns.Error = errors.New("kiwi")
+
+				_, err := client.VMExists("some-env-id", "some-storage-account:some-vm-name")
+				Expect(err).To(MatchError("List instances: kiwi"))
+			})
+		})
+	})
 })
@@ -7,7 +7,9 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 
 	"github.com/cloudfoundry/bosh-bootloader/application"
 	"github.com/cloudfoundry/bosh-bootloader/aws"
@@ -19,6 +21,8 @@ import (
 	"github.com/cloudfoundry/bosh-bootloader/config"
 	"github.com/cloudfoundry/bosh-bootloader/gcp"
 	"github.com/cloudfoundry/bosh-bootloader/helpers"
+	"github.com/cloudfoundry/bosh-bootloader/interrupt"
+	"github.com/cloudfoundry/bosh-bootloader/patches"
 	"github.com/cloudfoundry/bosh-bootloader/ssh"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 	"github.com/cloudfoundry/bosh-bootloader/terraform"
@@ -50,9 +54,8 @@ func main() {
 
 	logger := application.NewLogger(os.Stdout, os.Stdin)
 	stderrLogger := application.NewLogger(os.Stderr, os.Stdin)
-	stateBootstrap := storage.NewStateBootstrap(stderrLogger, Version)
 
-	globals, _, err := config.ParseArgs(os.Args)
+	globals, remainingArgs, err := config.ParseArgs(os.Args)
 	if err != nil {
 		log.Fatalf("\n\n%s\n", err)
 	}
@@ -62,24 +65,64 @@ func main() {
 
 	// File IO
 	fs := afero.NewOsFs()
-	afs := &afero.Afero{Fs: fs}
+	osFs := &afero.Afero{Fs: fs}
+
+	// State encryption
+	stateKey, err := config.GetStateKey(osFs)
+	if err != nil {
+		log.Fatalf("\n\n%s\n", err)
+	}
+	stateCipher := storage.NewCipher(stateKey)
+	afs := &afero.Afero{Fs: storage.NewEncryptedFs(fs, stateCipher)}
+	vault := storage.NewVault(globals.StateDir, osFs, stateCipher)
+
+	// State lock, taken before the state is pulled or migrated
+	stateLocker := storage.NewLocker(globals.StateDir, osFs, globals.LockTimeout)
+	lockName, locking := application.LockingCommand(remainingArgs)
+	locking = locking && !globals.Help
+	if locking {
+		err = stateLocker.Lock(lockName)
+		if err != nil {
+			log.Fatalf("\n\n%s\n", err)
+		}
+	}
+	fatalf := func(format string, v ...interface{}) {
+		if locking {
+			stateLocker.Unlock()
+		}
+		log.Fatalf(format, v...)
+	}
+
+	// State backend
+	stateBackend, err := config.GetStateBackend(globals, osFs)
+	if err != nil {
+		fatalf("\n\n%s\n", err)
+	}
+	if application.StateCommand(remainingArgs) && !globals.Help {
+		err = stateBackend.Pull(globals.StateDir)
+		if err != nil {
+			fatalf("\n\nPull state: %s\n", err)
+		}
+	}
 
 	// bbl Configuration
+	stateBootstrap := storage.NewStateBootstrap(stderrLogger, stateCipher, Version)
 	garbageCollector := storage.NewGarbageCollector(afs)
-	stateStore := storage.NewStore(globals.StateDir, afs, garbageCollector)
+	stateSnapshots := storage.NewSnapshots(globals.StateDir, afs, storage.SNAPSHOT_LIMIT)
+	stateStore := storage.NewStore(globals.StateDir, afs, garbageCollector, stateBackend, stateSnapshots)
 	stateMigrator := storage.NewMigrator(stateStore, afs)
 	newConfig := config.NewConfig(stateBootstrap, stateMigrator, stderrLogger, afs)
 
 	appConfig, err := newConfig.Bootstrap(os.Args)
 	if err != nil {
-		log.Fatalf("\n\n%s\n", err)
+		fatalf("\n\n%s\n", err)
 	}
 
-	needsIAASCreds := config.NeedsIAASCreds(appConfig.Command) && !appConfig.ShowCommandHelp
+	needsIAASCreds := config.NeedsIAASCreds(appConfig.Command, appConfig.SubcommandFlags) && !appConfig.ShowCommandHelp
 	if needsIAASCreds {
 		err = config.ValidateIAAS(appConfig.State)
 		if err != nil {
-			log.Fatal(err)
+			fatalf("%s", err)
 		}
 	}
 
@@ -89,6 +132,8 @@ func main() {
 	certificateValidator := certs.NewValidator()
 	lbArgsHandler := commands.NewLBArgsHandler(certificateValidator)
 	sshCmd := ssh.NewCmd(os.Stdin, os.Stdout, os.Stderr)
+	importedStateLoader := config.NewStateLoader(newConfig, os.Args)
+	stateBundler := storage.NewBundler(afs, stateCipher, Version)
 
 	// Terraform
 	terraformOutputBuffer := bytes.NewBuffer([]byte{})
@@ -106,17 +151,17 @@ func main() {
 		terraformCmd = bufferingCmd
 		out = ioutil.Discard
 	}
-	terraformExecutor := terraform.NewExecutor(terraformCmd, bufferingCmd, stateStore, afs, appConfig.Global.Debug, out)
+	terraformExecutor := terraform.NewExecutor(terraformCmd, bufferingCmd, stateStore, afs, vault, appConfig.Global.Debug, out)
 
 	// BOSH
 	hostKey := proxy.NewHostKey()
 	socks5Proxy := proxy.NewSocks5Proxy(hostKey, nil)
 	boshPath, err := config.GetBOSHPath()
 	if err != nil {
-		log.Fatal(err)
+		fatalf("%s", err)
 	}
 	boshCommand := bosh.NewCmd(os.Stderr, boshPath)
-	boshExecutor := bosh.NewExecutor(boshCommand, afs)
+	boshExecutor := bosh.NewExecutor(boshCommand, afs, vault)
 	sshKeyGetter := bosh.NewSSHKeyGetter(stateStore, afs)
 	allProxyGetter := bosh.NewAllProxyGetter(sshKeyGetter, afs)
 	credhubGetter := bosh.NewCredhubGetter(stateStore, afs)
@@ -127,6 +172,7 @@ func main() {
 	var (
 		networkClient            helpers.NetworkClient
 		networkDeletionValidator commands.NetworkDeletionValidator
+		vmChecker                commands.VMChecker
 
 		availabilityZoneRetriever aws.AvailabilityZoneRetriever
 		leftovers                 commands.FilteredDeleter
@@ -139,51 +185,57 @@ func main() {
 			availabilityZoneRetriever = awsClient
 			networkDeletionValidator = awsClient
 			networkClient = awsClient
+			vmChecker = awsClient
 
 			leftovers, err = awsleftovers.NewLeftovers(logger, appConfig.State.AWS.AccessKeyID, appConfig.State.AWS.SecretAccessKey, appConfig.State.AWS.Region)
 			if err != nil {
-				log.Fatalf("\n\n%s\n", err)
+				fatalf("\n\n%s\n", err)
 			}
 
 		case "gcp":
 			gcpClient, err := gcp.NewClient(appConfig.State.GCP, "")
 			if err != nil {
-				log.Fatalf("\n\n%s\n", err)
+				fatalf("\n\n%s\n", err)
 			}
 
-			networkDeletionValidator = gcpClient
-			networkClient = gcpClient
-
 			gcpZonerHack := config.NewGCPZonerHack(gcpClient)
 			stateWithZones, err := gcpZonerHack.SetZones(appConfig.State)
 			if err != nil {
-				log.Fatalf("\n\n%s\n", err)
+				fatalf("\n\n%s\n", err)
 			}
 			appConfig.State = stateWithZones
 
+			// The jumpbox and director are deployed to the zone that the
+			// zoner hack settles on, which a new state does not have yet.
+			gcpClient = gcpClient.WithZone(appConfig.State.GCP.Zone)
+			networkDeletionValidator = gcpClient
+			networkClient = gcpClient
+			vmChecker = gcpClient
+
 			leftovers, err = gcpleftovers.NewLeftovers(logger, appConfig.State.GCP.ServiceAccountKeyPath)
 			if err != nil {
-				log.Fatalf("\n\n%s\n", err)
+				fatalf("\n\n%s\n", err)
 			}
 
 		case "azure":
 			azureClient, err := azure.NewClient(appConfig.State.Azure)
 			if err != nil {
-				log.Fatalf("\n\n%s\n", err)
+				fatalf("\n\n%s\n", err)
 			}
 
 			networkDeletionValidator = azureClient
 			networkClient = azureClient
+			vmChecker = azureClient
 
 			leftovers, err = azureleftovers.NewLeftovers(logger, appConfig.State.Azure.ClientID, appConfig.State.Azure.ClientSecret, appConfig.State.Azure.SubscriptionID, appConfig.State.Azure.TenantID)
 			if err != nil {
-				log.Fatalf("\n\n%s\n", err)
+				fatalf("\n\n%s\n", err)
 			}
 		case "vsphere":
 			vSphereLogger := application.NewLogger(os.Stdout, os.Stdin)
 			leftovers, err = vsphereleftovers.NewLeftovers(vSphereLogger, appConfig.State.VSphere.VCenterIP, appConfig.State.VSphere.VCenterUser, appConfig.State.VSphere.VCenterPassword, appConfig.State.VSphere.VCenterDC)
 			if err != nil {
-				log.Fatalf("\n\n%s\n", err)
+				fatalf("\n\n%s\n", err)
 			}
 		}
 	}
@@ -192,6 +244,7 @@ func main() {
 	var (
 		inputGenerator    terraform.InputGenerator
 		templateGenerator terraform.TemplateGenerator
+		outputContract    terraform.OutputContract
 
 		terraformManager        terraform.Manager
 		cloudConfigOpsGenerator cloudconfig.OpsGenerator
@@ -202,17 +255,19 @@ func main() {
 	case "aws":
 		templateGenerator = awsterraform.NewTemplateGenerator()
 		inputGenerator = awsterraform.NewInputGenerator(availabilityZoneRetriever)
+		outputContract = awsterraform.NewOutputContract()
 
-		terraformManager = terraform.NewManager(terraformExecutor, templateGenerator, inputGenerator, terraformOutputBuffer, logger)
+		terraformManager = terraform.NewManager(terraformExecutor, templateGenerator, inputGenerator, outputContract, terraformOutputBuffer, logger)
 
-		cloudConfigOpsGenerator = awscloudconfig.NewOpsGenerator(terraformManager, availabilityZoneRetriever)
+		cloudConfigOpsGenerator = awscloudconfig.NewOpsGenerator(terraformManager, availabilityZoneRetriever, outputContract)
 
 		lbsCmd = commands.NewAWSLBs(terraformManager, logger)
 	case "azure":
 		templateGenerator = azureterraform.NewTemplateGenerator()
 		inputGenerator = azureterraform.NewInputGenerator()
+		outputContract = azureterraform.NewOutputContract()
 
-		terraformManager = terraform.NewManager(terraformExecutor, templateGenerator, inputGenerator, terraformOutputBuffer, logger)
+		terraformManager = terraform.NewManager(terraformExecutor, templateGenerator, inputGenerator, outputContract, terraformOutputBuffer, logger)
 
 		cloudConfigOpsGenerator = azurecloudconfig.NewOpsGenerator(terraformManager)
 
@@ -220,8 +275,9 @@ func main() {
 	case "gcp":
 		templateGenerator = gcpterraform.NewTemplateGenerator()
 		inputGenerator = gcpterraform.NewInputGenerator()
+		outputContract = gcpterraform.NewOutputContract()
 
-		terraformManager = terraform.NewManager(terraformExecutor, templateGenerator, inputGenerator, terraformOutputBuffer, logger)
+		terraformManager = terraform.NewManager(terraformExecutor, templateGenerator, inputGenerator, outputContract, terraformOutputBuffer, logger)
 
 		cloudConfigOpsGenerator = gcpcloudconfig.NewOpsGenerator(terraformManager)
 
@@ -229,16 +285,18 @@ func main() {
 	case "vsphere":
 		templateGenerator = vsphereterraform.NewTemplateGenerator()
 		inputGenerator = vsphereterraform.NewInputGenerator()
+		outputContract = vsphereterraform.NewOutputContract()
 
-		terraformManager = terraform.NewManager(terraformExecutor, templateGenerator, inputGenerator, terraformOutputBuffer, logger)
+		terraformManager = terraform.NewManager(terraformExecutor, templateGenerator, inputGenerator, outputContract, terraformOutputBuffer, logger)
 
 		cloudConfigOpsGenerator = vspherecloudconfig.NewOpsGenerator(terraformManager)
 
 	case "openstack":
 		templateGenerator = openstackterraform.NewTemplateGenerator()
 		inputGenerator = openstackterraform.NewInputGenerator()
+		outputContract = openstackterraform.NewOutputContract()
 
-		terraformManager = terraform.NewManager(terraformExecutor, templateGenerator, inputGenerator, terraformOutputBuffer, logger)
+		terraformManager = terraform.NewManager(terraformExecutor, templateGenerator, inputGenerator, outputContract, terraformOutputBuffer, logger)
 
 		cloudConfigOpsGenerator = openstackcloudconfig.NewOpsGenerator(terraformManager)
 	}
@@ -250,14 +308,19 @@ func main() {
 	if appConfig.State.IAAS != "" {
 		envIDManager = helpers.NewEnvIDManager(envIDGenerator, networkClient)
 	}
-	plan := commands.NewPlan(boshManager, cloudConfigManager, stateStore, envIDManager, terraformManager, lbArgsHandler, stderrLogger, Version)
-	up := commands.NewUp(plan, boshManager, cloudConfigManager, stateStore, terraformManager)
+	builtinPatches := patches.NewBuiltin()
+	patcher := storage.NewPatcher(afs, builtinPatches)
+	overrideTracker := storage.NewOverrideTracker(afs)
+	deploymentSource := bosh.NewDeploymentSource(afs)
+	plan := commands.NewPlan(boshManager, cloudConfigManager, stateStore, envIDManager, terraformManager, lbArgsHandler, patcher, overrideTracker, deploymentSource, stderrLogger, Version)
+	fingerprinter := storage.NewFingerprinter(globals.StateDir, afs)
+	up := commands.NewUp(plan, boshManager, cloudConfigManager, stateStore, terraformManager, fingerprinter, logger)
 	usage := commands.NewUsage(logger)
 
 	commandSet := application.CommandSet{}
 	commandSet["help"] = usage
 	commandSet["version"] = commands.NewVersion(Version, logger)
-	commandSet["outputs"] = commands.NewOutputs(logger, terraformManager, stateValidator)
+	commandSet["outputs"] = commands.NewOutputs(logger, terraformManager, outputContract, stateValidator)
 	commandSet["up"] = up
 	commandSet["plan"] = plan
 	sshKeyDeleter := bosh.NewSSHKeyDeleter(stateStore, afs)
@@ -279,11 +342,48 @@ func main() {
 	commandSet["latest-error"] = commands.NewLatestError(logger, stateValidator)
 	commandSet["print-env"] = commands.NewPrintEnv(logger, stderrLogger, stateValidator, allProxyGetter, credhubGetter, terraformManager, afs)
 	commandSet["ssh"] = commands.NewSSH(sshCmd, sshKeyGetter, afs, ssh.RandomPort{})
+	commandSet["force-unlock"] = commands.NewForceUnlock(logger, stateLocker)
+	commandSet["migrate"] = commands.NewMigrate(logger, stateValidator, stateMigrator)
+	commandSet["drift"] = commands.NewDrift(logger, stateValidator, terraformManager, vmChecker, stateStore, afs)
+	commandSet["state"] = commands.NewCommandGroup("state", commands.StateCommandUsage, map[string]commands.Command{
+		"encrypt":  commands.NewStateEncrypt(logger, stateValidator, vault),
+		"decrypt":  commands.NewStateDecrypt(logger, stateValidator, vault),
+		"history":  commands.NewStateHistory(logger, stateValidator, stateSnapshots),
+		"diff":     commands.NewStateDiff(logger, stateValidator, stateSnapshots),
+		"rollback": commands.NewStateRollback(logger, stateValidator, stateSnapshots, stateBootstrap, stateStore),
+		"export":   commands.NewStateExport(logger, stateValidator, stateStore, stateBundler, osFs),
+		"import":   commands.NewStateImport(logger, importedStateLoader, stateStore, stateBundler, osFs, plan),
+	})
+	commandSet["patches"] = commands.NewCommandGroup("patches", commands.PatchesCommandUsage, map[string]commands.Command{
+		"list":      commands.NewPatchesList(logger, stateValidator, stateStore, patcher),
+		"available": commands.NewPatchesAvailable(logger, builtinPatches),
+		"remove":    commands.NewPatchesRemove(logger, stateValidator, stateStore, patcher),
+	})
+	commandSet["overrides"] = commands.NewCommandGroup("overrides", commands.OverridesCommandUsage, map[string]commands.Command{
+		"diff": commands.NewOverridesDiff(logger, stateValidator, stateStore, afs),
+	})
 
 	app := application.New(commandSet, appConfig, usage)
 
+	switch appConfig.Command {
+	case "up", "destroy", "down", "rotate":
+		signals := make(chan os.Signal, 2)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		interrupt.Listen(signals, interrupt.DefaultTimeout, os.Stderr, func() { signal.Stop(signals) })
+	}
+
 	err = app.Run()
 	if err != nil {
-		log.Fatalf("\n\n%s\n", err)
+		if interrupt.Interrupted() {
+			fatalf("\n\n%s\n\nbbl saved the state it had when it was interrupted. Run bbl %s again to resume.\n", err, appConfig.Command)
+		}
+		fatalf("\n\n%s\n", err)
+	}
+
+	if locking {
+		err = stateLocker.Unlock()
+		if err != nil {
+			log.Fatalf("\n\nUnlock state dir: %s\n", err)
+		}
 	}
 }
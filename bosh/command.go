@@ -3,6 +3,8 @@ package bosh
 import (
 	"io"
 	"os/exec"
+
+	"github.com/cloudfoundry/bosh-bootloader/interrupt"
 )
 
 type Cmd struct {
@@ -24,7 +26,7 @@ func (c Cmd) Run(stdout io.Writer, workingDirectory string, args []string) error
 	command.Stdout = stdout
 	command.Stderr = c.stderr
 
-	return command.Run()
+	return interrupt.Run(command)
 }
 
 func (c Cmd) GetBOSHPath() string {
@@ -0,0 +1,185 @@
+package bosh
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+)
+
+// BuiltInDeployment given as --jumpbox-deployment-dir or --bosh-deployment-dir
+// goes back to the deployment that is built into bbl.
+const BuiltInDeployment = "builtin"
+
+type deploymentSourceFs interface {
+	fileio.FileReader
+	fileio.Stater
+}
+
+// DeploymentSource reads a bosh-deployment or jumpbox-deployment checkout
+// that bbl plan was given to use instead of the one built into bbl.
+type DeploymentSource struct {
+	fs deploymentSourceFs
+}
+
+func NewDeploymentSource(fs deploymentSourceFs) DeploymentSource {
+	return DeploymentSource{
+		fs: fs,
+	}
+}
+
+// Check fails when dir does not have the manifest of the deployment.
+func (d DeploymentSource) Check(dir, manifest string) error {
+	_, err := d.fs.Stat(filepath.Join(dir, manifest))
+	if err != nil {
+		return fmt.Errorf("%s does not have %s: %s", dir, manifest, err)
+	}
+	return nil
+}
+
+// Revision is the commit that the git checkout in dir is at, or empty when
+// dir is not a git checkout.
+func (d DeploymentSource) Revision(dir string) (string, error) {
+	head, err := d.readGitFile(dir, "HEAD")
+	if err != nil || head == "" {
+		return "", err
+	}
+
+	if !strings.HasPrefix(head, "ref: ") {
+		return head, nil
+	}
+	ref := strings.TrimPrefix(head, "ref: ")
+
+	revision, err := d.readGitFile(dir, ref)
+	if err != nil || revision != "" {
+		return revision, err
+	}
+
+	packedRefs, err := d.readGitFile(dir, "packed-refs")
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(packedRefs, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[1] == ref {
+			return fields[0], nil
+		}
+	}
+
+	return "", nil
+}
+
+func (d DeploymentSource) readGitFile(dir, name string) (string, error) {
+	contents, err := d.fs.ReadFile(filepath.Join(dir, ".git", filepath.FromSlash(name)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("Read git %s: %s", name, err)
+	}
+	return strings.TrimSpace(string(contents)), nil
+}
+
+// getDeploymentFiles are the files of the deployment in repo that bbl
+// writes into the deployment dir: the ones built into bbl, or the ones in
+// sourceDir when bbl plan was given a checkout of the deployment.
+func (e Executor) getDeploymentFiles(repo, sourceDir, deploymentDir string) ([]setupFile, error) {
+	if sourceDir == "" {
+		return e.getSetupFiles(repo, deploymentDir), nil
+	}
+	return e.getSourceDirFiles(sourceDir, "", deploymentDir)
+}
+
+// clearDeploymentDir removes the files that an earlier bbl plan copied into
+// the deployment dir, so that the files a newer deployment no longer has do
+// not stay behind.
+func (e Executor) clearDeploymentDir(deployment, sourceDir, deploymentDir string) error {
+	if sourceDir != "" && filepath.Clean(sourceDir) == filepath.Clean(deploymentDir) {
+		return fmt.Errorf("%s is the copy of the %s that bbl plan writes. Give bbl plan a checkout outside of the state directory.", sourceDir, deployment)
+	}
+
+	infos, err := e.fs.ReadDir(deploymentDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, info := range infos {
+		err = e.fs.RemoveAll(filepath.Join(deploymentDir, info.Name()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getSourceDirFiles reads the files under dir in sourceDir, leaving out
+// hidden files and dirs such as .git.
+func (e Executor) getSourceDirFiles(sourceDir, dir, deploymentDir string) ([]setupFile, error) {
+	infos, err := e.fs.ReadDir(filepath.Join(sourceDir, dir))
+	if err != nil {
+		return nil, err
+	}
+
+	files := []setupFile{}
+	for _, info := range infos {
+		if strings.HasPrefix(info.Name(), ".") {
+			continue
+		}
+
+		name := filepath.Join(dir, info.Name())
+		if info.IsDir() {
+			dirFiles, err := e.getSourceDirFiles(sourceDir, name, deploymentDir)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, dirFiles...)
+			continue
+		}
+
+		contents, err := e.fs.ReadFile(filepath.Join(sourceDir, name))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, setupFile{
+			source:   name,
+			dest:     filepath.Join(deploymentDir, name),
+			contents: contents,
+		})
+	}
+
+	return files, nil
+}
+
+// checkSourceDirFiles fails when a file in the deployment dir that bbl passes
+// to create-env is not in the checkout of the deployment, unless it is one of
+// the generated files that bbl writes there itself.
+func checkSourceDirFiles(deployment, sourceDir, deploymentDir string, setupFiles []setupFile, used, generated []string) error {
+	written := map[string]bool{}
+	for _, f := range setupFiles {
+		written[f.dest] = true
+	}
+	for _, name := range generated {
+		written[filepath.Join(deploymentDir, name)] = true
+	}
+
+	missing := []string{}
+	for _, f := range used {
+		if !strings.HasPrefix(f, deploymentDir+string(filepath.Separator)) || written[f] {
+			continue
+		}
+		rel, _ := filepath.Rel(deploymentDir, f)
+		missing = append(missing, filepath.ToSlash(rel))
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("The %s in %s does not have %s, which bbl uses.", deployment, sourceDir, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package bosh_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeploymentSource", func() {
+	var (
+		fs               *afero.Afero
+		dir              string
+		deploymentSource bosh.DeploymentSource
+	)
+
+	BeforeEach(func() {
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+		dir = "/some/bosh-deployment"
+		fs.MkdirAll(filepath.Join(dir, ".git", "refs", "heads"), os.ModePerm)
+
+		deploymentSource = bosh.NewDeploymentSource(fs)
+	})
+
+	Describe("Check", func() {
+		It("returns an error when the dir does not have the manifest", func() {
+			err := deploymentSource.Check(dir, "bosh.yml")
+			Expect(err).To(MatchError(ContainSubstring("/some/bosh-deployment does not have bosh.yml: ")))
+
+			fs.WriteFile(filepath.Join(dir, "bosh.yml"), []byte("manifest"), os.ModePerm)
+			err = deploymentSource.Check(dir, "bosh.yml")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("Revision", func() {
+		It("returns the commit of a detached head", func() {
+			fs.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("some-revision\n"), os.ModePerm)
+
+			revision, err := deploymentSource.Revision(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision).To(Equal("some-revision"))
+		})
+
+		It("returns the commit of the checked out branch", func() {
+			fs.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("ref: refs/heads/master\n"), os.ModePerm)
+			fs.WriteFile(filepath.Join(dir, ".git", "refs", "heads", "master"), []byte("branch-revision\n"), os.ModePerm)
+
+			revision, err := deploymentSource.Revision(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision).To(Equal("branch-revision"))
+		})
+
+		It("looks the branch up in the packed refs", func() {
+			fs.WriteFile(filepath.Join(dir, ".git", "HEAD"), []byte("ref: refs/heads/master\n"), os.ModePerm)
+			fs.WriteFile(filepath.Join(dir, ".git", "packed-refs"), []byte("# pack-refs with: peeled\nother-revision refs/heads/other\npacked-revision refs/heads/master\n"), os.ModePerm)
+
+			revision, err := deploymentSource.Revision(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision).To(Equal("packed-revision"))
+		})
+
+		It("returns no revision when the dir is not a git checkout", func() {
+			revision, err := deploymentSource.Revision("/some/other-dir")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision).To(BeEmpty())
+		})
+
+		Context("when HEAD cannot be read", func() {
+			It("returns an error", func() {
+				fakeFs := &fakes.FileIO{}
+				fakeFs.ReadFileCall.Returns.Error = errors.New("banana")
+
+				_, err := bosh.NewDeploymentSource(fakeFs).Revision(dir)
+				Expect(err).To(MatchError("Read git HEAD: banana"))
+			})
+		})
+	})
+
+	It("has the revisions of the built in deployments from deployment-versions.txt", func() {
+		versions, err := ioutil.ReadFile("../deployment-versions.txt")
+		Expect(err).NotTo(HaveOccurred())
+
+		lines := strings.Split(strings.TrimSpace(string(versions)), "\n")
+		Expect(lines).To(ConsistOf(
+			"- *Current jumpbox-deployment: "+bosh.JumpboxDeploymentVersion+"*",
+			"- *Current bosh-deployment: "+bosh.BOSHDeploymentVersion+"*",
+		))
+	})
+})
@@ -0,0 +1,278 @@
+package bosh
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// NoDirectorFeatures turns off every director feature when it is given as
+// the only --director-feature.
+const NoDirectorFeatures = "none"
+
+// directorFeature is a director customization: the bosh-deployment ops files
+// that turn it on, or the ops file that bbl generates for it when
+// bosh-deployment has none, and the vars those ops files need.
+type directorFeature struct {
+	name      string
+	opsFiles  []string
+	generated string
+	vars      []string
+	iaas      string
+}
+
+// directorFeatures are listed in the order their ops files are applied, so
+// that the order they were given in does not change the create-env arguments.
+var directorFeatures = []directorFeature{
+	{
+		name:     "dns-servers",
+		opsFiles: []string{"misc/dns.yml"},
+		vars:     []string{"internal_dns"},
+	},
+	{
+		name:     "local-dns",
+		opsFiles: []string{"local-dns.yml"},
+	},
+	{
+		name:     "syslog",
+		opsFiles: []string{"syslog.yml"},
+		vars:     []string{"syslog_address", "syslog_port", "syslog_transport"},
+	},
+	{
+		name:     "bpm",
+		opsFiles: []string{"experimental/bpm.yml"},
+	},
+	{
+		name:     "external-db",
+		opsFiles: []string{"misc/external-db.yml"},
+		vars:     []string{"external_db_host", "external_db_port", "external_db_user", "external_db_password", "external_db_adapter", "external_db_name"},
+	},
+	{
+		name:     "gcs-blobstore",
+		opsFiles: []string{"gcp/gcs-blobstore.yml"},
+		vars:     []string{"bucket_name", "director_gcs_credentials_json", "agent_gcs_credentials_json"},
+		iaas:     "gcp",
+	},
+	{
+		name:      "s3-blobstore",
+		generated: S3BlobstoreOps,
+		vars:      []string{"blobstore_bucket_name", "blobstore_access_key_id", "blobstore_secret_access_key", "blobstore_region", "blobstore_host"},
+	},
+	{
+		name:      "prometheus-exporter",
+		generated: PrometheusExporterOps,
+	},
+}
+
+func DirectorFeatureNames() []string {
+	names := []string{}
+	for _, feature := range directorFeatures {
+		names = append(names, feature.name)
+	}
+	return names
+}
+
+// ValidateDirectorFeatures checks that bbl knows every feature and that the
+// features can be used on iaas.
+func ValidateDirectorFeatures(features []string, iaas string) error {
+	for _, name := range features {
+		if name == NoDirectorFeatures {
+			if len(features) > 1 {
+				return fmt.Errorf("--director-feature %s cannot be combined with other director features", NoDirectorFeatures)
+			}
+			continue
+		}
+
+		feature, ok := findDirectorFeature(name)
+		if !ok {
+			return fmt.Errorf("Unknown director feature %s. The director features are: %s.", name, strings.Join(DirectorFeatureNames(), ", "))
+		}
+
+		if feature.iaas != "" && iaas != "" && feature.iaas != iaas {
+			return fmt.Errorf("Director feature %s only works on %s.", name, feature.iaas)
+		}
+	}
+
+	return nil
+}
+
+func findDirectorFeature(name string) (directorFeature, bool) {
+	for _, feature := range directorFeatures {
+		if feature.name == name {
+			return feature, true
+		}
+	}
+	return directorFeature{}, false
+}
+
+// enabledDirectorFeatures returns the features that are in names.
+func enabledDirectorFeatures(names []string) []directorFeature {
+	features := []directorFeature{}
+	for _, feature := range directorFeatures {
+		for _, name := range names {
+			if feature.name == name {
+				features = append(features, feature)
+				break
+			}
+		}
+	}
+	return features
+}
+
+func (e Executor) getDirectorFeatureOpsFiles(stateDir, deploymentDir, iaas string, names []string) []string {
+	files := []string{}
+	for _, feature := range enabledDirectorFeatures(names) {
+		if feature.generated != "" {
+			files = append(files, directorFeatureOpsFile(stateDir, iaas, feature.name))
+		}
+		for _, opsFile := range feature.opsFiles {
+			files = append(files, filepath.Join(deploymentDir, filepath.FromSlash(opsFile)))
+		}
+	}
+	return files
+}
+
+// getDirectorFeatureSetupFiles are the ops files that bbl generates for the
+// features in names.
+func (e Executor) getDirectorFeatureSetupFiles(stateDir, iaas string, names []string) []setupFile {
+	files := []setupFile{}
+	for _, feature := range enabledDirectorFeatures(names) {
+		if feature.generated == "" {
+			continue
+		}
+		files = append(files, setupFile{
+			source:   filepath.Join(boshDeploymentRepo, iaas, directorFeatureOpsFileName(feature.name)),
+			dest:     directorFeatureOpsFile(stateDir, iaas, feature.name),
+			contents: []byte(feature.generated),
+		})
+	}
+	return files
+}
+
+// removeDisabledDirectorFeatureOpsFiles removes the ops files that bbl
+// generated for features that are no longer in names.
+func (e Executor) removeDisabledDirectorFeatureOpsFiles(stateDir, iaas string, names []string) error {
+	enabled := map[string]bool{}
+	for _, feature := range enabledDirectorFeatures(names) {
+		enabled[feature.name] = true
+	}
+
+	for _, feature := range directorFeatures {
+		if feature.generated == "" || enabled[feature.name] {
+			continue
+		}
+		err := e.fs.RemoveAll(directorFeatureOpsFile(stateDir, iaas, feature.name))
+		if err != nil {
+			return fmt.Errorf("Director remove %s ops file: %s", feature.name, err)
+		}
+	}
+	return nil
+}
+
+// directorFeatureOpsFile is where bbl generates the ops file of a feature,
+// with the other ops files it generates for the director.
+func directorFeatureOpsFile(stateDir, iaas, name string) string {
+	return filepath.Join(stateDir, "bbl-ops-files", iaas, directorFeatureOpsFileName(name))
+}
+
+func directorFeatureOpsFileName(name string) string {
+	return fmt.Sprintf("director-%s-ops.yml", name)
+}
+
+// CheckDirectorFeatureVars fails when a director feature needs a var that
+// the create-env script of the director does not set, so that bbl up stops
+// before create-env rather than part way through it. The vars come from the
+// -v, --var and --var-file flags of the script and from the vars files it
+// passes, which are the vars store, the vars file bbl generates and the
+// files in the director-vars dir of the state dir.
+func (e Executor) CheckDirectorFeatureVars(input DirInput) error {
+	features := enabledDirectorFeatures(input.DirectorFeatures)
+	if len(features) == 0 {
+		return nil
+	}
+
+	script := e.createEnvScript(input.StateDir, "director")
+	contents, err := e.fs.ReadFile(script)
+	if err != nil {
+		return fmt.Errorf("Read %s: %s", filepath.Base(script), err)
+	}
+
+	set, varsFiles := scriptVars(string(contents), input.StateDir)
+
+	for _, f := range varsFiles {
+		contents, err := e.fs.ReadFile(f)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return fmt.Errorf("Read vars file: %s", err)
+		}
+
+		vars := map[string]interface{}{}
+		err = yaml.Unmarshal(contents, &vars)
+		if err != nil {
+			return fmt.Errorf("Parse vars file %s: %s", filepath.Base(f), err)
+		}
+
+		for name := range vars {
+			set[name] = true
+		}
+	}
+
+	problems := []string{}
+	for _, feature := range features {
+		missing := []string{}
+		for _, name := range feature.vars {
+			if !set[name] {
+				missing = append(missing, name)
+			}
+		}
+
+		if len(missing) > 0 {
+			problems = append(problems, fmt.Sprintf("%s needs %s", feature.name, strings.Join(missing, ", ")))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("Director feature %s. Set them in a vars file in the director-vars directory of the state directory.", strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+// scriptVars reads the flags of a create-env script, returning the names of
+// the vars it sets and the vars files it passes. ${BBL_STATE_DIR} in a path
+// is the state dir, and files that do not exist yet are left for the caller
+// to skip.
+func scriptVars(script, stateDir string) (map[string]bool, []string) {
+	expand := strings.NewReplacer("${BBL_STATE_DIR}", stateDir, "$BBL_STATE_DIR", stateDir)
+
+	set := map[string]bool{}
+	varsFiles := []string{}
+
+	args := strings.Fields(script)
+	for i, arg := range args {
+		flag, value := arg, ""
+		if strings.HasPrefix(arg, "--") && strings.Contains(arg, "=") {
+			parts := strings.SplitN(arg, "=", 2)
+			flag, value = parts[0], parts[1]
+		} else if i+1 < len(args) {
+			value = args[i+1]
+		}
+		value = strings.Trim(value, `"'`)
+
+		switch flag {
+		case "-l", "--vars-file", "--vars-store":
+			varsFiles = append(varsFiles, expand.Replace(value))
+		case "-v", "--var", "--var-file":
+			if name := strings.SplitN(value, "=", 2)[0]; name != "" {
+				set[name] = true
+			}
+		}
+	}
+
+	return set, varsFiles
+}
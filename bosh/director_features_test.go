@@ -0,0 +1,41 @@
+package bosh_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateDirectorFeatures", func() {
+	It("accepts the known director features", func() {
+		err := bosh.ValidateDirectorFeatures([]string{"syslog", "external-db", "gcs-blobstore"}, "gcp")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("accepts none on its own", func() {
+		err := bosh.ValidateDirectorFeatures([]string{"none"}, "aws")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when a feature is unknown", func() {
+		It("returns an error listing the features", func() {
+			err := bosh.ValidateDirectorFeatures([]string{"prometheus"}, "aws")
+			Expect(err).To(MatchError("Unknown director feature prometheus. The director features are: dns-servers, local-dns, syslog, bpm, external-db, gcs-blobstore, s3-blobstore, prometheus-exporter."))
+		})
+	})
+
+	Context("when a feature is for another iaas", func() {
+		It("returns an error", func() {
+			err := bosh.ValidateDirectorFeatures([]string{"gcs-blobstore"}, "aws")
+			Expect(err).To(MatchError("Director feature gcs-blobstore only works on gcp."))
+		})
+	})
+
+	Context("when none is combined with other features", func() {
+		It("returns an error", func() {
+			err := bosh.ValidateDirectorFeatures([]string{"none", "bpm"}, "aws")
+			Expect(err).To(MatchError("--director-feature none cannot be combined with other director features"))
+		})
+	})
+})
@@ -9,9 +9,11 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/cloudfoundry/bosh-bootloader/fileio"
+	"github.com/cloudfoundry/bosh-bootloader/interrupt"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 )
 
@@ -19,17 +21,39 @@ type executorFs interface {
 	fileio.FileReader
 	fileio.FileWriter
 	fileio.Stater
+	fileio.DirReader
+	fileio.FileOpener
+	fileio.AllRemover
+	fileio.AllMkdirer
 }
 
 type Executor struct {
-	command command
-	fs      executorFs
+	command       command
+	fs            executorFs
+	vault         vault
+	offlineAssets offlineAssetCache
 }
 
 type DirInput struct {
 	StateDir   string
 	VarsDir    string
 	Deployment string
+
+	// DirectorFeatures are the director features from the state, which
+	// add ops files to the director deployment.
+	DirectorFeatures []string
+
+	// Sizing is the VM type and disk sizes from the state for the
+	// jumpbox or the director.
+	Sizing storage.Sizing
+
+	// SourceDir is the jumpbox-deployment or bosh-deployment checkout to
+	// plan with instead of the one built into bbl.
+	SourceDir string
+
+	// OfflineAssetsDir has the release and stemcell tarballs that
+	// create-env uses instead of downloading them.
+	OfflineAssetsDir string
 }
 
 type command interface {
@@ -37,6 +61,11 @@ type command interface {
 	Run(stdout io.Writer, workingDirectory string, args []string) error
 }
 
+type vault interface {
+	Unseal() error
+	Seal() error
+}
+
 type setupFile struct {
 	source   string
 	dest     string
@@ -46,12 +75,21 @@ type setupFile struct {
 var (
 	jumpboxDeploymentRepo = "vendor/github.com/cppforlife/jumpbox-deployment"
 	boshDeploymentRepo    = "vendor/github.com/cloudfoundry/bosh-deployment"
+
+	// jumpboxGeneratedOpsFiles are written into the jumpbox deployment dir
+	// by bbl rather than coming from jumpbox-deployment.
+	jumpboxGeneratedOpsFiles = []string{
+		"vsphere-jumpbox-network.yml",
+		"openstack-keystone-v3-ops.yml",
+	}
 )
 
-func NewExecutor(cmd command, fs executorFs) Executor {
+func NewExecutor(cmd command, fs executorFs, vault vault) Executor {
 	return Executor{
-		command: cmd,
-		fs:      fs,
+		command:       cmd,
+		fs:            fs,
+		vault:         vault,
+		offlineAssets: offlineAssetCache{},
 	}
 }
 
@@ -72,7 +110,25 @@ func (e Executor) getSetupFiles(sourcePath, destPath string) []setupFile {
 }
 
 func (e Executor) PlanJumpbox(input DirInput, deploymentDir, iaas string) error {
-	setupFiles := e.getSetupFiles(jumpboxDeploymentRepo, deploymentDir)
+	setupFiles, err := e.getDeploymentFiles(jumpboxDeploymentRepo, input.SourceDir, deploymentDir)
+	if err != nil {
+		return fmt.Errorf("Jumpbox read jumpbox-deployment: %s", err)
+	}
+
+	opsFiles := e.getJumpboxOpsFiles(input.StateDir, deploymentDir, iaas, input.Sizing)
+
+	if input.SourceDir != "" {
+		used := append([]string{filepath.Join(deploymentDir, "jumpbox.yml")}, opsFiles...)
+		err = checkSourceDirFiles("jumpbox-deployment", input.SourceDir, deploymentDir, setupFiles, used, jumpboxGeneratedOpsFiles)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = e.clearDeploymentDir("jumpbox-deployment", input.SourceDir, deploymentDir)
+	if err != nil {
+		return fmt.Errorf("Jumpbox clear jumpbox-deployment: %s", err)
+	}
 
 	for _, f := range setupFiles {
 		os.MkdirAll(filepath.Dir(f.dest), os.ModePerm)
@@ -85,29 +141,68 @@ func (e Executor) PlanJumpbox(input DirInput, deploymentDir, iaas string) error
 	sharedArgs := []string{
 		"--vars-store", filepath.Join(input.VarsDir, "jumpbox-vars-store.yml"),
 		"--vars-file", filepath.Join(input.VarsDir, "jumpbox-vars-file.yml"),
-		"-o", filepath.Join(deploymentDir, iaas, "cpi.yml"),
 	}
 
 	if iaas == "vsphere" {
-		sharedArgs = append(sharedArgs, "-o", filepath.Join(deploymentDir, "vsphere", "resource-pool.yml"))
-		vSphereJumpboxNetworkOpsPath := filepath.Join(deploymentDir, "vsphere-jumpbox-network.yml")
-		sharedArgs = append(sharedArgs, "-o", vSphereJumpboxNetworkOpsPath)
-		err := e.fs.WriteFile(vSphereJumpboxNetworkOpsPath, []byte(VSphereJumpboxNetworkOps), os.ModePerm)
+		err := e.fs.WriteFile(filepath.Join(deploymentDir, "vsphere-jumpbox-network.yml"), []byte(VSphereJumpboxNetworkOps), os.ModePerm)
 		if err != nil {
 			return fmt.Errorf("Jumpbox write vsphere network ops file: %s", err) //not tested
 		}
 	} else if iaas == "openstack" {
-		path := filepath.Join(deploymentDir, "openstack-keystone-v3-ops.yml")
-		sharedArgs = append(sharedArgs, "-o", path)
-		err := e.fs.WriteFile(path, []byte(OpenStackJumpboxKeystoneV3Ops), os.ModePerm)
+		err := e.fs.WriteFile(filepath.Join(deploymentDir, "openstack-keystone-v3-ops.yml"), []byte(OpenStackJumpboxKeystoneV3Ops), os.ModePerm)
 		if err != nil {
 			return fmt.Errorf("Jumpbox write openstack keystone v3 ops file: %s", err) //not tested
 		}
 	}
 
+	sizingOpsFile := jumpboxSizingOpsFile(input.StateDir, iaas)
+	if input.Sizing.IsEmpty() {
+		err := e.fs.RemoveAll(sizingOpsFile)
+		if err != nil {
+			return fmt.Errorf("Jumpbox remove sizing ops file: %s", err) //not tested
+		}
+	} else {
+		err := e.fs.MkdirAll(filepath.Dir(sizingOpsFile), storage.StateMode)
+		if err != nil {
+			return fmt.Errorf("Jumpbox create bbl-ops-files: %s", err) //not tested
+		}
+		err = e.fs.WriteFile(sizingOpsFile, []byte(sizingOps("jumpbox", iaas, input.Sizing)), storage.StateMode)
+		if err != nil {
+			return fmt.Errorf("Jumpbox write sizing ops file: %s", err) //not tested
+		}
+	}
+
+	opsArgs := []string{}
+	for _, f := range opsFiles {
+		opsArgs = append(opsArgs, "-o", f)
+	}
+
+	userArgs, err := e.getUserArgs(input.StateDir, "jumpbox")
+	if err != nil {
+		return err
+	}
+	opsArgs = append(opsArgs, userArgs...)
+
+	manifest := filepath.Join(deploymentDir, "jumpbox.yml")
+
+	offlineOpsFile := offlineAssetsOpsFile(input.StateDir, iaas, "jumpbox")
+	if input.OfflineAssetsDir != "" {
+		err = e.writeOfflineAssetsOps(input, "jumpbox", append([]string{"interpolate", manifest}, opsArgs...), offlineOpsFile)
+		if err != nil {
+			return err
+		}
+		opsArgs = append(opsArgs, "-o", offlineOpsFile)
+	} else {
+		err = e.fs.RemoveAll(offlineOpsFile)
+		if err != nil {
+			return fmt.Errorf("Jumpbox remove offline assets ops file: %s", err) //not tested
+		}
+	}
+	sharedArgs = append(sharedArgs, opsArgs...)
+
 	jumpboxState := filepath.Join(input.VarsDir, "jumpbox-state.json")
 
-	boshArgs := append([]string{filepath.Join(deploymentDir, "jumpbox.yml"), "--state", jumpboxState}, sharedArgs...)
+	boshArgs := append([]string{manifest, "--state", jumpboxState}, sharedArgs...)
 
 	switch iaas {
 	case "aws":
@@ -144,7 +239,7 @@ func (e Executor) PlanJumpbox(input DirInput, deploymentDir, iaas string) error
 
 	createEnvCmd := []byte(formatScript(boshPath, input.StateDir, "create-env", boshArgs))
 	createJumpboxScript := filepath.Join(input.StateDir, "create-jumpbox.sh")
-	err := e.fs.WriteFile(createJumpboxScript, createEnvCmd, 0750)
+	err = e.fs.WriteFile(createJumpboxScript, createEnvCmd, 0750)
 	if err != nil {
 		return err
 	}
@@ -159,8 +254,33 @@ func (e Executor) PlanJumpbox(input DirInput, deploymentDir, iaas string) error
 	return nil
 }
 
-func (e Executor) getDirectorSetupFiles(stateDir, deploymentDir, iaas string) []setupFile {
-	files := e.getSetupFiles(boshDeploymentRepo, deploymentDir)
+func (e Executor) getJumpboxOpsFiles(stateDir, deploymentDir, iaas string, sizing storage.Sizing) []string {
+	files := []string{
+		filepath.Join(deploymentDir, iaas, "cpi.yml"),
+	}
+	if iaas == "vsphere" {
+		files = append(files, filepath.Join(deploymentDir, "vsphere", "resource-pool.yml"))
+		files = append(files, filepath.Join(deploymentDir, "vsphere-jumpbox-network.yml"))
+	} else if iaas == "openstack" {
+		files = append(files, filepath.Join(deploymentDir, "openstack-keystone-v3-ops.yml"))
+	}
+	if !sizing.IsEmpty() {
+		files = append(files, jumpboxSizingOpsFile(stateDir, iaas))
+	}
+	return files
+}
+
+// jumpboxSizingOpsFile is kept with the other ops files bbl generates in the
+// state dir, rather than in the jumpbox deployment dir that bbl plan
+// replaces.
+func jumpboxSizingOpsFile(stateDir, iaas string) string {
+	return filepath.Join(stateDir, "bbl-ops-files", iaas, "jumpbox-sizing-ops.yml")
+}
+
+// getDirectorSetupFiles are the ops files that bbl generates for the
+// director in the bbl-ops-files dir of the state dir.
+func (e Executor) getDirectorSetupFiles(stateDir, iaas string, sizing storage.Sizing) []setupFile {
+	files := []setupFile{}
 
 	statePath := filepath.Join(stateDir, "bbl-ops-files", iaas)
 	assetPath := filepath.Join(boshDeploymentRepo, iaas)
@@ -179,10 +299,18 @@ func (e Executor) getDirectorSetupFiles(stateDir, deploymentDir, iaas string) []
 		})
 	}
 
+	if !sizing.IsEmpty() {
+		files = append(files, setupFile{
+			source:   filepath.Join(assetPath, "director-sizing-ops.yml"),
+			dest:     filepath.Join(statePath, "director-sizing-ops.yml"),
+			contents: []byte(sizingOps("bosh", iaas, sizing)),
+		})
+	}
+
 	return files
 }
 
-func (e Executor) getDirectorOpsFiles(stateDir, deploymentDir, iaas string) []string {
+func (e Executor) getDirectorOpsFiles(stateDir, deploymentDir, iaas string, sizing storage.Sizing, features []string) []string {
 	files := []string{
 		filepath.Join(deploymentDir, iaas, "cpi.yml"),
 		filepath.Join(deploymentDir, "jumpbox-user.yml"),
@@ -198,11 +326,95 @@ func (e Executor) getDirectorOpsFiles(stateDir, deploymentDir, iaas string) []st
 	} else if iaas == "vsphere" {
 		files = append(files, filepath.Join(deploymentDir, "vsphere", "resource-pool.yml"))
 	}
-	return files
+	if !sizing.IsEmpty() {
+		files = append(files, filepath.Join(stateDir, "bbl-ops-files", iaas, "director-sizing-ops.yml"))
+	}
+	return append(files, e.getDirectorFeatureOpsFiles(stateDir, deploymentDir, iaas, features)...)
+}
+
+// getUserArgs passes the *.yml files in the jumpbox-ops or director-ops dir of
+// the state dir as ops files, and the files in director-vars as vars files.
+// They come after the ones bbl generates, so they can change anything bbl
+// sets without a create-env override script.
+func (e Executor) getUserArgs(stateDir, deployment string) ([]string, error) {
+	args := []string{}
+
+	opsFiles, err := e.listStateDirFiles(stateDir, fmt.Sprintf("%s-ops", deployment))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range opsFiles {
+		if filepath.Ext(f) == ".yml" {
+			args = append(args, "-o", f)
+		}
+	}
+
+	if deployment != "director" {
+		return args, nil
+	}
+
+	varsFiles, err := e.listStateDirFiles(stateDir, "director-vars")
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range varsFiles {
+		args = append(args, "--vars-file", f)
+	}
+
+	return args, nil
+}
+
+// listStateDirFiles lists the files in a dir of the state dir by name,
+// leaving out hidden files. A dir that does not exist has none.
+func (e Executor) listStateDirFiles(stateDir, dir string) ([]string, error) {
+	infos, err := e.fs.ReadDir(filepath.Join(stateDir, dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Read %s dir: %s", dir, err)
+	}
+
+	files := []string{}
+	for _, info := range infos {
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			continue
+		}
+		files = append(files, filepath.Join(stateDir, dir, info.Name()))
+	}
+	sort.Strings(files)
+
+	return files, nil
 }
 
 func (e Executor) PlanDirector(input DirInput, deploymentDir, iaas string) error {
-	setupFiles := e.getDirectorSetupFiles(input.StateDir, deploymentDir, iaas)
+	setupFiles, err := e.getDeploymentFiles(boshDeploymentRepo, input.SourceDir, deploymentDir)
+	if err != nil {
+		return fmt.Errorf("Director read bosh-deployment: %s", err)
+	}
+
+	opsFiles := e.getDirectorOpsFiles(input.StateDir, deploymentDir, iaas, input.Sizing, input.DirectorFeatures)
+
+	if input.SourceDir != "" {
+		used := append([]string{filepath.Join(deploymentDir, "bosh.yml")}, opsFiles...)
+		err = checkSourceDirFiles("bosh-deployment", input.SourceDir, deploymentDir, setupFiles, used, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = e.clearDeploymentDir("bosh-deployment", input.SourceDir, deploymentDir)
+	if err != nil {
+		return fmt.Errorf("Director clear bosh-deployment: %s", err)
+	}
+
+	setupFiles = append(setupFiles, e.getDirectorSetupFiles(input.StateDir, iaas, input.Sizing)...)
+	setupFiles = append(setupFiles, e.getDirectorFeatureSetupFiles(input.StateDir, iaas, input.DirectorFeatures)...)
+
+	err = e.removeDisabledDirectorFeatureOpsFiles(input.StateDir, iaas, input.DirectorFeatures)
+	if err != nil {
+		return err
+	}
 
 	for _, f := range setupFiles {
 		if f.source != "" {
@@ -218,13 +430,37 @@ func (e Executor) PlanDirector(input DirInput, deploymentDir, iaas string) error
 		"--vars-file", filepath.Join(input.VarsDir, "director-vars-file.yml"),
 	}
 
-	for _, f := range e.getDirectorOpsFiles(input.StateDir, deploymentDir, iaas) {
-		sharedArgs = append(sharedArgs, "-o", f)
+	opsArgs := []string{}
+	for _, f := range opsFiles {
+		opsArgs = append(opsArgs, "-o", f)
+	}
+
+	userArgs, err := e.getUserArgs(input.StateDir, "director")
+	if err != nil {
+		return err
+	}
+	opsArgs = append(opsArgs, userArgs...)
+
+	manifest := filepath.Join(deploymentDir, "bosh.yml")
+
+	offlineOpsFile := offlineAssetsOpsFile(input.StateDir, iaas, "director")
+	if input.OfflineAssetsDir != "" {
+		err = e.writeOfflineAssetsOps(input, "director", append([]string{"interpolate", manifest}, opsArgs...), offlineOpsFile)
+		if err != nil {
+			return err
+		}
+		opsArgs = append(opsArgs, "-o", offlineOpsFile)
+	} else {
+		err = e.fs.RemoveAll(offlineOpsFile)
+		if err != nil {
+			return fmt.Errorf("Director remove offline assets ops file: %s", err) //not tested
+		}
 	}
+	sharedArgs = append(sharedArgs, opsArgs...)
 
 	boshState := filepath.Join(input.VarsDir, "bosh-state.json")
 
-	boshArgs := append([]string{filepath.Join(deploymentDir, "bosh.yml"), "--state", boshState}, sharedArgs...)
+	boshArgs := append([]string{manifest, "--state", boshState}, sharedArgs...)
 
 	switch iaas {
 	case "aws":
@@ -260,7 +496,7 @@ func (e Executor) PlanDirector(input DirInput, deploymentDir, iaas string) error
 	boshPath := e.command.GetBOSHPath()
 
 	createEnvCmd := []byte(formatScript(boshPath, input.StateDir, "create-env", boshArgs))
-	err := e.fs.WriteFile(filepath.Join(input.StateDir, "create-director.sh"), createEnvCmd, 0750)
+	err = e.fs.WriteFile(filepath.Join(input.StateDir, "create-director.sh"), createEnvCmd, 0750)
 	if err != nil {
 		return err
 	}
@@ -287,6 +523,61 @@ func formatScript(boshPath, stateDir, command string, args []string) string {
 	return fmt.Sprintf("%s\n", script[:len(script)-2])
 }
 
+// Interpolate renders the manifest that bosh create-env would deploy, using
+// the ops files bbl plans and the deployment vars in varsFile. The vars store
+// and the IAAS credentials are left out, so that the variables they hold stay
+// as placeholders.
+func (e Executor) Interpolate(input DirInput, deploymentDir, iaas, varsFile string) (string, error) {
+	var args []string
+	switch input.Deployment {
+	case "jumpbox":
+		args = []string{"interpolate", filepath.Join(deploymentDir, "jumpbox.yml")}
+		for _, f := range e.getJumpboxOpsFiles(input.StateDir, deploymentDir, iaas, input.Sizing) {
+			args = append(args, "-o", f)
+		}
+	case "director":
+		args = []string{"interpolate", filepath.Join(deploymentDir, "bosh.yml")}
+		for _, f := range e.getDirectorOpsFiles(input.StateDir, deploymentDir, iaas, input.Sizing, input.DirectorFeatures) {
+			args = append(args, "-o", f)
+		}
+	default:
+		return "", fmt.Errorf("Executor doesn't know how to interpolate a %s manifest", input.Deployment)
+	}
+	args = append(args, "--vars-file", varsFile)
+
+	userArgs, err := e.getUserArgs(input.StateDir, input.Deployment)
+	if err != nil {
+		return "", err
+	}
+	args = append(args, userArgs...)
+
+	if input.OfflineAssetsDir != "" {
+		args = append(args, "-o", offlineAssetsOpsFile(input.StateDir, iaas, input.Deployment))
+	}
+
+	return e.interpolate(input.StateDir, input.Deployment, args)
+}
+
+func (e Executor) interpolate(stateDir, deployment string, args []string) (string, error) {
+	buffer := bytes.NewBuffer([]byte{})
+	err := e.vault.Unseal()
+	if err != nil {
+		return "", fmt.Errorf("Decrypt state: %s", err)
+	}
+
+	err = e.command.Run(buffer, stateDir, args)
+
+	sealErr := e.vault.Seal()
+	if err == nil && sealErr != nil {
+		return "", fmt.Errorf("Encrypt state: %s", sealErr)
+	}
+	if err != nil {
+		return "", fmt.Errorf("Interpolate %s manifest: %s", deployment, err)
+	}
+
+	return buffer.String(), nil
+}
+
 func (e Executor) WriteDeploymentVars(input DirInput, deploymentVars string) error {
 	varsFilePath := filepath.Join(input.VarsDir, fmt.Sprintf("%s-vars-file.yml", input.Deployment))
 	err := e.fs.WriteFile(varsFilePath, []byte(deploymentVars), storage.StateMode)
@@ -298,10 +589,13 @@ func (e Executor) WriteDeploymentVars(input DirInput, deploymentVars string) err
 
 func (e Executor) CreateEnv(input DirInput, state storage.State) (string, error) {
 	os.Setenv("BBL_STATE_DIR", input.StateDir)
-	createEnvScript := filepath.Join(input.StateDir, fmt.Sprintf("create-%s-override.sh", input.Deployment))
-	_, err := e.fs.Stat(createEnvScript)
-	if err != nil {
-		createEnvScript = strings.Replace(createEnvScript, "-override", "", -1)
+	createEnvScript := e.createEnvScript(input.StateDir, input.Deployment)
+
+	if input.Deployment == "director" {
+		err := e.CheckDirectorFeatureVars(input)
+		if err != nil {
+			return "", err
+		}
 	}
 
 	switch state.IAAS {
@@ -325,11 +619,7 @@ func (e Executor) CreateEnv(input DirInput, state storage.State) (string, error)
 		os.Setenv("BBL_OPENSTACK_PASSWORD", state.OpenStack.Password)
 	}
 
-	cmd := exec.Command(createEnvScript)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	err = cmd.Run()
+	err := e.runScript(createEnvScript)
 	if err != nil {
 		return "", fmt.Errorf("Running %s: %s", createEnvScript, err)
 	}
@@ -340,6 +630,38 @@ func (e Executor) CreateEnv(input DirInput, state storage.State) (string, error)
 	return string(contents), nil
 }
 
+// createEnvScript is the create-env override script of the deployment when
+// the state dir has one, and the script bbl plan wrote otherwise.
+func (e Executor) createEnvScript(stateDir, deployment string) string {
+	script := filepath.Join(stateDir, fmt.Sprintf("create-%s-override.sh", deployment))
+	if _, err := e.fs.Stat(script); err == nil {
+		return script
+	}
+	return filepath.Join(stateDir, fmt.Sprintf("create-%s.sh", deployment))
+}
+
+// runScript decrypts the vars and state files that the script passes to
+// bosh for the duration of the run.
+func (e Executor) runScript(script string) error {
+	err := e.vault.Unseal()
+	if err != nil {
+		return fmt.Errorf("Decrypt state: %s", err)
+	}
+
+	cmd := exec.Command(script)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err = interrupt.Run(cmd)
+
+	sealErr := e.vault.Seal()
+	if err == nil && sealErr != nil {
+		return fmt.Errorf("Encrypt state: %s", sealErr)
+	}
+
+	return err
+}
+
 func (e Executor) DeleteEnv(input DirInput, state storage.State) error {
 	isDeletable, err := e.deploymentExists(input.VarsDir, input.Deployment)
 	if err != nil {
@@ -375,11 +697,7 @@ func (e Executor) DeleteEnv(input DirInput, state storage.State) error {
 		os.Setenv("BBL_VSPHERE_VCENTER_PASSWORD", state.VSphere.VCenterPassword)
 	}
 
-	cmd := exec.Command(deleteEnvScript)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	err = cmd.Run()
+	err = e.runScript(deleteEnvScript)
 	if err != nil {
 		return fmt.Errorf("Run bosh delete-env %s: %s", input.Deployment, err)
 	}
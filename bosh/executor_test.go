@@ -21,6 +21,7 @@ var _ = Describe("Executor", func() {
 	var (
 		fs                    *afero.Afero
 		cmd                   *fakes.BOSHCommand
+		vault                 *fakes.Vault
 		stateDir              string
 		deploymentDir         string
 		varsDir               string
@@ -34,6 +35,7 @@ var _ = Describe("Executor", func() {
 
 	BeforeEach(func() {
 		fs = &afero.Afero{afero.NewMemMapFs()}
+		vault = &fakes.Vault{}
 		cmd = &fakes.BOSHCommand{}
 		cmd.RunStub = func(stdout io.Writer, workingDirectory string, args []string) error {
 			stdout.Write([]byte("some-manifest"))
@@ -62,7 +64,7 @@ var _ = Describe("Executor", func() {
 			StateDir: stateDir,
 		}
 
-		executor = bosh.NewExecutor(cmd, fs)
+		executor = bosh.NewExecutor(cmd, fs, vault)
 	})
 
 	Describe("PlanJumpbox", func() {
@@ -120,6 +122,180 @@ var _ = Describe("Executor", func() {
 			})
 		})
 
+		Context("when the state dir has a jumpbox-ops dir", func() {
+			It("appends the ops files in it", func() {
+				fs.MkdirAll(filepath.Join(stateDir, "jumpbox-ops"), os.ModePerm)
+				fs.WriteFile(filepath.Join(stateDir, "jumpbox-ops", "b-ops.yml"), []byte("b"), os.ModePerm)
+				fs.WriteFile(filepath.Join(stateDir, "jumpbox-ops", "a-ops.yml"), []byte("a"), os.ModePerm)
+				fs.WriteFile(filepath.Join(stateDir, "jumpbox-ops", "README.md"), []byte("notes"), os.ModePerm)
+
+				err := executor.PlanJumpbox(dirInput, deploymentDir, "aws")
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedArgs := []string{
+					fmt.Sprintf("%s/jumpbox.yml", relativeDeploymentDir),
+					"--state", fmt.Sprintf("%s/jumpbox-state.json", relativeVarsDir),
+					"--vars-store", fmt.Sprintf("%s/jumpbox-vars-store.yml", relativeVarsDir),
+					"--vars-file", fmt.Sprintf("%s/jumpbox-vars-file.yml", relativeVarsDir),
+					"-o", fmt.Sprintf("%s/aws/cpi.yml", relativeDeploymentDir),
+					"-o", fmt.Sprintf("%s/jumpbox-ops/a-ops.yml", relativeStateDir),
+					"-o", fmt.Sprintf("%s/jumpbox-ops/b-ops.yml", relativeStateDir),
+					"-v", `access_key_id="${BBL_AWS_ACCESS_KEY_ID}"`,
+					"-v", `secret_access_key="${BBL_AWS_SECRET_ACCESS_KEY}"`,
+				}
+
+				shellScript, err := fs.ReadFile(filepath.Join(stateDir, "create-jumpbox.sh"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(shellScript)).To(Equal(formatScript("create-env", stateDir, expectedArgs)))
+			})
+		})
+
+		Context("when the jumpbox is sized", func() {
+			It("writes a sizing ops file for the iaas and appends it", func() {
+				dirInput.Sizing = storage.Sizing{VMType: "n1-standard-2", PersistentDiskSize: 10240, EphemeralDiskSize: 30000}
+
+				err := executor.PlanJumpbox(dirInput, deploymentDir, "gcp")
+				Expect(err).NotTo(HaveOccurred())
+
+				opsFilePath := filepath.Join(stateDir, "bbl-ops-files", "gcp", "jumpbox-sizing-ops.yml")
+				opsFile, err := fs.ReadFile(opsFilePath)
+				Expect(err).NotTo(HaveOccurred())
+
+				info, err := fs.Stat(opsFilePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(storage.StateMode)))
+				Expect(string(opsFile)).To(Equal(`---
+- type: replace
+  path: /resource_pools/name=vms/cloud_properties/machine_type
+  value: "n1-standard-2"
+
+- type: replace
+  path: /resource_pools/name=vms/cloud_properties/root_disk_size_gb
+  value: 30
+
+- type: replace
+  path: /instance_groups/name=jumpbox/persistent_disk?
+  value: 10240
+
+`))
+
+				expectedArgs := []string{
+					fmt.Sprintf("%s/jumpbox.yml", relativeDeploymentDir),
+					"--state", fmt.Sprintf("%s/jumpbox-state.json", relativeVarsDir),
+					"--vars-store", fmt.Sprintf("%s/jumpbox-vars-store.yml", relativeVarsDir),
+					"--vars-file", fmt.Sprintf("%s/jumpbox-vars-file.yml", relativeVarsDir),
+					"-o", fmt.Sprintf("%s/gcp/cpi.yml", relativeDeploymentDir),
+					"-o", fmt.Sprintf("%s/bbl-ops-files/gcp/jumpbox-sizing-ops.yml", relativeStateDir),
+					"--var-file", `gcp_credentials_json="${BBL_GCP_SERVICE_ACCOUNT_KEY_PATH}"`,
+					"-v", `project_id="${BBL_GCP_PROJECT_ID}"`,
+					"-v", `zone="${BBL_GCP_ZONE}"`,
+				}
+
+				shellScript, err := fs.ReadFile(filepath.Join(stateDir, "create-jumpbox.sh"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(shellScript)).To(Equal(formatScript("create-env", stateDir, expectedArgs)))
+			})
+		})
+
+		Context("when the jumpbox size changes", func() {
+			It("changes the fingerprint of the jumpbox phase of bbl up", func() {
+				fingerprinter := storage.NewFingerprinter(stateDir, fs)
+
+				dirInput.Sizing = storage.Sizing{VMType: "n1-standard-2"}
+				err := executor.PlanJumpbox(dirInput, deploymentDir, "gcp")
+				Expect(err).NotTo(HaveOccurred())
+
+				before, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX)
+				Expect(err).NotTo(HaveOccurred())
+
+				dirInput.Sizing = storage.Sizing{VMType: "n1-standard-4"}
+				err = executor.PlanJumpbox(dirInput, deploymentDir, "gcp")
+				Expect(err).NotTo(HaveOccurred())
+
+				after, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(after).NotTo(Equal(before))
+			})
+		})
+
+		Context("when the jumpbox size goes back to the default", func() {
+			It("removes the sizing ops file", func() {
+				dirInput.Sizing = storage.Sizing{VMType: "n1-standard-2"}
+				err := executor.PlanJumpbox(dirInput, deploymentDir, "gcp")
+				Expect(err).NotTo(HaveOccurred())
+
+				dirInput.Sizing = storage.Sizing{}
+				err = executor.PlanJumpbox(dirInput, deploymentDir, "gcp")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = fs.Stat(filepath.Join(stateDir, "bbl-ops-files", "gcp", "jumpbox-sizing-ops.yml"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the jumpbox-deployment comes from a source dir", func() {
+			var sourceDir string
+
+			BeforeEach(func() {
+				sourceDir = filepath.Join(stateDir, "..", "my-jumpbox-deployment")
+				fs.MkdirAll(filepath.Join(sourceDir, "aws"), os.ModePerm)
+				fs.MkdirAll(filepath.Join(sourceDir, ".git"), os.ModePerm)
+				fs.WriteFile(filepath.Join(sourceDir, "jumpbox.yml"), []byte("my-manifest"), os.ModePerm)
+				fs.WriteFile(filepath.Join(sourceDir, "aws", "cpi.yml"), []byte("my-cpi"), os.ModePerm)
+				fs.WriteFile(filepath.Join(sourceDir, ".git", "HEAD"), []byte("some-revision"), os.ModePerm)
+
+				dirInput.SourceDir = sourceDir
+			})
+
+			It("writes the files in it to the deployment dir instead of the built in ones", func() {
+				err := executor.PlanJumpbox(dirInput, deploymentDir, "aws")
+				Expect(err).NotTo(HaveOccurred())
+
+				contents, err := fs.ReadFile(filepath.Join(deploymentDir, "aws", "cpi.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("my-cpi"))
+
+				_, err = fs.Stat(filepath.Join(deploymentDir, "no-external-ip.yml"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+
+				_, err = fs.Stat(filepath.Join(deploymentDir, ".git", "HEAD"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+
+			It("removes the files that an earlier bbl plan copied to the deployment dir", func() {
+				fs.MkdirAll(filepath.Join(deploymentDir, "aws"), os.ModePerm)
+				fs.WriteFile(filepath.Join(deploymentDir, "aws", "stale.yml"), []byte("stale"), os.ModePerm)
+
+				err := executor.PlanJumpbox(dirInput, deploymentDir, "aws")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = fs.Stat(filepath.Join(deploymentDir, "aws", "stale.yml"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+
+			Context("when it is the deployment dir", func() {
+				It("returns an error", func() {
+					fs.MkdirAll(filepath.Join(deploymentDir, "aws"), os.ModePerm)
+					fs.WriteFile(filepath.Join(deploymentDir, "jumpbox.yml"), []byte("my-manifest"), os.ModePerm)
+					fs.WriteFile(filepath.Join(deploymentDir, "aws", "cpi.yml"), []byte("my-cpi"), os.ModePerm)
+					dirInput.SourceDir = deploymentDir
+
+					err := executor.PlanJumpbox(dirInput, deploymentDir, "aws")
+					Expect(err).To(MatchError(ContainSubstring("Jumpbox clear jumpbox-deployment: ")))
+				})
+			})
+
+			Context("when it does not have an ops file that bbl uses", func() {
+				It("returns an error without writing the scripts", func() {
+					err := executor.PlanJumpbox(dirInput, deploymentDir, "vsphere")
+					Expect(err).To(MatchError(fmt.Sprintf("The jumpbox-deployment in %s does not have vsphere/cpi.yml, vsphere/resource-pool.yml, which bbl uses.", sourceDir)))
+
+					_, err = fs.Stat(filepath.Join(stateDir, "create-jumpbox.sh"))
+					Expect(os.IsNotExist(err)).To(BeTrue())
+				})
+			})
+		})
+
 		Context("on azure", func() {
 			It("generates create-env args for jumpbox", func() {
 				err := executor.PlanJumpbox(dirInput, deploymentDir, "azure")
@@ -330,6 +506,240 @@ var _ = Describe("Executor", func() {
 			})
 		})
 
+		Context("when the bosh-deployment comes from a source dir", func() {
+			var sourceDir string
+
+			BeforeEach(func() {
+				sourceDir = filepath.Join(stateDir, "..", "my-bosh-deployment")
+				for _, name := range []string{"bosh.yml", "gcp/cpi.yml", "jumpbox-user.yml", "uaa.yml", "credhub.yml"} {
+					fs.MkdirAll(filepath.Dir(filepath.Join(sourceDir, name)), os.ModePerm)
+					fs.WriteFile(filepath.Join(sourceDir, name), []byte("my-"+name), os.ModePerm)
+				}
+
+				dirInput.SourceDir = sourceDir
+			})
+
+			It("writes the files in it to the deployment dir along with the bbl ops files", func() {
+				err := executor.PlanDirector(dirInput, deploymentDir, "gcp")
+				Expect(err).NotTo(HaveOccurred())
+
+				contents, err := fs.ReadFile(filepath.Join(deploymentDir, "gcp", "cpi.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("my-gcp/cpi.yml"))
+
+				_, err = fs.Stat(filepath.Join(deploymentDir, "LICENSE"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+
+				_, err = fs.Stat(filepath.Join(stateDir, "bbl-ops-files", "gcp", "bosh-director-ephemeral-ip-ops.yml"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("removes the files that an earlier bbl plan copied to the deployment dir", func() {
+				fs.MkdirAll(filepath.Join(deploymentDir, "gcp"), os.ModePerm)
+				fs.WriteFile(filepath.Join(deploymentDir, "gcp", "stale.yml"), []byte("stale"), os.ModePerm)
+
+				err := executor.PlanDirector(dirInput, deploymentDir, "gcp")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = fs.Stat(filepath.Join(deploymentDir, "gcp", "stale.yml"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+
+			Context("when it is the deployment dir", func() {
+				It("returns an error", func() {
+					for _, name := range []string{"bosh.yml", "gcp/cpi.yml", "jumpbox-user.yml", "uaa.yml", "credhub.yml"} {
+						fs.MkdirAll(filepath.Dir(filepath.Join(deploymentDir, name)), os.ModePerm)
+						fs.WriteFile(filepath.Join(deploymentDir, name), []byte("my-"+name), os.ModePerm)
+					}
+					dirInput.SourceDir = deploymentDir
+
+					err := executor.PlanDirector(dirInput, deploymentDir, "gcp")
+					Expect(err).To(MatchError(ContainSubstring("Director clear bosh-deployment: ")))
+				})
+			})
+
+			Context("when it does not have an ops file of a director feature", func() {
+				It("returns an error", func() {
+					dirInput.DirectorFeatures = []string{"bpm"}
+
+					err := executor.PlanDirector(dirInput, deploymentDir, "gcp")
+					Expect(err).To(MatchError(fmt.Sprintf("The bosh-deployment in %s does not have experimental/bpm.yml, which bbl uses.", sourceDir)))
+				})
+			})
+
+			Context("when it cannot be read", func() {
+				It("returns an error", func() {
+					dirInput.SourceDir = filepath.Join(stateDir, "missing")
+
+					err := executor.PlanDirector(dirInput, deploymentDir, "gcp")
+					Expect(err).To(MatchError(ContainSubstring("Director read bosh-deployment: ")))
+				})
+			})
+		})
+
+		Context("when the state dir has director-ops and director-vars dirs", func() {
+			It("appends the ops files and the vars files in them", func() {
+				fs.MkdirAll(filepath.Join(stateDir, "director-ops"), os.ModePerm)
+				fs.WriteFile(filepath.Join(stateDir, "director-ops", "my-ops.yml"), []byte("ops"), os.ModePerm)
+				fs.MkdirAll(filepath.Join(stateDir, "director-vars"), os.ModePerm)
+				fs.WriteFile(filepath.Join(stateDir, "director-vars", "my-vars.yml"), []byte("vars"), os.ModePerm)
+				fs.WriteFile(filepath.Join(stateDir, "director-vars", ".my-vars.yml.swp"), []byte("swap"), os.ModePerm)
+
+				expectedArgs := []string{
+					filepath.Join(relativeDeploymentDir, "bosh.yml"),
+					"--state", filepath.Join(relativeVarsDir, "bosh-state.json"),
+					"--vars-store", filepath.Join(relativeVarsDir, "director-vars-store.yml"),
+					"--vars-file", filepath.Join(relativeVarsDir, "director-vars-file.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "azure", "cpi.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "jumpbox-user.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "uaa.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "credhub.yml"),
+					"-o", filepath.Join(relativeStateDir, "director-ops", "my-ops.yml"),
+					"--vars-file", filepath.Join(relativeStateDir, "director-vars", "my-vars.yml"),
+					"-v", `subscription_id="${BBL_AZURE_SUBSCRIPTION_ID}"`,
+					"-v", `client_id="${BBL_AZURE_CLIENT_ID}"`,
+					"-v", `client_secret="${BBL_AZURE_CLIENT_SECRET}"`,
+					"-v", `tenant_id="${BBL_AZURE_TENANT_ID}"`,
+				}
+
+				behavesLikePlan(expectedArgs, cmd, fs, executor, dirInput, deploymentDir, "azure", stateDir)
+			})
+		})
+
+		Context("when the director is sized", func() {
+			It("writes a sizing ops file for the iaas and appends it", func() {
+				dirInput.Sizing = storage.Sizing{VMType: "4x16384", PersistentDiskSize: 102400, EphemeralDiskSize: 50000}
+
+				expectedArgs := []string{
+					filepath.Join(relativeDeploymentDir, "bosh.yml"),
+					"--state", filepath.Join(relativeVarsDir, "bosh-state.json"),
+					"--vars-store", filepath.Join(relativeVarsDir, "director-vars-store.yml"),
+					"--vars-file", filepath.Join(relativeVarsDir, "director-vars-file.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "vsphere", "cpi.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "jumpbox-user.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "uaa.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "credhub.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "vsphere", "resource-pool.yml"),
+					"-o", filepath.Join(relativeStateDir, "bbl-ops-files", "vsphere", "director-sizing-ops.yml"),
+					"-v", `vcenter_user="${BBL_VSPHERE_VCENTER_USER}"`,
+					"-v", `vcenter_password="${BBL_VSPHERE_VCENTER_PASSWORD}"`,
+				}
+
+				behavesLikePlan(expectedArgs, cmd, fs, executor, dirInput, deploymentDir, "vsphere", stateDir)
+
+				opsFile, err := fs.ReadFile(filepath.Join(stateDir, "bbl-ops-files", "vsphere", "director-sizing-ops.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(opsFile)).To(Equal(`---
+- type: replace
+  path: /resource_pools/name=vms/cloud_properties/cpu
+  value: 4
+
+- type: replace
+  path: /resource_pools/name=vms/cloud_properties/ram
+  value: 16384
+
+- type: replace
+  path: /resource_pools/name=vms/cloud_properties/disk
+  value: 50000
+
+- type: replace
+  path: /disk_pools/name=disks/disk_size
+  value: 102400
+
+`))
+			})
+		})
+
+		Context("when director features are turned on", func() {
+			It("adds the ops files of the dns features", func() {
+				dirInput.DirectorFeatures = []string{"local-dns", "dns-servers"}
+
+				expectedArgs := []string{
+					filepath.Join(relativeDeploymentDir, "bosh.yml"),
+					"--state", filepath.Join(relativeVarsDir, "bosh-state.json"),
+					"--vars-store", filepath.Join(relativeVarsDir, "director-vars-store.yml"),
+					"--vars-file", filepath.Join(relativeVarsDir, "director-vars-file.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "azure", "cpi.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "jumpbox-user.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "uaa.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "credhub.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "misc", "dns.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "local-dns.yml"),
+					"-v", `subscription_id="${BBL_AZURE_SUBSCRIPTION_ID}"`,
+					"-v", `client_id="${BBL_AZURE_CLIENT_ID}"`,
+					"-v", `client_secret="${BBL_AZURE_CLIENT_SECRET}"`,
+					"-v", `tenant_id="${BBL_AZURE_TENANT_ID}"`,
+				}
+
+				behavesLikePlan(expectedArgs, cmd, fs, executor, dirInput, deploymentDir, "azure", stateDir)
+			})
+
+			It("adds their ops files in a fixed order before the ones from the state dir", func() {
+				dirInput.DirectorFeatures = []string{"external-db", "syslog"}
+
+				expectedArgs := []string{
+					filepath.Join(relativeDeploymentDir, "bosh.yml"),
+					"--state", filepath.Join(relativeVarsDir, "bosh-state.json"),
+					"--vars-store", filepath.Join(relativeVarsDir, "director-vars-store.yml"),
+					"--vars-file", filepath.Join(relativeVarsDir, "director-vars-file.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "azure", "cpi.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "jumpbox-user.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "uaa.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "credhub.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "syslog.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "misc", "external-db.yml"),
+					"-v", `subscription_id="${BBL_AZURE_SUBSCRIPTION_ID}"`,
+					"-v", `client_id="${BBL_AZURE_CLIENT_ID}"`,
+					"-v", `client_secret="${BBL_AZURE_CLIENT_SECRET}"`,
+					"-v", `tenant_id="${BBL_AZURE_TENANT_ID}"`,
+				}
+
+				behavesLikePlan(expectedArgs, cmd, fs, executor, dirInput, deploymentDir, "azure", stateDir)
+			})
+
+			It("generates the ops files of the features that bosh-deployment has none for", func() {
+				dirInput.DirectorFeatures = []string{"prometheus-exporter", "s3-blobstore"}
+
+				expectedArgs := []string{
+					filepath.Join(relativeDeploymentDir, "bosh.yml"),
+					"--state", filepath.Join(relativeVarsDir, "bosh-state.json"),
+					"--vars-store", filepath.Join(relativeVarsDir, "director-vars-store.yml"),
+					"--vars-file", filepath.Join(relativeVarsDir, "director-vars-file.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "azure", "cpi.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "jumpbox-user.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "uaa.yml"),
+					"-o", filepath.Join(relativeDeploymentDir, "credhub.yml"),
+					"-o", filepath.Join(relativeStateDir, "bbl-ops-files", "azure", "director-s3-blobstore-ops.yml"),
+					"-o", filepath.Join(relativeStateDir, "bbl-ops-files", "azure", "director-prometheus-exporter-ops.yml"),
+					"-v", `subscription_id="${BBL_AZURE_SUBSCRIPTION_ID}"`,
+					"-v", `client_id="${BBL_AZURE_CLIENT_ID}"`,
+					"-v", `client_secret="${BBL_AZURE_CLIENT_SECRET}"`,
+					"-v", `tenant_id="${BBL_AZURE_TENANT_ID}"`,
+				}
+
+				behavesLikePlan(expectedArgs, cmd, fs, executor, dirInput, deploymentDir, "azure", stateDir)
+
+				opsFile, err := fs.ReadFile(filepath.Join(stateDir, "bbl-ops-files", "azure", "director-s3-blobstore-ops.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(opsFile)).To(Equal(bosh.S3BlobstoreOps))
+
+				opsFile, err = fs.ReadFile(filepath.Join(stateDir, "bbl-ops-files", "azure", "director-prometheus-exporter-ops.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(opsFile)).To(Equal(bosh.PrometheusExporterOps))
+			})
+
+			It("removes the generated ops files of the features that are turned off", func() {
+				dirInput.DirectorFeatures = []string{"s3-blobstore"}
+				Expect(executor.PlanDirector(dirInput, deploymentDir, "azure")).To(Succeed())
+
+				dirInput.DirectorFeatures = nil
+				Expect(executor.PlanDirector(dirInput, deploymentDir, "azure")).To(Succeed())
+
+				_, err := fs.Stat(filepath.Join(stateDir, "bbl-ops-files", "azure", "director-s3-blobstore-ops.yml"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
 		Context("gcp", func() {
 			It("writes create-director.sh and delete-director.sh", func() {
 				expectedArgs := []string{
@@ -443,6 +853,87 @@ var _ = Describe("Executor", func() {
 		})
 	})
 
+	Describe("Interpolate", func() {
+		It("renders the jumpbox manifest with the planned ops files and the deployment vars", func() {
+			dirInput.Deployment = "jumpbox"
+
+			manifest, err := executor.Interpolate(dirInput, deploymentDir, "aws", "/some/vars-file.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifest).To(Equal("some-manifest"))
+
+			_, workingDirectory, args := cmd.RunArgsForCall(0)
+			Expect(workingDirectory).To(Equal(stateDir))
+			Expect(args).To(Equal([]string{
+				"interpolate", filepath.Join(deploymentDir, "jumpbox.yml"),
+				"-o", filepath.Join(deploymentDir, "aws", "cpi.yml"),
+				"--vars-file", "/some/vars-file.yml",
+			}))
+			Expect(vault.UnsealCall.CallCount).To(Equal(1))
+			Expect(vault.SealCall.CallCount).To(Equal(1))
+		})
+
+		It("renders the director manifest with the planned ops files and the deployment vars", func() {
+			dirInput.Deployment = "director"
+
+			_, err := executor.Interpolate(dirInput, deploymentDir, "vsphere", "/some/vars-file.yml")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, args := cmd.RunArgsForCall(0)
+			Expect(args).To(Equal([]string{
+				"interpolate", filepath.Join(deploymentDir, "bosh.yml"),
+				"-o", filepath.Join(deploymentDir, "vsphere", "cpi.yml"),
+				"-o", filepath.Join(deploymentDir, "jumpbox-user.yml"),
+				"-o", filepath.Join(deploymentDir, "uaa.yml"),
+				"-o", filepath.Join(deploymentDir, "credhub.yml"),
+				"-o", filepath.Join(deploymentDir, "vsphere", "resource-pool.yml"),
+				"--vars-file", "/some/vars-file.yml",
+			}))
+		})
+
+		It("includes the ops files and vars files from the state dir", func() {
+			dirInput.Deployment = "director"
+			fs.MkdirAll(filepath.Join(stateDir, "director-ops"), os.ModePerm)
+			fs.WriteFile(filepath.Join(stateDir, "director-ops", "my-ops.yml"), []byte("ops"), os.ModePerm)
+			fs.MkdirAll(filepath.Join(stateDir, "director-vars"), os.ModePerm)
+			fs.WriteFile(filepath.Join(stateDir, "director-vars", "my-vars.yml"), []byte("vars"), os.ModePerm)
+
+			_, err := executor.Interpolate(dirInput, deploymentDir, "azure", "/some/vars-file.yml")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, args := cmd.RunArgsForCall(0)
+			Expect(args).To(Equal([]string{
+				"interpolate", filepath.Join(deploymentDir, "bosh.yml"),
+				"-o", filepath.Join(deploymentDir, "azure", "cpi.yml"),
+				"-o", filepath.Join(deploymentDir, "jumpbox-user.yml"),
+				"-o", filepath.Join(deploymentDir, "uaa.yml"),
+				"-o", filepath.Join(deploymentDir, "credhub.yml"),
+				"--vars-file", "/some/vars-file.yml",
+				"-o", filepath.Join(stateDir, "director-ops", "my-ops.yml"),
+				"--vars-file", filepath.Join(stateDir, "director-vars", "my-vars.yml"),
+			}))
+		})
+
+		Context("when bosh interpolate fails", func() {
+			It("returns an error", func() {
+				dirInput.Deployment = "jumpbox"
+				cmd.RunStub = nil
+				cmd.RunReturns(errors.New("kiwi"))
+
+				_, err := executor.Interpolate(dirInput, deploymentDir, "aws", "/some/vars-file.yml")
+				Expect(err).To(MatchError("Interpolate jumpbox manifest: kiwi"))
+			})
+		})
+
+		Context("when the deployment is unfamiliar", func() {
+			It("returns an error", func() {
+				dirInput.Deployment = "some-deployment"
+
+				_, err := executor.Interpolate(dirInput, deploymentDir, "aws", "/some/vars-file.yml")
+				Expect(err).To(MatchError("Executor doesn't know how to interpolate a some-deployment manifest"))
+			})
+		})
+	})
+
 	Describe("CreateEnv", func() {
 		var (
 			cmd      *fakes.BOSHCommand
@@ -466,7 +957,7 @@ var _ = Describe("Executor", func() {
 			stateDir, err = fs.TempDir("", "")
 			Expect(err).NotTo(HaveOccurred())
 
-			executor = bosh.NewExecutor(cmd, fs)
+			executor = bosh.NewExecutor(cmd, fs, vault)
 
 			dirInput = bosh.DirInput{
 				Deployment: "some-deployment",
@@ -515,6 +1006,34 @@ var _ = Describe("Executor", func() {
 				bblStateDirEnv := os.Getenv("BBL_STATE_DIR")
 				Expect(bblStateDirEnv).To(Equal(stateDir))
 			})
+
+			By("decrypting the state for the duration of the script", func() {
+				Expect(vault.UnsealCall.CallCount).To(Equal(1))
+				Expect(vault.SealCall.CallCount).To(Equal(1))
+			})
+		})
+
+		Context("when the state cannot be decrypted", func() {
+			BeforeEach(func() {
+				vault.UnsealCall.Returns.Error = errors.New("mango")
+			})
+
+			It("returns an error without running the script", func() {
+				_, err := executor.CreateEnv(dirInput, state)
+				Expect(err).To(MatchError(fmt.Sprintf("Running %s: Decrypt state: mango", createEnvPath)))
+				Expect(vault.SealCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the state cannot be encrypted again", func() {
+			BeforeEach(func() {
+				vault.SealCall.Returns.Error = errors.New("papaya")
+			})
+
+			It("returns an error", func() {
+				_, err := executor.CreateEnv(dirInput, state)
+				Expect(err).To(MatchError(fmt.Sprintf("Running %s: Encrypt state: papaya", createEnvPath)))
+			})
 		})
 
 		Context("when iaas credentials are provided", func() {
@@ -623,6 +1142,88 @@ var _ = Describe("Executor", func() {
 				Expect(vars).To(Equal(""))
 			})
 		})
+
+		Context("when director features are turned on", func() {
+			BeforeEach(func() {
+				dirInput.Deployment = "director"
+				dirInput.DirectorFeatures = []string{"syslog", "bpm"}
+
+				createEnvPath = filepath.Join(stateDir, "create-director.sh")
+				fs.WriteFile(createEnvPath, []byte(`#!/bin/bash
+true \
+  --vars-store ${BBL_STATE_DIR}/vars/director-vars-store.yml  --vars-file ${BBL_STATE_DIR}/vars/director-vars-file.yml \
+  --vars-file ${BBL_STATE_DIR}/director-vars/syslog.yml  --vars-file ${BBL_STATE_DIR}/director-vars/transport.yml
+`), storage.ScriptMode)
+				fs.MkdirAll(filepath.Join(stateDir, "director-vars"), os.ModePerm)
+				fs.MkdirAll(filepath.Join(stateDir, "vars"), os.ModePerm)
+			})
+
+			AfterEach(func() {
+				fs.Remove(createEnvPath)
+				fs.Remove(filepath.Join(stateDir, "create-director-override.sh"))
+				fs.RemoveAll(filepath.Join(stateDir, "director-vars"))
+				fs.RemoveAll(filepath.Join(stateDir, "vars"))
+			})
+
+			It("runs the script when the vars files it passes set the vars they need", func() {
+				fs.WriteFile(filepath.Join(stateDir, "director-vars", "syslog.yml"), []byte("syslog_address: 10.0.0.5\nsyslog_port: 514\n"), os.ModePerm)
+				fs.WriteFile(filepath.Join(stateDir, "director-vars", "transport.yml"), []byte("syslog_transport: tcp\n"), os.ModePerm)
+
+				_, err := executor.CreateEnv(dirInput, state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vault.UnsealCall.CallCount).To(Equal(1))
+			})
+
+			It("reads the vars store and the vars file in the vars dir", func() {
+				fs.WriteFile(filepath.Join(stateDir, "vars", "director-vars-store.yml"), []byte("syslog_address: 10.0.0.5\n"), os.ModePerm)
+				fs.WriteFile(filepath.Join(stateDir, "vars", "director-vars-file.yml"), []byte("syslog_port: 514\nsyslog_transport: tcp\n"), os.ModePerm)
+
+				_, err := executor.CreateEnv(dirInput, state)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when there is a create-env override script", func() {
+				It("reads the vars it sets with flags", func() {
+					fs.WriteFile(filepath.Join(stateDir, "create-director-override.sh"), []byte(`#!/bin/bash
+true \
+  -v syslog_address=10.0.0.5 \
+  --var='syslog_port=514' \
+  --var-file syslog_transport="${BBL_STATE_DIR}/transport"
+`), storage.ScriptMode)
+
+					_, err := executor.CreateEnv(dirInput, state)
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			It("returns an error naming the missing vars without running the script", func() {
+				fs.WriteFile(filepath.Join(stateDir, "director-vars", "syslog.yml"), []byte("syslog_address: 10.0.0.5\n"), os.ModePerm)
+
+				_, err := executor.CreateEnv(dirInput, state)
+				Expect(err).To(MatchError("Director feature syslog needs syslog_port, syslog_transport. Set them in a vars file in the director-vars directory of the state directory."))
+				Expect(vault.UnsealCall.CallCount).To(Equal(0))
+			})
+
+			Context("when a vars file is not yaml", func() {
+				It("returns an error", func() {
+					fs.WriteFile(filepath.Join(stateDir, "director-vars", "bad.yml"), []byte("%%%"), os.ModePerm)
+
+					fs.WriteFile(createEnvPath, []byte("#!/bin/bash\ntrue --vars-file ${BBL_STATE_DIR}/director-vars/bad.yml\n"), storage.ScriptMode)
+
+					_, err := executor.CreateEnv(dirInput, state)
+					Expect(err).To(MatchError(ContainSubstring("Parse vars file bad.yml:")))
+				})
+			})
+
+			Context("when the create-env script does not exist", func() {
+				It("returns an error", func() {
+					fs.Remove(createEnvPath)
+
+					_, err := executor.CreateEnv(dirInput, state)
+					Expect(err).To(MatchError(ContainSubstring("Read create-director.sh:")))
+				})
+			})
+		})
 	})
 
 	Describe("DeleteEnv", func() {
@@ -646,7 +1247,7 @@ var _ = Describe("Executor", func() {
 			stateDir, err = fs.TempDir("", "")
 			Expect(err).NotTo(HaveOccurred())
 
-			executor = bosh.NewExecutor(cmd, fs)
+			executor = bosh.NewExecutor(cmd, fs, vault)
 
 			dirInput = bosh.DirInput{
 				Deployment: "director",
@@ -853,7 +1454,7 @@ var _ = Describe("Executor", func() {
 				return nil
 			}
 
-			executor = bosh.NewExecutor(cmd, fs)
+			executor = bosh.NewExecutor(cmd, fs, vault)
 		})
 
 		It("returns the correctly trimmed version", func() {
@@ -1,11 +1,13 @@
 package bosh
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/pmezard/go-difflib/difflib"
 	yaml "gopkg.in/yaml.v2"
 
 	"github.com/cloudfoundry/bosh-bootloader/fileio"
@@ -19,8 +21,10 @@ var (
 )
 
 type managerFs interface {
+	fileio.FileReader
 	fileio.FileWriter
 	fileio.TempDirer
+	fileio.AllRemover
 }
 
 type Manager struct {
@@ -41,10 +45,12 @@ type directorVars struct {
 
 type executor interface {
 	PlanDirector(DirInput, string, string) error
+	CheckDirectorFeatureVars(DirInput) error
 	PlanJumpbox(DirInput, string, string) error
 	CreateEnv(DirInput, storage.State) (string, error)
 	DeleteEnv(DirInput, storage.State) error
 	WriteDeploymentVars(DirInput, string) error
+	Interpolate(DirInput, string, string, string) (string, error)
 	Path() string
 	Version() (string, error)
 }
@@ -65,7 +71,7 @@ type sshKeyGetter interface {
 	Get(string) (string, error)
 }
 
-func NewManager(executor executor, logger logger, stateStore stateStore, sshKeyGetter sshKeyGetter, fs deleterFs) *Manager {
+func NewManager(executor executor, logger logger, stateStore stateStore, sshKeyGetter sshKeyGetter, fs managerFs) *Manager {
 	return &Manager{
 		executor:     executor,
 		logger:       logger,
@@ -102,8 +108,11 @@ func (m *Manager) InitializeJumpbox(state storage.State) error {
 	}
 
 	iaasInputs := DirInput{
-		StateDir: stateDir,
-		VarsDir:  varsDir,
+		StateDir:         stateDir,
+		VarsDir:          varsDir,
+		Sizing:           state.JumpboxSizing,
+		SourceDir:        state.JumpboxDeploymentDir,
+		OfflineAssetsDir: state.OfflineAssetsDir,
 	}
 
 	err = m.executor.PlanJumpbox(iaasInputs, deploymentDir, state.IAAS)
@@ -128,6 +137,7 @@ func (m *Manager) CreateJumpbox(state storage.State, terraformOutputs terraform.
 		Deployment: "jumpbox",
 		StateDir:   stateDir,
 		VarsDir:    varsDir,
+		Sizing:     state.JumpboxSizing,
 	}
 
 	err = m.executor.WriteDeploymentVars(dirInput, m.GetJumpboxDeploymentVars(state, terraformOutputs))
@@ -145,26 +155,43 @@ func (m *Manager) CreateJumpbox(state storage.State, terraformOutputs terraform.
 		URL: terraformOutputs.GetString("jumpbox_url"),
 	}
 
+	err = m.ConnectToJumpbox(state)
+	if err != nil {
+		return storage.State{}, err
+	}
+
+	m.saveManifest(dirInput, state.IAAS, m.stateStore.GetJumpboxDeploymentDir)
+
+	return state, nil
+}
+
+// ConnectToJumpbox points BOSH_ALL_PROXY at the jumpbox so that later
+// calls reach the director through it.
+func (m *Manager) ConnectToJumpbox(state storage.State) error {
+	if state.Jumpbox.URL == "" {
+		return errors.New("The jumpbox has not been created.")
+	}
+
 	dir, err := m.fs.TempDir("", "bosh-jumpbox")
 	if err != nil {
-		return storage.State{}, fmt.Errorf("Create temp dir for jumpbox private key: %s", err)
+		return fmt.Errorf("Create temp dir for jumpbox private key: %s", err)
 	}
 
 	privateKeyPath := filepath.Join(dir, "bosh_jumpbox_private.key")
 
 	privateKeyContents, err := m.sshKeyGetter.Get("jumpbox")
 	if err != nil {
-		return storage.State{}, fmt.Errorf("Get jumpbox private key: %s", err)
+		return fmt.Errorf("Get jumpbox private key: %s", err)
 	}
 
 	err = m.fs.WriteFile(privateKeyPath, []byte(privateKeyContents), 0600)
 	if err != nil {
-		return storage.State{}, fmt.Errorf("Write jumpbox private key: %s", err)
+		return fmt.Errorf("Write jumpbox private key: %s", err)
 	}
 
 	osSetenv("BOSH_ALL_PROXY", fmt.Sprintf("ssh+socks5://jumpbox@%s?private-key=%s", state.Jumpbox.URL, privateKeyPath))
 
-	return state, nil
+	return nil
 }
 
 func (m *Manager) InitializeDirector(state storage.State) error {
@@ -181,8 +208,12 @@ func (m *Manager) InitializeDirector(state storage.State) error {
 	}
 
 	iaasInputs := DirInput{
-		StateDir: stateDir,
-		VarsDir:  varsDir,
+		StateDir:         stateDir,
+		VarsDir:          varsDir,
+		DirectorFeatures: state.DirectorFeatures,
+		Sizing:           state.DirectorSizing,
+		SourceDir:        state.BOSHDeploymentDir,
+		OfflineAssetsDir: state.OfflineAssetsDir,
 	}
 
 	err = m.executor.PlanDirector(iaasInputs, directorDeploymentDir, state.IAAS)
@@ -190,6 +221,13 @@ func (m *Manager) InitializeDirector(state storage.State) error {
 		return err
 	}
 
+	// The vars can still be added to director-vars before bbl up, which
+	// fails if they are missing then.
+	err = m.executor.CheckDirectorFeatureVars(iaasInputs)
+	if err != nil {
+		m.logger.Println(fmt.Sprintf("warning: %s", err))
+	}
+
 	return nil
 }
 
@@ -204,9 +242,16 @@ func (m *Manager) CreateDirector(state storage.State, terraformOutputs terraform
 	stateDir := m.stateStore.GetStateDir()
 
 	dirInput := DirInput{
-		Deployment: "director",
-		StateDir:   stateDir,
-		VarsDir:    varsDir,
+		Deployment:       "director",
+		StateDir:         stateDir,
+		VarsDir:          varsDir,
+		DirectorFeatures: state.DirectorFeatures,
+		Sizing:           state.DirectorSizing,
+	}
+
+	internalIP, err := directorInternalIP(terraformOutputs)
+	if err != nil {
+		return storage.State{}, err
 	}
 
 	err = m.executor.WriteDeploymentVars(dirInput, m.GetDirectorDeploymentVars(state, terraformOutputs))
@@ -224,18 +269,6 @@ func (m *Manager) CreateDirector(state storage.State, terraformOutputs terraform
 
 	directorVars := getDirectorVars(variables)
 
-	internalCIDR := terraformOutputs.GetString("internal_cidr")
-	parsedInternalCIDR, err := ParseCIDRBlock(internalCIDR)
-	if err != nil {
-		internalCIDR = "10.0.0.0/24"
-		parsedInternalCIDR, _ = ParseCIDRBlock(internalCIDR)
-	}
-
-	internalIP := terraformOutputs.GetString("director__internal_ip")
-	if internalIP == "" {
-		internalIP = parsedInternalCIDR.GetNthIP(6).String()
-	}
-
 	state.BOSH = storage.BOSH{
 		DirectorName:           fmt.Sprintf("bosh-%s", state.EnvID),
 		DirectorAddress:        fmt.Sprintf("https://%s:25555", internalIP),
@@ -246,10 +279,131 @@ func (m *Manager) CreateDirector(state storage.State, terraformOutputs terraform
 		DirectorSSLPrivateKey:  directorVars.sslPrivateKey,
 	}
 
+	m.saveManifest(dirInput, state.IAAS, m.stateStore.GetDirectorDeploymentDir)
+
 	m.logger.Step("created bosh director")
 	return state, nil
 }
 
+// directorInternalIP is the director__internal_ip output, or the sixth IP in
+// internal_cidr for templates that do not have it.
+func directorInternalIP(terraformOutputs terraform.Outputs) (string, error) {
+	if internalIP, err := terraformOutputs.String("director__internal_ip"); err == nil && internalIP != "" {
+		return internalIP, nil
+	}
+
+	internalCIDR, err := terraformOutputs.String("internal_cidr")
+	if err != nil {
+		return "", fmt.Errorf("Director internal IP: %s", err)
+	}
+
+	parsedInternalCIDR, err := ParseCIDRBlock(internalCIDR)
+	if err != nil {
+		return "", fmt.Errorf("Parse internal_cidr terraform output: %s", err)
+	}
+
+	return parsedInternalCIDR.GetNthIP(6).String(), nil
+}
+
+// DiffJumpboxManifest shows how the jumpbox manifest that bbl up would
+// deploy differs from the one it last deployed.
+func (m *Manager) DiffJumpboxManifest(state storage.State, terraformOutputs terraform.Outputs) (string, error) {
+	return m.diffManifest("jumpbox", state, m.stateStore.GetJumpboxDeploymentDir, m.GetJumpboxDeploymentVars(state, terraformOutputs))
+}
+
+// DiffDirectorManifest shows how the director manifest that bbl up would
+// deploy differs from the one it last deployed.
+func (m *Manager) DiffDirectorManifest(state storage.State, terraformOutputs terraform.Outputs) (string, error) {
+	return m.diffManifest("director", state, m.stateStore.GetDirectorDeploymentDir, m.GetDirectorDeploymentVars(state, terraformOutputs))
+}
+
+func (m *Manager) diffManifest(deployment string, state storage.State, getDeploymentDir func() (string, error), deploymentVars string) (string, error) {
+	varsDir, err := m.stateStore.GetVarsDir()
+	if err != nil {
+		return "", err
+	}
+
+	deploymentDir, err := getDeploymentDir()
+	if err != nil {
+		return "", err
+	}
+
+	// The planned deployment vars go to a temp dir, since a dry run must
+	// leave the vars dir as it is.
+	dir, err := m.fs.TempDir("", "bbl-dry-run")
+	if err != nil {
+		return "", fmt.Errorf("Create temp dir for deployment vars: %s", err)
+	}
+	defer m.fs.RemoveAll(dir)
+
+	varsFile := filepath.Join(dir, fmt.Sprintf("%s-vars-file.yml", deployment))
+	err = m.fs.WriteFile(varsFile, []byte(deploymentVars), storage.StateMode)
+	if err != nil {
+		return "", fmt.Errorf("Write deployment vars: %s", err)
+	}
+
+	dirInput := DirInput{
+		Deployment:       deployment,
+		StateDir:         m.stateStore.GetStateDir(),
+		VarsDir:          varsDir,
+		DirectorFeatures: state.DirectorFeatures,
+		Sizing:           state.JumpboxSizing,
+		OfflineAssetsDir: state.OfflineAssetsDir,
+	}
+	if deployment == "director" {
+		dirInput.Sizing = state.DirectorSizing
+	}
+
+	planned, err := m.executor.Interpolate(dirInput, deploymentDir, state.IAAS, varsFile)
+	if err != nil {
+		return "", err
+	}
+
+	deployed, err := m.fs.ReadFile(filepath.Join(varsDir, fmt.Sprintf("%s-manifest.yml", deployment)))
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("Read deployed %s manifest: %s", deployment, err)
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(string(deployed)),
+		B:        splitLines(planned),
+		FromFile: fmt.Sprintf("deployed %s manifest", deployment),
+		ToFile:   fmt.Sprintf("planned %s manifest", deployment),
+		Context:  3,
+	})
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	if !strings.HasSuffix(s, "\n") {
+		s += "\n"
+	}
+	lines := strings.SplitAfter(s, "\n")
+	return lines[:len(lines)-1]
+}
+
+// saveManifest keeps the manifest that bosh create-env just deployed, so
+// that bbl up --dry-run can compare the next deploy against it. The deploy
+// has already succeeded, so a failure here is only a warning.
+func (m *Manager) saveManifest(dirInput DirInput, iaas string, getDeploymentDir func() (string, error)) {
+	deploymentDir, err := getDeploymentDir()
+	if err == nil {
+		varsFile := filepath.Join(dirInput.VarsDir, fmt.Sprintf("%s-vars-file.yml", dirInput.Deployment))
+
+		var manifest string
+		manifest, err = m.executor.Interpolate(dirInput, deploymentDir, iaas, varsFile)
+		if err == nil {
+			err = m.fs.WriteFile(filepath.Join(dirInput.VarsDir, fmt.Sprintf("%s-manifest.yml", dirInput.Deployment)), []byte(manifest), storage.StateMode)
+		}
+	}
+
+	if err != nil {
+		m.logger.Println(fmt.Sprintf("warning: could not save the deployed %s manifest: %s", dirInput.Deployment, err))
+	}
+}
+
 func (m *Manager) DeleteDirector(state storage.State, terraformOutputs terraform.Outputs) error {
 	if state.BOSH.IsEmpty() {
 		return nil
@@ -3,6 +3,7 @@ package bosh_test
 import (
 	"errors"
 	"io/ioutil"
+	"path/filepath"
 
 	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/fakes"
@@ -102,6 +103,54 @@ director_ssl:
 				Expect(boshExecutor.CreateEnvCall.CallCount).To(Equal(0))
 			})
 
+			It("passes the director features and sizing from the state", func() {
+				state.DirectorFeatures = []string{"syslog"}
+				state.JumpboxSizing = storage.Sizing{VMType: "t2.small"}
+				state.DirectorSizing = storage.Sizing{VMType: "m4.large"}
+
+				err := boshManager.InitializeDirector(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boshExecutor.PlanDirectorCall.Receives.DirInput.DirectorFeatures).To(Equal([]string{"syslog"}))
+				Expect(boshExecutor.PlanDirectorCall.Receives.DirInput.Sizing).To(Equal(storage.Sizing{VMType: "m4.large"}))
+			})
+
+			It("checks the vars of the director features", func() {
+				state.DirectorFeatures = []string{"syslog"}
+
+				err := boshManager.InitializeDirector(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boshExecutor.CheckDirectorFeatureVarsCall.CallCount).To(Equal(1))
+				Expect(boshExecutor.CheckDirectorFeatureVarsCall.Receives.DirInput.DirectorFeatures).To(Equal([]string{"syslog"}))
+				Expect(logger.PrintlnCall.CallCount).To(Equal(0))
+			})
+
+			Context("when a director feature is missing vars", func() {
+				It("warns, since they can still be set before bbl up", func() {
+					boshExecutor.CheckDirectorFeatureVarsCall.Returns.Error = errors.New("Director feature syslog needs syslog_port.")
+
+					err := boshManager.InitializeDirector(state)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(logger.PrintlnCall.Messages).To(ContainElement("warning: Director feature syslog needs syslog_port."))
+				})
+			})
+
+			It("passes the bosh-deployment dir from the state", func() {
+				state.JumpboxDeploymentDir = "/some/jumpbox-deployment"
+				state.BOSHDeploymentDir = "/some/bosh-deployment"
+
+				err := boshManager.InitializeDirector(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boshExecutor.PlanDirectorCall.Receives.DirInput.SourceDir).To(Equal("/some/bosh-deployment"))
+			})
+
+			It("passes the offline assets dir from the state", func() {
+				state.OfflineAssetsDir = "/some/assets"
+
+				err := boshManager.InitializeDirector(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boshExecutor.PlanDirectorCall.Receives.DirInput.OfflineAssetsDir).To(Equal("/some/assets"))
+			})
+
 			Context("when create env args fails", func() {
 				BeforeEach(func() {
 					boshExecutor.PlanDirectorCall.Returns.Error = errors.New("failed to interpolate")
@@ -139,6 +188,7 @@ director_ssl:
 					"some-key":      "some-value",
 					"tags":          []interface{}{"some-tag", "some-other-tag"},
 				}}
+				state.DirectorFeatures = []string{"bpm"}
 			})
 
 			It("calls create env on the bosh executor with the expected arguments", func() {
@@ -151,6 +201,7 @@ director_ssl:
 				Expect(boshExecutor.CreateEnvCall.Receives.DirInput.Deployment).To(Equal("director"))
 				Expect(boshExecutor.CreateEnvCall.Receives.DirInput.VarsDir).To(Equal("some-bbl-vars-dir"))
 				Expect(boshExecutor.CreateEnvCall.Receives.DirInput.StateDir).To(Equal("some-state-dir"))
+				Expect(boshExecutor.CreateEnvCall.Receives.DirInput.DirectorFeatures).To(Equal([]string{"bpm"}))
 
 				Expect(stateWithDirector.BOSH).To(Equal(storage.BOSH{
 					DirectorName:           "bosh-some-env-id",
@@ -164,7 +215,37 @@ director_ssl:
 				}))
 			})
 
+			Context("when terraform has a director__internal_ip output", func() {
+				It("uses it for the director address", func() {
+					terraformOutputs.Map["director__internal_ip"] = "10.2.0.10"
+
+					stateWithDirector, err := boshManager.CreateDirector(state, terraformOutputs)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(stateWithDirector.BOSH.DirectorAddress).To(Equal("https://10.2.0.10:25555"))
+				})
+			})
+
 			Context("when an error occurs", func() {
+				Context("when the internal_cidr output is missing", func() {
+					It("returns an error before creating the director", func() {
+						delete(terraformOutputs.Map, "internal_cidr")
+
+						_, err := boshManager.CreateDirector(state, terraformOutputs)
+						Expect(err).To(MatchError("Director internal IP: missing internal_cidr terraform output"))
+						Expect(boshExecutor.CreateEnvCall.CallCount).To(Equal(0))
+					})
+				})
+
+				Context("when the internal_cidr output is not a CIDR block", func() {
+					It("returns an error", func() {
+						terraformOutputs.Map["internal_cidr"] = "some-cidr"
+
+						_, err := boshManager.CreateDirector(state, terraformOutputs)
+						Expect(err).To(MatchError(`Parse internal_cidr terraform output: "some-cidr" cannot parse CIDR block`))
+					})
+				})
+
 				Context("when get vars dir fails", func() {
 					It("returns an error", func() {
 						stateStore.GetVarsDirCall.Returns.Error = errors.New("pineapple")
@@ -235,6 +316,32 @@ director_ssl:
 				Expect(boshExecutor.PlanJumpboxCall.Receives.DirInput.StateDir).To(Equal("some-state-dir"))
 			})
 
+			It("passes the jumpbox sizing from the state", func() {
+				state.JumpboxSizing = storage.Sizing{VMType: "t2.small"}
+				state.DirectorSizing = storage.Sizing{VMType: "m4.large"}
+
+				err := boshManager.InitializeJumpbox(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boshExecutor.PlanJumpboxCall.Receives.DirInput.Sizing).To(Equal(storage.Sizing{VMType: "t2.small"}))
+			})
+
+			It("passes the jumpbox-deployment dir from the state", func() {
+				state.JumpboxDeploymentDir = "/some/jumpbox-deployment"
+				state.BOSHDeploymentDir = "/some/bosh-deployment"
+
+				err := boshManager.InitializeJumpbox(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boshExecutor.PlanJumpboxCall.Receives.DirInput.SourceDir).To(Equal("/some/jumpbox-deployment"))
+			})
+
+			It("passes the offline assets dir from the state", func() {
+				state.OfflineAssetsDir = "/some/assets"
+
+				err := boshManager.InitializeJumpbox(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boshExecutor.PlanJumpboxCall.Receives.DirInput.OfflineAssetsDir).To(Equal("/some/assets"))
+			})
+
 			Context("when an error occurs", func() {
 				Context("when get vars dir fails", func() {
 					It("returns an error", func() {
@@ -294,6 +401,33 @@ director_ssl:
 				}))
 			})
 
+			It("saves the manifest it deployed", func() {
+				boshExecutor.InterpolateCall.Returns.Manifest = "name: jumpbox"
+
+				_, err := boshManager.CreateJumpbox(state, terraformOutputs)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(boshExecutor.InterpolateCall.Receives.DeploymentDir).To(Equal("some-jumpbox-deployment-dir"))
+				Expect(boshExecutor.InterpolateCall.Receives.IAAS).To(Equal("gcp"))
+				Expect(boshExecutor.InterpolateCall.Receives.VarsFile).To(Equal(filepath.Join("some-bbl-vars-dir", "jumpbox-vars-file.yml")))
+				Expect(fs.WriteFileCall.Receives).To(ContainElement(fakes.WriteFileReceive{
+					Filename: filepath.Join("some-bbl-vars-dir", "jumpbox-manifest.yml"),
+					Contents: []byte("name: jumpbox"),
+					Mode:     storage.StateMode,
+				}))
+			})
+
+			Context("when the deployed manifest cannot be saved", func() {
+				It("warns and carries on", func() {
+					boshExecutor.InterpolateCall.Returns.Error = errors.New("papaya")
+
+					_, err := boshManager.CreateJumpbox(state, terraformOutputs)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(logger.PrintlnCall.Messages).To(ContainElement("warning: could not save the deployed jumpbox manifest: papaya"))
+				})
+			})
+
 			Context("when an error occurs", func() {
 				Context("when geting the jumpbox key fails", func() {
 					BeforeEach(func() {
@@ -348,6 +482,82 @@ director_ssl:
 				})
 			})
 		})
+
+		Describe("ConnectToJumpbox", func() {
+			It("sets BOSH_ALL_PROXY for an existing jumpbox", func() {
+				err := boshManager.ConnectToJumpbox(storage.State{Jumpbox: storage.Jumpbox{URL: "some-jumpbox-url:22"}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(osSetenvKey).To(Equal("BOSH_ALL_PROXY"))
+				Expect(osSetenvValue).To(Equal("ssh+socks5://jumpbox@some-jumpbox-url:22?private-key=/fake/file/bosh-jumpbox/bosh_jumpbox_private.key"))
+			})
+
+			Context("when the jumpbox has not been created", func() {
+				It("returns an error", func() {
+					err := boshManager.ConnectToJumpbox(storage.State{})
+					Expect(err).To(MatchError("The jumpbox has not been created."))
+				})
+			})
+		})
+	})
+
+	Describe("DiffJumpboxManifest", func() {
+		BeforeEach(func() {
+			fs.TempDirCall.Returns.Name = "/tmp/bbl-dry-run"
+			fs.ReadFileCall.Returns.Contents = []byte("name: jumpbox\nvm_type: small\n")
+			boshExecutor.InterpolateCall.Returns.Manifest = "name: jumpbox\nvm_type: large\n"
+		})
+
+		It("diffs the planned manifest against the one bbl last deployed", func() {
+			diff, err := boshManager.DiffJumpboxManifest(storage.State{IAAS: "aws"}, terraform.Outputs{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(diff).To(Equal(`--- deployed jumpbox manifest
++++ planned jumpbox manifest
+@@ -1,2 +1,2 @@
+ name: jumpbox
+-vm_type: small
++vm_type: large
+`))
+
+			Expect(fs.ReadFileCall.Receives.Filename).To(Equal(filepath.Join("some-bbl-vars-dir", "jumpbox-manifest.yml")))
+			Expect(boshExecutor.InterpolateCall.Receives.DirInput.Deployment).To(Equal("jumpbox"))
+			Expect(boshExecutor.InterpolateCall.Receives.DeploymentDir).To(Equal("some-jumpbox-deployment-dir"))
+			Expect(boshExecutor.InterpolateCall.Receives.VarsFile).To(Equal(filepath.Join("/tmp/bbl-dry-run", "jumpbox-vars-file.yml")))
+			Expect(fs.RemoveAllCall.Receives).To(ContainElement(fakes.RemoveAllReceive{Path: "/tmp/bbl-dry-run"}))
+		})
+
+		Context("when the planned manifest matches", func() {
+			It("returns no diff", func() {
+				boshExecutor.InterpolateCall.Returns.Manifest = "name: jumpbox\nvm_type: small\n"
+
+				diff, err := boshManager.DiffJumpboxManifest(storage.State{}, terraform.Outputs{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(diff).To(BeEmpty())
+			})
+		})
+
+		Context("when bosh interpolate fails", func() {
+			It("returns an error", func() {
+				boshExecutor.InterpolateCall.Returns.Error = errors.New("guava")
+
+				_, err := boshManager.DiffJumpboxManifest(storage.State{}, terraform.Outputs{})
+				Expect(err).To(MatchError("guava"))
+			})
+		})
+	})
+
+	Describe("DiffDirectorManifest", func() {
+		It("interpolates the director manifest", func() {
+			fs.TempDirCall.Returns.Name = "/tmp/bbl-dry-run"
+
+			_, err := boshManager.DiffDirectorManifest(storage.State{IAAS: "gcp"}, terraform.Outputs{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(boshExecutor.InterpolateCall.Receives.DirInput.Deployment).To(Equal("director"))
+			Expect(boshExecutor.InterpolateCall.Receives.DeploymentDir).To(Equal("some-director-deployment-dir"))
+			Expect(fs.ReadFileCall.Receives.Filename).To(Equal(filepath.Join("some-bbl-vars-dir", "director-manifest.yml")))
+		})
 	})
 
 	Describe("DeleteJumpbox", func() {
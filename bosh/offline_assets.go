@@ -0,0 +1,295 @@
+package bosh
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// NoOfflineAssets given as --offline-assets goes back to downloading the
+// releases and stemcells.
+const NoOfflineAssets = "none"
+
+var stemcellFilename = regexp.MustCompile(`^(?:light-)?bosh-stemcell-([^-]+)-(.+)\.tgz$`)
+
+// offlineAsset is a release or stemcell tarball in the offline assets dir.
+type offlineAsset struct {
+	kind    string
+	name    string
+	version string
+	path    string
+	sha1    string
+	size    int64
+	modTime time.Time
+}
+
+// offlineAssetCache has the tarballs that scanOfflineAssets has read by path,
+// so that planning the jumpbox and then the director reads each one once.
+type offlineAssetCache map[string]offlineAsset
+
+type offlineAssetManifest struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+}
+
+// offlineAssetsManifest is the part of an interpolated manifest that says
+// where create-env downloads the releases and the stemcell from.
+type offlineAssetsManifest struct {
+	Releases []struct {
+		Name    string `yaml:"name"`
+		Version string `yaml:"version"`
+		URL     string `yaml:"url"`
+	} `yaml:"releases"`
+	ResourcePools []struct {
+		Name     string `yaml:"name"`
+		Stemcell struct {
+			URL string `yaml:"url"`
+		} `yaml:"stemcell"`
+	} `yaml:"resource_pools"`
+}
+
+// offlineAssetsOpsFile is where bbl writes the ops file that points the
+// releases and the stemcell of deployment at the offline assets dir.
+func offlineAssetsOpsFile(stateDir, iaas, deployment string) string {
+	return filepath.Join(stateDir, "bbl-ops-files", iaas, fmt.Sprintf("%s-offline-assets-ops.yml", deployment))
+}
+
+// writeOfflineAssetsOps interpolates the manifest with args and writes an ops
+// file to opsFile that replaces the url and sha1 of every release and stemcell
+// in it with a tarball from the offline assets dir. The sha1 paths are
+// optional, since a manifest does not have to give a sha1 with every url. It
+// fails listing every release and stemcell that the dir does not have.
+func (e Executor) writeOfflineAssetsOps(input DirInput, deployment string, args []string, opsFile string) error {
+	assets, err := e.scanOfflineAssets(input.OfflineAssetsDir)
+	if err != nil {
+		return fmt.Errorf("Read offline assets: %s", err)
+	}
+
+	contents, err := e.interpolate(input.StateDir, deployment, args)
+	if err != nil {
+		return err
+	}
+
+	var manifest offlineAssetsManifest
+	err = yaml.Unmarshal([]byte(contents), &manifest)
+	if err != nil {
+		return fmt.Errorf("Parse %s manifest: %s", deployment, err)
+	}
+
+	ops := "---\n"
+	replace := func(path, value string) {
+		ops += fmt.Sprintf("- type: replace\n  path: %s\n  value: %s\n\n", path, strconv.Quote(value))
+	}
+	missing := []string{}
+
+	for _, release := range manifest.Releases {
+		if strings.HasPrefix(release.URL, "file://") {
+			continue
+		}
+
+		version := release.Version
+		if version == "" {
+			version = urlVersion(release.URL)
+		}
+
+		asset, ok := findOfflineAsset(assets, "release", release.Name, version)
+		if !ok {
+			missing = append(missing, strings.TrimSpace(fmt.Sprintf("release %s %s", release.Name, version)))
+			continue
+		}
+
+		replace(fmt.Sprintf("/releases/name=%s/url", release.Name), "file://"+asset.path)
+		replace(fmt.Sprintf("/releases/name=%s/sha1?", release.Name), asset.sha1)
+	}
+
+	for _, pool := range manifest.ResourcePools {
+		if pool.Stemcell.URL == "" || strings.HasPrefix(pool.Stemcell.URL, "file://") {
+			continue
+		}
+
+		name, version := stemcellNameAndVersion(pool.Stemcell.URL)
+		if name == "" {
+			missing = append(missing, fmt.Sprintf("stemcell from %s", pool.Stemcell.URL))
+			continue
+		}
+
+		asset, ok := findOfflineAsset(assets, "stemcell", name, version)
+		if !ok {
+			missing = append(missing, fmt.Sprintf("stemcell %s %s", name, version))
+			continue
+		}
+
+		replace(fmt.Sprintf("/resource_pools/name=%s/stemcell/url", pool.Name), "file://"+asset.path)
+		replace(fmt.Sprintf("/resource_pools/name=%s/stemcell/sha1?", pool.Name), asset.sha1)
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("The offline assets in %s are missing what the %s needs: %s.", input.OfflineAssetsDir, deployment, strings.Join(missing, ", "))
+	}
+
+	err = e.fs.MkdirAll(filepath.Dir(opsFile), storage.StateMode)
+	if err != nil {
+		return fmt.Errorf("Create bbl-ops-files: %s", err) //not tested
+	}
+	err = e.fs.WriteFile(opsFile, []byte(ops), storage.StateMode)
+	if err != nil {
+		return fmt.Errorf("Write offline assets ops file: %s", err) //not tested
+	}
+
+	return nil
+}
+
+func findOfflineAsset(assets []offlineAsset, kind, name, version string) (offlineAsset, bool) {
+	for _, asset := range assets {
+		if asset.kind == kind && asset.name == name && (version == "" || asset.version == version) {
+			return asset, true
+		}
+	}
+	return offlineAsset{}, false
+}
+
+// urlVersion is the version in a bosh.io download url, such as
+// https://bosh.io/d/github.com/cloudfoundry/os-conf-release?v=13.
+func urlVersion(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("v")
+}
+
+// stemcellNameAndVersion reads the name and version of a stemcell from a
+// bosh.io download url or from the name of a stemcell tarball.
+func stemcellNameAndVersion(rawURL string) (string, string) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", ""
+	}
+
+	if strings.HasPrefix(u.Path, "/d/stemcells/") {
+		return path.Base(u.Path), u.Query().Get("v")
+	}
+
+	matches := stemcellFilename.FindStringSubmatch(path.Base(u.Path))
+	if matches == nil {
+		return "", ""
+	}
+	return "bosh-" + matches[2], matches[1]
+}
+
+// scanOfflineAssets reads the name and version of the release and stemcell
+// tarballs in dir. A tarball that has the same size and modification time as
+// when it was last read comes from the cache.
+func (e Executor) scanOfflineAssets(dir string) ([]offlineAsset, error) {
+	infos, err := e.fs.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := []offlineAsset{}
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || strings.HasPrefix(name, ".") {
+			continue
+		}
+		if !strings.HasSuffix(name, ".tgz") && !strings.HasSuffix(name, ".tar.gz") {
+			continue
+		}
+
+		tarball := filepath.Join(dir, name)
+		if asset, ok := e.offlineAssets[tarball]; ok && asset.size == info.Size() && asset.modTime.Equal(info.ModTime()) {
+			assets = append(assets, asset)
+			continue
+		}
+
+		asset, err := e.readOfflineAsset(tarball)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err)
+		}
+		asset.size = info.Size()
+		asset.modTime = info.ModTime()
+
+		if e.offlineAssets != nil {
+			e.offlineAssets[tarball] = asset
+		}
+		assets = append(assets, asset)
+	}
+
+	return assets, nil
+}
+
+// readOfflineAsset reads the release.MF or stemcell.MF at the top of a
+// tarball, and the sha1 of the whole tarball, without unpacking the rest.
+func (e Executor) readOfflineAsset(tarball string) (offlineAsset, error) {
+	f, err := e.fs.OpenFile(tarball, os.O_RDONLY, 0)
+	if err != nil {
+		return offlineAsset{}, err
+	}
+	defer f.Close()
+
+	hash := sha1.New()
+	gz, err := gzip.NewReader(io.TeeReader(f, hash))
+	if err != nil {
+		return offlineAsset{}, err
+	}
+
+	asset := offlineAsset{path: tarball}
+	tr := tar.NewReader(gz)
+	for asset.kind == "" {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return offlineAsset{}, err
+		}
+
+		switch path.Clean(header.Name) {
+		case "release.MF":
+			asset.kind = "release"
+		case "stemcell.MF":
+			asset.kind = "stemcell"
+		default:
+			continue
+		}
+
+		contents, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return offlineAsset{}, err
+		}
+
+		var manifest offlineAssetManifest
+		err = yaml.Unmarshal(contents, &manifest)
+		if err != nil {
+			return offlineAsset{}, fmt.Errorf("Parse %s: %s", path.Clean(header.Name), err)
+		}
+		asset.name = manifest.Name
+		asset.version = manifest.Version
+	}
+
+	if asset.kind == "" {
+		return offlineAsset{}, fmt.Errorf("not a release or stemcell tarball")
+	}
+
+	_, err = io.Copy(hash, f)
+	if err != nil {
+		return offlineAsset{}, err
+	}
+	asset.sha1 = fmt.Sprintf("%x", hash.Sum(nil))
+
+	return asset, nil
+}
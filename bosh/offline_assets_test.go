@@ -0,0 +1,298 @@
+package bosh_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Executor with offline assets", func() {
+	var (
+		fs            *afero.Afero
+		cmd           *fakes.BOSHCommand
+		stateDir      string
+		deploymentDir string
+		assetsDir     string
+		dirInput      bosh.DirInput
+		manifest      string
+
+		executor bosh.Executor
+	)
+
+	writeTarball := func(name, mfName, mf string) string {
+		buffer := bytes.NewBuffer([]byte{})
+		gz := gzip.NewWriter(buffer)
+		tw := tar.NewWriter(gz)
+		for _, file := range []struct{ name, contents string }{
+			{"./" + mfName, mf},
+			{"./image", "some-image"},
+		} {
+			Expect(tw.WriteHeader(&tar.Header{Name: file.name, Mode: 0644, Size: int64(len(file.contents))})).To(Succeed())
+			_, err := tw.Write([]byte(file.contents))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(tw.Close()).To(Succeed())
+		Expect(gz.Close()).To(Succeed())
+
+		Expect(fs.WriteFile(filepath.Join(assetsDir, name), buffer.Bytes(), os.ModePerm)).To(Succeed())
+		return fmt.Sprintf("%x", sha1.Sum(buffer.Bytes()))
+	}
+
+	BeforeEach(func() {
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+		cmd = &fakes.BOSHCommand{}
+		cmd.RunStub = func(stdout io.Writer, workingDirectory string, args []string) error {
+			stdout.Write([]byte(manifest))
+			return nil
+		}
+		cmd.GetBOSHPathCall.Returns.Path = "bosh-path"
+
+		stateDir = "/some/state-dir"
+		deploymentDir = filepath.Join(stateDir, "deployment")
+		assetsDir = "/some/assets"
+		fs.MkdirAll(deploymentDir, os.ModePerm)
+		fs.MkdirAll(assetsDir, os.ModePerm)
+
+		dirInput = bosh.DirInput{
+			StateDir:         stateDir,
+			VarsDir:          filepath.Join(stateDir, "vars"),
+			OfflineAssetsDir: assetsDir,
+		}
+
+		manifest = `---
+name: jumpbox
+releases:
+- name: os-conf
+  version: 13
+  url: https://bosh.io/d/github.com/cloudfoundry/os-conf-release?v=13
+- name: bosh-vsphere-cpi
+  url: https://bosh.io/d/github.com/cloudfoundry-incubator/bosh-vsphere-cpi-release?v=45.1.0
+resource_pools:
+- name: vms
+  stemcell:
+    url: https://bosh.io/d/stemcells/bosh-vsphere-esxi-ubuntu-trusty-go_agent?v=3468.17
+`
+
+		executor = bosh.NewExecutor(cmd, fs, &fakes.Vault{})
+	})
+
+	Describe("PlanJumpbox", func() {
+		It("points every release and the stemcell at the tarballs in the offline assets dir", func() {
+			osConfSHA1 := writeTarball("os-conf.tgz", "release.MF", "name: os-conf\nversion: 13\n")
+			cpiSHA1 := writeTarball("cpi.tgz", "release.MF", "name: bosh-vsphere-cpi\nversion: 45.1.0\n")
+			writeTarball("old-cpi.tgz", "release.MF", "name: bosh-vsphere-cpi\nversion: 44\n")
+			stemcellSHA1 := writeTarball("stemcell.tgz", "stemcell.MF", "name: bosh-vsphere-esxi-ubuntu-trusty-go_agent\nversion: '3468.17'\n")
+			fs.WriteFile(filepath.Join(assetsDir, "README.md"), []byte("notes"), os.ModePerm)
+
+			err := executor.PlanJumpbox(dirInput, deploymentDir, "vsphere")
+			Expect(err).NotTo(HaveOccurred())
+
+			By("interpolating the manifest with the planned ops files", func() {
+				_, _, args := cmd.RunArgsForCall(0)
+				Expect(args).To(Equal([]string{
+					"interpolate", filepath.Join(deploymentDir, "jumpbox.yml"),
+					"-o", filepath.Join(deploymentDir, "vsphere", "cpi.yml"),
+					"-o", filepath.Join(deploymentDir, "vsphere", "resource-pool.yml"),
+					"-o", filepath.Join(deploymentDir, "vsphere-jumpbox-network.yml"),
+				}))
+			})
+
+			By("writing an ops file with the local urls and sha1s to bbl-ops-files", func() {
+				opsFile, err := fs.ReadFile(filepath.Join(stateDir, "bbl-ops-files", "vsphere", "jumpbox-offline-assets-ops.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(opsFile)).To(Equal(fmt.Sprintf(`---
+- type: replace
+  path: /releases/name=os-conf/url
+  value: "file:///some/assets/os-conf.tgz"
+
+- type: replace
+  path: /releases/name=os-conf/sha1?
+  value: "%s"
+
+- type: replace
+  path: /releases/name=bosh-vsphere-cpi/url
+  value: "file:///some/assets/cpi.tgz"
+
+- type: replace
+  path: /releases/name=bosh-vsphere-cpi/sha1?
+  value: "%s"
+
+- type: replace
+  path: /resource_pools/name=vms/stemcell/url
+  value: "file:///some/assets/stemcell.tgz"
+
+- type: replace
+  path: /resource_pools/name=vms/stemcell/sha1?
+  value: "%s"
+
+`, osConfSHA1, cpiSHA1, stemcellSHA1)))
+			})
+
+			By("applying the ops file last", func() {
+				script, err := fs.ReadFile(filepath.Join(stateDir, "create-jumpbox.sh"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(script)).To(ContainSubstring("-o  ${BBL_STATE_DIR}/bbl-ops-files/vsphere/jumpbox-offline-assets-ops.yml \\\n  -v  vcenter_user"))
+			})
+		})
+
+		Context("when offline assets are turned on or off", func() {
+			It("changes the fingerprint of the jumpbox phase of bbl up", func() {
+				writeTarball("os-conf.tgz", "release.MF", "name: os-conf\nversion: 13\n")
+				writeTarball("cpi.tgz", "release.MF", "name: bosh-vsphere-cpi\nversion: 45.1.0\n")
+				writeTarball("stemcell.tgz", "stemcell.MF", "name: bosh-vsphere-esxi-ubuntu-trusty-go_agent\nversion: '3468.17'\n")
+				fingerprinter := storage.NewFingerprinter(stateDir, fs)
+
+				dirInput.OfflineAssetsDir = ""
+				err := executor.PlanJumpbox(dirInput, deploymentDir, "vsphere")
+				Expect(err).NotTo(HaveOccurred())
+
+				withoutAssets, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX)
+				Expect(err).NotTo(HaveOccurred())
+
+				dirInput.OfflineAssetsDir = assetsDir
+				err = executor.PlanJumpbox(dirInput, deploymentDir, "vsphere")
+				Expect(err).NotTo(HaveOccurred())
+
+				withAssets, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(withAssets).NotTo(Equal(withoutAssets))
+
+				dirInput.OfflineAssetsDir = ""
+				err = executor.PlanJumpbox(dirInput, deploymentDir, "vsphere")
+				Expect(err).NotTo(HaveOccurred())
+
+				withoutAssetsAgain, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(withoutAssetsAgain).To(Equal(withoutAssets))
+
+				_, err = fs.Stat(filepath.Join(stateDir, "bbl-ops-files", "vsphere", "jumpbox-offline-assets-ops.yml"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the director is planned after the jumpbox", func() {
+			BeforeEach(func() {
+				manifest = `---
+releases:
+- name: os-conf
+  version: 13
+  url: https://bosh.io/d/github.com/cloudfoundry/os-conf-release?v=13
+`
+			})
+
+			It("reads each tarball once unless its size or modification time changed", func() {
+				tarball := filepath.Join(assetsDir, "os-conf.tgz")
+				osConfSHA1 := writeTarball("os-conf.tgz", "release.MF", "name: os-conf\nversion: 13\n#1\n")
+				info, err := fs.Stat(tarball)
+				Expect(err).NotTo(HaveOccurred())
+				size := info.Size()
+				modTime := time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)
+				Expect(fs.Chtimes(tarball, modTime, modTime)).To(Succeed())
+
+				err = executor.PlanJumpbox(dirInput, deploymentDir, "aws")
+				Expect(err).NotTo(HaveOccurred())
+
+				newSHA1 := writeTarball("os-conf.tgz", "release.MF", "name: os-conf\nversion: 13\n#2\n")
+				Expect(fs.Chtimes(tarball, modTime, modTime)).To(Succeed())
+				info, err = fs.Stat(tarball)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Size()).To(Equal(size))
+
+				err = executor.PlanDirector(dirInput, filepath.Join(stateDir, "bosh-deployment"), "aws")
+				Expect(err).NotTo(HaveOccurred())
+
+				opsFile, err := fs.ReadFile(filepath.Join(stateDir, "bbl-ops-files", "aws", "director-offline-assets-ops.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(opsFile)).To(ContainSubstring(osConfSHA1))
+
+				newModTime := modTime.Add(time.Minute)
+				Expect(fs.Chtimes(tarball, newModTime, newModTime)).To(Succeed())
+
+				err = executor.PlanDirector(dirInput, filepath.Join(stateDir, "bosh-deployment"), "aws")
+				Expect(err).NotTo(HaveOccurred())
+
+				opsFile, err = fs.ReadFile(filepath.Join(stateDir, "bbl-ops-files", "aws", "director-offline-assets-ops.yml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(opsFile)).To(ContainSubstring(newSHA1))
+			})
+		})
+
+		Context("when the offline assets dir is missing releases or stemcells", func() {
+			It("returns an error listing all of them without writing the scripts", func() {
+				writeTarball("os-conf.tgz", "release.MF", "name: os-conf\nversion: 12\n")
+
+				err := executor.PlanJumpbox(dirInput, deploymentDir, "vsphere")
+				Expect(err).To(MatchError("The offline assets in /some/assets are missing what the jumpbox needs: release os-conf 13, release bosh-vsphere-cpi 45.1.0, stemcell bosh-vsphere-esxi-ubuntu-trusty-go_agent 3468.17."))
+
+				_, err = fs.Stat(filepath.Join(stateDir, "create-jumpbox.sh"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when a tarball is not a release or a stemcell", func() {
+			It("returns an error", func() {
+				writeTarball("other.tgz", "other.MF", "name: other\n")
+
+				err := executor.PlanJumpbox(dirInput, deploymentDir, "vsphere")
+				Expect(err).To(MatchError("Read offline assets: other.tgz: not a release or stemcell tarball"))
+			})
+		})
+	})
+
+	Describe("PlanDirector", func() {
+		It("writes the ops file to bbl-ops-files and reads the stemcell from a tarball url", func() {
+			manifest = `---
+releases:
+- name: bosh
+  version: "265.2.0"
+  url: https://s3.amazonaws.com/bosh-compiled-release-tarballs/bosh-265.2.0-ubuntu-trusty-3541.10.tgz
+resource_pools:
+- name: vms
+  stemcell:
+    url: https://s3.amazonaws.com/bosh-aws-light-stemcells/light-bosh-stemcell-3541.10-aws-xen-hvm-ubuntu-trusty-go_agent.tgz
+`
+			writeTarball("bosh.tgz", "release.MF", "name: bosh\nversion: 265.2.0\n")
+			writeTarball("stemcell.tgz", "stemcell.MF", "name: bosh-aws-xen-hvm-ubuntu-trusty-go_agent\nversion: '3541.10'\n")
+
+			err := executor.PlanDirector(dirInput, deploymentDir, "aws")
+			Expect(err).NotTo(HaveOccurred())
+
+			opsFile, err := fs.ReadFile(filepath.Join(stateDir, "bbl-ops-files", "aws", "director-offline-assets-ops.yml"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(opsFile)).To(ContainSubstring(`value: "file:///some/assets/bosh.tgz"`))
+			Expect(string(opsFile)).To(ContainSubstring(`value: "file:///some/assets/stemcell.tgz"`))
+
+			script, err := fs.ReadFile(filepath.Join(stateDir, "create-director.sh"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(script)).To(ContainSubstring("-o  ${BBL_STATE_DIR}/bbl-ops-files/aws/director-offline-assets-ops.yml \\\n"))
+		})
+	})
+
+	Describe("Interpolate", func() {
+		It("applies the offline assets ops file last", func() {
+			dirInput.Deployment = "director"
+
+			_, err := executor.Interpolate(dirInput, deploymentDir, "azure", "/some/vars-file.yml")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, args := cmd.RunArgsForCall(0)
+			Expect(args[len(args)-2:]).To(Equal([]string{
+				"-o", filepath.Join(stateDir, "bbl-ops-files", "azure", "director-offline-assets-ops.yml"),
+			}))
+		})
+	})
+})
@@ -41,3 +41,42 @@ const OpenStackJumpboxKeystoneV3Ops = `---
   path: /cloud_provider/properties/openstack/human_readable_vm_names?
   value: true
 `
+
+const S3BlobstoreOps = `---
+- type: replace
+  path: /instance_groups/name=bosh/properties/blobstore?
+  value:
+    provider: s3
+    bucket_name: ((blobstore_bucket_name))
+    access_key_id: ((blobstore_access_key_id))
+    secret_access_key: ((blobstore_secret_access_key))
+    s3_region: ((blobstore_region))
+    host: ((blobstore_host))
+    s3_force_path_style: true
+
+- type: remove
+  path: /instance_groups/name=bosh/jobs/name=blobstore
+
+- type: replace
+  path: /instance_groups/name=bosh/properties/agent/blobstore?
+  value:
+    access_key_id: ((blobstore_access_key_id))
+    secret_access_key: ((blobstore_secret_access_key))
+`
+
+const PrometheusExporterOps = `---
+- type: replace
+  path: /instance_groups/name=bosh/jobs/name=uaa/properties/uaa/clients/bosh_exporter?
+  value:
+    override: true
+    authorized-grant-types: client_credentials
+    scope: ""
+    authorities: bosh.read
+    secret: ((bosh_exporter_client_secret))
+
+- type: replace
+  path: /variables/-
+  value:
+    name: bosh_exporter_client_secret
+    type: password
+`
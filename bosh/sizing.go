@@ -0,0 +1,125 @@
+package bosh
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+const vmCloudPropertiesPath = "/resource_pools/name=vms/cloud_properties"
+
+// ParseSizing reads the sizing flags of an instance group. A VM type or disk
+// size of default, or a disk size of 0, puts it back to the default from
+// bosh-deployment or jumpbox-deployment. On vSphere the VM type is the
+// number of CPUs and the RAM in MB, as in 2x4096.
+func ParseSizing(flagPrefix, vmType, persistentDiskSize, ephemeralDiskSize, iaas string) (storage.Sizing, error) {
+	sizing := storage.Sizing{VMType: vmType}
+
+	if iaas == "vsphere" && vmType != "" && vmType != storage.DefaultVMType {
+		if _, _, err := parseVSphereVMType(vmType); err != nil {
+			return storage.Sizing{}, fmt.Errorf("--%s-vm-type on vsphere is <cpus>x<ram in MB>, such as 2x4096", flagPrefix)
+		}
+	}
+
+	var err error
+	sizing.PersistentDiskSize, err = parseDiskSize(fmt.Sprintf("--%s-persistent-disk-size", flagPrefix), persistentDiskSize)
+	if err != nil {
+		return storage.Sizing{}, err
+	}
+
+	sizing.EphemeralDiskSize, err = parseDiskSize(fmt.Sprintf("--%s-ephemeral-disk-size", flagPrefix), ephemeralDiskSize)
+	if err != nil {
+		return storage.Sizing{}, err
+	}
+
+	return sizing, nil
+}
+
+func parseDiskSize(flag, value string) (int, error) {
+	switch value {
+	case "":
+		return 0, nil
+	case "0", "default":
+		return storage.DefaultDiskSize, nil
+	}
+
+	size, err := strconv.Atoi(value)
+	if err != nil || size < 0 {
+		return 0, fmt.Errorf("%s must be a number of MB, or default", flag)
+	}
+
+	return size, nil
+}
+
+func parseVSphereVMType(vmType string) (int, int, error) {
+	parts := strings.Split(vmType, "x")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("%s is not <cpus>x<ram>", vmType)
+	}
+
+	cpu, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ram, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return cpu, ram, nil
+}
+
+// sizingOps is an ops file that sets the VM type and disk sizes of the
+// instance group with the cloud properties of iaas. The persistent disk of
+// the director is a disk pool, the jumpbox does not have one by default.
+func sizingOps(instanceGroup, iaas string, sizing storage.Sizing) string {
+	ops := "---\n"
+	replace := func(path string, value interface{}) {
+		ops += fmt.Sprintf("- type: replace\n  path: %s\n  value: %v\n\n", path, value)
+	}
+
+	if sizing.VMType != "" {
+		switch iaas {
+		case "gcp":
+			replace(vmCloudPropertiesPath+"/machine_type", strconv.Quote(sizing.VMType))
+		case "vsphere":
+			cpu, ram, _ := parseVSphereVMType(sizing.VMType)
+			replace(vmCloudPropertiesPath+"/cpu", cpu)
+			replace(vmCloudPropertiesPath+"/ram", ram)
+		default:
+			replace(vmCloudPropertiesPath+"/instance_type", strconv.Quote(sizing.VMType))
+		}
+	}
+
+	if sizing.EphemeralDiskSize != 0 {
+		switch iaas {
+		case "gcp":
+			replace(vmCloudPropertiesPath+"/root_disk_size_gb", gigabytes(sizing.EphemeralDiskSize))
+		case "vsphere":
+			replace(vmCloudPropertiesPath+"/disk", sizing.EphemeralDiskSize)
+		case "openstack":
+			replace(vmCloudPropertiesPath+"/root_disk?/size", gigabytes(sizing.EphemeralDiskSize))
+		default:
+			replace(vmCloudPropertiesPath+"/ephemeral_disk?/size", sizing.EphemeralDiskSize)
+		}
+	}
+
+	if sizing.PersistentDiskSize != 0 {
+		if instanceGroup == "bosh" {
+			replace("/disk_pools/name=disks/disk_size", sizing.PersistentDiskSize)
+		} else {
+			replace(fmt.Sprintf("/instance_groups/name=%s/persistent_disk?", instanceGroup), sizing.PersistentDiskSize)
+		}
+	}
+
+	return ops
+}
+
+// gigabytes rounds a size in MB up to whole GB, for the CPIs that size
+// disks in GB.
+func gigabytes(megabytes int) int {
+	return (megabytes + 1023) / 1024
+}
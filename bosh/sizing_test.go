@@ -0,0 +1,50 @@
+package bosh_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseSizing", func() {
+	It("accepts any vm type outside of vsphere", func() {
+		sizing, err := bosh.ParseSizing("director", "m4.2xlarge", "65536", "", "aws")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sizing).To(Equal(storage.Sizing{VMType: "m4.2xlarge", PersistentDiskSize: 65536}))
+	})
+
+	It("accepts cpus and ram as the vm type on vsphere", func() {
+		sizing, err := bosh.ParseSizing("director", "4x16384", "", "", "vsphere")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sizing).To(Equal(storage.Sizing{VMType: "4x16384"}))
+	})
+
+	It("reads default, and 0 for a disk size, as going back to the default", func() {
+		sizing, err := bosh.ParseSizing("jumpbox", "default", "0", "default", "vsphere")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sizing).To(Equal(storage.Sizing{
+			VMType:             storage.DefaultVMType,
+			PersistentDiskSize: storage.DefaultDiskSize,
+			EphemeralDiskSize:  storage.DefaultDiskSize,
+		}))
+	})
+
+	Context("when the vm type on vsphere is not cpus and ram", func() {
+		It("returns an error", func() {
+			_, err := bosh.ParseSizing("jumpbox", "large", "", "", "vsphere")
+			Expect(err).To(MatchError("--jumpbox-vm-type on vsphere is <cpus>x<ram in MB>, such as 2x4096"))
+		})
+	})
+
+	Context("when a disk size is not a number of MB", func() {
+		It("returns an error", func() {
+			_, err := bosh.ParseSizing("director", "", "", "-1", "gcp")
+			Expect(err).To(MatchError("--director-ephemeral-disk-size must be a number of MB, or default"))
+
+			_, err = bosh.ParseSizing("director", "", "large", "", "gcp")
+			Expect(err).To(MatchError("--director-persistent-disk-size must be a number of MB, or default"))
+		})
+	})
+})
@@ -16,6 +16,7 @@ import (
 type OpsGenerator struct {
 	terraformManager          terraformManager
 	availabilityZoneRetriever availabilityZoneRetriever
+	outputContract            terraform.OutputContract
 }
 
 type availabilityZoneRetriever interface {
@@ -74,10 +75,11 @@ type lbCloudProperties struct {
 
 var marshal func(interface{}) ([]byte, error) = yaml.Marshal
 
-func NewOpsGenerator(terraformManager terraformManager, availabilityZoneRetriever availabilityZoneRetriever) OpsGenerator {
+func NewOpsGenerator(terraformManager terraformManager, availabilityZoneRetriever availabilityZoneRetriever, outputContract terraform.OutputContract) OpsGenerator {
 	return OpsGenerator{
 		terraformManager:          terraformManager,
 		availabilityZoneRetriever: availabilityZoneRetriever,
+		outputContract:            outputContract,
 	}
 }
 
@@ -87,34 +89,9 @@ func (o OpsGenerator) GenerateVars(state storage.State) (string, error) {
 		return "", fmt.Errorf("Get terraform outputs: %s", err)
 	}
 
-	requiredOutputs := []string{
-		"internal_security_group",
-		"internal_az_subnet_id_mapping",
-		"internal_az_subnet_cidr_mapping",
-	}
-	switch state.LB.Type {
-	case "concourse":
-		requiredOutputs = append(
-			requiredOutputs,
-			"concourse_lb_target_groups",
-			"concourse_lb_internal_security_group",
-		)
-	case "cf":
-		requiredOutputs = append(
-			requiredOutputs,
-			"cf_router_lb_name",
-			"cf_router_lb_internal_security_group",
-			"cf_ssh_lb_name",
-			"cf_ssh_lb_internal_security_group",
-			"cf_tcp_lb_name",
-			"cf_tcp_lb_internal_security_group",
-		)
-	}
-
-	for _, output := range requiredOutputs {
-		if _, ok := terraformOutputs.Map[output]; !ok {
-			return "", fmt.Errorf("missing %s terraform output", output)
-		}
+	err = terraformOutputs.Validate(o.outputContract.Outputs(state))
+	if err != nil {
+		return "", err
 	}
 
 	internalAZSubnetIDMap := terraformOutputs.GetStringMap("internal_az_subnet_id_mapping")
@@ -11,6 +11,7 @@ import (
 	"github.com/cloudfoundry/bosh-bootloader/fakes"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 	"github.com/cloudfoundry/bosh-bootloader/terraform"
+	awsterraform "github.com/cloudfoundry/bosh-bootloader/terraform/aws"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -38,7 +39,28 @@ var _ = Describe("OpsGenerator", func() {
 		}
 
 		terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{Map: map[string]interface{}{
+			"default_key_name":                     "some-key-name",
+			"private_key":                          "some-private-key",
+			"external_ip":                          "some-external-ip",
+			"jumpbox_url":                          "some-jumpbox-url",
+			"jumpbox__default_security_groups":     []interface{}{"some-jumpbox-security-group"},
+			"director__default_security_groups":    []interface{}{"some-bosh-security-group"},
+			"subnet_id":                            "some-subnet-id",
+			"az":                                   "us-east-1a",
+			"vpc_id":                               "some-vpc-id",
+			"region":                               "us-east-1",
+			"director_name":                        "some-director-name",
+			"internal_cidr":                        "10.0.0.0/16",
+			"internal_gw":                          "10.0.0.1",
+			"jumpbox__internal_ip":                 "10.0.0.5",
+			"director__internal_ip":                "10.0.0.6",
+			"iam_instance_profile":                 "some-iam-instance-profile",
 			"internal_security_group":              "some-internal-security-group",
+			"cf_router_lb_url":                     "some-cf-router-lb-url",
+			"cf_ssh_lb_url":                        "some-cf-ssh-lb-url",
+			"cf_tcp_lb_url":                        "some-cf-tcp-lb-url",
+			"concourse_lb_name":                    "some-concourse-lb-name",
+			"concourse_lb_url":                     "some-concourse-lb-url",
 			"cf_router_lb_name":                    "some-cf-router-lb-name",
 			"cf_router_lb_internal_security_group": "some-cf-router-lb-internal-security-group",
 			"cf_router_lb_security_group":          "some-cf-router-lb-security-group",
@@ -71,9 +93,9 @@ var _ = Describe("OpsGenerator", func() {
 			"cf_iso_router_lb_name":        "some-cf-iso-seg-router-lb-name",
 			"iso_security_group_id":        "some-iso-seg-security-group",
 			"iso_shared_security_group_id": "some-iso-shared-security-group",
-		}}
+		}, Sensitive: map[string]bool{"private_key": true}}
 
-		opsGenerator = aws.NewOpsGenerator(terraformManager, availabilityZoneRetriever)
+		opsGenerator = aws.NewOpsGenerator(terraformManager, availabilityZoneRetriever, awsterraform.NewOutputContract())
 	})
 
 	Describe("GenerateVars", func() {
@@ -125,6 +147,27 @@ az6_reserved_1: 10.1.48.2-10.1.48.3
 az6_reserved_2: 10.1.63.255
 az6_static: 10.1.63.190-10.1.63.254
 az6_subnet: some-iso-seg-subnet-id-3
+default_key_name: some-key-name
+private_key: some-private-key
+external_ip: some-external-ip
+jumpbox_url: some-jumpbox-url
+jumpbox__default_security_groups: [some-jumpbox-security-group]
+director__default_security_groups: [some-bosh-security-group]
+subnet_id: some-subnet-id
+az: us-east-1a
+vpc_id: some-vpc-id
+region: us-east-1
+director_name: some-director-name
+internal_cidr: 10.0.0.0/16
+internal_gw: 10.0.0.1
+jumpbox__internal_ip: 10.0.0.5
+director__internal_ip: 10.0.0.6
+iam_instance_profile: some-iam-instance-profile
+cf_router_lb_url: some-cf-router-lb-url
+cf_ssh_lb_url: some-cf-ssh-lb-url
+cf_tcp_lb_url: some-cf-tcp-lb-url
+concourse_lb_name: some-concourse-lb-name
+concourse_lb_url: some-concourse-lb-url
 internal_security_group: some-internal-security-group
 iso_security_group_id: some-iso-seg-security-group
 iso_shared_security_group_id: some-iso-shared-security-group
@@ -168,6 +211,18 @@ iso_az_subnet_id_mapping:
 					Expect(err).To(MatchError("missing AZ in terraform output: internal_az_subnet_cidr_mapping"))
 				})
 			})
+			Context("when the outputs do not meet the output contract", func() {
+				It("returns an error", func() {
+					outputContract := &fakes.OutputContract{}
+					outputContract.OutputsCall.Returns.Outputs = []terraform.OutputSpec{{Name: "some_extra_output", Type: terraform.StringOutput}}
+					opsGenerator = aws.NewOpsGenerator(terraformManager, availabilityZoneRetriever, outputContract)
+
+					_, err := opsGenerator.GenerateVars(incomingState)
+					Expect(err).To(MatchError("missing some_extra_output terraform output. bbl needs these outputs, check that your terraform overrides do not remove or change them."))
+					Expect(outputContract.OutputsCall.Receives.State).To(Equal(incomingState))
+				})
+			})
+
 			Context("when terraform fails to get outputs", func() {
 				It("returns an error", func() {
 					terraformManager.GetOutputsCall.Returns.Error = errors.New("breadfruit")
@@ -190,7 +245,7 @@ iso_az_subnet_id_mapping:
 				delete(terraformManager.GetOutputsCall.Returns.Outputs.Map, outputKey)
 				incomingState.LB.Type = lbType
 				_, err := opsGenerator.GenerateVars(incomingState)
-				Expect(err).To(MatchError(fmt.Sprintf("missing %s terraform output", outputKey)))
+				Expect(err).To(MatchError(fmt.Sprintf("missing %s terraform output. bbl needs these outputs, check that your terraform overrides do not remove or change them.", outputKey)))
 			},
 				Entry("when internal_security_group is missing", "internal_security_group", ""),
 
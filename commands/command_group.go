@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+// CommandGroup dispatches to one of several related subcommands,
+// e.g. `bbl state encrypt`.
+type CommandGroup struct {
+	name        string
+	description string
+	subcommands map[string]Command
+}
+
+func NewCommandGroup(name, description string, subcommands map[string]Command) CommandGroup {
+	return CommandGroup{
+		name:        name,
+		description: description,
+		subcommands: subcommands,
+	}
+}
+
+func (g CommandGroup) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	subcommand, err := g.subcommand(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	return subcommand.CheckFastFails(subcommandFlags[1:], state)
+}
+
+func (g CommandGroup) Execute(subcommandFlags []string, state storage.State) error {
+	subcommand, err := g.subcommand(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	return subcommand.Execute(subcommandFlags[1:], state)
+}
+
+func (g CommandGroup) subcommand(subcommandFlags []string) (Command, error) {
+	if len(subcommandFlags) == 0 {
+		return nil, fmt.Errorf("bbl %s requires a subcommand: %s", g.name, strings.Join(g.names(), ", "))
+	}
+
+	subcommand, ok := g.subcommands[subcommandFlags[0]]
+	if !ok {
+		return nil, fmt.Errorf("unknown %s subcommand: %s", g.name, subcommandFlags[0])
+	}
+
+	return subcommand, nil
+}
+
+func (g CommandGroup) names() []string {
+	names := []string{}
+	for name := range g.subcommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,93 @@
+package commands_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CommandGroup", func() {
+	var (
+		encrypt *fakes.Command
+		decrypt *fakes.Command
+		state   storage.State
+
+		group commands.CommandGroup
+	)
+
+	BeforeEach(func() {
+		encrypt = &fakes.Command{}
+		decrypt = &fakes.Command{}
+		state = storage.State{EnvID: "some-env-id"}
+
+		group = commands.NewCommandGroup("state", "Manages the bbl state directory", map[string]commands.Command{
+			"encrypt": encrypt,
+			"decrypt": decrypt,
+		})
+	})
+
+	Describe("CheckFastFails", func() {
+		It("checks the subcommand with the remaining flags", func() {
+			err := group.CheckFastFails([]string{"encrypt", "--some-flag"}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(encrypt.CheckFastFailsCall.CallCount).To(Equal(1))
+			Expect(encrypt.CheckFastFailsCall.Receives.SubcommandFlags).To(Equal([]string{"--some-flag"}))
+			Expect(encrypt.CheckFastFailsCall.Receives.State).To(Equal(state))
+			Expect(decrypt.CheckFastFailsCall.CallCount).To(Equal(0))
+		})
+
+		Context("when the subcommand fails fast", func() {
+			It("returns the error", func() {
+				encrypt.CheckFastFailsCall.Returns.Error = errors.New("banana")
+
+				err := group.CheckFastFails([]string{"encrypt"}, state)
+				Expect(err).To(MatchError("banana"))
+			})
+		})
+
+		Context("when no subcommand is provided", func() {
+			It("returns an error listing the subcommands", func() {
+				err := group.CheckFastFails([]string{}, state)
+				Expect(err).To(MatchError("bbl state requires a subcommand: decrypt, encrypt"))
+			})
+		})
+
+		Context("when the subcommand is unknown", func() {
+			It("returns an error", func() {
+				err := group.CheckFastFails([]string{"shred"}, state)
+				Expect(err).To(MatchError("unknown state subcommand: shred"))
+			})
+		})
+	})
+
+	Describe("Execute", func() {
+		It("executes the subcommand with the remaining flags", func() {
+			err := group.Execute([]string{"decrypt", "--some-flag"}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(decrypt.ExecuteCall.CallCount).To(Equal(1))
+			Expect(decrypt.ExecuteCall.Receives.SubcommandFlags).To(Equal([]string{"--some-flag"}))
+			Expect(decrypt.ExecuteCall.Receives.State).To(Equal(state))
+			Expect(encrypt.ExecuteCall.CallCount).To(Equal(0))
+		})
+	})
+
+	Describe("Usage", func() {
+		It("describes each subcommand", func() {
+			encrypt.UsageCall.Returns.Usage = "Encrypts things\n\n  --some-flag   Some flag"
+			decrypt.UsageCall.Returns.Usage = "Decrypts things"
+
+			Expect(group.Usage()).To(Equal(`Manages the bbl state directory
+
+  Subcommands:
+  decrypt                  Decrypts things
+  encrypt                  Encrypts things`))
+		})
+	})
+})
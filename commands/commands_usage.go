@@ -1,6 +1,9 @@
 package commands
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 const (
 	Credentials = `
@@ -58,12 +61,47 @@ const (
 
   --iaas                     IAAS to deploy your BOSH director onto: "aws", "azure", "gcp", "vsphere"   env: $BBL_IAAS
   --name                     Name to assign to your BOSH director (optional)                            env: $BBL_ENV_NAME
+  [--terraform-plan-out]     Save the terraform plan under this name, ending in .tfplan, in the vars directory (optional)
+  [--terraform-backend]      Keep the terraform state in this backend: "s3", "gcs", "azurerm", "local" (optional)
+  [--terraform-backend-config] A key=value setting of the terraform backend, can be given more than once (optional)
+  [--patch]                  Copy the plan patch in this directory, or builtin:<name>, into the state directory, can be given more than once (optional)
+  [--strict]                 Fail instead of warning when an override script was written for an older base script (optional)
+  [--director-feature]       Turn on this director feature, can be given more than once, "none" turns them all off (optional)
+  [--jumpbox-vm-type]        Instance type of the jumpbox, or <cpus>x<ram in MB> on vsphere, "default" resets it (optional)
+  [--jumpbox-persistent-disk-size] Size of the jumpbox persistent disk in MB, 0 resets it (optional)
+  [--jumpbox-ephemeral-disk-size] Size of the jumpbox ephemeral disk in MB, 0 resets it (optional)
+  [--director-vm-type]       Instance type of the director, or <cpus>x<ram in MB> on vsphere, "default" resets it (optional)
+  [--director-persistent-disk-size] Size of the director persistent disk in MB, 0 resets it (optional)
+  [--director-ephemeral-disk-size] Size of the director ephemeral disk in MB, 0 resets it (optional)
+  [--jumpbox-deployment-dir] Plan the jumpbox with the jumpbox-deployment in this directory, "builtin" goes back to the one in bbl (optional)
+  [--bosh-deployment-dir]    Plan the director with the bosh-deployment in this directory, "builtin" goes back to the one in bbl (optional)
+  [--offline-assets]         Deploy the jumpbox and the director with the release and stemcell tarballs in this directory instead of downloading them, "none" turns it off (optional)
 `
 
 	UpCommandUsage = `Deploys BOSH director on an IAAS
 
   --iaas                     IAAS to deploy your BOSH director onto: "aws", "azure", "gcp", "vsphere"   env: $BBL_IAAS
   --name                     Name to assign to your BOSH director (optional)                            env: $BBL_ENV_NAME
+  [--from]                   Rerun this phase and the ones after it (optional)
+  [--only]                   Rerun only this phase (optional)
+  [--dry-run]                Show what would change without changing it (optional)
+  [--force]                  Rerun every phase, even when its inputs have not changed (optional)
+  [--terraform-plan-file]    Apply the terraform plan of this name that bbl plan saved, instead of planning again (optional)
+  [--strict]                 Fail instead of warning when an override script was written for an older base script (optional)
+  [--jumpbox-vm-type]        Instance type of the jumpbox, or <cpus>x<ram in MB> on vsphere, "default" resets it (optional)
+  [--jumpbox-persistent-disk-size] Size of the jumpbox persistent disk in MB, 0 resets it (optional)
+  [--jumpbox-ephemeral-disk-size] Size of the jumpbox ephemeral disk in MB, 0 resets it (optional)
+  [--director-vm-type]       Instance type of the director, or <cpus>x<ram in MB> on vsphere, "default" resets it (optional)
+  [--director-persistent-disk-size] Size of the director persistent disk in MB, 0 resets it (optional)
+  [--director-ephemeral-disk-size] Size of the director ephemeral disk in MB, 0 resets it (optional)
+  [--jumpbox-deployment-dir] Plan the jumpbox with the jumpbox-deployment in this directory, "builtin" goes back to the one in bbl (optional)
+  [--bosh-deployment-dir]    Plan the director with the bosh-deployment in this directory, "builtin" goes back to the one in bbl (optional)
+  [--offline-assets]         Deploy the jumpbox and the director with the release and stemcell tarballs in this directory instead of downloading them, "none" turns it off (optional)
+
+  The phases are terraform, jumpbox, director and cloud-config. Phases whose
+  inputs have not changed since they last completed are skipped, so a rerun
+  does not repair VMs or infrastructure that were changed or deleted outside
+  of bbl. Use --force for that.
 `
 
 	DestroyCommandUsage = `Tears down BOSH director infrastructure
@@ -76,7 +114,11 @@ const (
 
 	LBsCommandUsage = "Prints attached load balancer(s)"
 
-	OutputsCommandUsage = "Prints the outputs from terraform."
+	OutputsCommandUsage = `Prints the outputs from terraform. Sensitive outputs are masked.
+
+  --show-sensitive    Print the values of sensitive outputs
+  --json              Print the outputs as JSON
+  --key               Print only the value of this output`
 
 	VersionCommandUsage = "Prints version"
 
@@ -109,6 +151,95 @@ const (
 	PrintEnvCommandUsage = "Prints required BOSH environment variables"
 
 	LatestErrorCommandUsage = "Prints the output from the latest call to terraform"
+
+	ForceUnlockCommandUsage = `Removes the lock on the state directory
+
+  Only use this when the bbl command that took the lock is no longer running.`
+
+	MigrateCommandUsage = `Migrates the state directory to the layout of this version of bbl
+
+  [--dry-run]                Print the changes without making them (optional)
+  [--revert]                 Restore the state directory from the backup taken before the last migration (optional)
+
+  Other commands migrate the state directory automatically. --revert refuses
+  to restore the backup once terraform or create-env has changed the state.`
+
+	DriftCommandUsage = `Reports infrastructure and VMs that no longer match what bbl applied
+
+  Runs terraform plan -refresh-only, which leaves the terraform state as it
+  is, lists the resources that have changed or gone missing outside of
+  terraform, and checks that the jumpbox and director VMs still exist. Exits
+  non-zero when it finds drift. Needs terraform v0.15.4 or later.
+
+  Only resources in the terraform state are checked: resources added to the
+  IaaS outside of terraform are not reported.`
+
+	StateCommandUsage = "Manages the bbl state directory"
+
+	PatchesCommandUsage = "Manages the plan patches applied with bbl plan --patch"
+
+	PatchesListCommandUsage = `Lists the applied plan patches
+
+  Files that were edited or removed since the patch was applied are listed under it.`
+
+	PatchesAvailableCommandUsage = `Lists the plan patches that are built into bbl
+
+  Apply one with bbl plan --patch builtin:<name>.`
+
+	PatchesRemoveCommandUsage = `Removes the files of a plan patch from the state directory
+
+  Usage: bbl patches remove <name>
+
+  The environment is not changed until the next bbl plan and bbl up.`
+
+	OverridesCommandUsage = "Manages the override scripts in the state directory"
+
+	OverridesDiffCommandUsage = `Shows how the override scripts differ from the scripts bbl plan generated
+
+  Lines that bbl plan added to a script since its override was written show up as removals.`
+
+	StateEncryptCommandUsage = `Encrypts the sensitive files in the state directory
+
+  Requires BBL_STATE_PASSPHRASE or BBL_STATE_KEY_FILE to be set.`
+
+	StateDecryptCommandUsage = "Decrypts the sensitive files in the state directory"
+
+	StateHistoryCommandUsage = `Lists the snapshots of bbl-state.json and the vars files
+
+  bbl keeps the latest 20 versions of the state in the .snapshots directory.`
+
+	StateDiffCommandUsage = `Compares two snapshots of the state
+
+  Usage: bbl state diff <snapshot-id> <snapshot-id>`
+
+	StateRollbackCommandUsage = `Restores a snapshot of the state
+
+  Usage: bbl state rollback <snapshot-id> [--include-terraform-state]
+
+  [--include-terraform-state]  Also roll back the terraform state and saved plans, which makes terraform forget resources created since the snapshot (optional)
+
+  The environment is not changed until the next bbl plan or bbl up.`
+
+	StateExportCommandUsage = `Packages the state directory into a portable bundle
+
+  --output                   Path of the bundle to write
+  --encrypt                  Encrypt the bundle with BBL_STATE_PASSPHRASE or BBL_STATE_KEY_FILE
+  --insecure-plaintext       Write the bundle, with every private key and password of the environment, unencrypted
+
+  One of --encrypt or --insecure-plaintext is required.
+
+  Only bbl-state.json, the bbl managed vars files, terraform variables files,
+  terraform overrides, cloud-config and user ops files, director-vars and the
+  override scripts are packaged. Saved terraform plans, generated files and
+  anything else in the state directory are left out.`
+
+	StateImportCommandUsage = `Unpacks a bundle into an empty state directory and runs bbl plan
+
+  Usage: bbl state import <bundle> [--skip-plan]
+
+  [--skip-plan]              Do not run bbl plan after the import (optional)
+
+  Unless --skip-plan is given, credentials for the IaaS of the bundle are required:`
 )
 
 func (Up) Usage() string {
@@ -141,6 +272,45 @@ func (LatestError) Usage() string { return LatestErrorCommandUsage }
 
 func (Validate) Usage() string { return "" }
 
+func (ForceUnlock) Usage() string { return ForceUnlockCommandUsage }
+
+func (Migrate) Usage() string { return MigrateCommandUsage }
+
+func (Drift) Usage() string { return DriftCommandUsage }
+
+func (StateEncrypt) Usage() string { return StateEncryptCommandUsage }
+
+func (StateDecrypt) Usage() string { return StateDecryptCommandUsage }
+
+func (StateHistory) Usage() string { return StateHistoryCommandUsage }
+
+func (StateDiff) Usage() string { return StateDiffCommandUsage }
+
+func (StateRollback) Usage() string { return StateRollbackCommandUsage }
+
+func (StateExport) Usage() string { return StateExportCommandUsage }
+
+func (StateImport) Usage() string {
+	return fmt.Sprintf("%s%s", StateImportCommandUsage, Credentials)
+}
+
+func (PatchesList) Usage() string { return PatchesListCommandUsage }
+
+func (PatchesAvailable) Usage() string { return PatchesAvailableCommandUsage }
+
+func (PatchesRemove) Usage() string { return PatchesRemoveCommandUsage }
+
+func (OverridesDiff) Usage() string { return OverridesDiffCommandUsage }
+
+func (g CommandGroup) Usage() string {
+	usage := fmt.Sprintf("%s\n\n  Subcommands:", g.description)
+	for _, name := range g.names() {
+		summary := strings.SplitN(g.subcommands[name].Usage(), "\n", 2)[0]
+		usage = fmt.Sprintf("%s\n  %-24s %s", usage, name, summary)
+	}
+	return usage
+}
+
 func (s SSHKey) Usage() string {
 	if s.Director {
 		return DirectorSSHKeyCommandUsage
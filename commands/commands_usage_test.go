@@ -20,6 +20,26 @@ var _ = Describe("Commands Usage", func() {
 
   --iaas                     IAAS to deploy your BOSH director onto: "aws", "azure", "gcp", "vsphere"   env: $BBL_IAAS
   --name                     Name to assign to your BOSH director (optional)                            env: $BBL_ENV_NAME
+  [--from]                   Rerun this phase and the ones after it (optional)
+  [--only]                   Rerun only this phase (optional)
+  [--dry-run]                Show what would change without changing it (optional)
+  [--force]                  Rerun every phase, even when its inputs have not changed (optional)
+  [--terraform-plan-file]    Apply the terraform plan of this name that bbl plan saved, instead of planning again (optional)
+  [--strict]                 Fail instead of warning when an override script was written for an older base script (optional)
+  [--jumpbox-vm-type]        Instance type of the jumpbox, or <cpus>x<ram in MB> on vsphere, "default" resets it (optional)
+  [--jumpbox-persistent-disk-size] Size of the jumpbox persistent disk in MB, 0 resets it (optional)
+  [--jumpbox-ephemeral-disk-size] Size of the jumpbox ephemeral disk in MB, 0 resets it (optional)
+  [--director-vm-type]       Instance type of the director, or <cpus>x<ram in MB> on vsphere, "default" resets it (optional)
+  [--director-persistent-disk-size] Size of the director persistent disk in MB, 0 resets it (optional)
+  [--director-ephemeral-disk-size] Size of the director ephemeral disk in MB, 0 resets it (optional)
+  [--jumpbox-deployment-dir] Plan the jumpbox with the jumpbox-deployment in this directory, "builtin" goes back to the one in bbl (optional)
+  [--bosh-deployment-dir]    Plan the director with the bosh-deployment in this directory, "builtin" goes back to the one in bbl (optional)
+  [--offline-assets]         Deploy the jumpbox and the director with the release and stemcell tarballs in this directory instead of downloading them, "none" turns it off (optional)
+
+  The phases are terraform, jumpbox, director and cloud-config. Phases whose
+  inputs have not changed since they last completed are skipped, so a rerun
+  does not repair VMs or infrastructure that were changed or deleted outside
+  of bbl. Use --force for that.
 
   --aws-access-key-id                AWS Access Key ID                env: $BBL_AWS_ACCESS_KEY_ID
   --aws-secret-access-key            AWS Secret Access Key            env: $BBL_AWS_SECRET_ACCESS_KEY
@@ -77,6 +97,21 @@ var _ = Describe("Commands Usage", func() {
 
   --iaas                     IAAS to deploy your BOSH director onto: "aws", "azure", "gcp", "vsphere"   env: $BBL_IAAS
   --name                     Name to assign to your BOSH director (optional)                            env: $BBL_ENV_NAME
+  [--terraform-plan-out]     Save the terraform plan under this name, ending in .tfplan, in the vars directory (optional)
+  [--terraform-backend]      Keep the terraform state in this backend: "s3", "gcs", "azurerm", "local" (optional)
+  [--terraform-backend-config] A key=value setting of the terraform backend, can be given more than once (optional)
+  [--patch]                  Copy the plan patch in this directory, or builtin:<name>, into the state directory, can be given more than once (optional)
+  [--strict]                 Fail instead of warning when an override script was written for an older base script (optional)
+  [--director-feature]       Turn on this director feature, can be given more than once, "none" turns them all off (optional)
+  [--jumpbox-vm-type]        Instance type of the jumpbox, or <cpus>x<ram in MB> on vsphere, "default" resets it (optional)
+  [--jumpbox-persistent-disk-size] Size of the jumpbox persistent disk in MB, 0 resets it (optional)
+  [--jumpbox-ephemeral-disk-size] Size of the jumpbox ephemeral disk in MB, 0 resets it (optional)
+  [--director-vm-type]       Instance type of the director, or <cpus>x<ram in MB> on vsphere, "default" resets it (optional)
+  [--director-persistent-disk-size] Size of the director persistent disk in MB, 0 resets it (optional)
+  [--director-ephemeral-disk-size] Size of the director ephemeral disk in MB, 0 resets it (optional)
+  [--jumpbox-deployment-dir] Plan the jumpbox with the jumpbox-deployment in this directory, "builtin" goes back to the one in bbl (optional)
+  [--bosh-deployment-dir]    Plan the director with the bosh-deployment in this directory, "builtin" goes back to the one in bbl (optional)
+  [--offline-assets]         Deploy the jumpbox and the director with the release and stemcell tarballs in this directory instead of downloading them, "none" turns it off (optional)
 %s%s`, commands.Credentials, commands.LBUsage)))
 			})
 		})
@@ -149,7 +184,11 @@ var _ = Describe("Commands Usage", func() {
 		Expect(usageText).To(Equal(expectedDescription))
 	},
 		Entry("LBs", commands.LBs{}, "Prints attached load balancer(s)"),
-		Entry("outputs", commands.Outputs{}, "Prints the outputs from terraform."),
+		Entry("outputs", commands.Outputs{}, `Prints the outputs from terraform. Sensitive outputs are masked.
+
+  --show-sensitive    Print the values of sensitive outputs
+  --json              Print the outputs as JSON
+  --key               Print only the value of this output`),
 		Entry("jumpbox-address", newStateQuery("jumpbox address"), "Prints BOSH jumpbox address"),
 		Entry("director-address", newStateQuery("director address"), "Prints BOSH director address"),
 		Entry("director-password", newStateQuery("director password"), "Prints BOSH director password"),
@@ -161,6 +200,20 @@ var _ = Describe("Commands Usage", func() {
 		Entry("print-env", commands.PrintEnv{}, "Prints required BOSH environment variables"),
 		Entry("latest-error", commands.LatestError{}, "Prints the output from the latest call to terraform"),
 		Entry("version", commands.Version{}, "Prints version"),
+		Entry("patches list", commands.PatchesList{}, `Lists the applied plan patches
+
+  Files that were edited or removed since the patch was applied are listed under it.`),
+		Entry("patches available", commands.PatchesAvailable{}, `Lists the plan patches that are built into bbl
+
+  Apply one with bbl plan --patch builtin:<name>.`),
+		Entry("patches remove", commands.PatchesRemove{}, `Removes the files of a plan patch from the state directory
+
+  Usage: bbl patches remove <name>
+
+  The environment is not changed until the next bbl plan and bbl up.`),
+		Entry("overrides diff", commands.OverridesDiff{}, `Shows how the override scripts differ from the scripts bbl plan generated
+
+  Lines that bbl plan added to a script since its override was written show up as removals.`),
 	)
 })
 
@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+)
+
+type VMChecker interface {
+	VMExists(envID, cid string) (bool, error)
+}
+
+type driftFs interface {
+	fileio.FileReader
+}
+
+type Drift struct {
+	logger           logger
+	stateValidator   stateValidator
+	terraformManager terraformManager
+	vmChecker        VMChecker
+	stateStore       stateStore
+	fs               driftFs
+}
+
+type deploymentState struct {
+	CurrentVMCID string `json:"current_vm_cid"`
+}
+
+func NewDrift(logger logger, stateValidator stateValidator, terraformManager terraformManager,
+	vmChecker VMChecker, stateStore stateStore, fs driftFs) Drift {
+	return Drift{
+		logger:           logger,
+		stateValidator:   stateValidator,
+		terraformManager: terraformManager,
+		vmChecker:        vmChecker,
+		stateStore:       stateStore,
+		fs:               fs,
+	}
+}
+
+func (d Drift) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	err := d.stateValidator.Validate()
+	if err != nil {
+		return err
+	}
+
+	return d.terraformManager.ValidateVersion()
+}
+
+func (d Drift) Execute(subcommandFlags []string, state storage.State) error {
+	drift, err := d.terraformManager.Drift(state)
+	if err != nil {
+		return fmt.Errorf("Detect infrastructure drift: %s", err)
+	}
+
+	missingVMs, err := d.missingVMs(state)
+	if err != nil {
+		return err
+	}
+
+	if len(drift) == 0 && len(missingVMs) == 0 {
+		d.logger.Println("no drift detected")
+		return nil
+	}
+
+	byType := map[string][]terraform.ResourceDrift{}
+	types := []string{}
+	for _, resource := range drift {
+		if _, ok := byType[resource.Type]; !ok {
+			types = append(types, resource.Type)
+		}
+		byType[resource.Type] = append(byType[resource.Type], resource)
+	}
+	sort.Strings(types)
+
+	for _, resourceType := range types {
+		d.logger.Printf("%s\n", resourceType)
+		for _, resource := range byType[resourceType] {
+			d.logger.Printf("  %-8s %s\n", resource.Change, resource.Address)
+		}
+	}
+
+	for _, vm := range missingVMs {
+		d.logger.Printf("%s\n", vm)
+	}
+
+	return errors.New("The environment has drifted from what bbl applied.")
+}
+
+// missingVMs looks up the VMs that bosh create-env recorded for the
+// jumpbox and the director.
+func (d Drift) missingVMs(state storage.State) ([]string, error) {
+	if d.vmChecker == nil {
+		d.logger.Step("skipping the VM check, bbl cannot look up VMs on %s", state.IAAS)
+		return nil, nil
+	}
+
+	varsDir, err := d.stateStore.GetVarsDir()
+	if err != nil {
+		return nil, fmt.Errorf("Get vars dir: %s", err)
+	}
+
+	missing := []string{}
+	for _, deployment := range []struct{ name, stateFile string }{
+		{"jumpbox", "jumpbox-state.json"},
+		{"director", "bosh-state.json"},
+	} {
+		contents, err := d.fs.ReadFile(filepath.Join(varsDir, deployment.stateFile))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Read %s: %s", deployment.stateFile, err)
+		}
+
+		var boshState deploymentState
+		err = json.Unmarshal(contents, &boshState)
+		if err != nil {
+			return nil, fmt.Errorf("Parse %s: %s", deployment.stateFile, err)
+		}
+		if boshState.CurrentVMCID == "" {
+			continue
+		}
+
+		exists, err := d.vmChecker.VMExists(state.EnvID, boshState.CurrentVMCID)
+		if err != nil {
+			return nil, fmt.Errorf("Look up %s VM: %s", deployment.name, err)
+		}
+		if !exists {
+			missing = append(missing, fmt.Sprintf("%s VM %s is missing", deployment.name, boshState.CurrentVMCID))
+		}
+	}
+
+	return missing, nil
+}
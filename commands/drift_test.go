@@ -0,0 +1,150 @@
+package commands_test
+
+import (
+	"errors"
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drift", func() {
+	var (
+		logger           *fakes.Logger
+		stateValidator   *fakes.StateValidator
+		terraformManager *fakes.TerraformManager
+		vmChecker        *fakes.VMChecker
+		stateStore       *fakes.StateStore
+		fileIO           *fakes.FileIO
+		command          commands.Drift
+		state            storage.State
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		stateValidator = &fakes.StateValidator{}
+		terraformManager = &fakes.TerraformManager{}
+		vmChecker = &fakes.VMChecker{}
+		stateStore = &fakes.StateStore{}
+		stateStore.GetVarsDirCall.Returns.Directory = "some-vars-dir"
+		fileIO = &fakes.FileIO{}
+		fileIO.ReadFileCall.Fake = func(filename string) ([]byte, error) {
+			switch filename {
+			case "some-vars-dir/jumpbox-state.json":
+				return []byte(`{"current_vm_cid": "some-jumpbox-cid"}`), nil
+			case "some-vars-dir/bosh-state.json":
+				return []byte(`{"current_vm_cid": "some-director-cid"}`), nil
+			}
+			return nil, os.ErrNotExist
+		}
+		vmChecker.VMExistsCall.Returns.Exists = true
+
+		command = commands.NewDrift(logger, stateValidator, terraformManager, vmChecker, stateStore, fileIO)
+		state = storage.State{IAAS: "aws", EnvID: "some-env-id"}
+	})
+
+	Describe("CheckFastFails", func() {
+		It("validates the state dir", func() {
+			stateValidator.ValidateCall.Returns.Error = errors.New("no state")
+
+			err := command.CheckFastFails([]string{}, state)
+			Expect(err).To(MatchError("no state"))
+		})
+
+		It("validates the terraform version", func() {
+			terraformManager.ValidateVersionCall.Returns.Error = errors.New("old terraform")
+
+			err := command.CheckFastFails([]string{}, state)
+			Expect(err).To(MatchError("old terraform"))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("reports that nothing has drifted", func() {
+			err := command.Execute([]string{}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(terraformManager.DriftCall.Receives.BBLState).To(Equal(state))
+			Expect(vmChecker.VMExistsCall.CallCount).To(Equal(2))
+			Expect(vmChecker.VMExistsCall.Receives.EnvID).To(Equal("some-env-id"))
+			Expect(logger.PrintlnCall.Messages).To(Equal([]string{"no drift detected"}))
+		})
+
+		Context("when resources have drifted", func() {
+			It("reports them by type and fails", func() {
+				terraformManager.DriftCall.Returns.Drift = []terraform.ResourceDrift{
+					{Address: "aws_subnet.bosh_subnet", Type: "aws_subnet", Change: "missing"},
+					{Address: "aws_instance.nat", Type: "aws_instance", Change: "changed"},
+					{Address: "aws_instance.leftover", Type: "aws_instance", Change: "added"},
+				}
+
+				err := command.Execute([]string{}, state)
+				Expect(err).To(MatchError("The environment has drifted from what bbl applied."))
+
+				Expect(logger.PrintfCall.Messages).To(Equal([]string{
+					"aws_instance\n",
+					"  changed  aws_instance.nat\n",
+					"  added    aws_instance.leftover\n",
+					"aws_subnet\n",
+					"  missing  aws_subnet.bosh_subnet\n",
+				}))
+			})
+		})
+
+		Context("when a VM has been deleted", func() {
+			It("reports it and fails", func() {
+				vmChecker.VMExistsCall.Stub = func(envID, cid string) (bool, error) {
+					return cid != "some-director-cid", nil
+				}
+
+				err := command.Execute([]string{}, state)
+				Expect(err).To(MatchError("The environment has drifted from what bbl applied."))
+				Expect(logger.PrintfCall.Messages).To(Equal([]string{"director VM some-director-cid is missing\n"}))
+			})
+		})
+
+		Context("when the jumpbox and director have not been deployed", func() {
+			It("does not look up their VMs", func() {
+				fileIO.ReadFileCall.Fake = nil
+				fileIO.ReadFileCall.Returns.Error = os.ErrNotExist
+
+				err := command.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vmChecker.VMExistsCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when bbl cannot look up VMs on the iaas", func() {
+			It("skips the VM check", func() {
+				command = commands.NewDrift(logger, stateValidator, terraformManager, nil, stateStore, fileIO)
+
+				err := command.Execute([]string{}, storage.State{IAAS: "vsphere"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(logger.StepCall.Messages).To(ContainElement("skipping the VM check, bbl cannot look up VMs on vsphere"))
+			})
+		})
+
+		Context("when terraform fails", func() {
+			It("returns an error", func() {
+				terraformManager.DriftCall.Returns.Error = errors.New("banana")
+
+				err := command.Execute([]string{}, state)
+				Expect(err).To(MatchError("Detect infrastructure drift: banana"))
+			})
+		})
+
+		Context("when the VM lookup fails", func() {
+			It("returns an error", func() {
+				vmChecker.VMExistsCall.Returns.Error = errors.New("kiwi")
+
+				err := command.Execute([]string{}, state)
+				Expect(err).To(MatchError("Look up jumpbox VM: kiwi"))
+			})
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type stateUnlocker interface {
+	ForceUnlock() (storage.LockInfo, error)
+}
+
+type ForceUnlock struct {
+	logger   logger
+	unlocker stateUnlocker
+}
+
+func NewForceUnlock(logger logger, unlocker stateUnlocker) ForceUnlock {
+	return ForceUnlock{
+		logger:   logger,
+		unlocker: unlocker,
+	}
+}
+
+func (f ForceUnlock) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	return nil
+}
+
+func (f ForceUnlock) Execute(subcommandFlags []string, state storage.State) error {
+	info, err := f.unlocker.ForceUnlock()
+	if os.IsNotExist(err) {
+		f.logger.Println("the state directory is not locked")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Force unlock: %s", err)
+	}
+
+	f.logger.Println(fmt.Sprintf("released the lock held by %s", info))
+	return nil
+}
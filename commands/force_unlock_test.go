@@ -0,0 +1,67 @@
+package commands_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("force-unlock", func() {
+	var (
+		logger   *fakes.Logger
+		unlocker *fakes.StateUnlocker
+
+		command commands.ForceUnlock
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		unlocker = &fakes.StateUnlocker{}
+
+		command = commands.NewForceUnlock(logger, unlocker)
+	})
+
+	Describe("Execute", func() {
+		It("removes the lock and reports who held it", func() {
+			unlocker.ForceUnlockCall.Returns.LockInfo = storage.LockInfo{
+				PID:     1234,
+				Host:    "some-host",
+				Command: "up",
+				Started: time.Date(2018, time.March, 1, 12, 0, 0, 0, time.UTC),
+			}
+
+			err := command.Execute([]string{}, storage.State{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(unlocker.ForceUnlockCall.CallCount).To(Equal(1))
+			Expect(logger.PrintlnCall.Messages).To(ContainElement("released the lock held by bbl up (pid 1234 on some-host) since 2018-03-01T12:00:00Z"))
+		})
+
+		Context("when the state dir is not locked", func() {
+			It("says so", func() {
+				unlocker.ForceUnlockCall.Returns.Error = &os.PathError{Op: "open", Path: "bbl.lock", Err: os.ErrNotExist}
+
+				err := command.Execute([]string{}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("the state directory is not locked"))
+			})
+		})
+
+		Context("when the lock cannot be removed", func() {
+			It("returns an error", func() {
+				unlocker.ForceUnlockCall.Returns.Error = errors.New("banana")
+
+				err := command.Execute([]string{}, storage.State{})
+				Expect(err).To(MatchError("Force unlock: banana"))
+			})
+		})
+	})
+})
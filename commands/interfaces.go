@@ -2,6 +2,7 @@ package commands
 
 import (
 	"github.com/cloudfoundry/bosh-bootloader/certs"
+	"github.com/cloudfoundry/bosh-bootloader/patches"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 	"github.com/cloudfoundry/bosh-bootloader/terraform"
 )
@@ -12,6 +13,8 @@ type plan interface {
 	Execute([]string, storage.State) error
 	InitializePlan(PlanConfig, storage.State) (storage.State, error)
 	IsInitialized(storage.State) bool
+	WarnAboutPatchDrift(storage.State) error
+	CheckOverrides(PlanConfig, storage.State) (storage.State, error)
 }
 
 type up interface {
@@ -25,7 +28,10 @@ type terraformManager interface {
 	GetOutputs() (terraform.Outputs, error)
 	Init(storage.State) error
 	Apply(storage.State) (storage.State, error)
+	ApplyPlan(storage.State, string) (storage.State, error)
 	Validate(storage.State) (storage.State, error)
+	Plan(storage.State, string) ([]terraform.ResourceChange, error)
+	Drift(storage.State) ([]terraform.ResourceDrift, error)
 	Destroy(storage.State) (storage.State, error)
 	IsPaved() (bool, error)
 }
@@ -35,14 +41,21 @@ type boshManager interface {
 	CreateDirector(bblState storage.State, terraformOutputs terraform.Outputs) (storage.State, error)
 	InitializeJumpbox(bblState storage.State) error
 	CreateJumpbox(bblState storage.State, terraformOutputs terraform.Outputs) (storage.State, error)
+	ConnectToJumpbox(bblState storage.State) error
 	DeleteDirector(bblState storage.State, terraformOutputs terraform.Outputs) error
 	DeleteJumpbox(bblState storage.State, terraformOutputs terraform.Outputs) error
 	GetDirectorDeploymentVars(bblState storage.State, terraformOutputs terraform.Outputs) string
 	GetJumpboxDeploymentVars(bblState storage.State, terraformOutputs terraform.Outputs) string
+	DiffDirectorManifest(bblState storage.State, terraformOutputs terraform.Outputs) (string, error)
+	DiffJumpboxManifest(bblState storage.State, terraformOutputs terraform.Outputs) (string, error)
 	Path() string
 	Version() (string, error)
 }
 
+type fingerprinter interface {
+	Fingerprint(phase string, values ...string) (string, error)
+}
+
 type envIDManager interface {
 	Sync(storage.State, string) (storage.State, error)
 }
@@ -94,10 +107,31 @@ type logger interface {
 type stateStore interface {
 	Set(state storage.State) error
 	GetOldBblDir() string
+	GetStateDir() string
 	GetVarsDir() (string, error)
 	GetCloudConfigDir() (string, error)
 }
 
+type patcher interface {
+	Apply(stateDir, patchDir string, patches []storage.Patch) ([]storage.Patch, error)
+	Remove(stateDir, name string, patches []storage.Patch) ([]storage.Patch, error)
+	Drift(stateDir string, patches []storage.Patch) ([]storage.PatchDrift, error)
+}
+
+type overrideTracker interface {
+	Track(stateDir string, overrides []storage.Override) ([]storage.Override, error)
+	Stale(stateDir string, overrides []storage.Override) ([]storage.Override, error)
+}
+
+type deploymentSource interface {
+	Check(dir, manifest string) error
+	Revision(dir string) (string, error)
+}
+
+type builtinPatches interface {
+	Available() []patches.Info
+}
+
 type cloudConfigManager interface {
 	Update(state storage.State) error
 	Initialize(state storage.State) error
@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type stateMigrator interface {
+	Plan(state storage.State) []storage.PlannedMigration
+	Migrate(state storage.State) (storage.State, error)
+	Revert() (storage.MigrationRecord, error)
+	LegacyTerraformOverrides(state storage.State) ([]string, error)
+}
+
+type Migrate struct {
+	logger         logger
+	stateValidator stateValidator
+	migrator       stateMigrator
+}
+
+type MigrateConfig struct {
+	DryRun bool
+	Revert bool
+}
+
+func NewMigrate(logger logger, stateValidator stateValidator, migrator stateMigrator) Migrate {
+	return Migrate{
+		logger:         logger,
+		stateValidator: stateValidator,
+		migrator:       migrator,
+	}
+}
+
+func (m Migrate) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	config, err := m.ParseArgs(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	if config.DryRun && config.Revert {
+		return errors.New("--dry-run and --revert cannot be used together")
+	}
+
+	return m.stateValidator.Validate()
+}
+
+func (m Migrate) ParseArgs(args []string) (MigrateConfig, error) {
+	var config MigrateConfig
+
+	migrateFlags := flags.New("migrate")
+	migrateFlags.Bool(&config.DryRun, "dry-run")
+	migrateFlags.Bool(&config.Revert, "revert")
+
+	err := migrateFlags.Parse(args)
+	if err != nil {
+		return MigrateConfig{}, err
+	}
+
+	return config, nil
+}
+
+func (m Migrate) Execute(subcommandFlags []string, state storage.State) error {
+	config, err := m.ParseArgs(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	if config.Revert {
+		m.logger.Step("reverting the last migration")
+		record, err := m.migrator.Revert()
+		if err != nil {
+			return err
+		}
+
+		m.logger.Step("restored the state directory to schema %d", record.FromSchema)
+		return nil
+	}
+
+	warnings, err := m.migrator.LegacyTerraformOverrides(state)
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range warnings {
+		m.logger.Println(fmt.Sprintf("warning: %s", warning))
+	}
+
+	planned := m.migrator.Plan(state)
+	if len(planned) == 0 {
+		m.logger.Println("the state directory is up to date")
+		return nil
+	}
+
+	for _, migration := range planned {
+		m.logger.Printf("schema %d: %s\n", migration.Version, migration.Name)
+		for _, change := range migration.Changes {
+			m.logger.Printf("  %s\n", change)
+		}
+	}
+
+	if config.DryRun {
+		return nil
+	}
+
+	m.logger.Step("migrating the state directory")
+	_, err = m.migrator.Migrate(state)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Step("migrated the state directory to schema %d, run bbl migrate --revert to undo it", storage.STATE_SCHEMA)
+	return nil
+}
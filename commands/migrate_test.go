@@ -0,0 +1,139 @@
+package commands_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Migrate", func() {
+	var (
+		logger         *fakes.Logger
+		stateValidator *fakes.StateValidator
+		migrator       *fakes.StateMigrator
+		command        commands.Migrate
+		state          storage.State
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		stateValidator = &fakes.StateValidator{}
+		migrator = &fakes.StateMigrator{}
+		command = commands.NewMigrate(logger, stateValidator, migrator)
+
+		state = storage.State{Version: 13, EnvID: "some-env"}
+		migrator.PlanCall.Returns.Migrations = []storage.PlannedMigration{{
+			Version: 14,
+			Name:    "rename the bbl provided terraform variables",
+			Changes: []string{"move vars/terraform.tfvars to vars/bbl.tfvars"},
+		}}
+	})
+
+	Describe("CheckFastFails", func() {
+		It("validates the state dir", func() {
+			stateValidator.ValidateCall.Returns.Error = errors.New("no state")
+
+			err := command.CheckFastFails([]string{}, state)
+			Expect(err).To(MatchError("no state"))
+		})
+
+		It("rejects --dry-run with --revert", func() {
+			err := command.CheckFastFails([]string{"--dry-run", "--revert"}, state)
+			Expect(err).To(MatchError("--dry-run and --revert cannot be used together"))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("prints and applies the planned migrations", func() {
+			err := command.Execute([]string{}, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(migrator.PlanCall.Receives.State).To(Equal(state))
+			Expect(logger.PrintfCall.Messages).To(Equal([]string{
+				"schema 14: rename the bbl provided terraform variables\n",
+				"  move vars/terraform.tfvars to vars/bbl.tfvars\n",
+			}))
+			Expect(migrator.MigrateCall.CallCount).To(Equal(1))
+			Expect(migrator.MigrateCall.Receives.State).To(Equal(state))
+		})
+
+		Context("when --dry-run is provided", func() {
+			It("prints the planned migrations without applying them", func() {
+				err := command.Execute([]string{"--dry-run"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Messages).To(HaveLen(2))
+				Expect(migrator.MigrateCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the state directory is up to date", func() {
+			It("says so", func() {
+				migrator.PlanCall.Returns.Migrations = nil
+
+				err := command.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("the state directory is up to date"))
+				Expect(migrator.MigrateCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when override files use terraform 0.11 syntax", func() {
+			It("warns about them", func() {
+				migrator.LegacyTerraformOverridesCall.Returns.Warnings = []string{"terraform/my-override.tf uses terraform 0.11 syntax: quoted type constraint on line 2"}
+
+				err := command.Execute([]string{"--dry-run"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(migrator.LegacyTerraformOverridesCall.Receives.State).To(Equal(state))
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("warning: terraform/my-override.tf uses terraform 0.11 syntax: quoted type constraint on line 2"))
+			})
+
+			Context("when the override files cannot be read", func() {
+				It("returns an error", func() {
+					migrator.LegacyTerraformOverridesCall.Returns.Error = errors.New("kiwi")
+
+					err := command.Execute([]string{}, state)
+					Expect(err).To(MatchError("kiwi"))
+				})
+			})
+		})
+
+		Context("when the migration fails", func() {
+			It("returns an error", func() {
+				migrator.MigrateCall.Returns.Error = errors.New("banana")
+
+				err := command.Execute([]string{}, state)
+				Expect(err).To(MatchError("banana"))
+			})
+		})
+
+		Context("when --revert is provided", func() {
+			It("reverts the last migration", func() {
+				migrator.RevertCall.Returns.Record = storage.MigrationRecord{FromSchema: 13}
+
+				err := command.Execute([]string{"--revert"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(migrator.RevertCall.CallCount).To(Equal(1))
+				Expect(migrator.PlanCall.CallCount).To(Equal(0))
+				Expect(logger.StepCall.Messages).To(ContainElement("restored the state directory to schema 13"))
+			})
+
+			Context("when the revert fails", func() {
+				It("returns an error", func() {
+					migrator.RevertCall.Returns.Error = errors.New("There is no migration to revert.")
+
+					err := command.Execute([]string{"--revert"}, state)
+					Expect(err).To(MatchError("There is no migration to revert."))
+				})
+			})
+		})
+	})
+})
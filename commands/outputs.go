@@ -1,34 +1,116 @@
 package commands
 
 import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudfoundry/bosh-bootloader/flags"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
 	yaml "gopkg.in/yaml.v2"
 )
 
 type Outputs struct {
 	logger           logger
 	terraformManager terraformManager
+	outputContract   terraform.OutputContract
 	stateValidator   stateValidator
 }
 
-func NewOutputs(logger logger, terraformManager terraformManager, stateValidator stateValidator) Outputs {
+type OutputsConfig struct {
+	ShowSensitive bool
+	JSON          bool
+	Key           string
+}
+
+func NewOutputs(logger logger, terraformManager terraformManager, outputContract terraform.OutputContract, stateValidator stateValidator) Outputs {
 	return Outputs{
 		logger:           logger,
 		terraformManager: terraformManager,
+		outputContract:   outputContract,
 		stateValidator:   stateValidator,
 	}
 }
 
 func (o Outputs) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	_, err := o.ParseArgs(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
 	return o.stateValidator.Validate()
 }
 
+func (o Outputs) ParseArgs(args []string) (OutputsConfig, error) {
+	var config OutputsConfig
+
+	outputsFlags := flags.New("outputs")
+	outputsFlags.Bool(&config.ShowSensitive, "show-sensitive")
+	outputsFlags.Bool(&config.JSON, "json")
+	outputsFlags.String(&config.Key, "key", "")
+
+	err := outputsFlags.Parse(args)
+	if err != nil {
+		return OutputsConfig{}, err
+	}
+
+	return config, nil
+}
+
 func (o Outputs) Execute(subcommandFlags []string, state storage.State) error {
+	config, err := o.ParseArgs(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
 	outputs, err := o.terraformManager.GetOutputs()
 	if err != nil {
 		return err
 	}
-	marshalled, err := yaml.Marshal(outputs.Map)
+
+	if o.outputContract != nil {
+		outputs = outputs.MarkSensitive(o.outputContract.Outputs(state))
+	}
+
+	if config.Key != "" {
+		value, ok := outputs.Map[config.Key]
+		if !ok {
+			return fmt.Errorf("There is no %s terraform output.", config.Key)
+		}
+
+		if outputs.IsSensitive(config.Key) && !config.ShowSensitive {
+			return fmt.Errorf("The %s terraform output is sensitive. Use --show-sensitive to print it.", config.Key)
+		}
+
+		// Strings are printed raw, so that scripts do not have to unquote them.
+		if s, ok := value.(string); ok && !config.JSON {
+			o.logger.Println(s)
+			return nil
+		}
+
+		marshalled, err := json.Marshal(value)
+		if err != nil {
+			return err // not tested
+		}
+		o.logger.Println(string(marshalled))
+		return nil
+	}
+
+	values := outputs.Masked()
+	if config.ShowSensitive {
+		values = outputs.Map
+	}
+
+	if config.JSON {
+		marshalled, err := json.MarshalIndent(values, "", "  ")
+		if err != nil {
+			return err // not tested
+		}
+		o.logger.Println(string(marshalled))
+		return nil
+	}
+
+	marshalled, err := yaml.Marshal(values)
 	if err != nil {
 		return err
 	}
@@ -18,16 +18,23 @@ var _ = Describe("Outputs", func() {
 		stateValidator   *fakes.StateValidator
 		logger           *fakes.Logger
 		terraformManager *fakes.TerraformManager
+		outputContract   *fakes.OutputContract
 	)
 
 	BeforeEach(func() {
 		stateValidator = &fakes.StateValidator{}
 		logger = &fakes.Logger{}
 		terraformManager = &fakes.TerraformManager{}
-		outputsCommand = commands.NewOutputs(logger, terraformManager, stateValidator)
+		outputContract = &fakes.OutputContract{}
+		outputsCommand = commands.NewOutputs(logger, terraformManager, outputContract, stateValidator)
 	})
 
 	Describe("CheckFastFails", func() {
+		It("rejects unknown flags", func() {
+			err := outputsCommand.CheckFastFails([]string{"--yaml"}, storage.State{})
+			Expect(err).To(MatchError("flag provided but not defined: -yaml"))
+		})
+
 		Context("when state validation fails", func() {
 			BeforeEach(func() {
 				stateValidator.ValidateCall.Returns.Error = errors.New("state validation failed")
@@ -57,6 +64,100 @@ make sure we quote multiline strings`,
 			Expect(logger.PrintfCall.Receives.Message).To(ContainSubstring("external: address\nfirewall: |-\n  cidr\n  make sure we quote multiline strings"))
 		})
 
+		Context("when there are sensitive outputs", func() {
+			BeforeEach(func() {
+				terraformManager.GetOutputsCall.Returns.Outputs = terraform.Outputs{
+					Map: map[string]interface{}{
+						"external_ip":   "some-external-ip",
+						"private_key":   "some-private-key",
+						"jumpbox__tags": []interface{}{"some-tag"},
+					},
+					Sensitive: map[string]bool{"private_key": true},
+				}
+			})
+
+			It("masks their values", func() {
+				err := outputsCommand.Execute([]string{}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Receives.Message).To(MatchYAML("external_ip: some-external-ip\njumpbox__tags: [some-tag]\nprivate_key: <sensitive>\n"))
+			})
+
+			It("masks the outputs that the output contract marks sensitive", func() {
+				state := storage.State{IAAS: "aws", EnvID: "some-env-id"}
+				outputContract.OutputsCall.Returns.Outputs = []terraform.OutputSpec{
+					{Name: "external_ip", Type: terraform.StringOutput, Sensitive: true},
+				}
+
+				err := outputsCommand.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(outputContract.OutputsCall.Receives.State).To(Equal(state))
+				Expect(logger.PrintfCall.Receives.Message).To(MatchYAML("external_ip: <sensitive>\njumpbox__tags: [some-tag]\nprivate_key: <sensitive>\n"))
+			})
+
+			Context("when --show-sensitive is provided", func() {
+				It("prints their values", func() {
+					err := outputsCommand.Execute([]string{"--show-sensitive"}, storage.State{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(logger.PrintfCall.Receives.Message).To(ContainSubstring("private_key: some-private-key"))
+				})
+			})
+
+			Context("when --json is provided", func() {
+				It("prints the outputs as JSON", func() {
+					err := outputsCommand.Execute([]string{"--json"}, storage.State{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(logger.PrintlnCall.Messages).To(HaveLen(1))
+					Expect(logger.PrintlnCall.Messages[0]).To(MatchJSON(`{
+						"external_ip": "some-external-ip",
+						"jumpbox__tags": ["some-tag"],
+						"private_key": "<sensitive>"
+					}`))
+				})
+			})
+
+			Context("when --key is provided", func() {
+				It("prints a string value raw", func() {
+					err := outputsCommand.Execute([]string{"--key", "external_ip"}, storage.State{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(logger.PrintlnCall.Messages).To(Equal([]string{"some-external-ip"}))
+				})
+
+				It("prints other values as JSON", func() {
+					err := outputsCommand.Execute([]string{"--key", "jumpbox__tags"}, storage.State{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(logger.PrintlnCall.Messages).To(Equal([]string{`["some-tag"]`}))
+				})
+
+				It("quotes a string value with --json", func() {
+					err := outputsCommand.Execute([]string{"--key", "external_ip", "--json"}, storage.State{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(logger.PrintlnCall.Messages).To(Equal([]string{`"some-external-ip"`}))
+				})
+
+				It("refuses to print a sensitive value without --show-sensitive", func() {
+					err := outputsCommand.Execute([]string{"--key", "private_key"}, storage.State{})
+					Expect(err).To(MatchError("The private_key terraform output is sensitive. Use --show-sensitive to print it."))
+					Expect(logger.PrintlnCall.Messages).To(BeEmpty())
+
+					err = outputsCommand.Execute([]string{"--key", "private_key", "--show-sensitive"}, storage.State{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(logger.PrintlnCall.Messages).To(Equal([]string{"some-private-key"}))
+				})
+
+				It("returns an error when the output does not exist", func() {
+					err := outputsCommand.Execute([]string{"--key", "missing"}, storage.State{})
+					Expect(err).To(MatchError("There is no missing terraform output."))
+				})
+			})
+		})
+
 		Context("failure cases", func() {
 			Context("when getOutputs failes", func() {
 				It("returns an error", func() {
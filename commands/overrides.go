@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+type overridesFs interface {
+	fileio.FileReader
+}
+
+type OverridesDiff struct {
+	logger         logger
+	stateValidator stateValidator
+	stateStore     stateStore
+	fs             overridesFs
+}
+
+func NewOverridesDiff(logger logger, stateValidator stateValidator, stateStore stateStore, fs overridesFs) OverridesDiff {
+	return OverridesDiff{
+		logger:         logger,
+		stateValidator: stateValidator,
+		stateStore:     stateStore,
+		fs:             fs,
+	}
+}
+
+func (o OverridesDiff) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	return o.stateValidator.Validate()
+}
+
+// Execute diffs each override script against the script bbl plan generated
+// last, so that the lines an override is missing show up as removals.
+func (o OverridesDiff) Execute(subcommandFlags []string, state storage.State) error {
+	stateDir := o.stateStore.GetStateDir()
+
+	found := false
+	var diff string
+	for _, script := range storage.OverrideScripts {
+		override, err := o.readScript(stateDir, script)
+		if err != nil {
+			return err
+		}
+		if override == nil {
+			continue
+		}
+		found = true
+
+		base, err := o.readScript(stateDir, storage.BaseScript(script))
+		if err != nil {
+			return err
+		}
+
+		scriptDiff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(base),
+			B:        splitLines(override),
+			FromFile: storage.BaseScript(script),
+			ToFile:   script,
+			Context:  3,
+		})
+		if err != nil {
+			return fmt.Errorf("Diff %s: %s", script, err) // not tested
+		}
+		diff += scriptDiff
+	}
+
+	if !found {
+		o.logger.Println("there are no override scripts in the state directory")
+		return nil
+	}
+
+	if diff == "" {
+		o.logger.Println("the override scripts match the scripts bbl plan generated")
+		return nil
+	}
+
+	o.logger.Printf("%s", diff)
+	return nil
+}
+
+func (o OverridesDiff) readScript(stateDir, script string) ([]byte, error) {
+	contents, err := o.fs.ReadFile(filepath.Join(stateDir, script))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Read %s: %s", script, err)
+	}
+	return contents, nil
+}
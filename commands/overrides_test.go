@@ -0,0 +1,82 @@
+package commands_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OverridesDiff", func() {
+	var (
+		logger         *fakes.Logger
+		stateValidator *fakes.StateValidator
+		stateStore     *fakes.StateStore
+		fs             *afero.Afero
+		command        commands.OverridesDiff
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		stateValidator = &fakes.StateValidator{}
+		stateStore = &fakes.StateStore{}
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+
+		stateStore.GetStateDirCall.Returns.Directory = "/state"
+
+		command = commands.NewOverridesDiff(logger, stateValidator, stateStore, fs)
+	})
+
+	Describe("CheckFastFails", func() {
+		It("validates the state dir", func() {
+			stateValidator.ValidateCall.Returns.Error = errors.New("no state")
+
+			err := command.CheckFastFails([]string{}, storage.State{})
+			Expect(err).To(MatchError("no state"))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("diffs each override script against its base script", func() {
+			fs.WriteFile("/state/create-director.sh", []byte("bosh create-env \\\n  -o new-ops.yml \\\n  -v foo=bar\n"), 0755)
+			fs.WriteFile("/state/create-director-override.sh", []byte("bosh create-env \\\n  -v foo=bar\n"), 0755)
+
+			err := command.Execute([]string{}, storage.State{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(logger.PrintfCall.Messages).To(Equal([]string{`--- create-director.sh
++++ create-director-override.sh
+@@ -1,3 +1,2 @@
+ bosh create-env \
+-  -o new-ops.yml \
+   -v foo=bar
+`}))
+		})
+
+		Context("when the override scripts match their base scripts", func() {
+			It("says so", func() {
+				fs.WriteFile("/state/create-jumpbox.sh", []byte("bosh create-env\n"), 0755)
+				fs.WriteFile("/state/create-jumpbox-override.sh", []byte("bosh create-env\n"), 0755)
+
+				err := command.Execute([]string{}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("the override scripts match the scripts bbl plan generated"))
+			})
+		})
+
+		Context("when there are no override scripts", func() {
+			It("says so", func() {
+				fs.WriteFile("/state/create-jumpbox.sh", []byte("bosh create-env\n"), 0755)
+
+				err := command.Execute([]string{}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(logger.PrintlnCall.Messages).To(ContainElement("there are no override scripts in the state directory"))
+			})
+		})
+	})
+})
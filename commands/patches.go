@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type patchesStore interface {
+	Set(state storage.State) error
+	GetStateDir() string
+}
+
+type PatchesList struct {
+	logger         logger
+	stateValidator stateValidator
+	stateStore     patchesStore
+	patcher        patcher
+}
+
+type PatchesAvailable struct {
+	logger         logger
+	builtinPatches builtinPatches
+}
+
+type PatchesRemove struct {
+	logger         logger
+	stateValidator stateValidator
+	stateStore     patchesStore
+	patcher        patcher
+}
+
+func NewPatchesList(logger logger, stateValidator stateValidator, stateStore patchesStore, patcher patcher) PatchesList {
+	return PatchesList{
+		logger:         logger,
+		stateValidator: stateValidator,
+		stateStore:     stateStore,
+		patcher:        patcher,
+	}
+}
+
+func NewPatchesAvailable(logger logger, builtinPatches builtinPatches) PatchesAvailable {
+	return PatchesAvailable{
+		logger:         logger,
+		builtinPatches: builtinPatches,
+	}
+}
+
+func NewPatchesRemove(logger logger, stateValidator stateValidator, stateStore patchesStore, patcher patcher) PatchesRemove {
+	return PatchesRemove{
+		logger:         logger,
+		stateValidator: stateValidator,
+		stateStore:     stateStore,
+		patcher:        patcher,
+	}
+}
+
+func (p PatchesList) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	return p.stateValidator.Validate()
+}
+
+func (p PatchesList) Execute(subcommandFlags []string, state storage.State) error {
+	if len(state.Patches) == 0 {
+		p.logger.Println("no patches have been applied")
+		return nil
+	}
+
+	drift, err := p.patcher.Drift(p.stateStore.GetStateDir(), state.Patches)
+	if err != nil {
+		return fmt.Errorf("Check patches: %s", err)
+	}
+
+	for _, patch := range state.Patches {
+		sum := patch.SHA256
+		if len(sum) > 12 {
+			sum = sum[:12]
+		}
+
+		p.logger.Printf("%-32s %s  %s\n", patch.Name, sum, patch.Source)
+		for _, file := range drift {
+			if file.Patch == patch.Name {
+				p.logger.Printf("  %-8s %s\n", file.Status, file.Path)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p PatchesAvailable) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	return nil
+}
+
+func (p PatchesAvailable) Execute(subcommandFlags []string, state storage.State) error {
+	for _, patch := range p.builtinPatches.Available() {
+		p.logger.Printf("%-32s %s\n", storage.BuiltinPatchPrefix+patch.Name, patch.Description)
+	}
+
+	return nil
+}
+
+func (p PatchesRemove) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	if len(subcommandFlags) != 1 {
+		return errors.New("bbl patches remove takes the name of a patch")
+	}
+
+	return p.stateValidator.Validate()
+}
+
+func (p PatchesRemove) Execute(subcommandFlags []string, state storage.State) error {
+	name := subcommandFlags[0]
+
+	remaining, err := p.patcher.Remove(p.stateStore.GetStateDir(), name, state.Patches)
+	if err != nil {
+		return err
+	}
+
+	state.Patches = remaining
+	err = p.stateStore.Set(state)
+	if err != nil {
+		return fmt.Errorf("Save state: %s", err)
+	}
+
+	p.logger.Step("removed patch %s, run bbl plan and bbl up to apply the change", name)
+	return nil
+}
@@ -0,0 +1,173 @@
+package commands_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/patches"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("patches", func() {
+	var (
+		logger         *fakes.Logger
+		stateValidator *fakes.StateValidator
+		stateStore     *fakes.StateStore
+		patcher        *fakes.Patcher
+		state          storage.State
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		stateValidator = &fakes.StateValidator{}
+		stateStore = &fakes.StateStore{}
+		patcher = &fakes.Patcher{}
+
+		stateStore.GetStateDirCall.Returns.Directory = "/some/state-dir"
+		state = storage.State{
+			EnvID: "some-env",
+			Patches: []storage.Patch{
+				{Name: "some-patch", Source: "/patches/some-patch", SHA256: "0123456789abcdef0123"},
+				{Name: "other-patch", Source: "/patches/other-patch", SHA256: "fedcba9876543210fedc"},
+			},
+		}
+	})
+
+	Describe("PatchesList", func() {
+		var command commands.PatchesList
+
+		BeforeEach(func() {
+			command = commands.NewPatchesList(logger, stateValidator, stateStore, patcher)
+		})
+
+		Describe("CheckFastFails", func() {
+			It("validates the state dir", func() {
+				stateValidator.ValidateCall.Returns.Error = errors.New("no state")
+
+				err := command.CheckFastFails([]string{}, state)
+				Expect(err).To(MatchError("no state"))
+			})
+		})
+
+		Describe("Execute", func() {
+			It("lists the patches with the files that changed since they were applied", func() {
+				patcher.DriftCall.Returns.Drift = []storage.PatchDrift{
+					{Patch: "other-patch", Path: "terraform/other_override.tf", Status: storage.PATCH_FILE_EDITED},
+				}
+
+				err := command.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(patcher.DriftCall.Receives.StateDir).To(Equal("/some/state-dir"))
+				Expect(patcher.DriftCall.Receives.Patches).To(Equal(state.Patches))
+				Expect(logger.PrintfCall.Messages).To(Equal([]string{
+					"some-patch                       0123456789ab  /patches/some-patch\n",
+					"other-patch                      fedcba987654  /patches/other-patch\n",
+					"  edited   terraform/other_override.tf\n",
+				}))
+			})
+
+			Context("when there are no patches", func() {
+				It("says so", func() {
+					err := command.Execute([]string{}, storage.State{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(logger.PrintlnCall.Messages).To(ContainElement("no patches have been applied"))
+					Expect(patcher.DriftCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when the patches cannot be checked", func() {
+				It("returns an error", func() {
+					patcher.DriftCall.Returns.Error = errors.New("banana")
+
+					err := command.Execute([]string{}, state)
+					Expect(err).To(MatchError("Check patches: banana"))
+				})
+			})
+		})
+	})
+
+	Describe("PatchesAvailable", func() {
+		It("lists the builtin patches", func() {
+			builtinPatches := &fakes.BuiltinPatches{}
+			builtinPatches.AvailableCall.Returns.Infos = []patches.Info{
+				{Name: "1-az-aws", Description: "Only create resources in a single availability zone"},
+				{Name: "iso-segs-aws", Description: "Add Isolation Segments"},
+			}
+
+			command := commands.NewPatchesAvailable(logger, builtinPatches)
+			Expect(command.CheckFastFails([]string{}, storage.State{})).To(Succeed())
+
+			err := command.Execute([]string{}, storage.State{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(logger.PrintfCall.Messages).To(Equal([]string{
+				"builtin:1-az-aws                 Only create resources in a single availability zone\n",
+				"builtin:iso-segs-aws             Add Isolation Segments\n",
+			}))
+		})
+	})
+
+	Describe("PatchesRemove", func() {
+		var command commands.PatchesRemove
+
+		BeforeEach(func() {
+			command = commands.NewPatchesRemove(logger, stateValidator, stateStore, patcher)
+		})
+
+		Describe("CheckFastFails", func() {
+			It("requires the name of a patch", func() {
+				err := command.CheckFastFails([]string{}, state)
+				Expect(err).To(MatchError("bbl patches remove takes the name of a patch"))
+			})
+
+			It("validates the state dir", func() {
+				stateValidator.ValidateCall.Returns.Error = errors.New("no state")
+
+				err := command.CheckFastFails([]string{"some-patch"}, state)
+				Expect(err).To(MatchError("no state"))
+			})
+		})
+
+		Describe("Execute", func() {
+			It("removes the patch and saves the state", func() {
+				patcher.RemoveCall.Returns.Patches = []storage.Patch{state.Patches[1]}
+
+				err := command.Execute([]string{"some-patch"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(patcher.RemoveCall.Receives.StateDir).To(Equal("/some/state-dir"))
+				Expect(patcher.RemoveCall.Receives.Name).To(Equal("some-patch"))
+				Expect(patcher.RemoveCall.Receives.Patches).To(Equal(state.Patches))
+
+				Expect(stateStore.SetCall.CallCount).To(Equal(1))
+				Expect(stateStore.SetCall.Receives[0].State.Patches).To(Equal([]storage.Patch{state.Patches[1]}))
+				Expect(logger.StepCall.Messages).To(ContainElement("removed patch some-patch, run bbl plan and bbl up to apply the change"))
+			})
+
+			Context("when the patch cannot be removed", func() {
+				It("returns the error and does not save the state", func() {
+					patcher.RemoveCall.Returns.Error = errors.New("There is no applied patch named missing-patch.")
+
+					err := command.Execute([]string{"missing-patch"}, state)
+					Expect(err).To(MatchError("There is no applied patch named missing-patch."))
+					Expect(stateStore.SetCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when the state cannot be saved", func() {
+				It("returns an error", func() {
+					stateStore.SetCall.Returns = []fakes.SetCallReturn{{Error: errors.New("cherry")}}
+
+					err := command.Execute([]string{"some-patch"}, state)
+					Expect(err).To(MatchError("Save state: cherry"))
+				})
+			})
+		})
+	})
+})
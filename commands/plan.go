@@ -1,11 +1,17 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/flags"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
 )
 
 type Plan struct {
@@ -15,21 +21,40 @@ type Plan struct {
 	envIDManager       envIDManager
 	terraformManager   terraformManager
 	lbArgsHandler      lbArgsHandler
+	patcher            patcher
+	overrideTracker    overrideTracker
+	deploymentSource   deploymentSource
 	logger             logger
 	bblVersion         string
 }
 
 type PlanConfig struct {
-	Name string
-	LB   storage.LB
+	Name             string
+	LB               storage.LB
+	TerraformPlanOut string
+	TerraformBackend storage.TerraformBackend
+	Patches          []string
+	Strict           bool
+	DirectorFeatures []string
+	JumpboxSizing    storage.Sizing
+	DirectorSizing   storage.Sizing
+
+	JumpboxDeploymentDir string
+	BOSHDeploymentDir    string
+	OfflineAssetsDir     string
 }
 
+var terraformBackends = []string{"s3", "gcs", "azurerm", "local"}
+
 func NewPlan(boshManager boshManager,
 	cloudConfigManager cloudConfigManager,
 	stateStore stateStore,
 	envIDManager envIDManager,
 	terraformManager terraformManager,
 	lbArgsHandler lbArgsHandler,
+	patcher patcher,
+	overrideTracker overrideTracker,
+	deploymentSource deploymentSource,
 	logger logger,
 	bblVersion string,
 ) Plan {
@@ -40,6 +65,9 @@ func NewPlan(boshManager boshManager,
 		envIDManager:       envIDManager,
 		terraformManager:   terraformManager,
 		lbArgsHandler:      lbArgsHandler,
+		patcher:            patcher,
+		overrideTracker:    overrideTracker,
+		deploymentSource:   deploymentSource,
 		logger:             logger,
 		bblVersion:         bblVersion,
 	}
@@ -68,8 +96,14 @@ func (p Plan) CheckFastFails(args []string, state storage.State) error {
 
 func (p Plan) ParseArgs(args []string, state storage.State) (PlanConfig, error) {
 	var (
-		config PlanConfig
-		lbArgs LBArgs
+		config        PlanConfig
+		lbArgs        LBArgs
+		backendConfig []string
+
+		jumpboxPersistentDiskSize  string
+		jumpboxEphemeralDiskSize   string
+		directorPersistentDiskSize string
+		directorEphemeralDiskSize  string
 	)
 	planFlags := flags.New("up")
 	planFlags.String(&config.Name, "name", os.Getenv("BBL_ENV_NAME"))
@@ -77,6 +111,21 @@ func (p Plan) ParseArgs(args []string, state storage.State) (PlanConfig, error)
 	planFlags.String(&lbArgs.CertPath, "lb-cert", "")
 	planFlags.String(&lbArgs.KeyPath, "lb-key", "")
 	planFlags.String(&lbArgs.Domain, "lb-domain", "")
+	planFlags.String(&config.TerraformPlanOut, "terraform-plan-out", "")
+	planFlags.String(&config.TerraformBackend.Type, "terraform-backend", "")
+	planFlags.StringSlice(&backendConfig, "terraform-backend-config")
+	planFlags.StringSlice(&config.Patches, "patch")
+	planFlags.Bool(&config.Strict, "strict")
+	planFlags.StringSlice(&config.DirectorFeatures, "director-feature")
+	planFlags.String(&config.JumpboxSizing.VMType, "jumpbox-vm-type", "")
+	planFlags.String(&jumpboxPersistentDiskSize, "jumpbox-persistent-disk-size", "")
+	planFlags.String(&jumpboxEphemeralDiskSize, "jumpbox-ephemeral-disk-size", "")
+	planFlags.String(&config.DirectorSizing.VMType, "director-vm-type", "")
+	planFlags.String(&directorPersistentDiskSize, "director-persistent-disk-size", "")
+	planFlags.String(&directorEphemeralDiskSize, "director-ephemeral-disk-size", "")
+	planFlags.String(&config.JumpboxDeploymentDir, "jumpbox-deployment-dir", "")
+	planFlags.String(&config.BOSHDeploymentDir, "bosh-deployment-dir", "")
+	planFlags.String(&config.OfflineAssetsDir, "offline-assets", "")
 	if state.IAAS == "aws" {
 		planFlags.String(&lbArgs.ChainPath, "lb-chain", "")
 	}
@@ -86,6 +135,48 @@ func (p Plan) ParseArgs(args []string, state storage.State) (PlanConfig, error)
 		return PlanConfig{}, err
 	}
 
+	err = validatePlanFile("--terraform-plan-out", config.TerraformPlanOut)
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	config.TerraformBackend, err = parseTerraformBackend(config.TerraformBackend.Type, backendConfig)
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	err = bosh.ValidateDirectorFeatures(config.DirectorFeatures, state.IAAS)
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	config.JumpboxSizing, err = bosh.ParseSizing("jumpbox", config.JumpboxSizing.VMType, jumpboxPersistentDiskSize, jumpboxEphemeralDiskSize, state.IAAS)
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	config.DirectorSizing, err = bosh.ParseSizing("director", config.DirectorSizing.VMType, directorPersistentDiskSize, directorEphemeralDiskSize, state.IAAS)
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	config.JumpboxDeploymentDir, err = absDeploymentDir(config.JumpboxDeploymentDir)
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	config.BOSHDeploymentDir, err = absDeploymentDir(config.BOSHDeploymentDir)
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	if config.OfflineAssetsDir != "" && config.OfflineAssetsDir != bosh.NoOfflineAssets {
+		config.OfflineAssetsDir, err = filepath.Abs(config.OfflineAssetsDir)
+		if err != nil {
+			return PlanConfig{}, err
+		}
+	}
+
 	if (lbArgs != LBArgs{}) {
 		lbState, err := p.lbArgsHandler.GetLBState(state.IAAS, lbArgs)
 		if err != nil {
@@ -103,21 +194,89 @@ func (p Plan) Execute(args []string, state storage.State) error {
 		return err
 	}
 
-	_, err = p.InitializePlan(config, state)
-	return err
+	err = p.WarnAboutPatchDrift(state)
+	if err != nil {
+		return err
+	}
+
+	state, err = p.InitializePlan(config, state)
+	if err != nil {
+		return err
+	}
+
+	if config.TerraformPlanOut == "" {
+		return nil
+	}
+
+	varsDir, err := p.stateStore.GetVarsDir()
+	if err != nil {
+		return fmt.Errorf("Get vars dir: %s", err)
+	}
+
+	planFile := filepath.Join(varsDir, config.TerraformPlanOut)
+	changes, err := p.terraformManager.Plan(state, planFile)
+	if err != nil {
+		return fmt.Errorf("Terraform manager plan: %s", err)
+	}
+
+	printTerraformChanges(p.logger, changes)
+	p.logger.Step("saved the terraform plan to %s, run bbl up --terraform-plan-file %s to apply it", planFile, config.TerraformPlanOut)
+
+	return nil
 }
 
 func (p Plan) InitializePlan(config PlanConfig, state storage.State) (storage.State, error) {
 	state.BBLVersion = p.bblVersion
 	state.LB = config.LB
 	state.NoDirector = false
+	if config.TerraformBackend.Type != "" {
+		state.TerraformBackend = config.TerraformBackend
+	}
+	if len(config.DirectorFeatures) > 0 {
+		state.DirectorFeatures = nil
+		for _, feature := range config.DirectorFeatures {
+			if feature != bosh.NoDirectorFeatures {
+				state.DirectorFeatures = append(state.DirectorFeatures, feature)
+			}
+		}
+	}
+	state.JumpboxSizing = state.JumpboxSizing.Merge(config.JumpboxSizing)
+	state.DirectorSizing = state.DirectorSizing.Merge(config.DirectorSizing)
+	if config.OfflineAssetsDir == bosh.NoOfflineAssets {
+		state.OfflineAssetsDir = ""
+	} else if config.OfflineAssetsDir != "" {
+		state.OfflineAssetsDir = config.OfflineAssetsDir
+	}
 
 	var err error
+	state.JumpboxDeploymentDir, state.JumpboxDeploymentRevision, err = p.useDeploymentDir("jumpbox-deployment", "jumpbox.yml", config.JumpboxDeploymentDir, state.JumpboxDeploymentDir)
+	if err != nil {
+		return storage.State{}, err
+	}
+
+	state.BOSHDeploymentDir, state.BOSHDeploymentRevision, err = p.useDeploymentDir("bosh-deployment", "bosh.yml", config.BOSHDeploymentDir, state.BOSHDeploymentDir)
+	if err != nil {
+		return storage.State{}, err
+	}
+
 	state, err = p.envIDManager.Sync(state, config.Name)
 	if err != nil {
 		return storage.State{}, fmt.Errorf("Env id manager sync: %s", err)
 	}
 
+	for _, patchDir := range config.Patches {
+		state.Patches, err = p.patcher.Apply(p.stateStore.GetStateDir(), patchDir, state.Patches)
+		if err != nil {
+			return storage.State{}, fmt.Errorf("Apply patch: %s", err)
+		}
+		p.logger.Step("applied patch %s", state.Patches[len(state.Patches)-1].Name)
+	}
+
+	state.Overrides, err = p.overrideTracker.Track(p.stateStore.GetStateDir(), state.Overrides)
+	if err != nil {
+		return storage.State{}, fmt.Errorf("Check override scripts: %s", err)
+	}
+
 	err = p.stateStore.Set(state)
 	if err != nil {
 		return storage.State{}, fmt.Errorf("Save state: %s", err)
@@ -139,10 +298,174 @@ func (p Plan) InitializePlan(config PlanConfig, state storage.State) (storage.St
 		return storage.State{}, fmt.Errorf("Bosh manager initialize director: %s", err)
 	}
 
+	return p.CheckOverrides(config, state)
+}
+
+// CheckOverrides records the override scripts in the state dir, and warns
+// about the ones whose base script has changed since they were written, which
+// bbl would otherwise keep running with the old arguments. With --strict it
+// fails instead.
+func (p Plan) CheckOverrides(config PlanConfig, state storage.State) (storage.State, error) {
+	stateDir := p.stateStore.GetStateDir()
+
+	overrides, err := p.overrideTracker.Track(stateDir, state.Overrides)
+	if err != nil {
+		return storage.State{}, fmt.Errorf("Check override scripts: %s", err)
+	}
+
+	if !reflect.DeepEqual(overrides, state.Overrides) {
+		state.Overrides = overrides
+		err = p.stateStore.Set(state)
+		if err != nil {
+			return storage.State{}, fmt.Errorf("Save state: %s", err)
+		}
+	}
+
+	stale, err := p.overrideTracker.Stale(stateDir, state.Overrides)
+	if err != nil {
+		return storage.State{}, fmt.Errorf("Check override scripts: %s", err)
+	}
+
+	problems := []string{}
+	for _, override := range stale {
+		problems = append(problems, fmt.Sprintf("%s was written for an older %s", override.Script, storage.BaseScript(override.Script)))
+	}
+
+	if len(problems) == 0 {
+		return state, nil
+	}
+
+	if config.Strict {
+		return storage.State{}, fmt.Errorf("%s. Run bbl overrides diff to see what changed, then update or remove the override scripts.", strings.Join(problems, ", "))
+	}
+
+	for _, problem := range problems {
+		p.logger.Println(fmt.Sprintf("warning: %s, run bbl overrides diff to see what it is missing", problem))
+	}
+
 	return state, nil
 }
 
+// useDeploymentDir returns the checkout of a deployment to plan with and the
+// revision it is at: the one given to bbl plan, or else the one in the state.
+// The revision is read on every plan, since that is when bbl copies the files.
+func (p Plan) useDeploymentDir(deployment, manifest, flagDir, stateDir string) (string, string, error) {
+	dir := stateDir
+	if flagDir != "" {
+		dir = flagDir
+	}
+	if dir == "" || dir == bosh.BuiltInDeployment {
+		return "", "", nil
+	}
+
+	err := p.deploymentSource.Check(dir, manifest)
+	if err != nil {
+		return "", "", fmt.Errorf("Check %s dir: %s", deployment, err)
+	}
+
+	revision, err := p.deploymentSource.Revision(dir)
+	if err != nil {
+		return "", "", fmt.Errorf("Read %s revision: %s", deployment, err)
+	}
+
+	return dir, revision, nil
+}
+
+// WarnAboutPatchDrift warns about files from applied plan patches that were
+// edited or removed since, which applying the patch again would overwrite.
+func (p Plan) WarnAboutPatchDrift(state storage.State) error {
+	if len(state.Patches) == 0 {
+		return nil
+	}
+
+	drift, err := p.patcher.Drift(p.stateStore.GetStateDir(), state.Patches)
+	if err != nil {
+		return fmt.Errorf("Check patches: %s", err)
+	}
+
+	for _, file := range drift {
+		p.logger.Println(fmt.Sprintf("warning: %s from patch %s has been %s since it was applied", file.Path, file.Patch, patchDriftVerbs[file.Status]))
+	}
+
+	return nil
+}
+
+var patchDriftVerbs = map[string]string{
+	storage.PATCH_FILE_EDITED:  "edited",
+	storage.PATCH_FILE_MISSING: "removed",
+}
+
 func (p Plan) IsInitialized(state storage.State) bool {
 	// If it is older than bbl v5.4.0 with schema 13, we want to re-initialize.
 	return state.Version >= 13
 }
+
+// printTerraformChanges summarizes a terraform plan the way terraform does,
+// then lists the resources it touches.
+func printTerraformChanges(logger logger, changes []terraform.ResourceChange) {
+	creates, updates, destroys := terraform.SummarizePlan(changes)
+	logger.Printf("terraform: %d to create, %d to update, %d to destroy\n", creates, updates, destroys)
+	for _, change := range changes {
+		logger.Printf("  %-8s %s\n", change.Action, change.Address)
+	}
+}
+
+// validatePlanFile checks that a --terraform-plan-out or
+// --terraform-plan-file value names a plan file in the vars dir, where bbl
+// encrypts the plan and cleans it up with the rest of the state dir.
+func validatePlanFile(flag, name string) error {
+	if name == "" {
+		return nil
+	}
+	if filepath.Base(name) != name || name == "." || name == ".." || !storage.IsTerraformPlan(name) {
+		return fmt.Errorf("%s takes the name of a file ending in .tfplan, which bbl keeps in the vars directory of the state directory", flag)
+	}
+	return nil
+}
+
+// absDeploymentDir resolves a --jumpbox-deployment-dir or --bosh-deployment-dir
+// against the working dir, since bbl copies the files from it on later runs.
+func absDeploymentDir(dir string) (string, error) {
+	if dir == "" || dir == bosh.BuiltInDeployment {
+		return dir, nil
+	}
+	return filepath.Abs(dir)
+}
+
+// parseTerraformBackend reads --terraform-backend and its key=value
+// --terraform-backend-config settings.
+func parseTerraformBackend(backendType string, settings []string) (storage.TerraformBackend, error) {
+	if backendType == "" {
+		if len(settings) > 0 {
+			return storage.TerraformBackend{}, errors.New("--terraform-backend-config requires --terraform-backend")
+		}
+		return storage.TerraformBackend{}, nil
+	}
+
+	supported := false
+	for _, t := range terraformBackends {
+		supported = supported || t == backendType
+	}
+	if !supported {
+		return storage.TerraformBackend{}, fmt.Errorf("--terraform-backend must be one of %s", strings.Join(terraformBackends, ", "))
+	}
+
+	backend := storage.TerraformBackend{Type: backendType}
+	if !backend.IsRemote() {
+		if len(settings) > 0 {
+			return storage.TerraformBackend{}, errors.New("The local terraform backend keeps the state in the vars dir and takes no --terraform-backend-config.")
+		}
+		return backend, nil
+	}
+
+	backend.Config = map[string]string{}
+	for _, setting := range settings {
+		parts := strings.SplitN(setting, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return storage.TerraformBackend{}, fmt.Errorf("--terraform-backend-config takes key=value, got %q", setting)
+		}
+		backend.Config[parts[0]] = parts[1]
+	}
+
+	return backend, nil
+}
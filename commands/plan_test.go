@@ -3,11 +3,13 @@ package commands_test
 import (
 	"errors"
 	"os"
+	"path/filepath"
 
 	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/commands"
 	"github.com/cloudfoundry/bosh-bootloader/fakes"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -21,6 +23,9 @@ var _ = Describe("Plan", func() {
 		cloudConfigManager *fakes.CloudConfigManager
 		envIDManager       *fakes.EnvIDManager
 		lbArgsHandler      *fakes.LBArgsHandler
+		patcher            *fakes.Patcher
+		overrideTracker    *fakes.OverrideTracker
+		deploymentSource   *fakes.DeploymentSource
 		logger             *fakes.Logger
 		stateStore         *fakes.StateStore
 		terraformManager   *fakes.TerraformManager
@@ -32,6 +37,9 @@ var _ = Describe("Plan", func() {
 		cloudConfigManager = &fakes.CloudConfigManager{}
 		envIDManager = &fakes.EnvIDManager{}
 		lbArgsHandler = &fakes.LBArgsHandler{}
+		patcher = &fakes.Patcher{}
+		overrideTracker = &fakes.OverrideTracker{}
+		deploymentSource = &fakes.DeploymentSource{}
 		logger = &fakes.Logger{}
 		stateStore = &fakes.StateStore{}
 		terraformManager = &fakes.TerraformManager{}
@@ -46,6 +54,9 @@ var _ = Describe("Plan", func() {
 			envIDManager,
 			terraformManager,
 			lbArgsHandler,
+			patcher,
+			overrideTracker,
+			deploymentSource,
 			logger,
 			bblVersion,
 		)
@@ -91,6 +102,322 @@ var _ = Describe("Plan", func() {
 			Expect(cloudConfigManager.InitializeCall.Receives.State).To(Equal(syncedState))
 		})
 
+		Context("when --terraform-backend is passed", func() {
+			It("saves the backend in the state", func() {
+				err := command.Execute([]string{
+					"--terraform-backend", "gcs",
+					"--terraform-backend-config", "bucket=some-bucket",
+				}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.TerraformBackend).To(Equal(storage.TerraformBackend{
+					Type:   "gcs",
+					Config: map[string]string{"bucket": "some-bucket"},
+				}))
+			})
+		})
+
+		Context("when --terraform-backend is not passed", func() {
+			It("keeps the backend in the state", func() {
+				state.TerraformBackend = storage.TerraformBackend{Type: "azurerm"}
+
+				err := command.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.TerraformBackend).To(Equal(storage.TerraformBackend{Type: "azurerm"}))
+			})
+		})
+
+		Context("when --director-feature is passed", func() {
+			It("replaces the director features in the state", func() {
+				state.DirectorFeatures = []string{"bpm"}
+
+				err := command.Execute([]string{
+					"--director-feature", "syslog",
+					"--director-feature", "external-db",
+				}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.DirectorFeatures).To(Equal([]string{"syslog", "external-db"}))
+			})
+
+			Context("when the feature is none", func() {
+				It("turns the director features off", func() {
+					state.DirectorFeatures = []string{"bpm"}
+
+					err := command.Execute([]string{"--director-feature", "none"}, state)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(envIDManager.SyncCall.Receives.State.DirectorFeatures).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when sizing flags are passed", func() {
+			It("changes only the sizes that were given", func() {
+				state.JumpboxSizing = storage.Sizing{VMType: "t2.micro", EphemeralDiskSize: 20000}
+				state.DirectorSizing = storage.Sizing{VMType: "m4.xlarge"}
+
+				err := command.Execute([]string{
+					"--jumpbox-vm-type", "t2.small",
+					"--director-persistent-disk-size", "131072",
+				}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.JumpboxSizing).To(Equal(storage.Sizing{VMType: "t2.small", EphemeralDiskSize: 20000}))
+				Expect(envIDManager.SyncCall.Receives.State.DirectorSizing).To(Equal(storage.Sizing{VMType: "m4.xlarge", PersistentDiskSize: 131072}))
+			})
+
+			It("puts the sizes given as 0 or default back to the default", func() {
+				state.JumpboxSizing = storage.Sizing{VMType: "t2.micro", EphemeralDiskSize: 20000}
+				state.DirectorSizing = storage.Sizing{VMType: "m4.xlarge", PersistentDiskSize: 131072}
+
+				err := command.Execute([]string{
+					"--jumpbox-ephemeral-disk-size", "0",
+					"--director-vm-type", "default",
+					"--director-persistent-disk-size", "default",
+				}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.JumpboxSizing).To(Equal(storage.Sizing{VMType: "t2.micro"}))
+				Expect(envIDManager.SyncCall.Receives.State.DirectorSizing).To(Equal(storage.Sizing{}))
+			})
+		})
+
+		Context("when deployment dirs are passed", func() {
+			BeforeEach(func() {
+				deploymentSource.RevisionCall.Returns.Revision = "some-revision"
+			})
+
+			It("records them in the state with the revision they are at", func() {
+				err := command.Execute([]string{
+					"--jumpbox-deployment-dir", "/some/jumpbox-deployment",
+					"--bosh-deployment-dir", "/some/bosh-deployment",
+				}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(deploymentSource.CheckCall.Receives.Dir).To(Equal("/some/bosh-deployment"))
+				Expect(deploymentSource.CheckCall.Receives.Manifest).To(Equal("bosh.yml"))
+
+				syncedState := envIDManager.SyncCall.Receives.State
+				Expect(syncedState.JumpboxDeploymentDir).To(Equal("/some/jumpbox-deployment"))
+				Expect(syncedState.JumpboxDeploymentRevision).To(Equal("some-revision"))
+				Expect(syncedState.BOSHDeploymentDir).To(Equal("/some/bosh-deployment"))
+				Expect(syncedState.BOSHDeploymentRevision).To(Equal("some-revision"))
+			})
+
+			Context("when the dir is builtin", func() {
+				It("goes back to the deployment built into bbl", func() {
+					state.BOSHDeploymentDir = "/some/bosh-deployment"
+					state.BOSHDeploymentRevision = "old-revision"
+
+					err := command.Execute([]string{"--bosh-deployment-dir", "builtin"}, state)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(deploymentSource.CheckCall.CallCount).To(Equal(0))
+					Expect(envIDManager.SyncCall.Receives.State.BOSHDeploymentDir).To(BeEmpty())
+					Expect(envIDManager.SyncCall.Receives.State.BOSHDeploymentRevision).To(BeEmpty())
+				})
+			})
+
+			Context("when the dir does not have the deployment", func() {
+				It("returns an error", func() {
+					deploymentSource.CheckCall.Returns.Error = errors.New("kiwi")
+
+					err := command.Execute([]string{"--bosh-deployment-dir", "/some/bosh-deployment"}, state)
+					Expect(err).To(MatchError("Check bosh-deployment dir: kiwi"))
+				})
+			})
+
+			Context("when the revision cannot be read", func() {
+				It("returns an error", func() {
+					deploymentSource.RevisionCall.Returns.Error = errors.New("kiwi")
+
+					err := command.Execute([]string{"--jumpbox-deployment-dir", "/some/jumpbox-deployment"}, state)
+					Expect(err).To(MatchError("Read jumpbox-deployment revision: kiwi"))
+				})
+			})
+		})
+
+		Context("when --offline-assets is passed", func() {
+			It("records the dir in the state", func() {
+				err := command.Execute([]string{"--offline-assets", "/some/assets"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.OfflineAssetsDir).To(Equal("/some/assets"))
+			})
+
+			Context("when the dir is none", func() {
+				It("goes back to downloading the assets", func() {
+					state.OfflineAssetsDir = "/some/assets"
+
+					err := command.Execute([]string{"--offline-assets", "none"}, state)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(envIDManager.SyncCall.Receives.State.OfflineAssetsDir).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when the state has a deployment dir", func() {
+			It("reads the revision it is at again", func() {
+				state.JumpboxDeploymentDir = "/some/jumpbox-deployment"
+				state.JumpboxDeploymentRevision = "old-revision"
+				deploymentSource.RevisionCall.Returns.Revision = "new-revision"
+
+				err := command.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(deploymentSource.RevisionCall.Receives.Dir).To(Equal("/some/jumpbox-deployment"))
+				Expect(envIDManager.SyncCall.Receives.State.JumpboxDeploymentDir).To(Equal("/some/jumpbox-deployment"))
+				Expect(envIDManager.SyncCall.Receives.State.JumpboxDeploymentRevision).To(Equal("new-revision"))
+			})
+		})
+
+		Context("when --director-feature is not passed", func() {
+			It("keeps the director features in the state", func() {
+				state.DirectorFeatures = []string{"bpm"}
+
+				err := command.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(envIDManager.SyncCall.Receives.State.DirectorFeatures).To(Equal([]string{"bpm"}))
+			})
+		})
+
+		Context("when --patch is passed", func() {
+			BeforeEach(func() {
+				stateStore.GetStateDirCall.Returns.Directory = "/some/state-dir"
+				patcher.ApplyCall.Stub = func(stateDir, patchDir string, patches []storage.Patch) ([]storage.Patch, error) {
+					return append(patches, storage.Patch{Name: filepath.Base(patchDir)}), nil
+				}
+			})
+
+			It("applies each patch and saves them in the state", func() {
+				err := command.Execute([]string{
+					"--patch", "plan-patches/some-patch",
+					"--patch", "plan-patches/other-patch",
+				}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(patcher.ApplyCall.CallCount).To(Equal(2))
+				Expect(patcher.ApplyCall.Receives.StateDir).To(Equal("/some/state-dir"))
+				Expect(patcher.ApplyCall.Receives.PatchDir).To(Equal("plan-patches/other-patch"))
+
+				Expect(stateStore.SetCall.Receives[0].State.Patches).To(Equal([]storage.Patch{
+					{Name: "some-patch"},
+					{Name: "other-patch"},
+				}))
+				Expect(logger.StepCall.Messages).To(ContainElement("applied patch other-patch"))
+			})
+
+			Context("when a patch cannot be applied", func() {
+				It("returns an error and does not save the state", func() {
+					patcher.ApplyCall.Stub = nil
+					patcher.ApplyCall.Returns.Error = errors.New("papaya")
+
+					err := command.Execute([]string{"--patch", "plan-patches/some-patch"}, state)
+					Expect(err).To(MatchError("Apply patch: papaya"))
+					Expect(stateStore.SetCall.CallCount).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when files from an applied patch have changed", func() {
+			BeforeEach(func() {
+				state.Patches = []storage.Patch{{Name: "some-patch"}}
+				stateStore.GetStateDirCall.Returns.Directory = "/some/state-dir"
+				patcher.DriftCall.Returns.Drift = []storage.PatchDrift{
+					{Patch: "some-patch", Path: "terraform/some_override.tf", Status: storage.PATCH_FILE_EDITED},
+					{Patch: "some-patch", Path: "cloud-config/some-ops.yml", Status: storage.PATCH_FILE_MISSING},
+				}
+			})
+
+			It("warns about them", func() {
+				err := command.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(patcher.DriftCall.Receives.StateDir).To(Equal("/some/state-dir"))
+				Expect(patcher.DriftCall.Receives.Patches).To(Equal([]storage.Patch{{Name: "some-patch"}}))
+				Expect(logger.PrintlnCall.Messages).To(Equal([]string{
+					"warning: terraform/some_override.tf from patch some-patch has been edited since it was applied",
+					"warning: cloud-config/some-ops.yml from patch some-patch has been removed since it was applied",
+				}))
+			})
+
+			Context("when the patches cannot be checked", func() {
+				It("returns an error", func() {
+					patcher.DriftCall.Returns.Error = errors.New("guava")
+
+					err := command.Execute([]string{}, state)
+					Expect(err).To(MatchError("Check patches: guava"))
+				})
+			})
+		})
+
+		Context("when there are override scripts in the state dir", func() {
+			var overrides []storage.Override
+
+			BeforeEach(func() {
+				stateStore.GetStateDirCall.Returns.Directory = "/some/state-dir"
+				overrides = []storage.Override{
+					{Script: "create-director-override.sh", BaseSHA256: "some-base-sum", OverrideSHA256: "some-override-sum"},
+				}
+				overrideTracker.TrackCall.Returns.Overrides = overrides
+			})
+
+			It("records them in the state", func() {
+				err := command.Execute([]string{}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(overrideTracker.TrackCall.Receives.StateDir).To(Equal("/some/state-dir"))
+				Expect(stateStore.SetCall.CallCount).To(Equal(1))
+				Expect(stateStore.SetCall.Receives[0].State.Overrides).To(Equal(overrides))
+				Expect(overrideTracker.StaleCall.Receives.Overrides).To(Equal(overrides))
+				Expect(logger.PrintlnCall.Messages).To(BeEmpty())
+			})
+
+			Context("when a base script has changed since its override was written", func() {
+				BeforeEach(func() {
+					overrideTracker.StaleCall.Returns.Stale = overrides
+				})
+
+				It("warns about it", func() {
+					err := command.Execute([]string{}, state)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(logger.PrintlnCall.Messages).To(Equal([]string{
+						"warning: create-director-override.sh was written for an older create-director.sh, run bbl overrides diff to see what it is missing",
+					}))
+				})
+
+				Context("when --strict is passed", func() {
+					It("returns an error", func() {
+						err := command.Execute([]string{"--strict"}, state)
+						Expect(err).To(MatchError("create-director-override.sh was written for an older create-director.sh. Run bbl overrides diff to see what changed, then update or remove the override scripts."))
+					})
+				})
+			})
+
+			Context("when the override scripts cannot be read", func() {
+				It("returns an error", func() {
+					overrideTracker.TrackCall.Returns.Error = errors.New("kiwi")
+
+					err := command.Execute([]string{}, state)
+					Expect(err).To(MatchError("Check override scripts: kiwi"))
+				})
+			})
+
+			Context("when the base scripts cannot be read", func() {
+				It("returns an error", func() {
+					overrideTracker.StaleCall.Returns.Error = errors.New("papaya")
+
+					err := command.Execute([]string{}, state)
+					Expect(err).To(MatchError("Check override scripts: papaya"))
+				})
+			})
+		})
+
 		Context("when lb flags are passed", func() {
 			var lb storage.LB
 			BeforeEach(func() {
@@ -127,6 +454,46 @@ var _ = Describe("Plan", func() {
 			})
 		})
 
+		Context("when --terraform-plan-out is passed", func() {
+			BeforeEach(func() {
+				stateStore.GetVarsDirCall.Returns.Directory = "/some/state-dir/vars"
+				terraformManager.PlanCall.Returns.Changes = []terraform.ResourceChange{
+					{Address: "aws_subnet.bosh_subnet", Type: "aws_subnet", Action: "create"},
+				}
+			})
+
+			It("saves the terraform plan into the vars dir", func() {
+				err := command.Execute([]string{"--terraform-plan-out", "reviewed.tfplan"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(terraformManager.PlanCall.Receives.BBLState).To(Equal(syncedState))
+				Expect(terraformManager.PlanCall.Receives.PlanFile).To(Equal("/some/state-dir/vars/reviewed.tfplan"))
+				Expect(logger.PrintfCall.Messages).To(Equal([]string{
+					"terraform: 1 to create, 0 to update, 0 to destroy\n",
+					"  create   aws_subnet.bosh_subnet\n",
+				}))
+				Expect(logger.StepCall.Messages).To(ContainElement("saved the terraform plan to /some/state-dir/vars/reviewed.tfplan, run bbl up --terraform-plan-file reviewed.tfplan to apply it"))
+			})
+
+			Context("when the vars dir cannot be found", func() {
+				It("returns an error", func() {
+					stateStore.GetVarsDirCall.Returns.Error = errors.New("kiwi")
+
+					err := command.Execute([]string{"--terraform-plan-out", "reviewed.tfplan"}, state)
+					Expect(err).To(MatchError("Get vars dir: kiwi"))
+				})
+			})
+
+			Context("when terraform plan fails", func() {
+				It("returns an error", func() {
+					terraformManager.PlanCall.Returns.Error = errors.New("fig")
+
+					err := command.Execute([]string{"--terraform-plan-out", "reviewed.tfplan"}, state)
+					Expect(err).To(MatchError("Terraform manager plan: fig"))
+				})
+			})
+		})
+
 		Describe("failure cases", func() {
 			It("returns an error if state store set fails", func() {
 				stateStore.SetCall.Returns = []fakes.SetCallReturn{{Error: errors.New("peach")}}
@@ -323,6 +690,147 @@ var _ = Describe("Plan", func() {
 			})
 		})
 
+		Context("when --terraform-backend is passed", func() {
+			It("reads the backend and its settings", func() {
+				config, err := command.ParseArgs([]string{
+					"--terraform-backend", "s3",
+					"--terraform-backend-config", "bucket=some-bucket",
+					"--terraform-backend-config", "key=some-env/terraform.tfstate",
+				}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.TerraformBackend).To(Equal(storage.TerraformBackend{
+					Type: "s3",
+					Config: map[string]string{
+						"bucket": "some-bucket",
+						"key":    "some-env/terraform.tfstate",
+					},
+				}))
+			})
+
+			Context("when the backend is local", func() {
+				It("takes no settings", func() {
+					config, err := command.ParseArgs([]string{"--terraform-backend", "local"}, storage.State{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(config.TerraformBackend).To(Equal(storage.TerraformBackend{Type: "local"}))
+
+					_, err = command.ParseArgs([]string{
+						"--terraform-backend", "local",
+						"--terraform-backend-config", "path=some-path",
+					}, storage.State{})
+					Expect(err).To(MatchError("The local terraform backend keeps the state in the vars dir and takes no --terraform-backend-config."))
+				})
+			})
+
+			Context("when the backend is not supported", func() {
+				It("returns an error", func() {
+					_, err := command.ParseArgs([]string{"--terraform-backend", "consul"}, storage.State{})
+					Expect(err).To(MatchError("--terraform-backend must be one of s3, gcs, azurerm, local"))
+				})
+			})
+
+			Context("when a setting is not key=value", func() {
+				It("returns an error", func() {
+					_, err := command.ParseArgs([]string{
+						"--terraform-backend", "gcs",
+						"--terraform-backend-config", "some-bucket",
+					}, storage.State{})
+					Expect(err).To(MatchError(`--terraform-backend-config takes key=value, got "some-bucket"`))
+				})
+			})
+
+			Context("when settings are passed without a backend", func() {
+				It("returns an error", func() {
+					_, err := command.ParseArgs([]string{"--terraform-backend-config", "bucket=some-bucket"}, storage.State{})
+					Expect(err).To(MatchError("--terraform-backend-config requires --terraform-backend"))
+				})
+			})
+		})
+
+		Context("when --patch is passed", func() {
+			It("reads every patch dir", func() {
+				config, err := command.ParseArgs([]string{
+					"--patch", "plan-patches/some-patch",
+					"--patch", "plan-patches/other-patch",
+				}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.Patches).To(Equal([]string{"plan-patches/some-patch", "plan-patches/other-patch"}))
+			})
+		})
+
+		Context("when --director-feature is passed", func() {
+			It("reads every feature", func() {
+				config, err := command.ParseArgs([]string{
+					"--director-feature", "bpm",
+					"--director-feature", "dns-servers",
+				}, storage.State{IAAS: "aws"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.DirectorFeatures).To(Equal([]string{"bpm", "dns-servers"}))
+			})
+
+			Context("when a feature is unknown", func() {
+				It("returns an error", func() {
+					_, err := command.ParseArgs([]string{"--director-feature", "banana"}, storage.State{IAAS: "aws"})
+					Expect(err).To(MatchError(ContainSubstring("Unknown director feature banana.")))
+				})
+			})
+		})
+
+		Context("when sizing flags are passed", func() {
+			It("reads the sizes of the jumpbox and the director", func() {
+				config, err := command.ParseArgs([]string{
+					"--jumpbox-vm-type", "2x4096",
+					"--jumpbox-ephemeral-disk-size", "20000",
+					"--director-vm-type", "4x16384",
+					"--director-persistent-disk-size", "65536",
+					"--director-ephemeral-disk-size", "50000",
+				}, storage.State{IAAS: "vsphere"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.JumpboxSizing).To(Equal(storage.Sizing{VMType: "2x4096", EphemeralDiskSize: 20000}))
+				Expect(config.DirectorSizing).To(Equal(storage.Sizing{VMType: "4x16384", PersistentDiskSize: 65536, EphemeralDiskSize: 50000}))
+			})
+
+			Context("when a vm type does not fit the iaas", func() {
+				It("returns an error", func() {
+					_, err := command.ParseArgs([]string{"--director-vm-type", "large"}, storage.State{IAAS: "vsphere"})
+					Expect(err).To(MatchError("--director-vm-type on vsphere is <cpus>x<ram in MB>, such as 2x4096"))
+				})
+			})
+		})
+
+		Context("when deployment dirs are passed", func() {
+			It("resolves them against the working dir", func() {
+				cwd, err := os.Getwd()
+				Expect(err).NotTo(HaveOccurred())
+
+				config, err := command.ParseArgs([]string{
+					"--jumpbox-deployment-dir", "../jumpbox-deployment",
+					"--bosh-deployment-dir", "builtin",
+				}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.JumpboxDeploymentDir).To(Equal(filepath.Join(filepath.Dir(cwd), "jumpbox-deployment")))
+				Expect(config.BOSHDeploymentDir).To(Equal("builtin"))
+			})
+		})
+
+		Context("when --offline-assets is passed", func() {
+			It("resolves it against the working dir", func() {
+				cwd, err := os.Getwd()
+				Expect(err).NotTo(HaveOccurred())
+
+				config, err := command.ParseArgs([]string{"--offline-assets", "assets"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.OfflineAssetsDir).To(Equal(filepath.Join(cwd, "assets")))
+			})
+		})
+
+		Context("when --strict is passed", func() {
+			It("fails on stale override scripts", func() {
+				config, err := command.ParseArgs([]string{"--strict"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.Strict).To(BeTrue())
+			})
+		})
+
 		Context("failure cases", func() {
 			Context("when undefined flags are passed", func() {
 				It("returns an error", func() {
@@ -330,6 +838,13 @@ var _ = Describe("Plan", func() {
 					Expect(err).To(MatchError("flag provided but not defined: -foo"))
 				})
 			})
+
+			Context("when --terraform-plan-out is not a plan file name", func() {
+				It("returns an error", func() {
+					_, err := command.ParseArgs([]string{"--terraform-plan-out", "../reviewed.tfplan"}, storage.State{})
+					Expect(err).To(MatchError("--terraform-plan-out takes the name of a file ending in .tfplan, which bbl keeps in the vars directory of the state directory"))
+				})
+			})
 		})
 	})
 
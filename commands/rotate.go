@@ -43,7 +43,9 @@ func (r Rotate) Execute(args []string, state storage.State) error {
 		return fmt.Errorf("delete ssh key: %s", err)
 	}
 
-	err = r.up.Execute(args, state)
+	// The jumpbox inputs do not cover its vars store, so forget that the
+	// jumpbox is up to date to have bbl up redeploy it with the new key.
+	err = r.up.Execute(args, withoutCheckpoint(state, storage.PHASE_JUMPBOX))
 	if err != nil {
 		return fmt.Errorf("up: %s", err)
 	}
@@ -100,6 +100,15 @@ var _ = Describe("Rotate", func() {
 			Expect(up.ExecuteCall.Receives.State).To(Equal(state))
 		})
 
+		It("has up redeploy the jumpbox", func() {
+			state.Checkpoints = map[string]string{"terraform": "some-fingerprint", "jumpbox": "some-fingerprint"}
+
+			err := rotate.Execute(args, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(up.ExecuteCall.Receives.State.Checkpoints).To(Equal(map[string]string{"terraform": "some-fingerprint"}))
+		})
+
 		Context("when the ssh key deleter returns an error", func() {
 			BeforeEach(func() {
 				sshKeyDeleter.DeleteCall.Returns.Error = errors.New("guava")
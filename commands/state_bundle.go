@@ -0,0 +1,241 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type bundler interface {
+	Export(dir string, encrypt bool) ([]byte, error)
+	Import(dir string, bundle []byte) (storage.BundleManifest, error)
+}
+
+// importedStateLoader loads the state that was just imported, with the
+// credentials that the flags and environment of this run provide.
+type importedStateLoader interface {
+	LoadState() (storage.State, error)
+}
+
+type bundleFs interface {
+	fileio.FileReader
+	fileio.FileWriter
+	fileio.DirReader
+}
+
+type StateExport struct {
+	logger         logger
+	stateValidator stateValidator
+	stateStore     rollbackStore
+	bundler        bundler
+	fs             bundleFs
+}
+
+type StateImport struct {
+	logger      logger
+	stateLoader importedStateLoader
+	stateStore  rollbackStore
+	bundler     bundler
+	fs          bundleFs
+	plan        plan
+}
+
+type StateExportConfig struct {
+	Output            string
+	Encrypt           bool
+	InsecurePlaintext bool
+}
+
+type StateImportConfig struct {
+	Bundle   string
+	SkipPlan bool
+}
+
+func NewStateExport(logger logger, stateValidator stateValidator, stateStore rollbackStore, bundler bundler, fs bundleFs) StateExport {
+	return StateExport{
+		logger:         logger,
+		stateValidator: stateValidator,
+		stateStore:     stateStore,
+		bundler:        bundler,
+		fs:             fs,
+	}
+}
+
+func NewStateImport(logger logger, stateLoader importedStateLoader, stateStore rollbackStore, bundler bundler, fs bundleFs, plan plan) StateImport {
+	return StateImport{
+		logger:      logger,
+		stateLoader: stateLoader,
+		stateStore:  stateStore,
+		bundler:     bundler,
+		fs:          fs,
+		plan:        plan,
+	}
+}
+
+func (s StateExport) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	config, err := s.ParseArgs(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	if config.Output == "" {
+		return errors.New("bbl state export requires --output")
+	}
+
+	if !config.Encrypt && !config.InsecurePlaintext {
+		return errors.New("The bundle holds every private key and password of the environment. Pass --encrypt to encrypt it, or --insecure-plaintext to write it unencrypted.")
+	}
+
+	return s.stateValidator.Validate()
+}
+
+func (s StateExport) ParseArgs(args []string) (StateExportConfig, error) {
+	var config StateExportConfig
+
+	exportFlags := flags.New("state export")
+	exportFlags.String(&config.Output, "output", "")
+	exportFlags.Bool(&config.Encrypt, "encrypt")
+	exportFlags.Bool(&config.InsecurePlaintext, "insecure-plaintext")
+
+	err := exportFlags.Parse(args)
+	if err != nil {
+		return StateExportConfig{}, err
+	}
+
+	return config, nil
+}
+
+func (s StateExport) Execute(subcommandFlags []string, state storage.State) error {
+	config, err := s.ParseArgs(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Step("exporting state directory")
+	bundle, err := s.bundler.Export(s.stateStore.GetStateDir(), config.Encrypt)
+	if err != nil {
+		return fmt.Errorf("Export state: %s", err)
+	}
+
+	err = s.fs.WriteFile(config.Output, bundle, storage.StateMode)
+	if err != nil {
+		return fmt.Errorf("Write bundle: %s", err)
+	}
+
+	s.logger.Step("exported state directory to %s", config.Output)
+	return nil
+}
+
+func (s StateImport) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	config, err := s.ParseArgs(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	if config.Bundle == "" {
+		return errors.New("bbl state import requires a bundle")
+	}
+
+	dir := s.stateStore.GetStateDir()
+	infos, err := s.fs.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("Read state dir: %s", err)
+	}
+	for _, info := range infos {
+		// The lock file and sync record are written by this run.
+		if info.Name() == storage.LOCK_FILE || info.Name() == storage.SYNC_FILE {
+			continue
+		}
+		return fmt.Errorf("The state directory %s is not empty. Import the bundle into an empty directory.", dir)
+	}
+
+	return nil
+}
+
+// ParseArgs accepts --skip-plan on either side of the bundle path.
+func (s StateImport) ParseArgs(args []string) (StateImportConfig, error) {
+	var config StateImportConfig
+
+	importFlags := flags.New("state import")
+	importFlags.Bool(&config.SkipPlan, "skip-plan")
+
+	err := importFlags.Parse(args)
+	if err != nil {
+		return StateImportConfig{}, err
+	}
+
+	remaining := importFlags.Args()
+	if len(remaining) == 0 {
+		return config, nil
+	}
+	config.Bundle = remaining[0]
+
+	err = importFlags.Parse(remaining[1:])
+	if err != nil {
+		return StateImportConfig{}, err
+	}
+
+	if len(importFlags.Args()) > 0 {
+		return StateImportConfig{}, errors.New("bbl state import accepts a single bundle")
+	}
+
+	return config, nil
+}
+
+func (s StateImport) Execute(subcommandFlags []string, state storage.State) error {
+	config, err := s.ParseArgs(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	bundle, err := s.fs.ReadFile(config.Bundle)
+	if err != nil {
+		return fmt.Errorf("Read bundle: %s", err)
+	}
+
+	dir := s.stateStore.GetStateDir()
+
+	s.logger.Step("importing state directory")
+	manifest, err := s.bundler.Import(dir, bundle)
+	if err != nil {
+		return fmt.Errorf("Import state: %s", err)
+	}
+
+	imported, err := s.stateLoader.LoadState()
+	if err != nil {
+		return fmt.Errorf("Load imported state: %s", err)
+	}
+
+	err = s.stateStore.Set(imported)
+	if err != nil {
+		return fmt.Errorf("Save imported state: %s", err)
+	}
+
+	s.logger.Step("imported %d files exported by bbl %s", len(manifest.Files), manifest.BBLVersion)
+
+	if config.SkipPlan {
+		s.logger.Step("skipped plan, run bbl plan to regenerate the state directory")
+		return nil
+	}
+
+	// The IAAS clients of this run were set up for the iaas given by the
+	// flags and environment, so they can only plan an environment on it.
+	if imported.IAAS != state.IAAS {
+		return fmt.Errorf("The bundle is for an environment on %s. Run bbl plan with the %s credentials to regenerate the state directory.", imported.IAAS, imported.IAAS)
+	}
+
+	s.logger.Step("running bbl plan")
+	_, err = s.plan.InitializePlan(PlanConfig{
+		Name: imported.EnvID,
+		LB:   imported.LB,
+	}, imported)
+	if err != nil {
+		return fmt.Errorf("Plan: %s", err)
+	}
+
+	return nil
+}
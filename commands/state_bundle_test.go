@@ -0,0 +1,257 @@
+package commands_test
+
+import (
+	"errors"
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("state bundles", func() {
+	var (
+		logger     *fakes.Logger
+		stateStore *fakes.StateStore
+		bundler    *fakes.Bundler
+		fileIO     *fakes.FileIO
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		stateStore = &fakes.StateStore{}
+		stateStore.GetStateDirCall.Returns.Directory = "some-state-dir"
+		bundler = &fakes.Bundler{}
+		fileIO = &fakes.FileIO{}
+	})
+
+	Describe("StateExport", func() {
+		var (
+			stateValidator *fakes.StateValidator
+			command        commands.StateExport
+		)
+
+		BeforeEach(func() {
+			stateValidator = &fakes.StateValidator{}
+			command = commands.NewStateExport(logger, stateValidator, stateStore, bundler, fileIO)
+		})
+
+		Describe("CheckFastFails", func() {
+			It("requires --output", func() {
+				err := command.CheckFastFails([]string{}, storage.State{})
+				Expect(err).To(MatchError("bbl state export requires --output"))
+			})
+
+			It("requires --encrypt or --insecure-plaintext", func() {
+				err := command.CheckFastFails([]string{"--output", "some-bundle.tgz"}, storage.State{})
+				Expect(err).To(MatchError("The bundle holds every private key and password of the environment. Pass --encrypt to encrypt it, or --insecure-plaintext to write it unencrypted."))
+
+				err = command.CheckFastFails([]string{"--output", "some-bundle.tgz", "--insecure-plaintext"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("validates the state dir", func() {
+				stateValidator.ValidateCall.Returns.Error = errors.New("no state")
+
+				err := command.CheckFastFails([]string{"--output", "some-bundle.tgz", "--encrypt"}, storage.State{})
+				Expect(err).To(MatchError("no state"))
+			})
+		})
+
+		Describe("Execute", func() {
+			It("writes the bundle to the output file", func() {
+				bundler.ExportCall.Returns.Bundle = []byte("some-bundle")
+
+				err := command.Execute([]string{"--output", "some-bundle.tgz", "--encrypt"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(bundler.ExportCall.Receives.Dir).To(Equal("some-state-dir"))
+				Expect(bundler.ExportCall.Receives.Encrypt).To(BeTrue())
+				Expect(fileIO.WriteFileCall.Receives).To(Equal([]fakes.WriteFileReceive{{
+					Filename: "some-bundle.tgz",
+					Contents: []byte("some-bundle"),
+					Mode:     storage.StateMode,
+				}}))
+			})
+
+			Context("when the export fails", func() {
+				It("returns an error", func() {
+					bundler.ExportCall.Returns.Error = errors.New("banana")
+
+					err := command.Execute([]string{"--output", "some-bundle.tgz"}, storage.State{})
+					Expect(err).To(MatchError("Export state: banana"))
+				})
+			})
+
+			Context("when the bundle cannot be written", func() {
+				It("returns an error", func() {
+					fileIO.WriteFileCall.Returns = []fakes.WriteFileReturn{{Error: errors.New("kiwi")}}
+
+					err := command.Execute([]string{"--output", "some-bundle.tgz"}, storage.State{})
+					Expect(err).To(MatchError("Write bundle: kiwi"))
+				})
+			})
+		})
+	})
+
+	Describe("StateImport", func() {
+		var (
+			stateLoader *fakes.StateLoader
+			plan        *fakes.Plan
+			state       storage.State
+			command     commands.StateImport
+		)
+
+		BeforeEach(func() {
+			stateLoader = &fakes.StateLoader{}
+			plan = &fakes.Plan{}
+			state = storage.State{IAAS: "gcp"}
+			fileIO.ReadFileCall.Returns.Contents = []byte("some-bundle")
+			bundler.ImportCall.Returns.Manifest = storage.BundleManifest{
+				BBLVersion: "1.2.3",
+				Files:      []storage.BundleFile{{Path: "bbl-state.json"}},
+			}
+			stateLoader.LoadStateCall.Returns.State = storage.State{IAAS: "gcp", EnvID: "some-env"}
+
+			command = commands.NewStateImport(logger, stateLoader, stateStore, bundler, fileIO, plan)
+		})
+
+		Describe("CheckFastFails", func() {
+			It("requires a bundle", func() {
+				err := command.CheckFastFails([]string{"--skip-plan"}, storage.State{})
+				Expect(err).To(MatchError("bbl state import requires a bundle"))
+			})
+
+			It("accepts a single bundle", func() {
+				err := command.CheckFastFails([]string{"a.tgz", "b.tgz"}, storage.State{})
+				Expect(err).To(MatchError("bbl state import accepts a single bundle"))
+			})
+
+			It("accepts a state dir that does not exist", func() {
+				fileIO.ReadDirCall.Returns.Error = os.ErrNotExist
+
+				err := command.CheckFastFails([]string{"some-bundle.tgz"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fileIO.ReadDirCall.Receives.Dirname).To(Equal("some-state-dir"))
+			})
+
+			Context("when the state dir is not empty", func() {
+				It("returns an error", func() {
+					fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{fakes.FileInfo{FileName: "bbl-state.json"}}
+
+					err := command.CheckFastFails([]string{"some-bundle.tgz"}, storage.State{})
+					Expect(err).To(MatchError("The state directory some-state-dir is not empty. Import the bundle into an empty directory."))
+				})
+			})
+
+			It("ignores the lock file of this run", func() {
+				fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{fakes.FileInfo{FileName: "bbl.lock"}}
+
+				err := command.CheckFastFails([]string{"some-bundle.tgz"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Describe("Execute", func() {
+			It("imports the bundle, saves the state and runs plan", func() {
+				err := command.Execute([]string{"some-bundle.tgz"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fileIO.ReadFileCall.Receives.Filename).To(Equal("some-bundle.tgz"))
+				Expect(bundler.ImportCall.Receives.Dir).To(Equal("some-state-dir"))
+				Expect(bundler.ImportCall.Receives.Bundle).To(Equal([]byte("some-bundle")))
+				Expect(stateLoader.LoadStateCall.CallCount).To(Equal(1))
+				Expect(stateStore.SetCall.Receives[0].State).To(Equal(storage.State{IAAS: "gcp", EnvID: "some-env"}))
+				Expect(logger.StepCall.Messages).To(ContainElement("imported 1 files exported by bbl 1.2.3"))
+
+				Expect(plan.InitializePlanCall.CallCount).To(Equal(1))
+				Expect(plan.InitializePlanCall.Receives.Plan).To(Equal(commands.PlanConfig{Name: "some-env"}))
+				Expect(plan.InitializePlanCall.Receives.State).To(Equal(storage.State{IAAS: "gcp", EnvID: "some-env"}))
+			})
+
+			It("passes the load balancer to plan", func() {
+				lb := storage.LB{
+					Type:   "cf",
+					Cert:   "some-cert",
+					Key:    "some-key",
+					Domain: "example.com",
+				}
+				stateLoader.LoadStateCall.Returns.State.LB = lb
+
+				err := command.Execute([]string{"some-bundle.tgz"}, state)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.InitializePlanCall.Receives.Plan).To(Equal(commands.PlanConfig{Name: "some-env", LB: lb}))
+			})
+
+			Context("when the bundle is for another iaas", func() {
+				It("returns an error after importing it", func() {
+					err := command.Execute([]string{"some-bundle.tgz"}, storage.State{IAAS: "aws"})
+					Expect(err).To(MatchError("The bundle is for an environment on gcp. Run bbl plan with the gcp credentials to regenerate the state directory."))
+
+					Expect(stateStore.SetCall.CallCount).To(Equal(1))
+					Expect(plan.InitializePlanCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when --skip-plan is provided", func() {
+				It("does not run plan", func() {
+					err := command.Execute([]string{"some-bundle.tgz", "--skip-plan"}, state)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(bundler.ImportCall.CallCount).To(Equal(1))
+					Expect(plan.InitializePlanCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when the bundle cannot be read", func() {
+				It("returns an error", func() {
+					fileIO.ReadFileCall.Returns.Error = errors.New("banana")
+
+					err := command.Execute([]string{"some-bundle.tgz"}, state)
+					Expect(err).To(MatchError("Read bundle: banana"))
+				})
+			})
+
+			Context("when the import fails", func() {
+				It("returns an error", func() {
+					bundler.ImportCall.Returns.Error = errors.New("kiwi")
+
+					err := command.Execute([]string{"some-bundle.tgz"}, state)
+					Expect(err).To(MatchError("Import state: kiwi"))
+					Expect(stateStore.SetCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when the imported state cannot be saved", func() {
+				It("returns an error", func() {
+					stateStore.SetCall.Returns = []fakes.SetCallReturn{{Error: errors.New("lime")}}
+
+					err := command.Execute([]string{"some-bundle.tgz"}, state)
+					Expect(err).To(MatchError("Save imported state: lime"))
+				})
+			})
+
+			Context("when the imported state cannot be loaded", func() {
+				It("returns an error", func() {
+					stateLoader.LoadStateCall.Returns.Error = errors.New("apple")
+
+					err := command.Execute([]string{"some-bundle.tgz"}, state)
+					Expect(err).To(MatchError("Load imported state: apple"))
+				})
+			})
+
+			Context("when plan fails", func() {
+				It("returns an error", func() {
+					plan.InitializePlanCall.Returns.Error = errors.New("mango")
+
+					err := command.Execute([]string{"some-bundle.tgz"}, state)
+					Expect(err).To(MatchError("Plan: mango"))
+				})
+			})
+		})
+	})
+})
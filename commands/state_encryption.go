@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type vault interface {
+	IsEnabled() bool
+	Seal() error
+	Unseal() error
+}
+
+type StateEncrypt struct {
+	logger         logger
+	stateValidator stateValidator
+	vault          vault
+}
+
+type StateDecrypt struct {
+	logger         logger
+	stateValidator stateValidator
+	vault          vault
+}
+
+func NewStateEncrypt(logger logger, stateValidator stateValidator, vault vault) StateEncrypt {
+	return StateEncrypt{
+		logger:         logger,
+		stateValidator: stateValidator,
+		vault:          vault,
+	}
+}
+
+func NewStateDecrypt(logger logger, stateValidator stateValidator, vault vault) StateDecrypt {
+	return StateDecrypt{
+		logger:         logger,
+		stateValidator: stateValidator,
+		vault:          vault,
+	}
+}
+
+func (s StateEncrypt) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	err := s.stateValidator.Validate()
+	if err != nil {
+		return err
+	}
+
+	if !s.vault.IsEnabled() {
+		return errors.New("BBL_STATE_PASSPHRASE or BBL_STATE_KEY_FILE must be set to encrypt the state directory")
+	}
+
+	return nil
+}
+
+func (s StateEncrypt) Execute(subcommandFlags []string, state storage.State) error {
+	s.logger.Step("encrypting state directory")
+	err := s.vault.Seal()
+	if err != nil {
+		return err
+	}
+
+	s.logger.Step("encrypted state directory")
+	return nil
+}
+
+func (s StateDecrypt) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	return s.stateValidator.Validate()
+}
+
+func (s StateDecrypt) Execute(subcommandFlags []string, state storage.State) error {
+	s.logger.Step("decrypting state directory")
+	err := s.vault.Unseal()
+	if err != nil {
+		return err
+	}
+
+	s.logger.Step("decrypted state directory, unset BBL_STATE_PASSPHRASE and BBL_STATE_KEY_FILE to keep it in plaintext")
+	return nil
+}
@@ -0,0 +1,118 @@
+package commands_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("State Encryption", func() {
+	var (
+		logger         *fakes.Logger
+		stateValidator *fakes.StateValidator
+		vault          *fakes.Vault
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		stateValidator = &fakes.StateValidator{}
+		vault = &fakes.Vault{}
+		vault.IsEnabledCall.Returns.IsEnabled = true
+	})
+
+	Describe("StateEncrypt", func() {
+		var command commands.StateEncrypt
+
+		BeforeEach(func() {
+			command = commands.NewStateEncrypt(logger, stateValidator, vault)
+		})
+
+		Describe("CheckFastFails", func() {
+			It("validates the state", func() {
+				err := command.CheckFastFails([]string{}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(stateValidator.ValidateCall.CallCount).To(Equal(1))
+			})
+
+			Context("when the state does not exist", func() {
+				It("returns an error", func() {
+					stateValidator.ValidateCall.Returns.Error = errors.New("failed to validate state")
+
+					err := command.CheckFastFails([]string{}, storage.State{})
+					Expect(err).To(MatchError("failed to validate state"))
+				})
+			})
+
+			Context("when no state key is provided", func() {
+				It("returns an error", func() {
+					vault.IsEnabledCall.Returns.IsEnabled = false
+
+					err := command.CheckFastFails([]string{}, storage.State{})
+					Expect(err).To(MatchError("BBL_STATE_PASSPHRASE or BBL_STATE_KEY_FILE must be set to encrypt the state directory"))
+				})
+			})
+		})
+
+		Describe("Execute", func() {
+			It("encrypts the state directory", func() {
+				err := command.Execute([]string{}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(vault.SealCall.CallCount).To(Equal(1))
+				Expect(logger.StepCall.Messages).To(ContainElement("encrypted state directory"))
+			})
+
+			Context("when encrypting fails", func() {
+				It("returns an error", func() {
+					vault.SealCall.Returns.Error = errors.New("kiwi")
+
+					err := command.Execute([]string{}, storage.State{})
+					Expect(err).To(MatchError("kiwi"))
+				})
+			})
+		})
+	})
+
+	Describe("StateDecrypt", func() {
+		var command commands.StateDecrypt
+
+		BeforeEach(func() {
+			command = commands.NewStateDecrypt(logger, stateValidator, vault)
+		})
+
+		Describe("CheckFastFails", func() {
+			Context("when the state does not exist", func() {
+				It("returns an error", func() {
+					stateValidator.ValidateCall.Returns.Error = errors.New("failed to validate state")
+
+					err := command.CheckFastFails([]string{}, storage.State{})
+					Expect(err).To(MatchError("failed to validate state"))
+				})
+			})
+		})
+
+		Describe("Execute", func() {
+			It("decrypts the state directory", func() {
+				err := command.Execute([]string{}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(vault.UnsealCall.CallCount).To(Equal(1))
+			})
+
+			Context("when decrypting fails", func() {
+				It("returns an error", func() {
+					vault.UnsealCall.Returns.Error = errors.New("kiwi")
+
+					err := command.Execute([]string{}, storage.State{})
+					Expect(err).To(MatchError("kiwi"))
+				})
+			})
+		})
+	})
+})
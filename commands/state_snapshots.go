@@ -0,0 +1,235 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/flags"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+type snapshots interface {
+	List() ([]storage.Snapshot, error)
+	Read(id string) (map[string][]byte, error)
+	Restore(id string, includeTerraformState bool) error
+}
+
+type stateLoader interface {
+	GetState(dir string) (storage.State, error)
+}
+
+type rollbackStore interface {
+	Set(state storage.State) error
+	GetStateDir() string
+}
+
+type StateHistory struct {
+	logger         logger
+	stateValidator stateValidator
+	snapshots      snapshots
+}
+
+type StateDiff struct {
+	logger         logger
+	stateValidator stateValidator
+	snapshots      snapshots
+}
+
+type StateRollback struct {
+	logger         logger
+	stateValidator stateValidator
+	snapshots      snapshots
+	stateLoader    stateLoader
+	stateStore     rollbackStore
+}
+
+func NewStateHistory(logger logger, stateValidator stateValidator, snapshots snapshots) StateHistory {
+	return StateHistory{
+		logger:         logger,
+		stateValidator: stateValidator,
+		snapshots:      snapshots,
+	}
+}
+
+func NewStateDiff(logger logger, stateValidator stateValidator, snapshots snapshots) StateDiff {
+	return StateDiff{
+		logger:         logger,
+		stateValidator: stateValidator,
+		snapshots:      snapshots,
+	}
+}
+
+func NewStateRollback(logger logger, stateValidator stateValidator, snapshots snapshots, stateLoader stateLoader, stateStore rollbackStore) StateRollback {
+	return StateRollback{
+		logger:         logger,
+		stateValidator: stateValidator,
+		snapshots:      snapshots,
+		stateLoader:    stateLoader,
+		stateStore:     stateStore,
+	}
+}
+
+func (s StateHistory) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	return s.stateValidator.Validate()
+}
+
+func (s StateHistory) Execute(subcommandFlags []string, state storage.State) error {
+	list, err := s.snapshots.List()
+	if err != nil {
+		return err
+	}
+
+	if len(list) == 0 {
+		s.logger.Println("no snapshots of the state have been taken")
+		return nil
+	}
+
+	for _, snapshot := range list {
+		s.logger.Printf("%-20s  %s  %s\n", snapshot.ID, snapshot.Created.Format("2006-01-02 15:04:05 MST"), strings.Join(snapshot.Files, ", "))
+	}
+
+	return nil
+}
+
+func (s StateDiff) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	if len(subcommandFlags) != 2 {
+		return errors.New("bbl state diff requires two snapshot ids")
+	}
+
+	return s.stateValidator.Validate()
+}
+
+func (s StateDiff) Execute(subcommandFlags []string, state storage.State) error {
+	from, to := subcommandFlags[0], subcommandFlags[1]
+
+	fromFiles, err := s.snapshots.Read(from)
+	if err != nil {
+		return err
+	}
+
+	toFiles, err := s.snapshots.Read(to)
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	for name := range fromFiles {
+		names = append(names, name)
+	}
+	for name := range toFiles {
+		if _, ok := fromFiles[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var diff string
+	for _, name := range names {
+		fileDiff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(fromFiles[name]),
+			B:        splitLines(toFiles[name]),
+			FromFile: fmt.Sprintf("%s/%s", from, name),
+			ToFile:   fmt.Sprintf("%s/%s", to, name),
+			Context:  3,
+		})
+		if err != nil {
+			return fmt.Errorf("Diff %s: %s", name, err) // not tested
+		}
+		diff += fileDiff
+	}
+
+	if diff == "" {
+		s.logger.Println("the snapshots are identical")
+		return nil
+	}
+
+	s.logger.Printf("%s", diff)
+	return nil
+}
+
+type StateRollbackConfig struct {
+	ID                    string
+	IncludeTerraformState bool
+}
+
+func (s StateRollback) CheckFastFails(subcommandFlags []string, state storage.State) error {
+	_, err := s.ParseArgs(subcommandFlags)
+	if err != nil {
+		return err
+	}
+
+	return s.stateValidator.Validate()
+}
+
+// ParseArgs accepts --include-terraform-state on either side of the
+// snapshot id.
+func (s StateRollback) ParseArgs(args []string) (StateRollbackConfig, error) {
+	var config StateRollbackConfig
+
+	rollbackFlags := flags.New("state rollback")
+	rollbackFlags.Bool(&config.IncludeTerraformState, "include-terraform-state")
+
+	err := rollbackFlags.Parse(args)
+	if err != nil {
+		return StateRollbackConfig{}, err
+	}
+
+	remaining := rollbackFlags.Args()
+	if len(remaining) == 0 {
+		return StateRollbackConfig{}, errors.New("bbl state rollback requires a snapshot id")
+	}
+	config.ID = remaining[0]
+
+	err = rollbackFlags.Parse(remaining[1:])
+	if err != nil {
+		return StateRollbackConfig{}, err
+	}
+
+	if len(rollbackFlags.Args()) > 0 {
+		return StateRollbackConfig{}, errors.New("bbl state rollback accepts a single snapshot id")
+	}
+
+	return config, nil
+}
+
+func (s StateRollback) Execute(subcommandFlags []string, state storage.State) error {
+	config, err := s.ParseArgs(subcommandFlags)
+	if err != nil {
+		return err
+	}
+	id := config.ID
+
+	if config.IncludeTerraformState {
+		s.logger.Println("warning: rolling back the terraform state makes terraform forget the resources it created since the snapshot, and bbl up may create them again or leave them behind.")
+	}
+
+	s.logger.Step("restoring snapshot %s", id)
+	err = s.snapshots.Restore(id, config.IncludeTerraformState)
+	if err != nil {
+		return err
+	}
+
+	restored, err := s.stateLoader.GetState(s.stateStore.GetStateDir())
+	if err != nil {
+		return fmt.Errorf("Load restored state: %s", err)
+	}
+
+	err = s.stateStore.Set(restored)
+	if err != nil {
+		return fmt.Errorf("Save restored state: %s", err)
+	}
+
+	s.logger.Step("restored snapshot %s, run bbl plan or bbl up to apply it to the environment", id)
+	return nil
+}
+
+func splitLines(contents []byte) []string {
+	lines := strings.SplitAfter(string(contents), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
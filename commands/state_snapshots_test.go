@@ -0,0 +1,223 @@
+package commands_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/commands"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("state snapshots", func() {
+	var (
+		logger         *fakes.Logger
+		stateValidator *fakes.StateValidator
+		snapshots      *fakes.Snapshots
+	)
+
+	BeforeEach(func() {
+		logger = &fakes.Logger{}
+		stateValidator = &fakes.StateValidator{}
+		snapshots = &fakes.Snapshots{}
+	})
+
+	Describe("StateHistory", func() {
+		var command commands.StateHistory
+
+		BeforeEach(func() {
+			command = commands.NewStateHistory(logger, stateValidator, snapshots)
+		})
+
+		Describe("CheckFastFails", func() {
+			It("validates the state dir", func() {
+				stateValidator.ValidateCall.Returns.Error = errors.New("no state")
+
+				err := command.CheckFastFails([]string{}, storage.State{})
+				Expect(err).To(MatchError("no state"))
+			})
+		})
+
+		Describe("Execute", func() {
+			It("lists the snapshots", func() {
+				snapshots.ListCall.Returns.Snapshots = []storage.Snapshot{
+					{
+						ID:      "20180301T120000Z",
+						Created: time.Date(2018, time.March, 1, 12, 0, 0, 0, time.UTC),
+						Files:   []string{"bbl-state.json", "vars/bosh-state.json"},
+					},
+					{
+						ID:      "20180301T120000Z-1",
+						Created: time.Date(2018, time.March, 1, 12, 0, 0, 0, time.UTC),
+						Files:   []string{"bbl-state.json"},
+					},
+				}
+
+				err := command.Execute([]string{}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Messages).To(Equal([]string{
+					"20180301T120000Z      2018-03-01 12:00:00 UTC  bbl-state.json, vars/bosh-state.json\n",
+					"20180301T120000Z-1    2018-03-01 12:00:00 UTC  bbl-state.json\n",
+				}))
+			})
+
+			Context("when there are no snapshots", func() {
+				It("says so", func() {
+					err := command.Execute([]string{}, storage.State{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(logger.PrintlnCall.Messages).To(ContainElement("no snapshots of the state have been taken"))
+				})
+			})
+
+			Context("when the snapshots cannot be listed", func() {
+				It("returns an error", func() {
+					snapshots.ListCall.Returns.Error = errors.New("banana")
+
+					err := command.Execute([]string{}, storage.State{})
+					Expect(err).To(MatchError("banana"))
+				})
+			})
+		})
+	})
+
+	Describe("StateDiff", func() {
+		var command commands.StateDiff
+
+		BeforeEach(func() {
+			command = commands.NewStateDiff(logger, stateValidator, snapshots)
+			snapshots.ReadCall.Returns = map[string]fakes.ReadSnapshotReturn{
+				"a": {Files: map[string][]byte{
+					"bbl-state.json":       []byte("line-1\nline-2\n"),
+					"vars/bosh-state.json": []byte("same\n"),
+				}},
+				"b": {Files: map[string][]byte{
+					"bbl-state.json":       []byte("line-1\nline-3\n"),
+					"vars/bosh-state.json": []byte("same\n"),
+				}},
+			}
+		})
+
+		Describe("CheckFastFails", func() {
+			It("requires two snapshot ids", func() {
+				err := command.CheckFastFails([]string{"a"}, storage.State{})
+				Expect(err).To(MatchError("bbl state diff requires two snapshot ids"))
+			})
+		})
+
+		Describe("Execute", func() {
+			It("prints a unified diff of the snapshots", func() {
+				err := command.Execute([]string{"a", "b"}, storage.State{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Messages).To(Equal([]string{
+					"--- a/bbl-state.json\n+++ b/bbl-state.json\n@@ -1,2 +1,2 @@\n line-1\n-line-2\n+line-3\n",
+				}))
+			})
+
+			Context("when the snapshots are identical", func() {
+				It("says so", func() {
+					err := command.Execute([]string{"a", "a"}, storage.State{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(logger.PrintlnCall.Messages).To(ContainElement("the snapshots are identical"))
+				})
+			})
+
+			Context("when a snapshot cannot be read", func() {
+				It("returns an error", func() {
+					snapshots.ReadCall.Returns["b"] = fakes.ReadSnapshotReturn{Error: errors.New("Snapshot b does not exist")}
+
+					err := command.Execute([]string{"a", "b"}, storage.State{})
+					Expect(err).To(MatchError("Snapshot b does not exist"))
+				})
+			})
+		})
+	})
+
+	Describe("StateRollback", func() {
+		var (
+			stateBootstrap *fakes.StateBootstrap
+			stateStore     *fakes.StateStore
+			command        commands.StateRollback
+		)
+
+		BeforeEach(func() {
+			stateBootstrap = &fakes.StateBootstrap{}
+			stateStore = &fakes.StateStore{}
+			stateStore.GetStateDirCall.Returns.Directory = "some-state-dir"
+
+			command = commands.NewStateRollback(logger, stateValidator, snapshots, stateBootstrap, stateStore)
+		})
+
+		Describe("CheckFastFails", func() {
+			It("requires a snapshot id", func() {
+				err := command.CheckFastFails([]string{}, storage.State{})
+				Expect(err).To(MatchError("bbl state rollback requires a snapshot id"))
+			})
+
+			It("accepts a single snapshot id", func() {
+				err := command.CheckFastFails([]string{"some-id", "other-id"}, storage.State{})
+				Expect(err).To(MatchError("bbl state rollback accepts a single snapshot id"))
+			})
+		})
+
+		Describe("Execute", func() {
+			It("restores the snapshot and saves the restored state", func() {
+				stateBootstrap.GetStateCall.Returns.State = storage.State{EnvID: "restored-env"}
+
+				err := command.Execute([]string{"some-id"}, storage.State{EnvID: "current-env"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(snapshots.RestoreCall.Receives.ID).To(Equal("some-id"))
+				Expect(snapshots.RestoreCall.Receives.IncludeTerraformState).To(BeFalse())
+				Expect(stateBootstrap.GetStateCall.Receives.Dir).To(Equal("some-state-dir"))
+				Expect(stateStore.SetCall.Receives[0].State).To(Equal(storage.State{EnvID: "restored-env"}))
+				Expect(logger.PrintlnCall.CallCount).To(Equal(0))
+			})
+
+			Context("when --include-terraform-state is passed", func() {
+				It("restores the terraform state and warns about it", func() {
+					err := command.Execute([]string{"--include-terraform-state", "some-id"}, storage.State{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(snapshots.RestoreCall.Receives.ID).To(Equal("some-id"))
+					Expect(snapshots.RestoreCall.Receives.IncludeTerraformState).To(BeTrue())
+					Expect(logger.PrintlnCall.Receives.Message).To(ContainSubstring("warning: rolling back the terraform state"))
+				})
+			})
+
+			Context("when the snapshot cannot be restored", func() {
+				It("returns an error", func() {
+					snapshots.RestoreCall.Returns.Error = errors.New("banana")
+
+					err := command.Execute([]string{"some-id"}, storage.State{})
+					Expect(err).To(MatchError("banana"))
+					Expect(stateStore.SetCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when the restored state cannot be loaded", func() {
+				It("returns an error", func() {
+					stateBootstrap.GetStateCall.Returns.Error = errors.New("kiwi")
+
+					err := command.Execute([]string{"some-id"}, storage.State{})
+					Expect(err).To(MatchError("Load restored state: kiwi"))
+				})
+			})
+
+			Context("when the restored state cannot be saved", func() {
+				It("returns an error", func() {
+					stateStore.SetCall.Returns = []fakes.SetCallReturn{{Error: errors.New("lime")}}
+
+					err := command.Execute([]string{"some-id"}, storage.State{})
+					Expect(err).To(MatchError("Save restored state: lime"))
+				})
+			})
+		})
+	})
+})
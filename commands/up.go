@@ -1,60 +1,153 @@
 package commands
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
 )
 
+// upPhases are the phases of bbl up, in the order they run.
+var upPhases = []string{
+	storage.PHASE_TERRAFORM,
+	storage.PHASE_JUMPBOX,
+	storage.PHASE_DIRECTOR,
+	storage.PHASE_CLOUD_CONFIG,
+}
+
 type Up struct {
 	plan               plan
 	boshManager        boshManager
 	cloudConfigManager cloudConfigManager
 	stateStore         stateStore
 	terraformManager   terraformManager
+	fingerprinter      fingerprinter
+	logger             logger
+}
+
+type UpConfig struct {
+	From              string
+	Only              string
+	DryRun            bool
+	Force             bool
+	TerraformPlanFile string
 }
 
 func NewUp(plan plan, boshManager boshManager,
 	cloudConfigManager cloudConfigManager,
-	stateStore stateStore, terraformManager terraformManager) Up {
+	stateStore stateStore, terraformManager terraformManager,
+	fingerprinter fingerprinter, logger logger) Up {
 	return Up{
 		plan:               plan,
 		boshManager:        boshManager,
 		cloudConfigManager: cloudConfigManager,
 		stateStore:         stateStore,
 		terraformManager:   terraformManager,
+		fingerprinter:      fingerprinter,
+		logger:             logger,
 	}
 }
 
 func (u Up) CheckFastFails(args []string, state storage.State) error {
-	return u.plan.CheckFastFails(args, state)
+	_, planArgs, err := parseUpArgs(args)
+	if err != nil {
+		return err
+	}
+
+	return u.plan.CheckFastFails(planArgs, state)
 }
 
 func (u Up) Execute(args []string, state storage.State) error {
-	config, err := u.ParseArgs(args, state)
+	upConfig, planArgs, err := parseUpArgs(args)
 	if err != nil {
 		return err
 	}
 
-	if !u.plan.IsInitialized(state) {
-		planState, err := u.plan.InitializePlan(config, state)
-		if err != nil {
-			return err
-		}
-		state = planState
+	config, err := u.plan.ParseArgs(planArgs, state)
+	if err != nil {
+		return err
+	}
+
+	if config.TerraformPlanOut != "" {
+		return errors.New("--terraform-plan-out only works with bbl plan, use bbl up --dry-run to preview the changes")
+	}
+
+	if len(config.Patches) > 0 {
+		return errors.New("--patch only works with bbl plan")
 	}
 
-	state, err = u.terraformManager.Apply(state)
+	if len(config.DirectorFeatures) > 0 {
+		return errors.New("--director-feature only works with bbl plan")
+	}
+
+	err = u.plan.WarnAboutPatchDrift(state)
 	if err != nil {
-		return handleTerraformError(err, state, u.stateStore)
+		return err
+	}
+
+	// The sizing, deployment dir and offline assets flags change the scripts
+	// and files that bbl plan generates, so bbl up plans again when it is
+	// given them.
+	resized := !config.JumpboxSizing.IsEmpty() || !config.DirectorSizing.IsEmpty()
+	redeployed := config.JumpboxDeploymentDir != "" || config.BOSHDeploymentDir != "" || config.OfflineAssetsDir != ""
+	initialized := u.plan.IsInitialized(state)
+
+	// A dry run previews the plan in the state dir, and must not write a new
+	// one.
+	if upConfig.DryRun {
+		if !initialized {
+			return errors.New("bbl up --dry-run previews the plan in the state directory, which has not been planned yet. Run bbl plan first.")
+		}
+		if resized || redeployed {
+			return errors.New("bbl up --dry-run previews the plan in the state directory. Run bbl plan with the sizing, deployment dir and offline assets flags first.")
+		}
+		return u.dryRun(state)
 	}
 
-	state.NoDirector = false
+	if !initialized || resized || redeployed {
+		state, err = u.plan.InitializePlan(config, state)
+	} else {
+		state, err = u.plan.CheckOverrides(config, state)
+	}
+	if err != nil {
+		return err
+	}
 
-	err = u.stateStore.Set(state)
+	fingerprint, err := u.fingerprinter.Fingerprint(storage.PHASE_TERRAFORM)
 	if err != nil {
-		return fmt.Errorf("Save state after terraform apply: %s", err)
+		return fmt.Errorf("Fingerprint terraform inputs: %s", err)
+	}
+
+	// A reviewed plan is applied even when the inputs are unchanged, since
+	// it may fix drift in the infrastructure.
+	if upConfig.TerraformPlanFile != "" || !u.skip(upConfig, storage.PHASE_TERRAFORM, state, fingerprint) {
+		if upConfig.TerraformPlanFile != "" {
+			var varsDir string
+			varsDir, err = u.stateStore.GetVarsDir()
+			if err != nil {
+				return fmt.Errorf("Get vars dir: %s", err)
+			}
+			planFile := filepath.Join(varsDir, upConfig.TerraformPlanFile)
+			state, err = u.terraformManager.ApplyPlan(withoutCheckpoint(state, storage.PHASE_TERRAFORM), planFile)
+		} else {
+			state, err = u.terraformManager.Apply(withoutCheckpoint(state, storage.PHASE_TERRAFORM))
+		}
+		if err != nil {
+			return handleTerraformError(err, state, u.stateStore)
+		}
+
+		state.NoDirector = false
+		state = withCheckpoint(state, storage.PHASE_TERRAFORM, fingerprint)
+
+		err = u.stateStore.Set(state)
+		if err != nil {
+			return fmt.Errorf("Save state after terraform apply: %s", err)
+		}
 	}
 
 	terraformOutputs, err := u.terraformManager.GetOutputs()
@@ -62,48 +155,293 @@ func (u Up) Execute(args []string, state storage.State) error {
 		return fmt.Errorf("Parse terraform outputs: %s", err)
 	}
 
-	state, err = u.boshManager.CreateJumpbox(state, terraformOutputs)
-	switch err.(type) {
-	case bosh.ManagerCreateError:
-		bcErr := err.(bosh.ManagerCreateError)
-		if setErr := u.stateStore.Set(bcErr.State()); setErr != nil {
-			return fmt.Errorf("Save state after jumpbox create error: %s, %s", err, setErr)
+	fingerprint, err = u.fingerprinter.Fingerprint(storage.PHASE_JUMPBOX, u.boshManager.GetJumpboxDeploymentVars(state, terraformOutputs))
+	if err != nil {
+		return fmt.Errorf("Fingerprint jumpbox inputs: %s", err)
+	}
+
+	connected := false
+	if !u.skip(upConfig, storage.PHASE_JUMPBOX, state, fingerprint) {
+		state, err = u.boshManager.CreateJumpbox(withoutCheckpoint(state, storage.PHASE_JUMPBOX), terraformOutputs)
+		switch err.(type) {
+		case bosh.ManagerCreateError:
+			bcErr := err.(bosh.ManagerCreateError)
+			if setErr := u.stateStore.Set(bcErr.State()); setErr != nil {
+				return fmt.Errorf("Save state after jumpbox create error: %s, %s", err, setErr)
+			}
+			return fmt.Errorf("Create jumpbox: %s", err)
+		case error:
+			return fmt.Errorf("Create jumpbox: %s", err)
+		}
+		connected = true
+
+		state = withCheckpoint(state, storage.PHASE_JUMPBOX, fingerprint)
+
+		err = u.stateStore.Set(state)
+		if err != nil {
+			return fmt.Errorf("Save state after create jumpbox: %s", err)
 		}
-		return fmt.Errorf("Create jumpbox: %s", err)
-	case error:
-		return fmt.Errorf("Create jumpbox: %s", err)
 	}
 
-	err = u.stateStore.Set(state)
+	directorFingerprint, err := u.fingerprinter.Fingerprint(storage.PHASE_DIRECTOR, u.boshManager.GetDirectorDeploymentVars(state, terraformOutputs))
 	if err != nil {
-		return fmt.Errorf("Save state after create jumpbox: %s", err)
+		return fmt.Errorf("Fingerprint director inputs: %s", err)
 	}
 
-	state, err = u.boshManager.CreateDirector(state, terraformOutputs)
-	switch err.(type) {
-	case bosh.ManagerCreateError:
-		bcErr := err.(bosh.ManagerCreateError)
-		if setErr := u.stateStore.Set(bcErr.State()); setErr != nil {
-			return fmt.Errorf("Save state after bosh director create error: %s, %s", err, setErr)
+	if !u.skip(upConfig, storage.PHASE_DIRECTOR, state, directorFingerprint) {
+		if !connected {
+			err = u.boshManager.ConnectToJumpbox(state)
+			if err != nil {
+				return fmt.Errorf("Connect to jumpbox: %s", err)
+			}
+			connected = true
+		}
+
+		state, err = u.boshManager.CreateDirector(withoutCheckpoint(state, storage.PHASE_DIRECTOR), terraformOutputs)
+		switch err.(type) {
+		case bosh.ManagerCreateError:
+			bcErr := err.(bosh.ManagerCreateError)
+			if setErr := u.stateStore.Set(bcErr.State()); setErr != nil {
+				return fmt.Errorf("Save state after bosh director create error: %s, %s", err, setErr)
+			}
+			return fmt.Errorf("Create bosh director: %s", err)
+		case error:
+			return fmt.Errorf("Create bosh director: %s", err)
+		}
+
+		state = withCheckpoint(state, storage.PHASE_DIRECTOR, directorFingerprint)
+
+		err = u.stateStore.Set(state)
+		if err != nil {
+			return fmt.Errorf("Save state after create director: %s", err)
 		}
-		return fmt.Errorf("Create bosh director: %s", err)
-	case error:
-		return fmt.Errorf("Create bosh director: %s", err)
 	}
 
-	err = u.stateStore.Set(state)
+	outputsJSON, err := json.Marshal(terraformOutputs.Map)
 	if err != nil {
-		return fmt.Errorf("Save state after create director: %s", err)
+		return fmt.Errorf("Fingerprint cloud-config inputs: %s", err) // not tested
 	}
 
-	err = u.cloudConfigManager.Update(state)
+	fingerprint, err = u.fingerprinter.Fingerprint(storage.PHASE_CLOUD_CONFIG, string(outputsJSON), directorFingerprint)
 	if err != nil {
-		return fmt.Errorf("Update cloud config: %s", err)
+		return fmt.Errorf("Fingerprint cloud-config inputs: %s", err)
+	}
+
+	if !u.skip(upConfig, storage.PHASE_CLOUD_CONFIG, state, fingerprint) {
+		if !connected {
+			err = u.boshManager.ConnectToJumpbox(state)
+			if err != nil {
+				return fmt.Errorf("Connect to jumpbox: %s", err)
+			}
+		}
+
+		err = u.cloudConfigManager.Update(state)
+		if err != nil {
+			return fmt.Errorf("Update cloud config: %s", err)
+		}
+
+		state = withCheckpoint(state, storage.PHASE_CLOUD_CONFIG, fingerprint)
+
+		err = u.stateStore.Set(state)
+		if err != nil {
+			return fmt.Errorf("Save state after update cloud config: %s", err)
+		}
 	}
 
 	return nil
 }
 
 func (u Up) ParseArgs(args []string, state storage.State) (PlanConfig, error) {
-	return u.plan.ParseArgs(args, state)
+	_, planArgs, err := parseUpArgs(args)
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	return u.plan.ParseArgs(planArgs, state)
+}
+
+// dryRun shows what bbl up would change without changing it: the terraform
+// plan, which it saves so that it can be reviewed, and how the jumpbox and
+// director manifests differ from the ones bbl last deployed.
+func (u Up) dryRun(state storage.State) error {
+	varsDir, err := u.stateStore.GetVarsDir()
+	if err != nil {
+		return fmt.Errorf("Get vars dir: %s", err)
+	}
+
+	planFile := filepath.Join(varsDir, "bbl.tfplan")
+	changes, err := u.terraformManager.Plan(state, planFile)
+	if err != nil {
+		return fmt.Errorf("Plan terraform changes: %s", err)
+	}
+	u.logger.Step("saved the terraform plan to %s", planFile)
+
+	printTerraformChanges(u.logger, changes)
+
+	isPaved, err := u.terraformManager.IsPaved()
+	if err != nil {
+		return fmt.Errorf("Check if terraform has applied: %s", err)
+	}
+	if !isPaved {
+		u.logger.Step("skipping the manifest diff, the manifests need the outputs of terraform apply")
+		return nil
+	}
+
+	terraformOutputs, err := u.terraformManager.GetOutputs()
+	if err != nil {
+		return fmt.Errorf("Parse terraform outputs: %s", err)
+	}
+
+	for _, deployment := range []struct {
+		name string
+		diff func(storage.State, terraform.Outputs) (string, error)
+	}{
+		{"jumpbox", u.boshManager.DiffJumpboxManifest},
+		{"director", u.boshManager.DiffDirectorManifest},
+	} {
+		diff, err := deployment.diff(state, terraformOutputs)
+		if err != nil {
+			return fmt.Errorf("Diff %s manifest: %s", deployment.name, err)
+		}
+
+		if diff == "" {
+			u.logger.Printf("%s manifest: no changes\n", deployment.name)
+			continue
+		}
+		u.logger.Printf("%s", diff)
+	}
+
+	return nil
+}
+
+// skip reports whether a phase can be left out, either because --from or
+// --only excludes it or because it already completed with the same inputs.
+// The checkpoints only track the inputs, so --force reruns every phase to
+// repair an environment that changed outside of bbl.
+func (u Up) skip(config UpConfig, phase string, state storage.State, fingerprint string) bool {
+	index := phaseIndex(phase)
+
+	switch {
+	case config.Only != "" && config.Only != phase,
+		config.From != "" && index < phaseIndex(config.From):
+		u.logger.Step("skipping %s", phase)
+		return true
+	case config.Only != "" || config.From != "" || config.Force:
+		return false
+	}
+
+	checkpoint, ok := state.Checkpoints[phase]
+	if ok && checkpoint == fingerprint {
+		u.logger.Step("skipping %s, its inputs have not changed", phase)
+		return true
+	}
+
+	return false
+}
+
+// parseUpArgs takes the flags of bbl up out of args and returns the rest,
+// which belong to bbl plan.
+func parseUpArgs(args []string) (UpConfig, []string, error) {
+	var config UpConfig
+	planArgs := []string{}
+
+	for i := 0; i < len(args); i++ {
+		name, value := args[i], ""
+		hasValue := false
+		if equals := strings.Index(name, "="); equals != -1 {
+			name, value, hasValue = name[:equals], name[equals+1:], true
+		}
+
+		var target *string
+		isPhase := true
+		switch name {
+		case "--from", "-from":
+			target = &config.From
+		case "--only", "-only":
+			target = &config.Only
+		case "--terraform-plan-file", "-terraform-plan-file":
+			target, isPhase = &config.TerraformPlanFile, false
+		case "--dry-run", "-dry-run":
+			config.DryRun = true
+			continue
+		case "--force", "-force":
+			config.Force = true
+			continue
+		default:
+			planArgs = append(planArgs, args[i])
+			continue
+		}
+
+		if !hasValue {
+			if i+1 == len(args) {
+				if isPhase {
+					return UpConfig{}, nil, fmt.Errorf("%s requires a phase", name)
+				}
+				return UpConfig{}, nil, fmt.Errorf("%s requires a file", name)
+			}
+			i++
+			value = args[i]
+		}
+
+		if isPhase && phaseIndex(value) == -1 {
+			return UpConfig{}, nil, fmt.Errorf("Unknown phase %q, expected one of %s", value, strings.Join(upPhases, ", "))
+		}
+		*target = value
+	}
+
+	err := validatePlanFile("--terraform-plan-file", config.TerraformPlanFile)
+	if err != nil {
+		return UpConfig{}, nil, err
+	}
+
+	switch {
+	case config.From != "" && config.Only != "":
+		return UpConfig{}, nil, errors.New("--from and --only cannot be used together")
+	case config.DryRun && (config.From != "" || config.Only != ""):
+		return UpConfig{}, nil, errors.New("--dry-run cannot be used with --from or --only")
+	case config.DryRun && config.TerraformPlanFile != "":
+		return UpConfig{}, nil, errors.New("--dry-run and --terraform-plan-file cannot be used together")
+	case config.TerraformPlanFile != "" && (config.Only != "" && config.Only != storage.PHASE_TERRAFORM ||
+		config.From != "" && config.From != storage.PHASE_TERRAFORM):
+		return UpConfig{}, nil, errors.New("--terraform-plan-file cannot be used when --from or --only leaves out the terraform phase")
+	}
+
+	return config, planArgs, nil
+}
+
+func phaseIndex(phase string) int {
+	for i, p := range upPhases {
+		if p == phase {
+			return i
+		}
+	}
+	return -1
+}
+
+// withCheckpoint and withoutCheckpoint copy the checkpoints, since the map
+// is shared by every copy of the state.
+func withCheckpoint(state storage.State, phase, fingerprint string) storage.State {
+	checkpoints := map[string]string{phase: fingerprint}
+	for p, f := range state.Checkpoints {
+		if p != phase {
+			checkpoints[p] = f
+		}
+	}
+	state.Checkpoints = checkpoints
+	return state
+}
+
+func withoutCheckpoint(state storage.State, phase string) storage.State {
+	if _, ok := state.Checkpoints[phase]; !ok {
+		return state
+	}
+
+	checkpoints := map[string]string{}
+	for p, f := range state.Checkpoints {
+		if p != phase {
+			checkpoints[p] = f
+		}
+	}
+	state.Checkpoints = checkpoints
+	return state
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/cloudfoundry/bosh-bootloader/terraform"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -22,16 +23,23 @@ var _ = Describe("Up", func() {
 		terraformManager   *fakes.TerraformManager
 		cloudConfigManager *fakes.CloudConfigManager
 		stateStore         *fakes.StateStore
+		fingerprinter      *fakes.Fingerprinter
+		logger             *fakes.Logger
 	)
 
 	BeforeEach(func() {
 		plan = &fakes.Plan{}
+		plan.CheckOverridesCall.Stub = func(config commands.PlanConfig, state storage.State) (storage.State, error) {
+			return state, nil
+		}
 		boshManager = &fakes.BOSHManager{}
 		terraformManager = &fakes.TerraformManager{}
 		cloudConfigManager = &fakes.CloudConfigManager{}
 		stateStore = &fakes.StateStore{}
+		fingerprinter = &fakes.Fingerprinter{}
+		logger = &fakes.Logger{}
 
-		command = commands.NewUp(plan, boshManager, cloudConfigManager, stateStore, terraformManager)
+		command = commands.NewUp(plan, boshManager, cloudConfigManager, stateStore, terraformManager, fingerprinter, logger)
 	})
 
 	Describe("CheckFastFails", func() {
@@ -43,6 +51,54 @@ var _ = Describe("Up", func() {
 			Expect(plan.CheckFastFailsCall.Receives.SubcommandFlags).To(Equal([]string{}))
 			Expect(plan.CheckFastFailsCall.Receives.State).To(Equal(storage.State{Version: 999}))
 		})
+
+		It("passes the flags other than --from and --only to Plan", func() {
+			err := command.CheckFastFails([]string{"--name", "some-name", "--from", "director"}, storage.State{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan.CheckFastFailsCall.Receives.SubcommandFlags).To(Equal([]string{"--name", "some-name"}))
+		})
+
+		It("rejects an unknown phase", func() {
+			err := command.CheckFastFails([]string{"--only", "bosh"}, storage.State{})
+			Expect(err).To(MatchError(`Unknown phase "bosh", expected one of terraform, jumpbox, director, cloud-config`))
+		})
+
+		It("rejects --from with --only", func() {
+			err := command.CheckFastFails([]string{"--from", "jumpbox", "--only", "director"}, storage.State{})
+			Expect(err).To(MatchError("--from and --only cannot be used together"))
+		})
+
+		It("rejects --dry-run with --only", func() {
+			err := command.CheckFastFails([]string{"--dry-run", "--only", "director"}, storage.State{})
+			Expect(err).To(MatchError("--dry-run cannot be used with --from or --only"))
+		})
+
+		It("rejects --terraform-plan-file when the terraform phase is left out", func() {
+			err := command.CheckFastFails([]string{"--terraform-plan-file", "some.tfplan", "--from", "jumpbox"}, storage.State{})
+			Expect(err).To(MatchError("--terraform-plan-file cannot be used when --from or --only leaves out the terraform phase"))
+		})
+
+		DescribeTable("rejects a --terraform-plan-file that is not a plan file name",
+			func(planFile string) {
+				err := command.CheckFastFails([]string{"--terraform-plan-file", planFile}, storage.State{})
+				Expect(err).To(MatchError("--terraform-plan-file takes the name of a file ending in .tfplan, which bbl keeps in the vars directory of the state directory"))
+			},
+			Entry("an absolute path", "/tmp/reviewed.tfplan"),
+			Entry("a path into a subdirectory", "vars/reviewed.tfplan"),
+			Entry("a path out of the vars dir", "../reviewed.tfplan"),
+			Entry("a name without the .tfplan extension", "reviewed.plan"),
+		)
+
+		It("requires a file after --terraform-plan-file", func() {
+			err := command.CheckFastFails([]string{"--terraform-plan-file"}, storage.State{})
+			Expect(err).To(MatchError("--terraform-plan-file requires a file"))
+		})
+
+		It("requires a phase after --from", func() {
+			err := command.CheckFastFails([]string{"--from"}, storage.State{})
+			Expect(err).To(MatchError("--from requires a phase"))
+		})
 	})
 
 	Describe("Execute", func() {
@@ -101,6 +157,7 @@ var _ = Describe("Up", func() {
 
 				Expect(terraformManager.ApplyCall.CallCount).To(Equal(1))
 				Expect(terraformManager.ApplyCall.Receives.BBLState).To(Equal(incomingState))
+				terraformApplyState.Checkpoints = map[string]string{"terraform": ""}
 				Expect(stateStore.SetCall.Receives[0].State).To(Equal(terraformApplyState))
 
 				Expect(terraformManager.GetOutputsCall.CallCount).To(Equal(1))
@@ -109,18 +166,50 @@ var _ = Describe("Up", func() {
 				Expect(boshManager.CreateJumpboxCall.CallCount).To(Equal(1))
 				Expect(boshManager.CreateJumpboxCall.Receives.State).To(Equal(terraformApplyState))
 				Expect(boshManager.CreateJumpboxCall.Receives.TerraformOutputs).To(Equal(terraformOutputs))
+				createJumpboxState.Checkpoints = map[string]string{"jumpbox": ""}
 				Expect(stateStore.SetCall.Receives[1].State).To(Equal(createJumpboxState))
 
 				Expect(boshManager.InitializeDirectorCall.CallCount).To(Equal(0))
 				Expect(boshManager.CreateDirectorCall.CallCount).To(Equal(1))
 				Expect(boshManager.CreateDirectorCall.Receives.State).To(Equal(createJumpboxState))
 				Expect(boshManager.CreateDirectorCall.Receives.TerraformOutputs).To(Equal(terraformOutputs))
+				createDirectorState.Checkpoints = map[string]string{"director": ""}
 				Expect(stateStore.SetCall.Receives[2].State).To(Equal(createDirectorState))
+				Expect(boshManager.ConnectToJumpboxCall.CallCount).To(Equal(0))
 
 				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
 				Expect(cloudConfigManager.UpdateCall.Receives.State).To(Equal(createDirectorState))
+				createDirectorState.Checkpoints["cloud-config"] = ""
+				Expect(stateStore.SetCall.Receives[3].State).To(Equal(createDirectorState))
 
-				Expect(stateStore.SetCall.CallCount).To(Equal(3))
+				Expect(stateStore.SetCall.CallCount).To(Equal(4))
+			})
+		})
+
+		Context("when there are override scripts from an earlier bbl plan", func() {
+			It("checks them before applying", func() {
+				checkedState := storage.State{LatestTFOutput: "checked-state", IAAS: "some-iaas"}
+				plan.CheckOverridesCall.Stub = nil
+				plan.CheckOverridesCall.Returns.State = checkedState
+
+				err := command.Execute([]string{"some", "flags"}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.CheckOverridesCall.CallCount).To(Equal(1))
+				Expect(plan.CheckOverridesCall.Receives.Config).To(Equal(planConfig))
+				Expect(plan.CheckOverridesCall.Receives.State).To(Equal(incomingState))
+				Expect(terraformManager.ApplyCall.Receives.BBLState).To(Equal(checkedState))
+			})
+
+			Context("when an override script is stale and --strict is passed", func() {
+				It("returns the error without applying", func() {
+					plan.CheckOverridesCall.Stub = nil
+					plan.CheckOverridesCall.Returns.Error = errors.New("create-director-override.sh was written for an older create-director.sh.")
+
+					err := command.Execute([]string{"--strict"}, incomingState)
+					Expect(err).To(MatchError("create-director-override.sh was written for an older create-director.sh."))
+					Expect(terraformManager.ApplyCall.CallCount).To(Equal(0))
+				})
 			})
 		})
 
@@ -153,6 +242,350 @@ var _ = Describe("Up", func() {
 			})
 		})
 
+		Describe("checkpoints", func() {
+			BeforeEach(func() {
+				fingerprinter.FingerprintCall.Stub = func(phase string, values ...string) (string, error) {
+					return phase + "-fingerprint", nil
+				}
+				boshManager.GetJumpboxDeploymentVarsCall.Returns.Vars = "some-jumpbox-vars"
+				boshManager.GetDirectorDeploymentVarsCall.Returns.Vars = "some-director-vars"
+			})
+
+			It("fingerprints the inputs of each phase", func() {
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fingerprinter.FingerprintCall.Receives).To(Equal([]fakes.FingerprintReceive{
+					{Phase: "terraform"},
+					{Phase: "jumpbox", Values: []string{"some-jumpbox-vars"}},
+					{Phase: "director", Values: []string{"some-director-vars"}},
+					{Phase: "cloud-config", Values: []string{`{"jumpbox_url":"some-jumpbox-url"}`, "director-fingerprint"}},
+				}))
+			})
+
+			Context("when the inputs of a phase are unchanged", func() {
+				BeforeEach(func() {
+					incomingState.Checkpoints = map[string]string{
+						"terraform": "terraform-fingerprint",
+						"jumpbox":   "jumpbox-fingerprint",
+						"director":  "old-director-fingerprint",
+					}
+				})
+
+				It("skips it and resumes at the first changed phase", func() {
+					err := command.Execute([]string{}, incomingState)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(terraformManager.ApplyCall.CallCount).To(Equal(0))
+					Expect(terraformManager.GetOutputsCall.CallCount).To(Equal(1))
+					Expect(boshManager.CreateJumpboxCall.CallCount).To(Equal(0))
+					Expect(logger.StepCall.Messages).To(ContainElement("skipping terraform, its inputs have not changed"))
+					Expect(logger.StepCall.Messages).To(ContainElement("skipping jumpbox, its inputs have not changed"))
+
+					Expect(boshManager.ConnectToJumpboxCall.CallCount).To(Equal(1))
+					Expect(boshManager.ConnectToJumpboxCall.Receives.State).To(Equal(incomingState))
+
+					Expect(boshManager.CreateDirectorCall.CallCount).To(Equal(1))
+					Expect(boshManager.CreateDirectorCall.Receives.State.Checkpoints).To(Equal(map[string]string{
+						"terraform": "terraform-fingerprint",
+						"jumpbox":   "jumpbox-fingerprint",
+					}))
+					Expect(stateStore.SetCall.Receives[0].State.Checkpoints).To(Equal(map[string]string{"director": "director-fingerprint"}))
+					Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+				})
+
+				Context("when connecting to the jumpbox fails", func() {
+					It("returns an error", func() {
+						boshManager.ConnectToJumpboxCall.Returns.Error = errors.New("durian")
+
+						err := command.Execute([]string{}, incomingState)
+						Expect(err).To(MatchError("Connect to jumpbox: durian"))
+						Expect(boshManager.CreateDirectorCall.CallCount).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when --from is provided", func() {
+				It("skips the earlier phases and runs the rest", func() {
+					incomingState.Checkpoints = map[string]string{
+						"terraform": "terraform-fingerprint",
+						"jumpbox":   "jumpbox-fingerprint",
+						"director":  "director-fingerprint",
+					}
+
+					err := command.Execute([]string{"--from", "jumpbox", "some", "flags"}, incomingState)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(plan.ParseArgsCall.Receives.Args).To(Equal([]string{"some", "flags"}))
+					Expect(terraformManager.ApplyCall.CallCount).To(Equal(0))
+					Expect(logger.StepCall.Messages).To(ContainElement("skipping terraform"))
+					Expect(boshManager.CreateJumpboxCall.CallCount).To(Equal(1))
+					Expect(boshManager.CreateDirectorCall.CallCount).To(Equal(1))
+					Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+				})
+			})
+
+			Context("when --force is provided", func() {
+				It("reruns the phases whose inputs are unchanged", func() {
+					incomingState.Checkpoints = map[string]string{
+						"terraform":    "terraform-fingerprint",
+						"jumpbox":      "jumpbox-fingerprint",
+						"director":     "director-fingerprint",
+						"cloud-config": "cloud-config-fingerprint",
+					}
+
+					err := command.Execute([]string{"--force", "some", "flags"}, incomingState)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(plan.ParseArgsCall.Receives.Args).To(Equal([]string{"some", "flags"}))
+					Expect(terraformManager.ApplyCall.CallCount).To(Equal(1))
+					Expect(boshManager.CreateJumpboxCall.CallCount).To(Equal(1))
+					Expect(boshManager.CreateDirectorCall.CallCount).To(Equal(1))
+					Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+				})
+			})
+
+			Context("when --only is provided", func() {
+				It("runs just that phase", func() {
+					err := command.Execute([]string{"--only=cloud-config"}, incomingState)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(terraformManager.ApplyCall.CallCount).To(Equal(0))
+					Expect(boshManager.CreateJumpboxCall.CallCount).To(Equal(0))
+					Expect(boshManager.CreateDirectorCall.CallCount).To(Equal(0))
+					Expect(boshManager.ConnectToJumpboxCall.CallCount).To(Equal(1))
+					Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(1))
+				})
+			})
+
+			Context("when the inputs cannot be fingerprinted", func() {
+				It("returns an error", func() {
+					fingerprinter.FingerprintCall.Stub = nil
+					fingerprinter.FingerprintCall.Returns.Error = errors.New("papaya")
+
+					err := command.Execute([]string{}, incomingState)
+					Expect(err).To(MatchError("Fingerprint terraform inputs: papaya"))
+				})
+			})
+		})
+
+		Context("when --terraform-plan-file is provided", func() {
+			BeforeEach(func() {
+				stateStore.GetVarsDirCall.Returns.Directory = "/some/state-dir/vars"
+				terraformManager.ApplyPlanCall.Returns.BBLState = terraformApplyState
+			})
+
+			It("applies the reviewed plan even when the terraform inputs are unchanged", func() {
+				fingerprinter.FingerprintCall.Returns.Fingerprint = "terraform-fingerprint"
+				incomingState.Checkpoints = map[string]string{"terraform": "terraform-fingerprint"}
+
+				err := command.Execute([]string{"--terraform-plan-file", "reviewed.tfplan"}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(terraformManager.ApplyCall.CallCount).To(Equal(0))
+				Expect(terraformManager.ApplyPlanCall.CallCount).To(Equal(1))
+				Expect(terraformManager.ApplyPlanCall.Receives.PlanFile).To(Equal("/some/state-dir/vars/reviewed.tfplan"))
+				Expect(stateStore.SetCall.Receives[0].State.Checkpoints).To(HaveKeyWithValue("terraform", "terraform-fingerprint"))
+				Expect(boshManager.CreateJumpboxCall.CallCount).To(Equal(1))
+			})
+
+			Context("when the plan cannot be applied", func() {
+				It("returns an error", func() {
+					terraformManager.ApplyPlanCall.Returns.Error = errors.New("The terraform template or variables have changed since reviewed.tfplan was planned.")
+
+					err := command.Execute([]string{"--terraform-plan-file", "reviewed.tfplan"}, incomingState)
+					Expect(err).To(MatchError("The terraform template or variables have changed since reviewed.tfplan was planned."))
+					Expect(boshManager.CreateJumpboxCall.CallCount).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when --terraform-plan-out is provided", func() {
+			It("points to bbl plan", func() {
+				plan.ParseArgsCall.Returns.Config = commands.PlanConfig{TerraformPlanOut: "vars/reviewed.tfplan"}
+
+				err := command.Execute([]string{"--terraform-plan-out", "vars/reviewed.tfplan"}, incomingState)
+				Expect(err).To(MatchError("--terraform-plan-out only works with bbl plan, use bbl up --dry-run to preview the changes"))
+			})
+		})
+
+		Context("when --patch is provided", func() {
+			It("points to bbl plan", func() {
+				plan.ParseArgsCall.Returns.Config = commands.PlanConfig{Patches: []string{"plan-patches/some-patch"}}
+
+				err := command.Execute([]string{"--patch", "plan-patches/some-patch"}, incomingState)
+				Expect(err).To(MatchError("--patch only works with bbl plan"))
+			})
+		})
+
+		Context("when --director-feature is provided", func() {
+			It("points to bbl plan", func() {
+				plan.ParseArgsCall.Returns.Config = commands.PlanConfig{DirectorFeatures: []string{"syslog"}}
+
+				err := command.Execute([]string{"--director-feature", "syslog"}, incomingState)
+				Expect(err).To(MatchError("--director-feature only works with bbl plan"))
+			})
+		})
+
+		Context("when sizing flags are provided", func() {
+			It("plans again before applying", func() {
+				planConfig.DirectorSizing = storage.Sizing{VMType: "m4.2xlarge"}
+				plan.ParseArgsCall.Returns.Config = planConfig
+
+				err := command.Execute([]string{"--director-vm-type", "m4.2xlarge"}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.InitializePlanCall.CallCount).To(Equal(1))
+				Expect(plan.InitializePlanCall.Receives.Plan).To(Equal(planConfig))
+				Expect(plan.CheckOverridesCall.CallCount).To(Equal(0))
+				Expect(terraformManager.ApplyCall.Receives.BBLState).To(Equal(planState))
+			})
+		})
+
+		Context("when a deployment dir is provided", func() {
+			It("plans again before applying", func() {
+				planConfig.BOSHDeploymentDir = "/some/bosh-deployment"
+				plan.ParseArgsCall.Returns.Config = planConfig
+
+				err := command.Execute([]string{"--bosh-deployment-dir", "/some/bosh-deployment"}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.InitializePlanCall.CallCount).To(Equal(1))
+				Expect(plan.InitializePlanCall.Receives.Plan).To(Equal(planConfig))
+				Expect(terraformManager.ApplyCall.Receives.BBLState).To(Equal(planState))
+			})
+		})
+
+		Context("when an offline assets dir is provided", func() {
+			It("plans again before applying", func() {
+				planConfig.OfflineAssetsDir = "/some/assets"
+				plan.ParseArgsCall.Returns.Config = planConfig
+
+				err := command.Execute([]string{"--offline-assets", "/some/assets"}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.InitializePlanCall.CallCount).To(Equal(1))
+				Expect(plan.InitializePlanCall.Receives.Plan).To(Equal(planConfig))
+			})
+		})
+
+		Context("when files from an applied patch have changed", func() {
+			It("warns about them before applying", func() {
+				err := command.Execute([]string{}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.WarnAboutPatchDriftCall.CallCount).To(Equal(1))
+				Expect(plan.WarnAboutPatchDriftCall.Receives.State).To(Equal(incomingState))
+			})
+
+			Context("when the patches cannot be checked", func() {
+				It("returns an error", func() {
+					plan.WarnAboutPatchDriftCall.Returns.Error = errors.New("kiwi")
+
+					err := command.Execute([]string{}, incomingState)
+					Expect(err).To(MatchError("kiwi"))
+				})
+			})
+		})
+
+		Describe("--dry-run", func() {
+			BeforeEach(func() {
+				stateStore.GetVarsDirCall.Returns.Directory = "/some/vars"
+				terraformManager.PlanCall.Returns.Changes = []terraform.ResourceChange{
+					{Address: "aws_subnet.bosh_subnet", Type: "aws_subnet", Action: "create"},
+					{Address: "aws_instance.nat", Type: "aws_instance", Action: "replace"},
+				}
+				terraformManager.IsPavedCall.Returns.IsPaved = true
+				boshManager.DiffJumpboxManifestCall.Returns.Diff = "some-jumpbox-diff\n"
+			})
+
+			It("prints the terraform plan and the manifest diffs without changing anything", func() {
+				err := command.Execute([]string{"--dry-run"}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(terraformManager.PlanCall.Receives.BBLState).To(Equal(incomingState))
+				Expect(terraformManager.PlanCall.Receives.PlanFile).To(Equal("/some/vars/bbl.tfplan"))
+				Expect(logger.StepCall.Messages).To(ContainElement("saved the terraform plan to /some/vars/bbl.tfplan"))
+
+				Expect(boshManager.DiffJumpboxManifestCall.Receives.TerraformOutputs).To(Equal(terraformOutputs))
+				Expect(boshManager.DiffDirectorManifestCall.Receives.TerraformOutputs).To(Equal(terraformOutputs))
+				Expect(logger.PrintfCall.Messages).To(Equal([]string{
+					"terraform: 2 to create, 0 to update, 1 to destroy\n",
+					"  create   aws_subnet.bosh_subnet\n",
+					"  replace  aws_instance.nat\n",
+					"some-jumpbox-diff\n",
+					"director manifest: no changes\n",
+				}))
+
+				Expect(terraformManager.ApplyCall.CallCount).To(Equal(0))
+				Expect(boshManager.CreateJumpboxCall.CallCount).To(Equal(0))
+				Expect(boshManager.CreateDirectorCall.CallCount).To(Equal(0))
+				Expect(cloudConfigManager.UpdateCall.CallCount).To(Equal(0))
+				Expect(stateStore.SetCall.CallCount).To(Equal(0))
+			})
+
+			It("does not write the plan or the state", func() {
+				err := command.Execute([]string{"--dry-run"}, incomingState)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.InitializePlanCall.CallCount).To(Equal(0))
+				Expect(plan.CheckOverridesCall.CallCount).To(Equal(0))
+			})
+
+			Context("when the state dir has not been planned", func() {
+				It("returns an error without planning", func() {
+					plan.IsInitializedCall.Returns.IsInitialized = false
+
+					err := command.Execute([]string{"--dry-run"}, incomingState)
+					Expect(err).To(MatchError("bbl up --dry-run previews the plan in the state directory, which has not been planned yet. Run bbl plan first."))
+
+					Expect(plan.InitializePlanCall.CallCount).To(Equal(0))
+					Expect(terraformManager.PlanCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when flags that change the plan are provided", func() {
+				It("returns an error without planning", func() {
+					plan.ParseArgsCall.Returns.Config = commands.PlanConfig{JumpboxSizing: storage.Sizing{VMType: "some-vm-type"}}
+
+					err := command.Execute([]string{"--dry-run", "--jumpbox-vm-type", "some-vm-type"}, incomingState)
+					Expect(err).To(MatchError("bbl up --dry-run previews the plan in the state directory. Run bbl plan with the sizing, deployment dir and offline assets flags first."))
+
+					Expect(plan.InitializePlanCall.CallCount).To(Equal(0))
+				})
+			})
+
+			Context("when terraform has never applied", func() {
+				It("skips the manifest diffs", func() {
+					terraformManager.IsPavedCall.Returns.IsPaved = false
+
+					err := command.Execute([]string{"--dry-run"}, incomingState)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(boshManager.DiffJumpboxManifestCall.CallCount).To(Equal(0))
+					Expect(logger.StepCall.Messages).To(ContainElement("skipping the manifest diff, the manifests need the outputs of terraform apply"))
+				})
+			})
+
+			Context("when terraform plan fails", func() {
+				It("returns an error", func() {
+					terraformManager.PlanCall.Returns.Error = errors.New("lime")
+
+					err := command.Execute([]string{"--dry-run"}, incomingState)
+					Expect(err).To(MatchError("Plan terraform changes: lime"))
+				})
+			})
+
+			Context("when a manifest cannot be diffed", func() {
+				It("returns an error", func() {
+					boshManager.DiffDirectorManifestCall.Returns.Error = errors.New("mango")
+
+					err := command.Execute([]string{"--dry-run"}, incomingState)
+					Expect(err).To(MatchError("Diff director manifest: mango"))
+				})
+			})
+		})
+
 		Describe("failure cases", func() {
 			Context("when parse args fails", func() {
 				BeforeEach(func() {
@@ -242,6 +675,17 @@ var _ = Describe("Up", func() {
 				})
 			})
 
+			Context("when saving the state fails after update cloud config", func() {
+				BeforeEach(func() {
+					stateStore.SetCall.Returns = []fakes.SetCallReturn{{}, {}, {}, {Error: errors.New("kiwi")}}
+				})
+
+				It("returns an error", func() {
+					err := command.Execute([]string{}, storage.State{})
+					Expect(err).To(MatchError("Save state after update cloud config: kiwi"))
+				})
+			})
+
 			Context("when the cloud config cannot be uploaded", func() {
 				BeforeEach(func() {
 					cloudConfigManager.UpdateCall.Returns.Error = errors.New("coconut")
@@ -18,6 +18,9 @@ Global Options:
   --debug      [-d]        Prints debugging output                                                       env:"BBL_DEBUG"
   --version    [-v]        Prints version
   --no-confirm [-n]        No confirm
+  --state-backend          Where the bbl state is kept: local (default) or s3                            env:"BBL_STATE_BACKEND"
+  --state-bucket           Bucket holding the bbl state when --state-backend is s3                       env:"BBL_STATE_BUCKET"
+  --lock-timeout           How long to wait for the state directory lock, e.g. 5m (default 0)            env:"BBL_LOCK_TIMEOUT"
 %s
 `
 	CommandUsage = `
@@ -35,6 +38,12 @@ Maintenance Lifecycle Commands:
   rotate                  Rotates SSH key for the jumpbox user
   plan                    Populates a state directory with the latest config without applying it
   cleanup-leftovers       Cleans up orphaned IAAS resources
+  state                   Manages the bbl state directory
+  patches                 Manages the plan patches applied with bbl plan --patch
+  overrides               Manages the override scripts in the state directory
+  force-unlock            Removes the lock on the state directory
+  migrate                 Migrates the state directory to the layout of this version of bbl
+  drift                   Reports infrastructure and VMs that no longer match what bbl applied
 
 Environmental Detail Commands: Useful for automation and gaining access
   jumpbox-address         Prints BOSH jumpbox address
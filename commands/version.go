@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"runtime"
 
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 )
 
@@ -21,9 +22,29 @@ func NewVersion(version string, logger logger) Version {
 
 func (v Version) Execute(subcommandFlags []string, state storage.State) error {
 	v.logger.Printf("bbl %s\n", v.version)
+
+	if state.IAAS == "" {
+		return nil
+	}
+
+	v.logger.Printf("jumpbox-deployment: %s\n", deploymentVersion(bosh.JumpboxDeploymentVersion, state.JumpboxDeploymentDir, state.JumpboxDeploymentRevision))
+	v.logger.Printf("bosh-deployment: %s\n", deploymentVersion(bosh.BOSHDeploymentVersion, state.BOSHDeploymentDir, state.BOSHDeploymentRevision))
+
 	return nil
 }
 
+// deploymentVersion describes the deployment that the environment was
+// planned with: the one built into bbl, or the checkout bbl plan was given.
+func deploymentVersion(builtIn, dir, revision string) string {
+	if dir == "" {
+		return fmt.Sprintf("%s (built in)", builtIn)
+	}
+	if revision == "" {
+		return fmt.Sprintf("%s (not a git checkout)", dir)
+	}
+	return fmt.Sprintf("%s@%s", dir, revision)
+}
+
 func (v Version) CheckFastFails(subcommandFlags []string, state storage.State) error {
 	return nil
 }
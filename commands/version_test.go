@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"runtime"
 
+	"github.com/cloudfoundry/bosh-bootloader/bosh"
 	"github.com/cloudfoundry/bosh-bootloader/commands"
 	"github.com/cloudfoundry/bosh-bootloader/fakes"
 	"github.com/cloudfoundry/bosh-bootloader/storage"
@@ -67,5 +68,38 @@ var _ = Describe("Version", func() {
 				})
 			})
 		})
+
+		Context("when the state dir has an environment", func() {
+			BeforeEach(func() {
+				version = commands.NewVersion("1.2.3", logger)
+			})
+
+			It("prints the deployments that it uses", func() {
+				err := version.Execute([]string{}, storage.State{
+					IAAS:                   "gcp",
+					BOSHDeploymentDir:      "/some/bosh-deployment",
+					BOSHDeploymentRevision: "some-revision",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(logger.PrintfCall.Messages).To(Equal([]string{
+					fmt.Sprintf("bbl 1.2.3 (%s/%s)\n", runtime.GOOS, runtime.GOARCH),
+					fmt.Sprintf("jumpbox-deployment: %s (built in)\n", bosh.JumpboxDeploymentVersion),
+					"bosh-deployment: /some/bosh-deployment@some-revision\n",
+				}))
+			})
+
+			Context("when a deployment dir is not a git checkout", func() {
+				It("says so", func() {
+					err := version.Execute([]string{}, storage.State{
+						IAAS:                 "gcp",
+						JumpboxDeploymentDir: "/some/jumpbox-deployment",
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(logger.PrintfCall.Messages).To(ContainElement("jumpbox-deployment: /some/jumpbox-deployment (not a git checkout)\n"))
+				})
+			})
+		})
 	})
 })
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type globalFlags struct {
 	Help      bool   `short:"h" long:"help"`
 	Debug     bool   `short:"d" long:"debug"     env:"BBL_DEBUG"`
@@ -8,6 +10,16 @@ type globalFlags struct {
 	StateDir  string `short:"s" long:"state-dir" env:"BBL_STATE_DIRECTORY"`
 	IAAS      string `          long:"iaas"      env:"BBL_IAAS"`
 
+	LockTimeout time.Duration `long:"lock-timeout" env:"BBL_LOCK_TIMEOUT"`
+
+	StateBackend               string `long:"state-backend"                     env:"BBL_STATE_BACKEND"`
+	StateBucket                string `long:"state-bucket"                      env:"BBL_STATE_BUCKET"`
+	StateBucketPrefix          string `long:"state-bucket-prefix"               env:"BBL_STATE_BUCKET_PREFIX"`
+	StateBucketEndpoint        string `long:"state-bucket-endpoint"             env:"BBL_STATE_BUCKET_ENDPOINT"`
+	StateBucketRegion          string `long:"state-bucket-region"               env:"BBL_STATE_BUCKET_REGION"`
+	StateBucketAccessKeyID     string `long:"state-bucket-access-key-id"        env:"BBL_STATE_BUCKET_ACCESS_KEY_ID"`
+	StateBucketSecretAccessKey string `long:"state-bucket-secret-access-key"    env:"BBL_STATE_BUCKET_SECRET_ACCESS_KEY"`
+
 	AWSAccessKeyID     string `long:"aws-access-key-id"       env:"BBL_AWS_ACCESS_KEY_ID"`
 	AWSSecretAccessKey string `long:"aws-secret-access-key"   env:"BBL_AWS_SECRET_ACCESS_KEY"`
 	AWSRegion          string `long:"aws-region"              env:"BBL_AWS_REGION"`
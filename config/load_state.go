@@ -23,6 +23,7 @@ type StateBootstrap interface {
 
 type migrator interface {
 	Migrate(storage.State) (storage.State, error)
+	LegacyTerraformOverrides(storage.State) ([]string, error)
 }
 
 type fs interface {
@@ -86,11 +87,23 @@ func (c Config) Bootstrap(args []string) (application.Configuration, error) {
 	}
 
 	if globalFlags.Version || command == "version" {
-		command = "version"
-		return application.Configuration{
+		config := application.Configuration{
 			ShowCommandHelp: globalFlags.Help,
-			Command:         command,
-		}, nil
+			Command:         "version",
+		}
+
+		// bbl version reports the deployments that the environment in the
+		// state dir uses, when there is one it can read.
+		if command == "version" && !globalFlags.Help {
+			state, err := c.stateBootstrap.GetState(globalFlags.StateDir)
+			if err != nil {
+				c.logger.Println(fmt.Sprintf("warning: could not read the state, so bbl version does not show its deployments: %s", err))
+			} else {
+				config.State = state
+			}
+		}
+
+		return config, nil
 	}
 
 	if len(remainingArgs) == 0 {
@@ -124,9 +137,17 @@ func (c Config) Bootstrap(args []string) (application.Configuration, error) {
 		return application.Configuration{}, err
 	}
 
-	state, err = c.migrator.Migrate(state)
-	if err != nil {
-		return application.Configuration{}, err
+	// bbl migrate previews, applies and reverts the migrations itself.
+	if command != "migrate" {
+		state, err = c.migrator.Migrate(state)
+		if err != nil {
+			return application.Configuration{}, err
+		}
+
+		err = c.warnAboutLegacyOverrides(state)
+		if err != nil {
+			return application.Configuration{}, err
+		}
 	}
 
 	state, err = c.updateIAASState(globalFlags, state)
@@ -146,7 +167,31 @@ func (c Config) Bootstrap(args []string) (application.Configuration, error) {
 	}, nil
 }
 
-func NeedsIAASCreds(command string) bool {
+func (c Config) warnAboutLegacyOverrides(state storage.State) error {
+	warnings, err := c.migrator.LegacyTerraformOverrides(state)
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range warnings {
+		c.logger.Println(fmt.Sprintf("warning: %s", warning))
+	}
+
+	return nil
+}
+
+// NeedsIAASCreds reports whether the command talks to the IAAS, which bbl
+// state import does when it plans the imported environment.
+func NeedsIAASCreds(command string, subcommandFlags []string) bool {
+	if command == "state" && len(subcommandFlags) > 0 && subcommandFlags[0] == "import" {
+		for _, flag := range subcommandFlags[1:] {
+			if flag == "--skip-plan" || flag == "-skip-plan" {
+				return false
+			}
+		}
+		return true
+	}
+
 	_, ok := map[string]struct{}{
 		"up":                {},
 		"down":              {},
@@ -155,6 +200,7 @@ func NeedsIAASCreds(command string) bool {
 		"leftovers":         {},
 		"cleanup-leftovers": {},
 		"rotate":            {},
+		"drift":             {},
 	}[command]
 	return ok
 }
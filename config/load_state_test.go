@@ -75,6 +75,32 @@ var _ = Describe("LoadState", func() {
 				})
 			})
 
+			Context("when version is passed as a command", func() {
+				It("loads the state so that it can report the deployments", func() {
+					fakeStateBootstrap.GetStateCall.Returns.State = storage.State{IAAS: "gcp"}
+
+					appConfig, err := c.Bootstrap([]string{"bbl", "version"})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(appConfig.Command).To(Equal("version"))
+					Expect(appConfig.State).To(Equal(storage.State{IAAS: "gcp"}))
+					Expect(fakeStateMigrator.MigrateCall.CallCount).To(Equal(0))
+				})
+
+				Context("when the state cannot be read", func() {
+					It("still sets the version command and warns that the deployments are not shown", func() {
+						fakeStateBootstrap.GetStateCall.Returns.Error = errors.New("kiwi")
+
+						appConfig, err := c.Bootstrap([]string{"bbl", "version"})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(appConfig.Command).To(Equal("version"))
+						Expect(appConfig.State).To(Equal(storage.State{}))
+						Expect(fakeLogger.PrintlnCall.Messages).To(ContainElement("warning: could not read the state, so bbl version does not show its deployments: kiwi"))
+					})
+				})
+			})
+
 			DescribeTable("subcommand help for help and version",
 				func(args []string, expectedCommand string) {
 					appConfig, err := c.Bootstrap(args)
@@ -204,6 +230,44 @@ var _ = Describe("LoadState", func() {
 				Expect(appConfig.State).To(Equal(migratedState))
 			})
 
+			Context("when override files use terraform 0.11 syntax", func() {
+				It("warns about them", func() {
+					fakeStateMigrator.LegacyTerraformOverridesCall.Returns.Warnings = []string{"terraform/my-override.tf uses terraform 0.11 syntax: tags block instead of a map on line 4"}
+
+					_, err := c.Bootstrap([]string{
+						"bbl",
+						"rotate",
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeStateMigrator.LegacyTerraformOverridesCall.Receives.State).To(Equal(migratedState))
+					Expect(fakeLogger.PrintlnCall.Messages).To(ContainElement("warning: terraform/my-override.tf uses terraform 0.11 syntax: tags block instead of a map on line 4"))
+				})
+
+				Context("when the override files cannot be read", func() {
+					It("returns an error", func() {
+						fakeStateMigrator.LegacyTerraformOverridesCall.Returns.Error = errors.New("papaya")
+
+						_, err := c.Bootstrap([]string{"bbl", "rotate"})
+						Expect(err).To(MatchError("papaya"))
+					})
+				})
+			})
+
+			Context("when the command is migrate", func() {
+				It("returns the state without migrating it", func() {
+					appConfig, err := c.Bootstrap([]string{
+						"bbl",
+						"migrate",
+						"--dry-run",
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeStateMigrator.MigrateCall.CallCount).To(Equal(0))
+					Expect(appConfig.State).To(Equal(gotState))
+				})
+			})
+
 			It("uses the working directory", func() {
 				appConfig, err := c.Bootstrap([]string{
 					"bbl",
@@ -1245,4 +1309,16 @@ var _ = Describe("LoadState", func() {
 				"Missing --openstack-region. To see all required credentials run `bbl plan --help`."),
 		)
 	})
+
+	DescribeTable("NeedsIAASCreds",
+		func(command string, subcommandFlags []string, expected bool) {
+			Expect(config.NeedsIAASCreds(command, subcommandFlags)).To(Equal(expected))
+		},
+		Entry("plan", "plan", []string{}, true),
+		Entry("up", "up", []string{"--no-director"}, true),
+		Entry("lbs", "lbs", []string{}, false),
+		Entry("state import", "state", []string{"import", "some-bundle.tgz"}, true),
+		Entry("state import --skip-plan", "state", []string{"import", "some-bundle.tgz", "--skip-plan"}, false),
+		Entry("state export", "state", []string{"export", "--output", "some-bundle.tgz"}, false),
+	)
 })
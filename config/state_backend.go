@@ -0,0 +1,38 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+type backendFs interface {
+	fileio.FileReader
+	fileio.FileWriter
+	fileio.Remover
+	fileio.AllMkdirer
+	fileio.DirReader
+}
+
+func GetStateBackend(globals globalFlags, fs backendFs) (storage.StateBackend, error) {
+	switch globals.StateBackend {
+	case "", "local":
+		return storage.NewLocalBackend(), nil
+	case "s3":
+		if globals.StateBucket == "" {
+			return nil, errors.New("--state-bucket must be provided when --state-backend is s3")
+		}
+		return storage.NewS3Backend(storage.S3BackendConfig{
+			Bucket:          globals.StateBucket,
+			Prefix:          globals.StateBucketPrefix,
+			Endpoint:        globals.StateBucketEndpoint,
+			Region:          globals.StateBucketRegion,
+			AccessKeyID:     globals.StateBucketAccessKeyID,
+			SecretAccessKey: globals.StateBucketSecretAccessKey,
+		}, fs), nil
+	default:
+		return nil, fmt.Errorf("Unknown state backend: %s", globals.StateBackend)
+	}
+}
@@ -0,0 +1,58 @@
+package config_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/config"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetStateBackend", func() {
+	var fileIO *fakes.FileIO
+
+	BeforeEach(func() {
+		fileIO = &fakes.FileIO{}
+	})
+
+	It("defaults to the local backend", func() {
+		globals, _, err := config.ParseArgs([]string{"bbl", "up"})
+		Expect(err).NotTo(HaveOccurred())
+
+		backend, err := config.GetStateBackend(globals, fileIO)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backend).To(Equal(storage.NewLocalBackend()))
+	})
+
+	Context("when --state-backend is s3", func() {
+		It("returns an s3 backend", func() {
+			globals, _, err := config.ParseArgs([]string{"bbl", "--state-backend", "s3", "--state-bucket", "some-bucket", "up"})
+			Expect(err).NotTo(HaveOccurred())
+
+			backend, err := config.GetStateBackend(globals, fileIO)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backend).To(BeAssignableToTypeOf(storage.S3Backend{}))
+		})
+
+		Context("when --state-bucket is missing", func() {
+			It("returns an error", func() {
+				globals, _, err := config.ParseArgs([]string{"bbl", "--state-backend", "s3", "up"})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = config.GetStateBackend(globals, fileIO)
+				Expect(err).To(MatchError("--state-bucket must be provided when --state-backend is s3"))
+			})
+		})
+	})
+
+	Context("when the state backend is unknown", func() {
+		It("returns an error", func() {
+			globals, _, err := config.ParseArgs([]string{"bbl", "--state-backend", "floppy", "up"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = config.GetStateBackend(globals, fileIO)
+			Expect(err).To(MatchError("Unknown state backend: floppy"))
+		})
+	})
+})
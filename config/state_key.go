@@ -0,0 +1,25 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+)
+
+func GetStateKey(fs fileio.FileReader) ([]byte, error) {
+	if passphrase := os.Getenv("BBL_STATE_PASSPHRASE"); passphrase != "" {
+		return []byte(passphrase), nil
+	}
+
+	if keyFile := os.Getenv("BBL_STATE_KEY_FILE"); keyFile != "" {
+		key, err := fs.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Reading state key file: %s", err)
+		}
+		return bytes.TrimRight(key, "\r\n"), nil
+	}
+
+	return nil, nil
+}
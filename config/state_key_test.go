@@ -0,0 +1,73 @@
+package config_test
+
+import (
+	"errors"
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/config"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetStateKey", func() {
+	var fileIO *fakes.FileIO
+
+	BeforeEach(func() {
+		fileIO = &fakes.FileIO{}
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("BBL_STATE_PASSPHRASE")
+		os.Unsetenv("BBL_STATE_KEY_FILE")
+	})
+
+	It("returns nothing when no key is configured", func() {
+		key, err := config.GetStateKey(fileIO)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(key).To(BeEmpty())
+	})
+
+	Context("when BBL_STATE_PASSPHRASE is set", func() {
+		It("returns the passphrase", func() {
+			os.Setenv("BBL_STATE_PASSPHRASE", "some-passphrase")
+
+			key, err := config.GetStateKey(fileIO)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(key)).To(Equal("some-passphrase"))
+		})
+	})
+
+	Context("when BBL_STATE_KEY_FILE is set", func() {
+		BeforeEach(func() {
+			os.Setenv("BBL_STATE_KEY_FILE", "/some/key/file")
+			fileIO.ReadFileCall.Returns.Contents = []byte("some-key")
+		})
+
+		It("returns the contents of the key file", func() {
+			key, err := config.GetStateKey(fileIO)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(key)).To(Equal("some-key"))
+
+			Expect(fileIO.ReadFileCall.Receives.Filename).To(Equal("/some/key/file"))
+		})
+
+		It("trims the trailing newline", func() {
+			fileIO.ReadFileCall.Returns.Contents = []byte("some-key\n")
+
+			key, err := config.GetStateKey(fileIO)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(key)).To(Equal("some-key"))
+		})
+
+		Context("when the key file cannot be read", func() {
+			It("returns an error", func() {
+				fileIO.ReadFileCall.Returns.Error = errors.New("banana")
+
+				_, err := config.GetStateKey(fileIO)
+				Expect(err).To(MatchError("Reading state key file: banana"))
+			})
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package config
+
+import "github.com/cloudfoundry/bosh-bootloader/storage"
+
+// StateLoader loads the state in the state dir again, the way bbl loads it
+// when it starts, with the credentials given by the flags and environment.
+type StateLoader struct {
+	config Config
+	args   []string
+}
+
+func NewStateLoader(config Config, args []string) StateLoader {
+	return StateLoader{
+		config: config,
+		args:   args,
+	}
+}
+
+func (s StateLoader) LoadState() (storage.State, error) {
+	appConfig, err := s.config.Bootstrap(s.args)
+	if err != nil {
+		return storage.State{}, err
+	}
+
+	return appConfig.State, nil
+}
@@ -0,0 +1,69 @@
+package config_test
+
+import (
+	"errors"
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/config"
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StateLoader", func() {
+	var (
+		stateBootstrap *fakes.StateBootstrap
+		stateMigrator  *fakes.StateMigrator
+		stateLoader    config.StateLoader
+	)
+
+	BeforeEach(func() {
+		stateBootstrap = &fakes.StateBootstrap{}
+		stateMigrator = &fakes.StateMigrator{}
+		os.Clearenv()
+
+		c := config.NewConfig(stateBootstrap, stateMigrator, &fakes.Logger{}, &fakes.FileIO{})
+		stateLoader = config.NewStateLoader(c, []string{
+			"bbl", "--state-dir", "/some/state-dir", "--aws-access-key-id", "some-key-id", "--aws-secret-access-key", "some-secret",
+			"state", "import", "some-bundle.tgz",
+		})
+	})
+
+	AfterEach(func() {
+		os.Clearenv()
+	})
+
+	Describe("LoadState", func() {
+		It("loads the state with the credentials of the flags", func() {
+			stateMigrator.MigrateCall.Returns.State = storage.State{
+				IAAS:  "aws",
+				EnvID: "some-env-id",
+				AWS:   storage.AWS{Region: "some-region"},
+			}
+
+			state, err := stateLoader.LoadState()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(stateBootstrap.GetStateCall.Receives.Dir).To(Equal("/some/state-dir"))
+			Expect(state).To(Equal(storage.State{
+				IAAS:  "aws",
+				EnvID: "some-env-id",
+				AWS: storage.AWS{
+					AccessKeyID:     "some-key-id",
+					SecretAccessKey: "some-secret",
+					Region:          "some-region",
+				},
+			}))
+		})
+
+		Context("when the state cannot be loaded", func() {
+			It("returns the error", func() {
+				stateBootstrap.GetStateCall.Returns.Error = errors.New("failed to read")
+
+				_, err := stateLoader.LoadState()
+				Expect(err).To(MatchError("failed to read"))
+			})
+		})
+	})
+})
@@ -53,6 +53,16 @@ type BOSHExecutor struct {
 		}
 	}
 
+	CheckDirectorFeatureVarsCall struct {
+		CallCount int
+		Receives  struct {
+			DirInput bosh.DirInput
+		}
+		Returns struct {
+			Error error
+		}
+	}
+
 	WriteDeploymentVarsCall struct {
 		CallCount int
 		Receives  struct {
@@ -64,6 +74,20 @@ type BOSHExecutor struct {
 		}
 	}
 
+	InterpolateCall struct {
+		CallCount int
+		Receives  struct {
+			DirInput      bosh.DirInput
+			DeploymentDir string
+			IAAS          string
+			VarsFile      string
+		}
+		Returns struct {
+			Manifest string
+			Error    error
+		}
+	}
+
 	PathCall struct {
 		CallCount int
 		Returns   struct {
@@ -88,6 +112,16 @@ func (e *BOSHExecutor) WriteDeploymentVars(input bosh.DirInput, deploymentVars s
 	return e.WriteDeploymentVarsCall.Returns.Error
 }
 
+func (e *BOSHExecutor) Interpolate(input bosh.DirInput, deploymentDir, iaas, varsFile string) (string, error) {
+	e.InterpolateCall.CallCount++
+	e.InterpolateCall.Receives.DirInput = input
+	e.InterpolateCall.Receives.DeploymentDir = deploymentDir
+	e.InterpolateCall.Receives.IAAS = iaas
+	e.InterpolateCall.Receives.VarsFile = varsFile
+
+	return e.InterpolateCall.Returns.Manifest, e.InterpolateCall.Returns.Error
+}
+
 func (e *BOSHExecutor) CreateEnv(input bosh.DirInput, state storage.State) (string, error) {
 	e.CreateEnvCall.CallCount++
 	e.CreateEnvCall.Receives.DirInput = input
@@ -122,6 +156,13 @@ func (e *BOSHExecutor) PlanDirector(input bosh.DirInput, deploymentDir, iaas str
 	return e.PlanDirectorCall.Returns.Error
 }
 
+func (e *BOSHExecutor) CheckDirectorFeatureVars(input bosh.DirInput) error {
+	e.CheckDirectorFeatureVarsCall.CallCount++
+	e.CheckDirectorFeatureVarsCall.Receives.DirInput = input
+
+	return e.CheckDirectorFeatureVarsCall.Returns.Error
+}
+
 func (e *BOSHExecutor) Path() string {
 	e.PathCall.CallCount++
 	return e.PathCall.Returns.Path
@@ -26,6 +26,15 @@ type BOSHManager struct {
 			Error error
 		}
 	}
+	ConnectToJumpboxCall struct {
+		CallCount int
+		Receives  struct {
+			State storage.State
+		}
+		Returns struct {
+			Error error
+		}
+	}
 	InitializeDirectorCall struct {
 		CallCount int
 		Receives  struct {
@@ -99,6 +108,28 @@ type BOSHManager struct {
 			Vars string
 		}
 	}
+	DiffJumpboxManifestCall struct {
+		CallCount int
+		Receives  struct {
+			State            storage.State
+			TerraformOutputs terraform.Outputs
+		}
+		Returns struct {
+			Diff  string
+			Error error
+		}
+	}
+	DiffDirectorManifestCall struct {
+		CallCount int
+		Receives  struct {
+			State            storage.State
+			TerraformOutputs terraform.Outputs
+		}
+		Returns struct {
+			Diff  string
+			Error error
+		}
+	}
 }
 
 func (b *BOSHManager) InitializeJumpbox(state storage.State) error {
@@ -114,6 +145,12 @@ func (b *BOSHManager) CreateJumpbox(state storage.State, terraformOutputs terraf
 	return b.CreateJumpboxCall.Returns.State, b.CreateJumpboxCall.Returns.Error
 }
 
+func (b *BOSHManager) ConnectToJumpbox(state storage.State) error {
+	b.ConnectToJumpboxCall.CallCount++
+	b.ConnectToJumpboxCall.Receives.State = state
+	return b.ConnectToJumpboxCall.Returns.Error
+}
+
 func (b *BOSHManager) InitializeDirector(state storage.State) error {
 	b.InitializeDirectorCall.CallCount++
 	b.InitializeDirectorCall.Receives.State = state
@@ -155,6 +192,20 @@ func (b *BOSHManager) GetJumpboxDeploymentVars(state storage.State, terraformOut
 	return b.GetJumpboxDeploymentVarsCall.Returns.Vars
 }
 
+func (b *BOSHManager) DiffJumpboxManifest(state storage.State, terraformOutputs terraform.Outputs) (string, error) {
+	b.DiffJumpboxManifestCall.CallCount++
+	b.DiffJumpboxManifestCall.Receives.State = state
+	b.DiffJumpboxManifestCall.Receives.TerraformOutputs = terraformOutputs
+	return b.DiffJumpboxManifestCall.Returns.Diff, b.DiffJumpboxManifestCall.Returns.Error
+}
+
+func (b *BOSHManager) DiffDirectorManifest(state storage.State, terraformOutputs terraform.Outputs) (string, error) {
+	b.DiffDirectorManifestCall.CallCount++
+	b.DiffDirectorManifestCall.Receives.State = state
+	b.DiffDirectorManifestCall.Receives.TerraformOutputs = terraformOutputs
+	return b.DiffDirectorManifestCall.Returns.Diff, b.DiffDirectorManifestCall.Returns.Error
+}
+
 func (b *BOSHManager) Path() string {
 	b.PathCall.CallCount++
 	return b.PathCall.Returns.Path
@@ -0,0 +1,18 @@
+package fakes
+
+import "github.com/cloudfoundry/bosh-bootloader/patches"
+
+type BuiltinPatches struct {
+	AvailableCall struct {
+		CallCount int
+		Returns   struct {
+			Infos []patches.Info
+		}
+	}
+}
+
+func (b *BuiltinPatches) Available() []patches.Info {
+	b.AvailableCall.CallCount++
+
+	return b.AvailableCall.Returns.Infos
+}
@@ -0,0 +1,44 @@
+package fakes
+
+import "github.com/cloudfoundry/bosh-bootloader/storage"
+
+type Bundler struct {
+	ExportCall struct {
+		CallCount int
+		Receives  struct {
+			Dir     string
+			Encrypt bool
+		}
+		Returns struct {
+			Bundle []byte
+			Error  error
+		}
+	}
+	ImportCall struct {
+		CallCount int
+		Receives  struct {
+			Dir    string
+			Bundle []byte
+		}
+		Returns struct {
+			Manifest storage.BundleManifest
+			Error    error
+		}
+	}
+}
+
+func (b *Bundler) Export(dir string, encrypt bool) ([]byte, error) {
+	b.ExportCall.CallCount++
+	b.ExportCall.Receives.Dir = dir
+	b.ExportCall.Receives.Encrypt = encrypt
+
+	return b.ExportCall.Returns.Bundle, b.ExportCall.Returns.Error
+}
+
+func (b *Bundler) Import(dir string, bundle []byte) (storage.BundleManifest, error) {
+	b.ImportCall.CallCount++
+	b.ImportCall.Receives.Dir = dir
+	b.ImportCall.Receives.Bundle = bundle
+
+	return b.ImportCall.Returns.Manifest, b.ImportCall.Returns.Error
+}
@@ -0,0 +1,39 @@
+package fakes
+
+type DeploymentSource struct {
+	CheckCall struct {
+		CallCount int
+		Receives  struct {
+			Dir      string
+			Manifest string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	RevisionCall struct {
+		CallCount int
+		Receives  struct {
+			Dir string
+		}
+		Returns struct {
+			Revision string
+			Error    error
+		}
+	}
+}
+
+func (d *DeploymentSource) Check(dir, manifest string) error {
+	d.CheckCall.CallCount++
+	d.CheckCall.Receives.Dir = dir
+	d.CheckCall.Receives.Manifest = manifest
+
+	return d.CheckCall.Returns.Error
+}
+
+func (d *DeploymentSource) Revision(dir string) (string, error) {
+	d.RevisionCall.CallCount++
+	d.RevisionCall.Receives.Dir = dir
+
+	return d.RevisionCall.Returns.Revision, d.RevisionCall.Returns.Error
+}
@@ -0,0 +1,29 @@
+package fakes
+
+type Fingerprinter struct {
+	FingerprintCall struct {
+		Stub      func(string, ...string) (string, error)
+		CallCount int
+		Receives  []FingerprintReceive
+		Returns   struct {
+			Fingerprint string
+			Error       error
+		}
+	}
+}
+
+type FingerprintReceive struct {
+	Phase  string
+	Values []string
+}
+
+func (f *Fingerprinter) Fingerprint(phase string, values ...string) (string, error) {
+	f.FingerprintCall.CallCount++
+	f.FingerprintCall.Receives = append(f.FingerprintCall.Receives, FingerprintReceive{Phase: phase, Values: values})
+
+	if f.FingerprintCall.Stub != nil {
+		return f.FingerprintCall.Stub(phase, values...)
+	}
+
+	return f.FingerprintCall.Returns.Fingerprint, f.FingerprintCall.Returns.Error
+}
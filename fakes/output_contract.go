@@ -0,0 +1,24 @@
+package fakes
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+)
+
+type OutputContract struct {
+	OutputsCall struct {
+		CallCount int
+		Receives  struct {
+			State storage.State
+		}
+		Returns struct {
+			Outputs []terraform.OutputSpec
+		}
+	}
+}
+
+func (o *OutputContract) Outputs(state storage.State) []terraform.OutputSpec {
+	o.OutputsCall.CallCount++
+	o.OutputsCall.Receives.State = state
+	return o.OutputsCall.Returns.Outputs
+}
@@ -0,0 +1,49 @@
+package fakes
+
+import "github.com/cloudfoundry/bosh-bootloader/storage"
+
+type OverrideTracker struct {
+	TrackCall struct {
+		CallCount int
+		Stub      func(stateDir string, overrides []storage.Override) ([]storage.Override, error)
+		Receives  struct {
+			StateDir  string
+			Overrides []storage.Override
+		}
+		Returns struct {
+			Overrides []storage.Override
+			Error     error
+		}
+	}
+	StaleCall struct {
+		CallCount int
+		Receives  struct {
+			StateDir  string
+			Overrides []storage.Override
+		}
+		Returns struct {
+			Stale []storage.Override
+			Error error
+		}
+	}
+}
+
+func (o *OverrideTracker) Track(stateDir string, overrides []storage.Override) ([]storage.Override, error) {
+	o.TrackCall.CallCount++
+	o.TrackCall.Receives.StateDir = stateDir
+	o.TrackCall.Receives.Overrides = overrides
+
+	if o.TrackCall.Stub != nil {
+		return o.TrackCall.Stub(stateDir, overrides)
+	}
+
+	return o.TrackCall.Returns.Overrides, o.TrackCall.Returns.Error
+}
+
+func (o *OverrideTracker) Stale(stateDir string, overrides []storage.Override) ([]storage.Override, error) {
+	o.StaleCall.CallCount++
+	o.StaleCall.Receives.StateDir = stateDir
+	o.StaleCall.Receives.Overrides = overrides
+
+	return o.StaleCall.Returns.Stale, o.StaleCall.Returns.Error
+}
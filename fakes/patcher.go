@@ -0,0 +1,72 @@
+package fakes
+
+import "github.com/cloudfoundry/bosh-bootloader/storage"
+
+type Patcher struct {
+	ApplyCall struct {
+		CallCount int
+		Stub      func(stateDir, patchDir string, patches []storage.Patch) ([]storage.Patch, error)
+		Receives  struct {
+			StateDir string
+			PatchDir string
+			Patches  []storage.Patch
+		}
+		Returns struct {
+			Patches []storage.Patch
+			Error   error
+		}
+	}
+	RemoveCall struct {
+		CallCount int
+		Receives  struct {
+			StateDir string
+			Name     string
+			Patches  []storage.Patch
+		}
+		Returns struct {
+			Patches []storage.Patch
+			Error   error
+		}
+	}
+	DriftCall struct {
+		CallCount int
+		Receives  struct {
+			StateDir string
+			Patches  []storage.Patch
+		}
+		Returns struct {
+			Drift []storage.PatchDrift
+			Error error
+		}
+	}
+}
+
+func (p *Patcher) Apply(stateDir, patchDir string, patches []storage.Patch) ([]storage.Patch, error) {
+	p.ApplyCall.CallCount++
+	p.ApplyCall.Receives.StateDir = stateDir
+	p.ApplyCall.Receives.PatchDir = patchDir
+	p.ApplyCall.Receives.Patches = patches
+
+	if p.ApplyCall.Stub != nil {
+		return p.ApplyCall.Stub(stateDir, patchDir, patches)
+	}
+
+	return p.ApplyCall.Returns.Patches, p.ApplyCall.Returns.Error
+}
+
+func (p *Patcher) Remove(stateDir, name string, patches []storage.Patch) ([]storage.Patch, error) {
+	p.RemoveCall.CallCount++
+	p.RemoveCall.Receives.StateDir = stateDir
+	p.RemoveCall.Receives.Name = name
+	p.RemoveCall.Receives.Patches = patches
+
+	return p.RemoveCall.Returns.Patches, p.RemoveCall.Returns.Error
+}
+
+func (p *Patcher) Drift(stateDir string, patches []storage.Patch) ([]storage.PatchDrift, error) {
+	p.DriftCall.CallCount++
+	p.DriftCall.Receives.StateDir = stateDir
+	p.DriftCall.Receives.Patches = patches
+
+	return p.DriftCall.Returns.Drift, p.DriftCall.Returns.Error
+}
@@ -57,6 +57,27 @@ type Plan struct {
 			IsInitialized bool
 		}
 	}
+	WarnAboutPatchDriftCall struct {
+		CallCount int
+		Receives  struct {
+			State storage.State
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	CheckOverridesCall struct {
+		CallCount int
+		Stub      func(commands.PlanConfig, storage.State) (storage.State, error)
+		Receives  struct {
+			Config commands.PlanConfig
+			State  storage.State
+		}
+		Returns struct {
+			State storage.State
+			Error error
+		}
+	}
 }
 
 func (p *Plan) CheckFastFails(subcommandFlags []string, state storage.State) error {
@@ -97,3 +118,22 @@ func (p *Plan) IsInitialized(state storage.State) bool {
 
 	return p.IsInitializedCall.Returns.IsInitialized
 }
+
+func (p *Plan) WarnAboutPatchDrift(state storage.State) error {
+	p.WarnAboutPatchDriftCall.CallCount++
+	p.WarnAboutPatchDriftCall.Receives.State = state
+
+	return p.WarnAboutPatchDriftCall.Returns.Error
+}
+
+func (p *Plan) CheckOverrides(config commands.PlanConfig, state storage.State) (storage.State, error) {
+	p.CheckOverridesCall.CallCount++
+	p.CheckOverridesCall.Receives.Config = config
+	p.CheckOverridesCall.Receives.State = state
+
+	if p.CheckOverridesCall.Stub != nil {
+		return p.CheckOverridesCall.Stub(config, state)
+	}
+
+	return p.CheckOverridesCall.Returns.State, p.CheckOverridesCall.Returns.Error
+}
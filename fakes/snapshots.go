@@ -0,0 +1,55 @@
+package fakes
+
+import "github.com/cloudfoundry/bosh-bootloader/storage"
+
+type Snapshots struct {
+	ListCall struct {
+		CallCount int
+		Returns   struct {
+			Snapshots []storage.Snapshot
+			Error     error
+		}
+	}
+	ReadCall struct {
+		CallCount int
+		Receives  []string
+		Returns   map[string]ReadSnapshotReturn
+	}
+	RestoreCall struct {
+		CallCount int
+		Receives  struct {
+			ID                    string
+			IncludeTerraformState bool
+		}
+		Returns struct {
+			Error error
+		}
+	}
+}
+
+type ReadSnapshotReturn struct {
+	Files map[string][]byte
+	Error error
+}
+
+func (s *Snapshots) List() ([]storage.Snapshot, error) {
+	s.ListCall.CallCount++
+
+	return s.ListCall.Returns.Snapshots, s.ListCall.Returns.Error
+}
+
+func (s *Snapshots) Read(id string) (map[string][]byte, error) {
+	s.ReadCall.CallCount++
+	s.ReadCall.Receives = append(s.ReadCall.Receives, id)
+
+	result := s.ReadCall.Returns[id]
+	return result.Files, result.Error
+}
+
+func (s *Snapshots) Restore(id string, includeTerraformState bool) error {
+	s.RestoreCall.CallCount++
+	s.RestoreCall.Receives.ID = id
+	s.RestoreCall.Receives.IncludeTerraformState = includeTerraformState
+
+	return s.RestoreCall.Returns.Error
+}
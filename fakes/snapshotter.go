@@ -0,0 +1,16 @@
+package fakes
+
+type Snapshotter struct {
+	TakeCall struct {
+		CallCount int
+		Returns   struct {
+			Error error
+		}
+	}
+}
+
+func (s *Snapshotter) Take() error {
+	s.TakeCall.CallCount++
+
+	return s.TakeCall.Returns.Error
+}
@@ -0,0 +1,36 @@
+package fakes
+
+type StateBackend struct {
+	PullCall struct {
+		CallCount int
+		Receives  struct {
+			Dir string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+	PushCall struct {
+		CallCount int
+		Receives  struct {
+			Dir string
+		}
+		Returns struct {
+			Error error
+		}
+	}
+}
+
+func (s *StateBackend) Pull(dir string) error {
+	s.PullCall.CallCount++
+	s.PullCall.Receives.Dir = dir
+
+	return s.PullCall.Returns.Error
+}
+
+func (s *StateBackend) Push(dir string) error {
+	s.PushCall.CallCount++
+	s.PushCall.Receives.Dir = dir
+
+	return s.PushCall.Returns.Error
+}
@@ -13,6 +13,32 @@ type StateMigrator struct {
 			Error error
 		}
 	}
+	LegacyTerraformOverridesCall struct {
+		CallCount int
+		Receives  struct {
+			State storage.State
+		}
+		Returns struct {
+			Warnings []string
+			Error    error
+		}
+	}
+	PlanCall struct {
+		CallCount int
+		Receives  struct {
+			State storage.State
+		}
+		Returns struct {
+			Migrations []storage.PlannedMigration
+		}
+	}
+	RevertCall struct {
+		CallCount int
+		Returns   struct {
+			Record storage.MigrationRecord
+			Error  error
+		}
+	}
 }
 
 func (s *StateMigrator) Migrate(state storage.State) (storage.State, error) {
@@ -21,3 +47,23 @@ func (s *StateMigrator) Migrate(state storage.State) (storage.State, error) {
 
 	return s.MigrateCall.Returns.State, s.MigrateCall.Returns.Error
 }
+
+func (s *StateMigrator) LegacyTerraformOverrides(state storage.State) ([]string, error) {
+	s.LegacyTerraformOverridesCall.CallCount++
+	s.LegacyTerraformOverridesCall.Receives.State = state
+
+	return s.LegacyTerraformOverridesCall.Returns.Warnings, s.LegacyTerraformOverridesCall.Returns.Error
+}
+
+func (s *StateMigrator) Plan(state storage.State) []storage.PlannedMigration {
+	s.PlanCall.CallCount++
+	s.PlanCall.Receives.State = state
+
+	return s.PlanCall.Returns.Migrations
+}
+
+func (s *StateMigrator) Revert() (storage.MigrationRecord, error) {
+	s.RevertCall.CallCount++
+
+	return s.RevertCall.Returns.Record, s.RevertCall.Returns.Error
+}
@@ -0,0 +1,19 @@
+package fakes
+
+import "github.com/cloudfoundry/bosh-bootloader/storage"
+
+type StateUnlocker struct {
+	ForceUnlockCall struct {
+		CallCount int
+		Returns   struct {
+			LockInfo storage.LockInfo
+			Error    error
+		}
+	}
+}
+
+func (s *StateUnlocker) ForceUnlock() (storage.LockInfo, error) {
+	s.ForceUnlockCall.CallCount++
+
+	return s.ForceUnlockCall.Returns.LockInfo, s.ForceUnlockCall.Returns.Error
+}
@@ -1,5 +1,7 @@
 package fakes
 
+import "github.com/cloudfoundry/bosh-bootloader/storage"
+
 type Import struct {
 	Addr string
 	ID   string
@@ -22,6 +24,15 @@ type TerraformExecutor struct {
 			Error error
 		}
 	}
+	SetupBackendCall struct {
+		CallCount int
+		Receives  struct {
+			Backend storage.TerraformBackend
+		}
+		Returns struct {
+			Error error
+		}
+	}
 	InitCall struct {
 		CallCount int
 		Receives  struct{}
@@ -38,6 +49,15 @@ type TerraformExecutor struct {
 			Error error
 		}
 	}
+	ApplyPlanCall struct {
+		CallCount int
+		Receives  struct {
+			PlanFile string
+		}
+		Returns struct {
+			Error error
+		}
+	}
 	DestroyCall struct {
 		CallCount int
 		Receives  struct {
@@ -48,12 +68,30 @@ type TerraformExecutor struct {
 		}
 	}
 	ValidateCall struct {
+		CallCount int
+		Returns   struct {
+			Error error
+		}
+	}
+	PlanCall struct {
 		CallCount int
 		Receives  struct {
 			Credentials map[string]string
+			PlanFile    string
 		}
 		Returns struct {
-			Error error
+			Output string
+			Error  error
+		}
+	}
+	RefreshPlanCall struct {
+		CallCount int
+		Receives  struct {
+			Credentials map[string]string
+		}
+		Returns struct {
+			Output string
+			Error  error
 		}
 	}
 	VersionCall struct {
@@ -82,6 +120,13 @@ type TerraformExecutor struct {
 			Error   error
 		}
 	}
+	SensitiveOutputsCall struct {
+		CallCount int
+		Returns   struct {
+			Sensitive map[string]bool
+			Error     error
+		}
+	}
 	IsPavedCall struct {
 		CallCount int
 		Returns   struct {
@@ -103,6 +148,12 @@ func (t *TerraformExecutor) Setup(template string, inputs map[string]interface{}
 	return t.SetupCall.Returns.Error
 }
 
+func (t *TerraformExecutor) SetupBackend(backend storage.TerraformBackend) error {
+	t.SetupBackendCall.CallCount++
+	t.SetupBackendCall.Receives.Backend = backend
+	return t.SetupBackendCall.Returns.Error
+}
+
 func (t *TerraformExecutor) Init() error {
 	t.InitCall.CallCount++
 	return t.InitCall.Returns.Error
@@ -114,18 +165,36 @@ func (t *TerraformExecutor) Apply(credentials map[string]string) error {
 	return t.ApplyCall.Returns.Error
 }
 
+func (t *TerraformExecutor) ApplyPlan(planFile string) error {
+	t.ApplyPlanCall.CallCount++
+	t.ApplyPlanCall.Receives.PlanFile = planFile
+	return t.ApplyPlanCall.Returns.Error
+}
+
 func (t *TerraformExecutor) Destroy(credentials map[string]string) error {
 	t.DestroyCall.CallCount++
 	t.DestroyCall.Receives.Credentials = credentials
 	return t.DestroyCall.Returns.Error
 }
 
-func (t *TerraformExecutor) Validate(credentials map[string]string) error {
+func (t *TerraformExecutor) Validate() error {
 	t.ValidateCall.CallCount++
-	t.ValidateCall.Receives.Credentials = credentials
 	return t.ValidateCall.Returns.Error
 }
 
+func (t *TerraformExecutor) Plan(credentials map[string]string, planFile string) (string, error) {
+	t.PlanCall.CallCount++
+	t.PlanCall.Receives.Credentials = credentials
+	t.PlanCall.Receives.PlanFile = planFile
+	return t.PlanCall.Returns.Output, t.PlanCall.Returns.Error
+}
+
+func (t *TerraformExecutor) RefreshPlan(credentials map[string]string) (string, error) {
+	t.RefreshPlanCall.CallCount++
+	t.RefreshPlanCall.Receives.Credentials = credentials
+	return t.RefreshPlanCall.Returns.Output, t.RefreshPlanCall.Returns.Error
+}
+
 func (t *TerraformExecutor) Version() (string, error) {
 	t.VersionCall.CallCount++
 	return t.VersionCall.Returns.Version, t.VersionCall.Returns.Error
@@ -152,6 +221,11 @@ func (t *TerraformExecutor) Outputs() (map[string]interface{}, error) {
 	return t.OutputsCall.Returns.Outputs, t.OutputsCall.Returns.Error
 }
 
+func (t *TerraformExecutor) SensitiveOutputs() (map[string]bool, error) {
+	t.SensitiveOutputsCall.CallCount++
+	return t.SensitiveOutputsCall.Returns.Sensitive, t.SensitiveOutputsCall.Returns.Error
+}
+
 func (t *TerraformExecutor) IsPaved() (bool, error) {
 	t.IsPavedCall.CallCount++
 	return t.IsPavedCall.Returns.IsPaved, t.IsPavedCall.Returns.Error
@@ -25,6 +25,17 @@ type TerraformManager struct {
 			Error    error
 		}
 	}
+	ApplyPlanCall struct {
+		CallCount int
+		Receives  struct {
+			BBLState storage.State
+			PlanFile string
+		}
+		Returns struct {
+			BBLState storage.State
+			Error    error
+		}
+	}
 	DestroyCall struct {
 		CallCount int
 		Receives  struct {
@@ -45,6 +56,27 @@ type TerraformManager struct {
 			Error    error
 		}
 	}
+	PlanCall struct {
+		CallCount int
+		Receives  struct {
+			BBLState storage.State
+			PlanFile string
+		}
+		Returns struct {
+			Changes []terraform.ResourceChange
+			Error   error
+		}
+	}
+	DriftCall struct {
+		CallCount int
+		Receives  struct {
+			BBLState storage.State
+		}
+		Returns struct {
+			Drift []terraform.ResourceDrift
+			Error error
+		}
+	}
 	ImportCall struct {
 		CallCount int
 		Receives  struct {
@@ -99,6 +131,14 @@ func (t *TerraformManager) Apply(bblState storage.State) (storage.State, error)
 	return t.ApplyCall.Returns.BBLState, t.ApplyCall.Returns.Error
 }
 
+func (t *TerraformManager) ApplyPlan(bblState storage.State, planFile string) (storage.State, error) {
+	t.ApplyPlanCall.CallCount++
+	t.ApplyPlanCall.Receives.BBLState = bblState
+	t.ApplyPlanCall.Receives.PlanFile = planFile
+
+	return t.ApplyPlanCall.Returns.BBLState, t.ApplyPlanCall.Returns.Error
+}
+
 func (t *TerraformManager) Destroy(bblState storage.State) (storage.State, error) {
 	t.DestroyCall.CallCount++
 	t.DestroyCall.Receives.BBLState = bblState
@@ -113,6 +153,21 @@ func (t *TerraformManager) Validate(bblState storage.State) (storage.State, erro
 	return t.ValidateCall.Returns.BBLState, t.ValidateCall.Returns.Error
 }
 
+func (t *TerraformManager) Plan(bblState storage.State, planFile string) ([]terraform.ResourceChange, error) {
+	t.PlanCall.CallCount++
+	t.PlanCall.Receives.BBLState = bblState
+	t.PlanCall.Receives.PlanFile = planFile
+
+	return t.PlanCall.Returns.Changes, t.PlanCall.Returns.Error
+}
+
+func (t *TerraformManager) Drift(bblState storage.State) ([]terraform.ResourceDrift, error) {
+	t.DriftCall.CallCount++
+	t.DriftCall.Receives.BBLState = bblState
+
+	return t.DriftCall.Returns.Drift, t.DriftCall.Returns.Error
+}
+
 func (t *TerraformManager) Import(bblState storage.State, outputs map[string]string) (storage.State, error) {
 	t.ImportCall.CallCount++
 	t.ImportCall.Receives.BBLState = bblState
@@ -0,0 +1,37 @@
+package fakes
+
+type Vault struct {
+	IsEnabledCall struct {
+		CallCount int
+		Returns   struct {
+			IsEnabled bool
+		}
+	}
+	SealCall struct {
+		CallCount int
+		Returns   struct {
+			Error error
+		}
+	}
+	UnsealCall struct {
+		CallCount int
+		Returns   struct {
+			Error error
+		}
+	}
+}
+
+func (v *Vault) IsEnabled() bool {
+	v.IsEnabledCall.CallCount++
+	return v.IsEnabledCall.Returns.IsEnabled
+}
+
+func (v *Vault) Seal() error {
+	v.SealCall.CallCount++
+	return v.SealCall.Returns.Error
+}
+
+func (v *Vault) Unseal() error {
+	v.UnsealCall.CallCount++
+	return v.UnsealCall.Returns.Error
+}
@@ -0,0 +1,28 @@
+package fakes
+
+type VMChecker struct {
+	VMExistsCall struct {
+		CallCount int
+		Stub      func(envID, cid string) (bool, error)
+		Receives  struct {
+			EnvID string
+			CID   string
+		}
+		Returns struct {
+			Exists bool
+			Error  error
+		}
+	}
+}
+
+func (v *VMChecker) VMExists(envID, cid string) (bool, error) {
+	v.VMExistsCall.CallCount++
+	v.VMExistsCall.Receives.EnvID = envID
+	v.VMExistsCall.Receives.CID = cid
+
+	if v.VMExistsCall.Stub != nil {
+		return v.VMExistsCall.Stub(envID, cid)
+	}
+
+	return v.VMExistsCall.Returns.Exists, v.VMExistsCall.Returns.Error
+}
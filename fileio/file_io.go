@@ -45,3 +45,7 @@ type AllRemover interface {
 type AllMkdirer interface {
 	MkdirAll(dir string, perm os.FileMode) error
 }
+
+type FileOpener interface {
+	OpenFile(name string, flag int, perm os.FileMode) (afero.File, error)
+}
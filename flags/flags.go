@@ -3,6 +3,7 @@ package flags
 import (
 	"flag"
 	"io/ioutil"
+	"strings"
 )
 
 type Flags struct {
@@ -27,6 +28,15 @@ func (f Flags) Bool(v *bool, name string) {
 	f.set.BoolVar(v, name, false, "")
 }
 
+func (f Flags) Int(v *int, name string, value int) {
+	f.set.IntVar(v, name, value, "")
+}
+
+// StringSlice collects the values of a flag that can be given more than once.
+func (f Flags) StringSlice(v *[]string, name string) {
+	f.set.Var((*stringSlice)(v), name, "")
+}
+
 func (f Flags) Parse(args []string) error {
 	return f.set.Parse(args)
 }
@@ -34,3 +44,14 @@ func (f Flags) Parse(args []string) error {
 func (f Flags) Args() []string {
 	return f.set.Args()
 }
+
+type stringSlice []string
+
+func (s *stringSlice) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringSlice) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
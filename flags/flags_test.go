@@ -12,12 +12,18 @@ var _ = Describe("Flags", func() {
 		f         flags.Flags
 		stringVal string
 		boolVal   bool
+		intVal    int
+		sliceVal  []string
 	)
 
 	BeforeEach(func() {
 		f = flags.New("test")
 		f.String(&stringVal, "string", "")
 		f.Bool(&boolVal, "bool")
+		f.Int(&intVal, "int", 0)
+
+		sliceVal = nil
+		f.StringSlice(&sliceVal, "slice")
 	})
 
 	Describe("Parse", func() {
@@ -32,6 +38,18 @@ var _ = Describe("Flags", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(boolVal).To(BeTrue())
 		})
+
+		It("can parse int flags", func() {
+			err := f.Parse([]string{"--int", "42"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(intVal).To(Equal(42))
+		})
+
+		It("can parse flags that are given more than once", func() {
+			err := f.Parse([]string{"--slice", "a=1", "--slice", "b=2"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sliceVal).To(Equal([]string{"a=1", "b=2"}))
+		})
 	})
 
 	Describe("Args", func() {
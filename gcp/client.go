@@ -25,6 +25,12 @@ func (c Client) ProjectID() string {
 	return c.projectID
 }
 
+// WithZone returns a client that looks up instances in zone.
+func (c Client) WithZone(zone string) Client {
+	c.zone = zone
+	return c
+}
+
 func (c Client) listInstances() (*compute.InstanceList, error) {
 	return c.computeClient.ListInstances(c.projectID, c.zone)
 }
@@ -117,3 +123,20 @@ func (c Client) isBoshDirector(metadata *compute.Metadata) bool {
 
 	return false
 }
+
+// VMExists reports whether an instance named cid exists in the zone of the
+// client, which should be the zone the jumpbox and director are deployed to.
+func (c Client) VMExists(envID, cid string) (bool, error) {
+	instanceList, err := c.listInstances()
+	if err != nil {
+		return false, fmt.Errorf("List instances: %s", err)
+	}
+
+	for _, instance := range instanceList.Items {
+		if instance.Name == cid {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
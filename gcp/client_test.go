@@ -137,4 +137,44 @@ not-a-bosh-managed-vm (not managed by bosh)`))
 			})
 		})
 	})
+
+	Describe("VMExists", func() {
+		BeforeEach(func() {
+			computeClient = &fakes.GCPComputeClient{}
+			client = gcp.NewClientWithInjectedComputeClient(computeClient, "some-project-id", "some-zone")
+
+			computeClient.ListInstancesCall.Returns.InstanceList = &compute.InstanceList{
+				Items: []*compute.Instance{{Name: "vm-some-cid"}},
+			}
+		})
+
+		It("looks for an instance with the cid as its name", func() {
+			exists, err := client.VMExists("some-env-id", "vm-some-cid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+
+			Expect(computeClient.ListInstancesCall.Receives.ProjectID).To(Equal("some-project-id"))
+			Expect(computeClient.ListInstancesCall.Receives.Zone).To(Equal("some-zone"))
+
+			exists, err = client.VMExists("some-env-id", "vm-some-other-cid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("looks in the zone it is given", func() {
+			_, err := client.WithZone("some-other-zone").VMExists("some-env-id", "vm-some-cid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(computeClient.ListInstancesCall.Receives.Zone).To(Equal("some-other-zone"))
+		})
+
+		Context("when listing the instances fails", func() {
+			It("returns an error", func() {
+				computeClient.ListInstancesCall.Returns.Error = errors.New("kiwi")
+
+				_, err := client.VMExists("some-env-id", "vm-some-cid")
+				Expect(err).To(MatchError("List instances: kiwi"))
+			})
+		})
+	})
 })
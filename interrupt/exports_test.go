@@ -0,0 +1,19 @@
+package interrupt
+
+import "os/exec"
+
+func Reset() {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	children = map[*exec.Cmd]struct{}{}
+	listening = false
+	interrupted = false
+}
+
+func Running() int {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return len(children)
+}
@@ -0,0 +1,13 @@
+package interrupt_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestInterrupt(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "interrupt")
+}
@@ -0,0 +1,116 @@
+// Package interrupt keeps track of the terraform, bosh and bbl processes
+// that bbl runs, so that an interrupted bbl can stop them and still save
+// its state instead of leaving them running behind it.
+package interrupt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+const DefaultTimeout = 5 * time.Minute
+
+var ErrInterrupted = errors.New("bbl was interrupted")
+
+var (
+	mutex       sync.Mutex
+	children    = map[*exec.Cmd]struct{}{}
+	listening   bool
+	interrupted bool
+)
+
+// Run starts cmd and waits for it to exit. While bbl listens for signals,
+// cmd runs in a process group of its own, so that a Ctrl-C in the terminal
+// reaches bbl alone. Otherwise it stays in the group of bbl and is
+// interrupted along with it. Once bbl has been interrupted, Run refuses to
+// start anything new.
+func Run(cmd *exec.Cmd) error {
+	mutex.Lock()
+	if interrupted {
+		mutex.Unlock()
+		return ErrInterrupted
+	}
+
+	if listening {
+		setProcessGroup(cmd)
+	}
+
+	err := cmd.Start()
+	if err != nil {
+		mutex.Unlock()
+		return err
+	}
+	children[cmd] = struct{}{}
+	mutex.Unlock()
+
+	err = cmd.Wait()
+
+	mutex.Lock()
+	delete(children, cmd)
+	mutex.Unlock()
+
+	return err
+}
+
+// Interrupted reports whether bbl has received a signal.
+func Interrupted() bool {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return interrupted
+}
+
+// Listen starts handling the signals that bbl receives. The first one is
+// passed on to the running processes, so that the command fails and saves
+// the state it has. The processes are killed and stop is called when they
+// have not stopped within timeout, or right away on a second signal. After
+// that, another signal stops bbl as usual.
+func Listen(signals <-chan os.Signal, timeout time.Duration, stderr io.Writer, stop func()) {
+	mutex.Lock()
+	listening = true
+	mutex.Unlock()
+
+	go listen(signals, timeout, stderr, stop)
+}
+
+func listen(signals <-chan os.Signal, timeout time.Duration, stderr io.Writer, stop func()) {
+	sig := <-signals
+
+	mutex.Lock()
+	interrupted = true
+	running := len(children)
+	for cmd := range children {
+		signalProcess(cmd, sig)
+	}
+	mutex.Unlock()
+
+	if running > 0 {
+		fmt.Fprintf(stderr, "\nReceived %s, waiting up to %s for terraform and bosh to stop. Press Ctrl-C again to stop them now.\n", sig, timeout)
+	} else {
+		fmt.Fprintf(stderr, "\nReceived %s, stopping. Press Ctrl-C again to stop now.\n", sig)
+	}
+
+	select {
+	case <-time.After(timeout):
+		fmt.Fprintln(stderr, "Timed out waiting for terraform and bosh to stop, killing them. Press Ctrl-C again to exit without saving the state.")
+	case <-signals:
+		fmt.Fprintln(stderr, "Received a second signal, killing terraform and bosh. Press Ctrl-C again to exit without saving the state.")
+	}
+
+	killAll()
+	stop()
+}
+
+func killAll() {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	for cmd := range children {
+		killProcess(cmd)
+	}
+}
@@ -0,0 +1,123 @@
+package interrupt_test
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/interrupt"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("interrupt", func() {
+	var (
+		signals chan os.Signal
+		stderr  *gbytes.Buffer
+		stopped chan struct{}
+	)
+
+	BeforeEach(func() {
+		interrupt.Reset()
+
+		signals = make(chan os.Signal, 2)
+		stderr = gbytes.NewBuffer()
+		stopped = make(chan struct{}, 1)
+	})
+
+	stop := func() {
+		stopped <- struct{}{}
+	}
+
+	runInBackground := func(cmd *exec.Cmd) chan error {
+		done := make(chan error, 1)
+		go func() {
+			done <- interrupt.Run(cmd)
+		}()
+		Eventually(interrupt.Running).Should(Equal(1))
+		return done
+	}
+
+	// runIgnoringTERM runs a command that ignores SIGTERM and waits until it
+	// has set up its trap.
+	runIgnoringTERM := func() chan error {
+		stdout := gbytes.NewBuffer()
+		cmd := exec.Command("sh", "-c", `trap "" TERM; echo trapped; sleep 10`)
+		cmd.Stdout = stdout
+		done := runInBackground(cmd)
+		Eventually(stdout).Should(gbytes.Say("trapped"))
+		return done
+	}
+
+	It("runs the command in the process group of bbl", func() {
+		cmd := exec.Command("true")
+		err := interrupt.Run(cmd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(interrupt.Interrupted()).To(BeFalse())
+		Expect(cmd.SysProcAttr).To(BeNil())
+	})
+
+	Context("when bbl listens for signals", func() {
+		It("runs the command in a process group of its own", func() {
+			interrupt.Listen(signals, time.Minute, stderr, stop)
+
+			cmd := exec.Command("true")
+			err := interrupt.Run(cmd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmd.SysProcAttr.Setpgid).To(BeTrue())
+		})
+	})
+
+	Context("when bbl receives a signal", func() {
+		It("passes it on to the running commands", func() {
+			interrupt.Listen(signals, time.Minute, stderr, stop)
+			done := runInBackground(exec.Command("sleep", "10"))
+
+			signals <- syscall.SIGTERM
+
+			Eventually(done).Should(Receive(MatchError("signal: terminated")))
+			Expect(interrupt.Interrupted()).To(BeTrue())
+			Eventually(stderr).Should(gbytes.Say("Received terminated, waiting up to 1m0s for terraform and bosh to stop."))
+			Consistently(stopped).ShouldNot(Receive())
+		})
+
+		It("does not start new commands", func() {
+			interrupt.Listen(signals, time.Minute, stderr, stop)
+			signals <- os.Interrupt
+			Eventually(interrupt.Interrupted).Should(BeTrue())
+
+			err := interrupt.Run(exec.Command("true"))
+			Expect(err).To(Equal(interrupt.ErrInterrupted))
+		})
+
+		Context("when the commands do not stop in time", func() {
+			It("kills them and stops listening", func() {
+				interrupt.Listen(signals, 100*time.Millisecond, stderr, stop)
+				done := runIgnoringTERM()
+
+				signals <- syscall.SIGTERM
+
+				Eventually(done).Should(Receive(MatchError("signal: killed")))
+				Eventually(stopped).Should(Receive())
+				Expect(stderr).To(gbytes.Say("Timed out waiting for terraform and bosh to stop, killing them."))
+				Expect(stderr).NotTo(gbytes.Say("Received a second signal"))
+			})
+		})
+
+		Context("when bbl receives a second signal", func() {
+			It("kills the commands and stops listening", func() {
+				interrupt.Listen(signals, time.Minute, stderr, stop)
+				done := runIgnoringTERM()
+
+				signals <- syscall.SIGTERM
+				signals <- syscall.SIGTERM
+
+				Eventually(stopped).Should(Receive())
+				Eventually(done).Should(Receive(MatchError("signal: killed")))
+			})
+		})
+	})
+})
@@ -0,0 +1,18 @@
+package interrupt
+
+import (
+	"os"
+	"os/exec"
+)
+
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// signalProcess kills the process, since windows cannot deliver an
+// interrupt to another process.
+func signalProcess(cmd *exec.Cmd, sig os.Signal) {
+	cmd.Process.Kill()
+}
+
+func killProcess(cmd *exec.Cmd) {
+	cmd.Process.Kill()
+}
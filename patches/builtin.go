@@ -0,0 +1,104 @@
+package patches
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Info names an embedded plan patch and says what it is for.
+type Info struct {
+	Name        string
+	Description string
+}
+
+// Builtin reads the plan patches from the plan-patches directory that are
+// embedded in bbl, so that a patch always matches the templates of the bbl
+// that applies it.
+type Builtin struct{}
+
+var readmeRow = regexp.MustCompile(`(?m)^\| \[([^\]]+)\]\([^)]*\) \| (.*?) \|$`)
+
+func NewBuiltin() Builtin {
+	return Builtin{}
+}
+
+// Available lists the embedded patches with the purpose that
+// plan-patches/README.md gives for each.
+func (b Builtin) Available() []Info {
+	descriptions := map[string]string{}
+	readme, err := Asset("README.md")
+	if err == nil {
+		for _, row := range readmeRow.FindAllStringSubmatch(string(readme), -1) {
+			descriptions[row[1]] = row[2]
+		}
+	}
+
+	infos := []Info{}
+	names, _ := AssetDir("")
+	for _, name := range names {
+		if _, err := AssetInfo(name); err == nil {
+			continue
+		}
+		infos = append(infos, Info{Name: name, Description: descriptions[name]})
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// ReadDir lists an embedded directory the way ioutil.ReadDir lists one on
+// disk, so that the patcher can copy an embedded patch like any other.
+func (b Builtin) ReadDir(dirname string) ([]os.FileInfo, error) {
+	dir := assetPath(dirname)
+
+	names, err := AssetDir(dir)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: dirname, Err: os.ErrNotExist}
+	}
+
+	infos := []os.FileInfo{}
+	for _, name := range names {
+		info, err := AssetInfo(path.Join(dir, name))
+		if err != nil {
+			infos = append(infos, fileInfo{name: name, mode: os.ModeDir | 0750})
+			continue
+		}
+		infos = append(infos, fileInfo{name: name, size: info.Size(), mode: info.Mode(), modTime: info.ModTime()})
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name() < infos[j].Name() })
+	return infos, nil
+}
+
+func (b Builtin) ReadFile(filename string) ([]byte, error) {
+	contents, err := Asset(assetPath(filename))
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: filename, Err: os.ErrNotExist}
+	}
+	return contents, nil
+}
+
+func assetPath(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+filepath.ToSlash(name)), "/")
+}
+
+// fileInfo names an asset by its base name, as os.FileInfo does, where
+// AssetInfo gives the whole asset path.
+type fileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (f fileInfo) Name() string       { return f.name }
+func (f fileInfo) Size() int64        { return f.size }
+func (f fileInfo) Mode() os.FileMode  { return f.mode }
+func (f fileInfo) ModTime() time.Time { return f.modTime }
+func (f fileInfo) IsDir() bool        { return f.mode.IsDir() }
+func (f fileInfo) Sys() interface{}   { return nil }
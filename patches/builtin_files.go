@@ -0,0 +1,1631 @@
+// Code generated for package patches by go-bindata DO NOT EDIT. (@generated)
+// sources:
+// ../plan-patches/1-az-aws/PATCH.md
+// ../plan-patches/1-az-aws/vars/zone.tfvars
+// ../plan-patches/README.md
+// ../plan-patches/acm-aws/README.md
+// ../plan-patches/acm-aws/terraform/cert_override.tf
+// ../plan-patches/acm-aws/terraform/dns_override.tf
+// ../plan-patches/acm-aws/terraform/lb_override.tf
+// ../plan-patches/alb-aws/README.md
+// ../plan-patches/alb-aws/cloud-config/lb-ops.yml
+// ../plan-patches/alb-aws/terraform/cf-lb_override.tf
+// ../plan-patches/bosh-lite-gcp/README.md
+// ../plan-patches/bosh-lite-gcp/cloud-config/bosh-lite.yml
+// ../plan-patches/bosh-lite-gcp/create-director-override.sh
+// ../plan-patches/bosh-lite-gcp/create-jumpbox-override.sh
+// ../plan-patches/bosh-lite-gcp/delete-director-override.sh
+// ../plan-patches/bosh-lite-gcp/delete-jumpbox-override.sh
+// ../plan-patches/bosh-lite-gcp/external-ip-gcp.yml
+// ../plan-patches/bosh-lite-gcp/ip-forwarding.yml
+// ../plan-patches/bosh-lite-gcp/terraform/bosh-lite_override.tf
+// ../plan-patches/byobastion-gcp/README.md
+// ../plan-patches/byobastion-gcp/create-director-override.sh
+// ../plan-patches/byobastion-gcp/create-jumpbox-override.sh
+// ../plan-patches/byobastion-gcp/delete-director-override.sh
+// ../plan-patches/byobastion-gcp/delete-jumpbox-override.sh
+// ../plan-patches/byobastion-gcp/terraform/bastion_override.tf
+// ../plan-patches/byobastion-gcp/vars/bastion.tfvars
+// ../plan-patches/cfcr-aws/README.md
+// ../plan-patches/cfcr-aws/cfcr-ops.yml
+// ../plan-patches/cfcr-aws/cloud-config/cfcr-cloud-config-ops.yml
+// ../plan-patches/cfcr-aws/terraform/cfcr_dns_override.tf
+// ../plan-patches/cfcr-aws/terraform/cfcr_iam_override.tf
+// ../plan-patches/cfcr-aws/terraform/cfcr_lb_override.tf
+// ../plan-patches/cfcr-aws/terraform/cfcr_outputs_override.tf
+// ../plan-patches/cfcr-aws/vars/cfcr.tfvars
+// ../plan-patches/cfcr-gcp/README.md
+// ../plan-patches/cfcr-gcp/cfcr-ops.yml
+// ../plan-patches/cfcr-gcp/cloud-config/cfcr-cloud-config-ops.yml
+// ../plan-patches/cfcr-gcp/terraform/cfcr_dns_override.tf
+// ../plan-patches/cfcr-gcp/terraform/cfcr_iam_override.tf
+// ../plan-patches/cfcr-gcp/terraform/cfcr_lb_override.tf
+// ../plan-patches/cfcr-gcp/terraform/cfcr_outputs_override.tf
+// ../plan-patches/cfcr-gcp/vars/cfcr.tfvars
+// ../plan-patches/cfcr-openstack/README.md
+// ../plan-patches/cfcr-openstack/cloud-config/cfcr-overrides.yml
+// ../plan-patches/cfcr-vsphere/README.md
+// ../plan-patches/cfcr-vsphere/cloud-config/cfcr-overrides.yml
+// ../plan-patches/cfcr-vsphere/terraform/outputs-override.tf
+// ../plan-patches/colocate-gorouter-ssh-proxy-gcp/cloud-config/colocated-gorouter-ssh-proxy.yml
+// ../plan-patches/colocate-gorouter-ssh-proxy-gcp/terraform/colocated-gorouter-ssh-proxy_override.tf
+// ../plan-patches/iam-profile-aws/README.md
+// ../plan-patches/iam-profile-aws/vars/iam.tfvars
+// ../plan-patches/iso-segs-aws/README.md
+// ../plan-patches/iso-segs-aws/cloud-config/iso-segs-ops.yml
+// ../plan-patches/iso-segs-gcp/README.md
+// ../plan-patches/iso-segs-gcp/cloud-config/routing-iso-segs.yml
+// ../plan-patches/iso-segs-gcp/terraform/routing-iso-segs.tf
+// ../plan-patches/restricted-instance-groups-gcp/README.md
+// ../plan-patches/restricted-instance-groups-gcp/terraform/restricted_instance_groups_override.tf
+package patches
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+// Name return file name
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+
+// Size return file size
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+
+// Mode return file mode
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+
+// Mode return file modify time
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+
+// IsDir return file whether a directory
+func (fi bindataFileInfo) IsDir() bool {
+	return fi.mode&os.ModeDir != 0
+}
+
+// Sys return file is sys mode
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var __1AzAwsPatchMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\xc1\x6e\xdb\x30\x10\x44\xef\xfc\x8a\x01\xda\x93\xd0\xc8\xf0\xd5\x37\x27\x0d\x0a\x03\x45\x5a\x58\x2e\x72\x34\x57\x2a\x15\xb1\x65\xb9\x02\x77\x19\x41\x29\xfa\xef\x05\xad\x34\x70\x61\x9f\x24\x72\x31\xb3\x6f\x38\xef\xf0\x95\xb4\x1b\x36\x58\xdf\xd0\xcb\x0d\x4d\x62\xcc\x61\xf0\x82\xb1\xdc\xc2\x0b\x7a\x4e\xc8\xe2\xe3\x13\x08\xe5\x13\x1c\xe8\x99\x7c\xa0\xd6\x07\xaf\x33\x5e\x38\x3a\xf4\x89\x7f\xc1\xde\xde\x7e\x3e\x6e\x1f\x9b\xe3\xfe\xfe\xd3\xee\xcb\x83\xad\x8d\x69\xd4\x8d\xb2\x31\x66\x5d\x63\x9f\x23\x6c\xdb\x06\x8c\x81\x62\x99\xad\x6b\xdc\xf1\x38\x43\x07\x07\xfb\x4c\x49\x56\xc5\xaa\xd6\xbe\xfc\x5b\xf8\xa8\x0c\xfb\xfe\x77\x31\x6d\x0e\xdb\xc3\xfd\xf1\xe3\x6e\xff\x67\x55\x86\xab\x57\xf9\x37\x71\x8b\xba\x6d\xc3\xab\xae\xfe\x21\x1c\x2d\x94\x21\x6e\x19\x06\x2f\x0a\xee\x31\xb2\x88\x6f\xaf\xd1\x4b\x8d\xbb\x81\x59\x1c\x4a\x14\x8a\xdf\x21\x4e\xe1\x15\x3e\x9e\x1c\x28\x25\x9a\xcb\xc1\x9e\x03\xd6\xff\xa7\xca\x63\x81\xaa\xaa\x07\x56\xb7\xa9\x2a\xec\x7a\xcc\x9c\xf1\x33\xf2\x84\x69\x20\xbd\xb2\x16\x94\xde\x68\x82\x2b\x0b\x66\xce\x09\xdd\xc0\xe2\xa2\xd9\x3e\x36\x48\xee\xc9\x73\xfc\x70\x72\xea\x28\x82\x82\x30\xba\xe5\xd1\xde\x3a\x2a\xc0\xdd\xc2\x4f\xf1\x72\x8d\x51\xc6\x98\xf5\x82\xff\xa4\xfb\x67\x9c\xce\x62\x60\xf2\x3a\x70\x56\xf4\x3e\x89\x22\xe5\x18\x4b\xfb\xe7\xdd\xfd\x1d\x00\xe5\xbc\xf2\xa9\x35\x02\x00\x00")
+
+func _1AzAwsPatchMdBytes() ([]byte, error) {
+	return bindataRead(
+		__1AzAwsPatchMd,
+		"1-az-aws/PATCH.md",
+	)
+}
+
+func _1AzAwsPatchMd() (*asset, error) {
+	bytes, err := _1AzAwsPatchMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1-az-aws/PATCH.md", size: 565, mode: os.FileMode(480), modTime: time.Unix(1792208213, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __1AzAwsVarsZoneTfvars = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x18\x00\xe7\xff\x61\x76\x61\x69\x6c\x61\x62\x69\x6c\x69\x74\x79\x5f\x7a\x6f\x6e\x65\x73\x3d\x5b\x22\x22\x5d\x0a\x03\x00\x0d\xe7\xad\xc3\x18\x00\x00\x00")
+
+func _1AzAwsVarsZoneTfvarsBytes() ([]byte, error) {
+	return bindataRead(
+		__1AzAwsVarsZoneTfvars,
+		"1-az-aws/vars/zone.tfvars",
+	)
+}
+
+func _1AzAwsVarsZoneTfvars() (*asset, error) {
+	bytes, err := _1AzAwsVarsZoneTfvarsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1-az-aws/vars/zone.tfvars", size: 24, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _readmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xdf\x8f\xdb\x36\x12\x7e\xd7\x5f\x31\x40\x1f\xea\x2e\x56\x5e\x1c\xfa\x96\x3b\x1c\xe0\x6c\x36\xcd\xe2\x9a\xc6\x88\x73\xbd\x87\xc2\x80\x46\xd4\x48\x62\x97\x22\x09\x0e\x65\x57\x8b\xfd\xe3\x0f\x43\x51\x5e\x7b\xd3\x34\xb9\x87\x7b\xb2\x48\x0f\xbf\x19\x7e\xdf\xfc\xe0\x77\xdf\xc1\xd6\xa0\x85\x2d\x46\xd5\x13\x17\x45\x5a\xf9\x79\x05\x0a\x2d\xd4\x04\x23\x53\x03\xd1\x81\x1a\x39\xba\x41\x3f\x12\xc4\x9e\xe0\x7e\xb3\xd9\x15\x64\x0f\x3a\x38\x3b\x90\x8d\x80\xb6\x81\xda\x71\x0f\x8d\x0e\xa4\xa2\x0b\x10\x7b\x8c\xa0\x19\x54\x20\x8c\xd4\x40\x3d\x15\x55\x5d\x1b\x18\x7d\xb5\x2e\x8a\xcd\xec\x47\x0c\xf0\x74\x66\x82\xa3\x8e\x3d\x20\x30\x45\x70\x2d\xb4\xda\x10\x83\x0b\x1d\x5a\xfd\x48\x0d\x68\x9b\x9c\x33\x0e\x04\xbd\xa6\x80\x41\xf5\x13\x20\xa7\xdd\xba\x36\x25\x47\x8c\x24\x70\xeb\xd9\x57\xe3\x8e\xb6\x82\xa3\xb3\xdf\x47\x68\xc8\x50\xa4\x8c\x99\x82\x4b\x16\xba\x91\x3f\xe7\x20\xaf\xd3\x35\x96\x28\xe1\xa8\x8d\x81\x01\x1f\x08\xd0\x02\xb5\xad\x0b\x51\x98\x18\x28\x74\x04\x93\x1b\x03\x44\x0a\x01\x5b\x17\x06\x70\x07\x0a\x41\x37\xc4\x09\x02\xbd\x37\xd3\x6c\xf2\xfb\x38\xf8\xda\xfd\x71\x7d\xba\xe4\xec\x44\x19\x37\x36\xa5\x72\xb6\xd5\x1d\x38\xcf\x39\x2e\x1f\xdc\x41\x37\xc2\xb8\x04\x18\x7b\x9a\xbe\x0f\xb4\xdc\x3b\xe8\xae\x8f\x27\x1c\x4d\xbc\x2e\x8a\xf7\x68\x27\xa1\x2a\xf6\xc4\x04\x3d\x1e\x08\xb0\x69\x74\xd4\xce\xa2\x01\x1f\xc8\x03\x47\xf2\xc2\x22\xb0\x27\xa5\x5b\xad\x12\x2d\x1c\x03\xe1\x90\x35\x23\x6f\xdc\x24\x3a\xb2\xf8\x1a\xb4\x6d\xae\x81\x9d\xc8\xcf\x63\x20\xb9\x74\x20\x94\xa0\x08\xaa\x8f\x77\x9b\x37\xef\xef\xd6\x43\x53\x65\xbf\x59\xc9\xc9\x8d\x12\x6b\x0c\x93\xb6\x9d\x1c\x49\x24\xac\x8b\xe2\x53\xfe\x04\x9c\x2d\xaf\xc1\x23\x33\xe8\xc8\xa7\xbb\x4c\x62\x9f\x78\xf7\x06\x6d\xb5\x86\x4f\x3d\x41\x6b\xb0\x5b\xb2\xb0\xd3\x07\xb2\x30\x38\x71\xd0\xa3\x05\x67\x15\xbd\x2a\x8a\xaa\xaa\x8a\xe5\x14\x94\x65\x82\x4f\x8b\x32\x67\xf1\x8d\x6a\x55\x28\xf1\xc8\xc9\x6c\xf4\xe9\x44\xf1\x69\x09\x9a\x18\x6a\x32\xee\x08\x18\x08\xd0\xc8\x95\x47\x6d\x22\x68\x1b\x1d\xd4\xb5\x49\x2c\x88\x0a\x80\xe6\x88\x13\xc3\x20\xb0\x89\x87\x48\x83\x37\x18\x25\x3f\xdb\x25\xff\x24\xb6\x98\x2e\xab\x53\x8e\xd1\x90\xf3\x70\x71\x86\x07\xd4\x06\x6b\x43\x15\x18\xcd\x71\xb6\x59\xb2\x4e\x3c\x6b\xfb\xea\x1f\x16\x07\xfa\x67\x75\x82\x71\x96\x52\x59\xb8\x31\x02\x82\xea\x49\x3d\xc8\x67\xe2\x5e\x33\x04\xf2\xee\x4b\x4c\x2c\x90\x9a\x5d\xc9\xd4\x71\x22\x22\x31\x20\xc1\x2a\xe7\x73\x98\x39\xf9\x2e\xe4\x4c\x14\xc8\x7f\xa7\x9a\x4a\x61\x06\x52\x2e\x34\x7c\x61\x08\xd5\xa9\xf6\xd6\xbf\xb3\x13\xfd\x3e\x8e\x67\xa5\xaa\xdc\x30\xc8\xd9\x5c\xdc\x96\x8e\x14\xe0\x40\x81\xb5\xb3\x97\x39\x14\x1d\x8c\xbe\x0b\xd8\x10\xe8\xf8\x82\x3b\x21\xac\x02\xee\xdd\x71\xf6\x3e\xf3\xd3\x2c\xff\xcf\x2c\x3e\x5f\x26\x49\x71\xa4\x40\x40\x8d\x96\xfe\xe3\x02\x04\x1a\xdc\x81\x1a\x60\x6d\x95\x24\x12\x4d\xb3\x45\x86\x7a\xe1\x70\xb6\x86\x45\x8f\x79\xf9\x82\xaf\x9c\xd2\x7f\x87\x30\xda\xb3\x04\xbe\xec\x23\xd8\x46\x0a\x47\x4c\xb4\x2d\xb5\x20\x28\xaa\x47\xdb\xd1\x59\x33\x54\x68\xad\x8b\x22\xa9\x41\x45\x67\x9e\xd2\x65\xce\xe0\x3b\xb2\x14\x24\xf9\xae\x81\x47\xd5\x4b\x0b\xac\x4e\xbd\xe8\x46\xd4\x58\xd2\x73\x1d\xdb\x4a\x6a\xbf\x3a\x6f\x38\x37\xe7\x8b\xf5\x34\x98\x6a\x5d\xe4\x21\x90\x83\x4a\xbe\x93\x15\xe4\x1e\x35\x8b\x67\x4f\xbd\x4a\x1a\xc5\x05\x68\x75\x2d\x61\xfc\x26\x0d\xa5\x34\x3a\x52\xd9\x29\xbf\x5f\x5d\x2c\x6f\x7e\x80\xc6\x49\xd0\xc7\x5e\xab\x5e\x0a\xec\x94\xe5\x89\xa3\xe4\x75\xb9\x5b\x03\x95\xf3\x9c\xa3\x4b\x25\x1b\xdb\xb2\x46\xf5\x40\xf6\x24\x7a\xaa\x5b\xa1\xec\x65\xd9\x9e\xf8\x3f\xaf\x07\xd1\xb0\x1d\x99\xce\x64\xa8\x9e\x31\xa5\x38\x66\xaa\xce\xf6\x3a\xe5\x2b\x09\x97\x62\x4f\x01\xda\xe0\x86\xe7\x42\x4d\xc6\x69\xeb\x0b\x65\xb9\x86\x7f\x33\x5d\x84\x71\xd2\x68\x71\x00\xfc\x63\x82\xf9\x6b\xa3\x4e\x71\x05\xda\x72\x24\x6c\x16\xf2\x66\x59\xc6\x90\x33\x72\x31\xd5\x91\xc9\xb4\x29\xff\x06\xdd\x09\x8f\x9c\xdb\x55\x06\xcd\xf5\x7c\xec\xc9\x82\x8e\x59\x6e\x5e\x17\xc5\x13\xfc\x22\x73\xf5\x09\xb6\x63\xf0\x8e\x09\x9e\x8a\xa7\x57\x65\x59\x02\xe4\x1f\x00\xd9\x82\xab\xab\xcd\x7f\x76\x57\x57\xf0\x74\xda\xf9\x4d\xe3\x50\xfa\xe0\xa4\x2a\x84\xc5\xfd\xea\xc5\xc6\xcd\x0f\xf0\x04\xdb\x79\xb4\xc1\xfd\xe6\x3d\xdc\x5b\x8e\x28\x35\xb8\x9d\x4f\x41\xeb\x02\xbc\xfe\xb0\x7b\x07\x6f\xf2\x44\x98\x81\x51\x0d\x33\x60\xfe\x48\x40\x42\xea\x66\xc0\x47\x67\xe1\x96\x42\x94\x89\x26\x1d\xea\x3d\x5a\xec\x28\x88\xb6\x9a\x79\x24\x30\x0e\x1b\xa8\xd1\x88\xa3\x00\xea\xd9\x94\x33\xb8\xa9\x33\xb8\xa9\x2f\xc0\xd1\xc2\x46\xd2\x52\xa1\x8c\x51\xf8\x59\x70\x5e\x2f\x38\x59\x06\x11\x5a\x19\x64\xd6\x0a\xee\x7e\x7e\x9d\x21\x97\x69\xb3\x5f\x2d\x5f\x09\xf4\x4d\x9a\xaf\x80\x70\xfb\xf6\xf6\xe3\xd2\x06\x1f\xc6\x9a\xd0\xeb\x17\x61\x8a\x70\x78\xe4\xfc\x3e\xc8\xcf\x81\x90\x69\x3e\x6b\xe3\xfb\xd5\x79\x53\x4f\x5e\x36\x4d\x03\xf7\xec\xcc\x1c\xf5\x8e\xba\x79\xa0\xa7\xa3\x7f\x2b\xf1\x51\x0c\xf7\xab\xe5\x2b\x1d\xf9\x60\xcd\x94\xdf\x3e\x10\x88\xdd\x18\x14\xa5\x27\x00\x4a\x8f\xec\x0c\x2d\x13\x4b\x1b\x1d\x27\x78\x94\x59\x94\xf0\x2e\xcb\x66\xbf\xba\x5c\x27\xec\x5d\x74\xe1\xb3\x67\xd2\x9c\x7c\xda\xc2\xee\x47\x69\x5d\x9e\x02\x93\x3c\x77\xea\xe9\x1b\xea\x44\x3c\x5f\x5d\xfd\x74\xbb\xbd\xcc\xbe\xaf\x74\x9c\x27\x78\xeb\x02\x9c\x76\x19\x7a\xc7\xd2\x5d\x9c\x85\x4e\xf9\x33\xdd\xd2\xe9\xe5\xeb\xff\xa2\x5b\xf2\x70\xbe\xfa\xaa\x6e\xf5\xe4\x6a\x64\xf9\x23\x5f\xee\x62\x9d\x8e\xbf\x95\x0e\x24\x39\x95\x64\xfb\x75\x7b\x7b\x9d\xdf\x73\x80\x2f\x5e\xe4\xcf\xaf\x88\xfc\x28\xfd\x4c\xcb\xe4\xe3\x72\xfd\x0d\x5a\xfe\x74\xbb\xfb\xdf\xc4\x4c\xfd\x2c\xf9\x0e\xc4\x31\x68\x15\xa9\x29\x75\xee\x09\x65\x17\xdc\xe8\x33\x59\x7f\xfd\x7f\x8a\xed\x76\x4e\xdf\x78\x74\xc0\xe4\xd3\x58\x84\xc5\x16\x66\xdb\x2c\xb2\x33\x4e\xca\xbf\xec\x5c\x70\x63\xa4\x50\x32\xf7\xd2\xb7\xfe\x98\x04\x6c\xbf\xfa\x8a\x41\xf2\xf6\x8e\x8c\x6f\x47\x03\xba\xfd\xfc\xc1\xbb\x9c\x07\x3a\x50\x98\x44\x90\x2e\x77\xcc\x5f\x77\xdb\x77\x77\x1f\xef\x2e\xf3\x36\xa5\xda\x81\x7d\x4f\x81\xf6\xab\xf3\xd5\x97\x93\x2f\x57\xe5\x80\x2c\xe3\x52\x14\xd0\x0a\xee\xb7\xa7\xb7\x4f\x06\xf8\xb3\x34\xbc\xba\xfa\xb0\xbd\xfb\x65\xf7\x69\x73\xfb\xaf\x3f\x89\xc3\x79\x12\xce\xd4\xc3\x7e\x75\xb9\xfe\xd6\x58\x5a\xe3\x30\xca\x8d\x9f\x83\x7a\x2a\xfe\x3b\x00\x2c\xb3\xae\x24\x62\x0e\x00\x00")
+
+func readmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_readmeMd,
+		"README.md",
+	)
+}
+
+func readmeMd() (*asset, error) {
+	bytes, err := readmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "README.md", size: 3682, mode: os.FileMode(480), modTime: time.Unix(1792213524, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _acmAwsReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x5d\x8b\xdc\x36\x14\x7d\x5e\xff\x8a\x03\x81\xcc\xcb\xd8\x43\x09\x0d\x25\xd0\x87\x10\x08\x04\xba\x69\xe9\x06\x0a\xa5\xd0\x95\xa5\xeb\xb1\x3a\xb2\xae\xb9\x92\xc6\xb8\xbf\xbe\x5c\x69\x67\xb7\x84\x40\xf6\x65\x19\xdb\x3a\x1f\xf7\x9e\xa3\x57\xaf\x60\xec\xd2\x9b\x2d\x75\xdd\x97\xd9\x27\xf8\x04\x83\xd5\x64\x3b\x63\x62\x41\x49\x3e\x9e\xf1\xfe\x8f\x07\x7c\x20\xc9\x7e\xf2\xd6\x64\xc2\xbd\x89\xe6\x4c\x82\xcc\xf0\x29\x15\x42\x60\xe3\x30\x9a\x60\xa2\x25\xc1\x97\x5f\x1e\x60\x49\x72\x1a\xba\xee\xa3\x97\x94\xb1\x73\x39\x08\xe1\xcc\x8a\x96\x19\x91\xc8\xc1\xe0\x77\x2e\x99\x7e\x7c\x83\x3f\x39\x52\xa5\xdb\xb9\x08\xd2\x9e\x32\x2d\x70\xbc\x18\x1f\x07\xbc\xff\x70\x8f\xcd\x87\x80\x2b\x89\x9f\x76\xe4\xd9\x54\x40\xf0\x16\x91\x67\x7a\xfa\xb0\xab\xcf\x7d\x3e\xa4\x17\x9a\x55\xd8\x15\x4b\x4d\x8c\x12\x0c\xa8\x26\x97\x92\x32\x46\x3d\x19\x09\xab\x78\xae\x56\xc6\x31\x1c\x54\x5f\x59\x87\xae\xfb\x61\xc0\x6f\xde\x5e\xbe\xa1\xe8\xa8\xa4\x11\x67\xd6\x33\xca\xaf\xd3\xb1\x1c\x13\x07\x82\x89\x0e\x56\x48\x87\x64\x30\x73\xca\xe4\xf0\xaf\xba\xcb\x8c\x45\xa7\xda\xa0\xef\xcd\x85\x90\x8a\x50\xc3\x17\x3a\xfb\x94\xc5\x08\x58\xb0\x1a\xa1\x98\x9f\xc8\xb0\xb2\x8f\x39\xdd\xa8\xa2\x59\x08\x89\xe4\x4a\x92\xe0\x9b\xfd\x48\xdb\xff\x99\x0e\x09\x8e\x26\x53\x42\xc6\xe7\x07\x08\x59\x16\xd7\x48\x3f\x72\x08\xbc\xb5\x33\x2c\x8b\x09\x48\x99\xd6\x3a\x17\xdd\x79\x30\xb1\x2d\xfe\x1d\x3e\xc5\x2b\x5f\x08\x1d\x80\xc7\xc7\xc7\xee\x0e\xcb\xc5\x79\xc1\x48\x22\x7b\x4f\xf1\x8a\xd7\xaf\x61\xdd\xcb\x6f\xfd\x70\x1c\x43\xc3\xe8\xfb\x30\xf6\x79\x5f\x09\x76\x42\xfb\xf5\xe4\x25\xcf\x34\x48\x5b\xf9\xa0\x52\x87\x9d\xcb\xf0\x4f\x49\x79\x58\x8c\xa3\xc1\xf2\x82\xbf\x14\xea\xf9\xaf\x1e\xd6\xe5\x61\x18\x4e\xfa\x3f\x9d\x26\x73\xa1\xc1\x4a\x6e\xc0\x17\xda\xbf\x7a\x75\xa1\x5d\x21\xec\x8a\x5e\x30\x72\x9a\xfb\x91\x39\x6b\x3e\x49\x4e\xaa\xaf\xaf\x1e\x29\x9d\x9e\x72\x7f\x1a\x30\x74\x77\x55\x7e\x59\x6f\x8e\x01\x5d\xcc\x21\x84\x96\xd4\x16\xa5\xab\x77\xb7\x28\xb5\xb4\x1c\x31\x96\xac\xf3\xdc\xb1\x71\x3c\x64\x50\x74\x28\x2b\x4a\x22\x77\x44\x62\xc5\x40\x9a\xb9\x04\x9d\x15\x26\x1f\x09\x9b\xcf\x33\x68\x59\xf3\xae\xbb\x3e\x1b\x19\xcd\x99\x30\xf9\x40\x69\xb8\xab\x6b\xfa\x35\xda\x1a\x8b\xc3\x95\x94\xe4\xa0\x90\xc7\xaf\xa0\xcc\x18\x6a\xa4\x1c\xad\x81\x77\x18\x1d\xb5\x46\xcf\x67\xd5\x3c\x9b\x2b\x61\x63\xb9\xf8\x78\x3e\xd6\xfe\x2c\xb5\xb0\x0e\xf6\xa5\xc4\xe9\x78\xf3\xe4\x9e\x2b\xa5\xf6\x53\x59\xd7\xb0\xc3\x4e\x7d\x03\x5f\x34\x8c\x55\xf5\x63\x7f\x7d\x6a\xc2\xdf\x6d\xa1\x3f\x7f\x7f\xa1\x8f\xd5\xd2\x67\xce\x74\x84\xa9\xb3\x42\xf6\x0b\x81\x27\x6c\xe2\xb3\xd6\x2d\x6b\x23\x5f\x36\x53\xeb\x25\x04\x23\xd4\x2e\x97\xe7\xa0\x67\x12\x31\x13\xcb\x02\xb3\xa5\x9b\x78\x69\xe2\x57\xa1\xab\x0a\x2d\x09\x93\xf0\xd2\x8c\x58\x4b\x29\x4d\x25\x84\xfd\xb9\xdb\x4e\x2f\x0d\x6e\xc5\x99\xea\xd5\x94\x65\x1f\xf0\x69\x6a\x03\x26\xaa\x6f\x2a\x2f\x1c\x25\x2b\x7e\x24\xa7\x02\xe6\x9c\xd7\xf4\xee\x74\x3a\xfb\x3c\x97\x51\xad\x9d\x9e\xf5\xf4\x37\x2d\xe9\x1b\xcf\xf4\x6e\x3d\x55\xc0\x74\x7a\xf3\xd3\xdb\xb7\x38\x42\x23\xaf\x8a\x9a\x1a\x73\x36\x3e\x0e\x5d\xf7\xdf\x00\xea\xdf\x93\xa0\x8b\x05\x00\x00")
+
+func acmAwsReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_acmAwsReadmeMd,
+		"acm-aws/README.md",
+	)
+}
+
+func acmAwsReadmeMd() (*asset, error) {
+	bytes, err := acmAwsReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "acm-aws/README.md", size: 1419, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _acmAwsTerraformCert_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\xbf\x4e\x33\x31\x10\xc4\x7b\x3f\xc5\xca\xfa\xaa\xaf\xb0\x22\x21\xe8\xae\xa3\xa6\xa1\x44\x91\xb5\x9c\x37\xc2\xd2\xd9\x0e\xeb\xf5\xa1\x10\xe5\xdd\x91\x73\x0e\xb9\x0b\x88\x34\xb9\xe6\xfe\xad\xe7\x37\xe3\x31\x53\x4e\x85\x7b\x02\x8d\x1f\xd9\x7a\x0c\x36\x13\x8f\xc4\xb6\x27\x16\xbf\xf1\x3d\x0a\x69\xd0\xc3\xeb\xf1\x83\x86\xbd\x02\xe8\x53\x89\x02\x1d\xac\xd4\x41\xa9\xa5\x00\xf6\xe1\x62\xe5\x79\x99\x4b\x01\x7d\xb4\x11\x03\xc1\x74\x75\xa0\xff\x9b\x7f\xfb\x11\xd9\xe4\x5d\x16\x0a\x76\x9a\x39\x68\x05\x30\xe2\xe0\x1d\x8a\x4f\xd1\x06\x92\xb7\xe4\xa0\x03\xfd\xf8\xf4\xac\x7f\x52\x39\x15\xa1\xfb\x3b\xcb\xd4\x27\x76\x0d\x6a\xcf\x02\x13\xff\x04\xee\xe0\x17\xa7\xa6\x3e\x9b\x66\x71\x86\x4e\xdb\x7a\xcb\x66\x65\x4e\xc8\x46\x39\xe6\x50\x00\xb2\xdb\xde\x52\xb5\xca\x29\x80\xcf\x14\xc9\xfa\x1a\xd9\xa1\xa0\x99\x87\xac\xbf\x0c\xc5\xd1\xba\x98\xa7\x17\xef\x14\xc0\xe4\x2a\x43\x07\x2f\x37\x31\x32\xe2\x50\x68\x5d\x03\xca\xd0\xca\x7a\xb8\x5e\xf8\x4c\x7b\xd1\xfd\x7c\x04\x39\xb6\xfe\xff\xdc\x35\xe4\xb8\x3c\x05\xcd\xd8\xe6\xdd\xc5\xef\x98\xcb\xe6\xcd\x45\xef\xa6\xce\xae\xab\xe9\x54\x64\x5b\x04\xf4\x8c\x62\x91\xdb\xc1\x38\x46\xbd\x66\xe5\xa0\xbe\x06\x00\x62\x50\xee\x81\x2b\x03\x00\x00")
+
+func acmAwsTerraformCert_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_acmAwsTerraformCert_overrideTf,
+		"acm-aws/terraform/cert_override.tf",
+	)
+}
+
+func acmAwsTerraformCert_overrideTf() (*asset, error) {
+	bytes, err := acmAwsTerraformCert_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "acm-aws/terraform/cert_override.tf", size: 811, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _acmAwsTerraformDns_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\xce\xc1\x6a\xc3\x30\x0c\xc6\xf1\xbb\x9f\x42\xf8\x01\xcc\x60\xec\x98\x67\x31\x9a\x25\x98\x21\xb1\x82\x64\x3b\x6c\x63\xef\x3e\xdc\xf4\xd0\x96\x1e\x42\x21\x47\x1d\xfe\x3f\x7d\xca\x26\x4d\x13\x83\xc7\xcd\xa2\x4a\xab\xfc\xf1\x1e\x7f\xa4\xb0\x07\xcf\xa5\x47\x2a\x76\x3d\x7f\x1d\x40\x92\x56\x2a\x4c\xf0\xe6\xfe\x9c\x23\xac\x78\xb0\x2b\xb8\x30\x4c\xd0\x51\x83\x7d\x5b\xe5\x25\x92\x2c\x98\xcb\x60\xa4\xd5\xb5\xd5\xfb\x2a\x8e\x20\x1a\x6b\x67\xb5\x9d\xe8\x38\xb7\x61\x78\x3f\xa2\xe7\xbb\x95\x93\x28\x79\xf0\x5b\x9e\x29\xa1\xd2\x98\xb1\xe7\x17\x35\x13\x4c\x30\x66\x87\xc7\xd5\xe1\xf6\x7b\xc8\x74\xe0\x87\xd9\xd7\x59\xf4\xa7\x9c\x67\xd7\xb4\xbe\x4a\xff\x0f\x00\x28\xf0\xf9\xa6\x2d\x02\x00\x00")
+
+func acmAwsTerraformDns_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_acmAwsTerraformDns_overrideTf,
+		"acm-aws/terraform/dns_override.tf",
+	)
+}
+
+func acmAwsTerraformDns_overrideTf() (*asset, error) {
+	bytes, err := acmAwsTerraformDns_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "acm-aws/terraform/dns_override.tf", size: 557, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _acmAwsTerraformLb_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x92\xc1\x6a\xc3\x30\x0c\x86\xef\x7e\x0a\xe1\x07\x08\x83\xe5\xb0\x8b\x9f\xc5\x38\x9a\xc2\x0c\x9e\x1d\x24\x85\x1d\x46\xde\x7d\x38\x23\x4d\x5b\xda\xb4\xa5\xed\xad\xb7\x28\x3f\x1f\x7c\xbf\x25\x26\x29\x23\x23\x81\x0d\x3f\xe2\x29\x75\x16\x2c\xf6\x9e\xcb\xa8\xc4\xbe\x8e\xbf\x06\x20\x45\x51\xca\xc4\xf3\x00\x10\xb3\x68\xc8\x48\x7e\x28\xac\xf5\x07\x38\xf8\x78\x3b\x8a\xb8\x68\xc1\x92\xc0\x81\xfd\x52\x1d\xec\x1c\xa7\x6e\x65\xe0\x90\x4c\xdd\xca\x2c\xd1\x8e\x9c\xcc\x35\x16\x5b\x1a\x97\x3c\xc0\x41\xdb\xbe\x9f\x31\x59\x60\xf9\xa7\x45\x92\x47\x62\x8d\x7d\xc4\xa0\xe4\xe3\x27\x38\xa8\xef\x17\xf0\x7b\x3f\x68\xea\x77\x13\x38\x3f\xac\x81\xe2\x76\x81\xcd\x06\x22\xe9\x1e\xff\xc9\x98\x53\xd7\x12\xa5\xdc\x7c\x2e\xaf\x45\x3d\x77\x51\x7f\x03\x00\x7b\x5e\x90\x64\xd6\x03\x00\x00")
+
+func acmAwsTerraformLb_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_acmAwsTerraformLb_overrideTf,
+		"acm-aws/terraform/lb_override.tf",
+	)
+}
+
+func acmAwsTerraformLb_overrideTf() (*asset, error) {
+	bytes, err := acmAwsTerraformLb_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "acm-aws/terraform/lb_override.tf", size: 982, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _albAwsReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\xcd\x31\x0e\xc2\x30\x0c\x05\xd0\xdd\xa7\xf8\x52\xe7\x90\x9d\x0d\x24\x36\x46\x0e\x10\x27\x35\x6a\x24\x13\x5b\x75\x0a\xd7\x67\x61\x62\x7f\xd2\x5b\x16\xb0\xd6\xc4\x9f\x20\x7a\x18\x8e\x10\x5c\xdc\xb5\x37\x9e\xdd\x06\xee\xc6\x2b\xae\xac\x3c\x9a\xec\x81\x3e\x62\x0a\xaf\xb0\x27\x6e\xca\x31\x7b\xfb\x13\x67\x2a\xa5\x50\x73\xa4\x1d\xd5\x62\x4b\xd5\x6c\xaa\xf1\x2a\x7b\x76\xe5\x91\x9c\x67\xdb\x24\xf2\x2f\xcd\x27\x84\xbd\x24\xc9\x78\x67\xaa\x55\x71\x38\x95\x52\xe8\x3b\x00\x04\x31\xcd\x83\x97\x00\x00\x00")
+
+func albAwsReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_albAwsReadmeMd,
+		"alb-aws/README.md",
+	)
+}
+
+func albAwsReadmeMd() (*asset, error) {
+	bytes, err := albAwsReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "alb-aws/README.md", size: 151, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _albAwsCloudConfigLbOpsYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8c\x41\x8e\xc2\x30\x10\x04\xef\x79\xc5\x1c\x93\x83\x95\xbb\xa5\xd5\x3e\x65\xe4\xf5\x76\x82\x85\x63\x5b\xe3\x71\x20\xbf\x47\x04\x10\x22\x12\xd7\xee\xaa\x32\xa4\x5b\x81\x25\x41\x89\xce\xa3\x23\x2a\x4e\x4f\x96\xc6\x75\x61\x5c\x15\xa9\x86\x9c\xea\x98\xdc\x82\x1f\x3f\x19\xc9\x4d\x21\x26\x41\x2f\x59\xce\xa6\x48\x2e\x10\x0d\xa8\xa3\x8f\xb9\xfd\xf3\x7b\xf8\xed\x88\x56\x17\x1b\x6c\x47\x44\x14\xff\x58\x9d\xcc\x50\x9e\x25\xb7\x52\x2d\xf5\xbd\x9f\xf8\xd1\xe3\xe3\x3b\x0c\xbb\x54\xe1\x9b\x04\xdd\x5e\xce\x3e\x9a\xa3\x19\x92\x42\x92\x8b\xfc\x89\x3f\x1b\x77\xfc\x3b\x71\x1b\x00\xdb\x6e\x18\x43\xff\x00\x00\x00")
+
+func albAwsCloudConfigLbOpsYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_albAwsCloudConfigLbOpsYml,
+		"alb-aws/cloud-config/lb-ops.yml",
+	)
+}
+
+func albAwsCloudConfigLbOpsYml() (*asset, error) {
+	bytes, err := albAwsCloudConfigLbOpsYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "alb-aws/cloud-config/lb-ops.yml", size: 255, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _albAwsTerraformCfLb_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x56\xc1\x6e\xe3\x36\x10\xbd\xeb\x2b\x06\x44\x4f\x8b\x4a\xab\xad\x1d\x40\x17\x1d\xda\x62\x8b\x16\x68\x17\x41\x93\x5b\x60\x10\x14\x35\xb6\x89\xd0\xa4\x40\x52\x0e\xdc\xc0\xff\x5e\x90\x92\x1c\xca\x96\x93\xa0\x70\x0a\x63\x93\x8b\xc5\x19\x3e\xbe\xf7\x66\xc4\x91\x41\xab\x5b\xc3\x11\x08\x7b\xb2\x54\x56\x04\x08\x93\x15\x35\xba\x75\x68\x08\x3c\x27\x00\x8a\x6d\x10\xc6\x7f\x65\xc8\x4a\xfb\xac\x04\x40\x6a\x56\xd3\x8a\x49\xa6\x38\x1a\xea\x76\x0d\x86\x9c\xa6\x91\x82\x33\x27\xb4\x22\x49\x02\x60\x91\xb7\x46\xb8\x1d\x5d\x19\xdd\x36\x16\x4a\x78\xf0\xa7\x8e\x97\x33\xbe\xec\x8f\xa7\xb2\x3a\x8e\x89\x7a\xe1\x71\xda\x4a\xa1\xb3\x03\x1b\x28\x21\xc0\x84\xd5\xcc\x6f\x0a\xbf\x6c\xf6\x29\x13\x75\xb2\x4f\x92\x63\x91\x54\x0a\xeb\x50\xa1\x19\xa9\xa5\xf3\xf9\xac\x53\x3c\x56\xc3\x8c\xea\x4f\x90\x55\xf6\x92\x9e\x31\xa3\x12\x80\x46\x1b\x77\x62\x8e\x47\xf2\x31\xa3\x9d\xe6\x5a\x0e\x91\x10\xfb\xfd\xfe\xfe\xf6\x8e\x78\x15\x56\xd2\x46\x4b\xc1\x77\x51\xf4\xeb\x9f\xbf\xdc\xf5\x92\x6f\x43\x2c\xfd\x29\xff\x72\x93\xe6\x37\x7e\x07\x47\xe3\xc4\xd2\x3b\x8a\x81\xd5\xa0\x5c\xb0\x0d\xb5\x68\xb6\x68\x68\x94\xe2\x9d\xf0\x8f\x81\x68\x02\x50\xe3\x92\xb5\xd2\x51\xc6\x7d\x41\x82\x50\x00\xc7\xcc\x0a\x5d\xe7\xfc\x48\x29\x8d\x23\x91\x6c\xef\x52\x2f\x1d\x20\x14\x1a\x60\xa4\x6f\xa9\xcd\x13\x33\xb5\xe7\xbb\x9f\x34\x3f\x06\x3e\x53\x80\x43\xcb\x8d\x1a\x2d\xed\x76\xa6\x83\xbb\x83\xf3\x25\xcc\xe7\xb3\xd8\xee\xd8\xe5\x6d\xc3\xa9\xa8\x03\x94\xd4\x9c\xc9\xac\x5b\xf0\xed\xb8\x46\x26\xdd\x9a\xf2\x35\xf2\xc7\xde\x8e\x86\xb9\xb5\x2f\xd2\xe7\x2e\xe6\x01\xfa\x83\x4a\x28\xf2\x22\x3f\x2b\xea\x5c\x47\x5d\xb0\xa5\xbe\xeb\x9e\xfa\xc8\xa6\x7a\x6f\x57\x4d\xb4\xd5\x95\xf6\x55\x91\x5f\xa8\xab\x8a\xfc\xd5\x9e\x22\x17\xac\x71\x91\x7f\x54\x85\x8b\xfc\x3d\xf5\x2d\xf2\xa3\xea\x16\x79\x2c\xfd\xa0\xf8\xff\x29\xed\x78\xae\x11\x20\xa3\xa9\x27\x94\x43\xa3\x98\x3c\x49\x1b\xc9\x0c\x0c\xc9\x0f\xcf\x5b\x66\x32\x54\x5b\x2a\xea\x7d\xca\x97\x83\x6c\x59\xa5\x03\x4c\x3a\xc0\xa4\x1d\x4c\x28\xaa\xe5\x46\x34\xe1\xad\x2d\x81\xfc\xfa\x1b\xfc\x1d\xb6\xc1\x1f\xfd\x9e\x91\x11\x93\x5e\x08\xb5\x32\x68\x6d\x6f\xc3\xa5\x66\xfb\x69\x37\x96\x40\x1c\x0f\xa4\x01\x96\x46\x6f\x68\xd4\xc9\x7d\x15\x01\x9c\x8e\x97\x0f\x81\xfd\x35\x11\x7d\x85\xea\xb5\x91\xed\x06\xea\x14\xd7\x2e\x72\x55\x54\x5f\xe1\xfa\x42\x16\x63\xae\x31\x4a\x09\xa7\x65\x19\xd6\x62\x26\x25\x90\xf4\x4b\xe7\x17\x17\xb5\xa1\x95\xd4\xfc\x31\x54\x84\xe4\x59\xf8\xff\x9c\x93\xc5\x70\x9c\x63\x2b\x1f\xeb\x8e\xfb\xe6\x3f\x67\xfe\xf3\xab\x1a\xf0\xa4\x58\x22\xdf\x71\x89\x3d\xa4\x58\x29\x6d\x90\xf2\x35\x53\x2b\xf4\x27\x3d\xf8\x6b\x61\x31\x7d\xd9\x60\xf8\xae\x8f\xad\xef\x2e\x4c\xae\x5b\xe5\x7a\xb1\x7e\x7b\x74\x9f\xd8\xb5\x36\x8e\x4e\x51\x25\xfe\x32\xd3\xad\x6b\x5a\x77\x74\x6b\x79\x88\x0e\x78\xcb\x64\x8b\x93\xb3\xc8\xe7\x9c\x05\x68\x8d\x7c\x6b\x7f\xad\x2c\x1d\x30\xc6\x22\x83\x97\x37\x33\x6a\x90\x6b\x53\x13\x20\x4f\x42\xd6\x9c\x99\x9a\xd6\xca\x76\xb0\xff\x68\x85\xfe\x63\xb0\x84\x78\x83\x5f\x0d\x45\xf1\xd8\xe1\x41\xd4\xd1\x35\x5b\x02\xf9\x94\xf5\xa6\xec\xac\xc3\x0d\xad\xf5\x86\x09\xb5\x27\xc9\xcb\x24\xf3\x57\xe8\xb7\x9f\xff\xfa\x1a\xd6\x9c\xec\x9b\x68\x96\xe7\xbe\x76\x1d\xa3\xc3\x1b\x31\x2d\x68\x71\xd6\x95\x78\xf0\xd9\xb1\x3f\x0f\x13\xb3\x31\x02\x0f\xdf\xea\x1e\xfc\x47\x78\x33\xf1\xbd\x99\x45\x9e\x29\xb6\xc1\x45\xb2\x4f\xfe\x1d\x00\x2c\x08\x16\xf6\x3c\x0e\x00\x00")
+
+func albAwsTerraformCfLb_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_albAwsTerraformCfLb_overrideTf,
+		"alb-aws/terraform/cf-lb_override.tf",
+	)
+}
+
+func albAwsTerraformCfLb_overrideTf() (*asset, error) {
+	bytes, err := albAwsTerraformCfLb_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "alb-aws/terraform/cf-lb_override.tf", size: 3644, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _boshLiteGcpReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x90\x41\x8e\x02\x21\x10\x45\xf7\x9c\xe2\x27\x26\xba\x19\x74\x6f\x1c\x4f\xe1\x01\x28\xe8\xb2\x21\xd2\x05\x81\x6a\x8d\xb7\x9f\x74\x3b\x31\xce\x6c\xeb\xbf\x1f\xf8\x6f\xb3\xc1\x89\x20\x34\xf1\xf7\xce\x97\x1e\x6d\x4e\xca\x76\x0c\x75\x77\x3e\x1d\xe8\xfc\xe7\x64\xcc\xa5\x20\x34\x26\x65\x10\x96\x08\x0b\x8d\x22\x18\x43\xfd\x02\xd5\x9a\x9f\xd0\xc8\xf0\x73\xca\x9a\x04\x95\x34\x44\x3c\x22\x0b\x9e\x65\x46\xcd\x24\x6b\xce\x72\x4f\xad\xc8\xc4\xa2\x7b\xe3\x42\x2e\xf3\x60\x43\x91\x6b\x1a\x0f\xef\x17\xf7\xcf\x29\x3b\x34\xae\x99\x02\xf7\xb5\x36\x86\x8a\x15\xc6\x0b\x86\x46\x52\x78\x9f\x31\xb2\x70\x23\xe5\x6e\x1e\x49\x23\x8a\x30\xae\xa5\xbd\x4a\xd4\x06\x96\xa5\xa1\x94\x84\x5b\x47\xb9\xae\xc1\x7b\xc0\xde\x98\x4b\x64\x74\xe5\xda\x31\xa5\x31\x2a\x72\x29\x37\xe4\x74\x63\xf4\x39\xc4\xa3\x31\xce\x39\x33\xdd\x86\xd4\xe0\x49\x48\xc8\xb2\xdc\xb1\xdd\x22\x0c\x1f\x07\x63\x96\xbf\xac\x2b\xad\x5d\x9c\x7e\xc2\xd6\xbe\x6c\xfc\xba\x39\xfe\x53\xeb\x7d\xc6\x5c\x8d\x73\xce\xfc\x0c\x00\x25\xb7\x32\x8c\x94\x01\x00\x00")
+
+func boshLiteGcpReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_boshLiteGcpReadmeMd,
+		"bosh-lite-gcp/README.md",
+	)
+}
+
+func boshLiteGcpReadmeMd() (*asset, error) {
+	bytes, err := boshLiteGcpReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "bosh-lite-gcp/README.md", size: 404, mode: os.FileMode(480), modTime: time.Unix(1792210529, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _boshLiteGcpCloudConfigBoshLiteYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x94\xcf\x72\x9b\x30\x10\xc6\xef\x3c\xc5\x3e\x40\xe4\x20\x01\x6d\x46\xc7\x5c\xf2\x02\xbd\x75\x3a\x8c\x0c\x1b\xa3\x89\xfe\x8d\x24\x48\xcc\xd3\x77\xc0\x78\xac\x62\x4c\xa7\x3d\xea\xdb\xdf\x7e\xda\x5d\x16\x11\x88\x67\x87\x1c\x3c\x3a\x25\x1a\xcc\x00\x9c\x88\x1d\x87\x67\x31\x86\x0c\x60\x10\xaa\x47\x9e\x01\x10\x30\x42\x23\x87\x91\xa6\x07\x96\x1e\x8a\x2c\x7b\xe8\xd6\x58\xed\xa4\x12\x51\x5a\x93\xba\x02\x88\x71\xf1\x04\x30\x18\x3f\xad\xff\xe0\xd0\xe2\xbb\xe8\x55\x9c\x45\x8f\x7d\xc0\x3a\x49\xaf\x07\x1d\x38\x44\xdf\x4f\xee\x00\x83\xae\x2f\x57\x6a\x69\xa4\x16\x6a\x16\x27\x1b\xf4\x81\xc3\xb7\x9d\x92\x5a\x19\x3e\xe6\xdc\x55\x9f\xb3\x1e\xe4\x88\x1c\x68\xce\xca\xd9\xf0\xd2\x3b\x7d\x7b\x5d\x13\x15\x65\x79\x42\x54\xf7\xc4\xe4\x91\x22\x34\xdf\x62\xee\xa0\x89\x7a\x5c\xfb\x32\xaa\xcd\x2f\x94\x4e\x2f\xf4\x47\x83\x31\x5c\x46\x4d\x40\x8c\x81\xc3\xcf\x91\x3e\xc1\xc8\x9e\x60\x2c\x7e\xcd\x3a\x40\xa3\x6c\xdf\xd6\xce\x5b\x87\x3e\x4a\x5c\xf8\x5b\x39\x5e\x98\xd6\xea\x45\x3c\x89\x88\x9f\xe2\x3c\x55\x7d\x60\x65\x79\xc8\x0f\x74\x89\x78\x61\x4e\x98\xe8\xf9\xf3\x32\x1c\x00\x8f\x01\xfd\x80\xed\xd5\x99\xdc\x67\x87\x28\xa2\x6c\x36\x00\xf6\x07\xcd\xbe\xaf\x09\x7a\xc8\xd3\xc3\x06\xc1\x52\x82\x6d\x11\x45\x4a\x14\x33\xf1\x78\xfa\x83\xae\xf1\x2b\xa2\x09\xd2\x9a\xcd\x4f\x50\xbd\xbd\xd6\xe8\x3a\xd4\xe8\x85\xaa\xa7\x85\x4a\x82\x34\xdf\x8b\x56\xbb\x51\x9a\xff\x25\x79\x3f\xfb\xc7\x4e\x30\x84\x8e\x38\x6f\xbf\xce\x44\x98\x96\x78\xdb\x47\xf4\x44\x1d\xb3\xbd\xfd\x70\xd6\x5f\x97\x6b\xea\xa0\xb3\x21\x72\x78\xc9\x57\x42\x59\x16\x2b\x85\x31\x96\xbe\x1c\xcd\x3b\x89\x8d\xbb\xde\xb9\xec\x36\xb9\x5d\xf7\xef\x35\x4c\x3f\x1d\xa1\x94\x15\xe9\x2d\x1e\xdb\xae\x3f\xfe\x5f\x4f\x2f\x65\xb5\xf3\x3b\x2e\x8f\xd0\xe6\x2e\xdc\xde\xa5\xab\x12\xb4\x50\x77\x67\xd2\xc9\x53\xa7\x51\x67\xbf\x07\x00\xe6\x6a\xa2\x93\x91\x05\x00\x00")
+
+func boshLiteGcpCloudConfigBoshLiteYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_boshLiteGcpCloudConfigBoshLiteYml,
+		"bosh-lite-gcp/cloud-config/bosh-lite.yml",
+	)
+}
+
+func boshLiteGcpCloudConfigBoshLiteYml() (*asset, error) {
+	bytes, err := boshLiteGcpCloudConfigBoshLiteYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "bosh-lite-gcp/cloud-config/bosh-lite.yml", size: 1425, mode: os.FileMode(480), modTime: time.Unix(1792210493, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _boshLiteGcpCreateDirectorOverrideSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x90\x6f\x4f\xc2\x30\x10\x87\xdf\xf3\x29\x26\xfa\xb6\xec\x13\xf8\x02\xc6\xa2\xa8\x01\x02\xd3\x44\x63\xd2\x74\xdd\x31\x4a\xba\xb6\xb9\x76\x93\x69\xf8\xee\x66\x45\xc3\x4c\xfc\xb3\xf9\xf2\xee\x9e\xa7\xd7\xfb\x9d\x9f\x85\xa9\x50\xa1\xdd\x0e\x52\x6d\xb7\x01\x47\x60\x0e\x08\xa8\x2a\x78\x1e\x04\xc1\xc5\xdb\x64\x72\x47\xd7\xc9\x38\x89\xe9\x74\xb6\x3a\x84\x0d\x44\x32\x30\x52\xd7\x05\x28\xe7\xeb\x51\x5d\x48\x4f\x13\x62\x1d\x73\xf0\x8d\x56\x31\xb4\x9e\x3d\x12\xa3\x9d\xd5\xea\x43\x69\x46\xc4\x3a\x8d\x3f\x7a\x99\x40\xe0\x4e\x63\x0b\x6d\xad\xf4\xcd\x8d\x90\x1d\xf5\x86\xfc\x6a\xfb\x56\x90\x73\x43\x39\x42\x06\xca\x09\x26\x2d\x6d\x7e\x78\x39\x3c\x1e\x72\x15\x2d\xe9\x3a\x5e\x3d\xcc\xa2\x98\x8e\xa3\x68\x71\x3f\x4f\xe8\x6d\xfc\x48\x97\xe3\xe4\xfa\x30\x3c\xbe\x54\x05\x06\xf5\x0e\xb8\xa3\x22\x6b\x7b\xcb\xd5\xe2\x26\x8e\x12\x3a\x9b\x9e\xc8\x57\xad\xa0\xcd\x3c\x2d\xe6\xf1\xe7\x54\x77\x08\x3d\xe7\x26\xe4\x46\x9c\xce\xe8\x22\xed\xca\xc2\xa4\x7a\x4f\x4a\x0b\xd8\xcf\x2c\x19\xeb\x27\x34\x39\x6e\xcb\xb4\x9f\xe4\x6b\x29\x1c\xfc\x53\x23\x58\x2a\xde\xcf\x6d\x72\x3c\xf9\x55\x41\x5c\x6d\xfe\x58\x0f\x7b\x07\xa8\x98\x24\xc2\x90\x9c\x9b\xdf\x61\x61\xc8\x46\xe3\x0b\xc3\x4c\xa8\x7c\x54\x17\x72\xf0\x3e\x00\x81\xaf\x93\x81\x6e\x03\x00\x00")
+
+func boshLiteGcpCreateDirectorOverrideShBytes() ([]byte, error) {
+	return bindataRead(
+		_boshLiteGcpCreateDirectorOverrideSh,
+		"bosh-lite-gcp/create-director-override.sh",
+	)
+}
+
+func boshLiteGcpCreateDirectorOverrideSh() (*asset, error) {
+	bytes, err := boshLiteGcpCreateDirectorOverrideShBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "bosh-lite-gcp/create-director-override.sh", size: 878, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _boshLiteGcpCreateJumpboxOverrideSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\xd1\x4e\x83\x30\x14\x86\xef\xf7\x14\x75\x7a\x5b\x78\x02\x2f\x18\x6b\x14\x35\x83\x30\x34\xd1\x98\x34\x50\x3a\x56\x52\x7a\x9a\xb6\xa2\x68\xf6\xee\x06\xe6\x04\x23\x89\xde\xfe\xe7\xfb\x4e\xfb\x9f\xf3\x33\xbf\x10\xca\xb7\xfb\x45\x01\x76\x8f\x98\xe1\xb9\xe3\x98\xab\x16\x3d\x2f\x10\xba\xf8\x58\xad\xee\xe8\x36\x0b\x32\x42\xd7\x51\x7a\xf0\xeb\x97\x46\x17\xf0\x86\x4b\xae\x25\x74\x0d\x57\xee\x14\x79\x5d\x23\x07\x07\x63\xeb\x72\xc7\x67\xe4\x36\x37\xf6\x7b\xc3\x00\x79\xb5\x05\xf5\x65\xf5\x53\x6c\x1d\x98\x3f\xd5\x91\x9c\x3c\x3a\x84\x3b\x21\xff\x67\xf7\xe0\x4f\x79\x88\x50\xc5\x34\x65\x86\x97\x5c\x39\x91\x4b\x4b\xfb\xff\x5d\x2e\x8f\x4d\xae\xc2\x84\x6e\x49\xfa\x10\x85\x84\x06\x61\x18\xdf\x6f\x32\x7a\x4b\x1e\x69\x12\x64\xd7\x87\xe5\x71\x53\x8b\xb4\x81\x9a\x33\x47\x45\x39\xf5\x92\x34\xbe\x21\x61\x46\xa3\xf5\x48\xbe\x83\xe2\x53\xe6\x29\xde\x90\xd3\x14\x66\x5a\xcc\xdc\xbe\x62\xda\x67\x5a\x8c\x4d\xe0\xb7\x26\x34\xde\x81\x79\xcd\x4d\x29\x54\xe5\x75\x8d\x5c\x7c\x0e\x00\xd8\x1d\x63\x64\xf4\x01\x00\x00")
+
+func boshLiteGcpCreateJumpboxOverrideShBytes() ([]byte, error) {
+	return bindataRead(
+		_boshLiteGcpCreateJumpboxOverrideSh,
+		"bosh-lite-gcp/create-jumpbox-override.sh",
+	)
+}
+
+func boshLiteGcpCreateJumpboxOverrideSh() (*asset, error) {
+	bytes, err := boshLiteGcpCreateJumpboxOverrideShBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "bosh-lite-gcp/create-jumpbox-override.sh", size: 500, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _boshLiteGcpDeleteDirectorOverrideSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x90\xdf\x4e\xc2\x30\x14\x87\xef\x79\x8a\x89\xde\x96\x3d\x81\x17\x30\x16\x45\x0d\x10\x98\x26\x1a\x93\xa6\x6b\x0f\xa3\xa4\x6b\x9b\xb6\x9b\x4c\xc3\xbb\x9b\x16\x0d\x33\xf1\xcf\xe6\xe5\x39\xe7\xfb\x7a\x7a\x7e\xe7\x67\x71\xce\x65\x6c\xb7\x83\x5c\xd9\x6d\xc4\x40\x80\x03\x04\xb2\x8e\x9e\x07\x51\x74\xf1\x36\x99\xdc\xe1\x75\x36\xce\x52\x3c\x9d\xad\x0e\xb1\x87\x10\x03\x2d\x54\x53\x82\x74\xa1\x1e\x35\xa5\x08\x34\x42\xd6\x11\x07\xdf\x68\x35\x31\x36\xb0\x47\x62\xb4\xb3\x4a\x7e\x28\x7e\x84\xac\x53\xe6\x47\x8f\x71\x03\xd4\x29\xd3\x42\x5b\x2b\x43\x73\xc3\x45\x47\xdd\x93\x5f\xed\xd0\x8a\x0a\xaa\x31\x35\xc0\x40\x3a\x4e\x84\xc5\xfe\x87\x97\xc3\xe3\x21\x57\xc9\x12\xaf\xd3\xd5\xc3\x2c\x49\xf1\x38\x49\x16\xf7\xf3\x0c\xdf\xa6\x8f\x78\x39\xce\xae\x0f\xc3\xe3\x4b\x75\xa4\x8d\xda\x01\x75\x98\xb3\xb6\xb7\x5c\x2d\x6e\xd2\x24\xc3\xb3\xe9\x89\x7c\x55\x12\xda\xcc\xd3\x62\x9e\x7e\x4e\x55\x87\xd0\x0b\xaa\x63\xaa\xf9\xe9\x8c\x2e\xd2\xae\x2a\x75\xae\xf6\xa8\xb2\x60\xfa\x99\x15\x21\xfd\x04\x9f\xe3\xb6\xca\xfb\x49\xa1\x16\xdc\xc1\x3f\x35\x64\x2a\x49\xfb\xb9\x3e\xc7\x93\x5f\x97\xc8\x35\xfa\x8f\xf5\xb0\x77\x60\x24\x11\x88\x6b\x54\x50\xfd\x3b\xcc\x35\xda\x28\xf3\x42\x0c\xe3\xb2\x18\x35\xa5\x18\xbc\x0f\x00\x0d\xc3\xef\x28\x6e\x03\x00\x00")
+
+func boshLiteGcpDeleteDirectorOverrideShBytes() ([]byte, error) {
+	return bindataRead(
+		_boshLiteGcpDeleteDirectorOverrideSh,
+		"bosh-lite-gcp/delete-director-override.sh",
+	)
+}
+
+func boshLiteGcpDeleteDirectorOverrideSh() (*asset, error) {
+	bytes, err := boshLiteGcpDeleteDirectorOverrideShBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "bosh-lite-gcp/delete-director-override.sh", size: 878, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _boshLiteGcpDeleteJumpboxOverrideSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\xd1\x4e\x83\x30\x14\x86\xef\xf7\x14\x75\x7a\x5b\x78\x02\x2f\x18\x6b\x14\x35\x83\x30\x34\xd1\x98\x34\x40\xcf\x58\x49\x69\x9b\xb6\xa2\x68\xf6\xee\x06\xe6\x04\x23\x89\xde\xfe\xe7\xfb\x4e\xfb\x9f\xf3\x33\xbf\xe0\xd2\xb7\xfb\x45\xa1\xec\x1e\x31\x10\xe0\x00\x83\x6c\xd1\xf3\x02\xa1\x8b\x8f\xd5\xea\x8e\x6e\xb3\x20\x23\x74\x1d\xa5\x07\xbf\x7e\x69\x74\xa1\xde\x30\x03\x2d\x54\xd7\x80\x74\xa7\xc8\xeb\x1a\x31\x38\x18\x5b\x97\x3b\x98\x91\xdb\xdc\xd8\xef\x0d\x03\xe4\xd5\x56\xc9\x2f\xab\x9f\x62\xeb\x94\xf9\x53\x1d\xc9\xc9\xa3\x43\xb8\xe3\xe2\x7f\x76\x0f\xfe\x94\x87\x08\x55\xa5\xa6\xa5\x01\x06\xd2\xf1\x5c\x58\xda\xff\xef\x72\x79\x6c\x72\x15\x26\x74\x4b\xd2\x87\x28\x24\x34\x08\xc3\xf8\x7e\x93\xd1\x5b\xf2\x48\x93\x20\xbb\x3e\x2c\x8f\x9b\x5a\xa4\x8d\xaa\xa1\x74\x94\xb3\xa9\x97\xa4\xf1\x0d\x09\x33\x1a\xad\x47\xf2\x5d\x49\x98\x32\x4f\xf1\x86\x9c\xa6\x6a\xa6\xc5\xcc\xed\xab\x52\xfb\xa5\xe6\x63\x13\xf5\x5b\xe3\x1a\xef\x94\x79\xcd\x0d\xe3\xb2\xf2\xba\x46\x2c\x3e\x07\x00\x2a\xe6\xe3\x64\xf4\x01\x00\x00")
+
+func boshLiteGcpDeleteJumpboxOverrideShBytes() ([]byte, error) {
+	return bindataRead(
+		_boshLiteGcpDeleteJumpboxOverrideSh,
+		"bosh-lite-gcp/delete-jumpbox-override.sh",
+	)
+}
+
+func boshLiteGcpDeleteJumpboxOverrideSh() (*asset, error) {
+	bytes, err := boshLiteGcpDeleteJumpboxOverrideShBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "bosh-lite-gcp/delete-jumpbox-override.sh", size: 500, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _boshLiteGcpExternalIpGcpYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x90\xb1\x4e\x33\x31\x10\x84\x7b\x3f\xc5\x48\x7f\x93\x48\xbf\x31\xb5\x25\x84\x78\x8e\x28\x3a\x2d\xe7\xbd\xc4\xc2\xf6\xae\xec\xbd\x84\x7b\x7b\x14\x10\xd0\xa5\xa1\xdc\x62\xbf\x6f\x66\xfe\xe1\x25\x91\x1a\x27\x2c\x5d\x2a\x5e\x65\x9c\x7d\x62\x2d\xb2\x55\x6e\x16\xf8\xdd\xb8\x37\x2a\x3e\xab\x6f\x62\xbe\xf3\x2c\xb5\x72\x4b\x9c\x1e\xb6\x5a\x9c\xf3\xb0\x4d\x39\xa2\xb3\x16\x9a\xd9\x01\x4a\x76\x8e\x08\x8d\xed\x2a\xfd\x6d\x04\xef\x80\x0b\x95\x95\xa3\x03\x80\x46\x95\x23\x16\x91\xcf\xeb\xeb\xf9\x92\xf5\x0e\x29\xb7\x61\xd4\x66\x9e\x4e\x5d\x56\x1d\xe1\x46\x78\xba\x05\xfd\x75\x3c\x86\xc4\x0b\xad\xc5\x9e\x7f\x64\x38\xa4\x36\xfe\xe3\x44\xc6\x57\xda\x8e\x7f\xe3\xdf\xed\x30\x8c\x2c\xcf\x53\xd6\x11\x71\xd8\xed\xbe\x27\x9b\xb2\xee\xf7\x47\xf7\x31\x00\xf8\x75\x38\x37\x61\x01\x00\x00")
+
+func boshLiteGcpExternalIpGcpYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_boshLiteGcpExternalIpGcpYml,
+		"bosh-lite-gcp/external-ip-gcp.yml",
+	)
+}
+
+func boshLiteGcpExternalIpGcpYml() (*asset, error) {
+	bytes, err := boshLiteGcpExternalIpGcpYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "bosh-lite-gcp/external-ip-gcp.yml", size: 353, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _boshLiteGcpIpForwardingYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x63\x00\x9c\xff\x2d\x2d\x2d\x0a\x2d\x20\x74\x79\x70\x65\x3a\x20\x72\x65\x70\x6c\x61\x63\x65\x0a\x20\x20\x70\x61\x74\x68\x3a\x20\x2f\x72\x65\x73\x6f\x75\x72\x63\x65\x5f\x70\x6f\x6f\x6c\x73\x2f\x6e\x61\x6d\x65\x3d\x76\x6d\x73\x2f\x63\x6c\x6f\x75\x64\x5f\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x2f\x69\x70\x5f\x66\x6f\x72\x77\x61\x72\x64\x69\x6e\x67\x3f\x0a\x20\x20\x76\x61\x6c\x75\x65\x3a\x20\x74\x72\x75\x65\x0a\x03\x00\xd8\xc0\xc4\x0e\x63\x00\x00\x00")
+
+func boshLiteGcpIpForwardingYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_boshLiteGcpIpForwardingYml,
+		"bosh-lite-gcp/ip-forwarding.yml",
+	)
+}
+
+func boshLiteGcpIpForwardingYml() (*asset, error) {
+	bytes, err := boshLiteGcpIpForwardingYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "bosh-lite-gcp/ip-forwarding.yml", size: 99, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _boshLiteGcpTerraformBoshLite_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x92\xdd\x8e\x9b\x30\x10\x85\xef\x79\x8a\x91\xd5\xcb\x42\x81\x45\x55\x6f\xf2\x24\xab\xc8\x32\x30\x65\xdd\x3a\x8c\x35\x1e\x27\xa9\x56\x79\xf7\xca\x98\xad\xf2\xd3\x64\x55\xa9\x5a\x71\x01\xc8\x33\xe7\x8c\xbf\x33\x8c\x81\x22\x0f\x08\x6a\x22\x9a\x1c\xea\x81\x76\x3e\x0a\xea\xef\x96\xf1\x60\x9c\x53\xa0\x7a\x0a\x2f\xe5\x68\x19\x07\x21\x2e\x9d\x15\x54\xf0\x5a\x00\xcc\x66\x87\x00\x00\x1b\x50\x9f\x5e\xf7\x86\x2b\x9c\xf7\xda\x8e\xa7\xf2\x2f\x0d\xa9\x1c\xe5\x40\xfc\x13\x36\x70\x65\xb5\x1e\x54\x7d\xef\xca\xb7\xef\xa4\x5d\x14\x00\x79\x3a\xcd\x66\x9e\x30\xc0\x06\x9e\x55\x5d\x2d\xcf\x97\x5a\x6d\x53\x81\x71\x8e\x0e\xcb\x38\x00\x9e\x58\x42\x9e\xe8\x59\x7d\xab\xd5\x67\x50\x5d\xf7\x94\x5e\x6d\xdb\xb6\x6a\x9b\x8b\x98\x84\x06\x72\x69\x6c\x19\xbc\x2a\x00\x4e\x49\x48\x0c\x4f\x28\x5a\xcc\x94\x7d\x1e\x5c\x49\x6d\x8b\x53\x51\xdc\x45\x67\xc6\x91\x31\x84\x1b\x72\xd6\x9f\x71\x7b\x07\x9a\xf5\xea\xa1\x07\x53\x4c\x31\x64\x87\x44\xb8\xdc\xef\xc2\x65\x2c\xf7\xa3\xf9\x53\x5f\x00\x8c\x18\x24\xe3\x5d\xaa\x9b\xba\x6a\xbb\x6e\x01\xdc\x7c\x3d\x4f\x2d\xab\x5d\x4d\x71\x37\xb9\xd4\x77\x14\xfd\x42\x5e\x5b\xbf\xea\xa6\xd8\x16\x49\xcf\x96\xd8\xca\xaf\x2c\xd9\x24\xf8\x23\x7a\x9c\xc7\xa0\x69\x4e\xec\xaf\x5c\x42\xec\xcf\x8d\xf2\xef\xe3\x08\x1e\x6e\x6f\x29\x83\x2f\x13\x40\x3b\x4f\x97\xc8\xde\x09\xe5\xa6\xf9\xe3\xb7\xba\xa9\xdb\xae\x6c\x9a\xf6\xe9\x7f\x6f\x33\x45\xf1\x51\x40\xe1\x51\x90\x67\xe3\xf4\xdb\xb6\xee\x8d\x8b\x78\x7b\xbd\x75\xc9\xab\x4b\x42\xd6\x57\xeb\xc1\xb9\xe6\x8f\xb8\xf3\x3d\x1d\xb5\xfe\x57\xf1\xb5\xf1\x52\xf6\xf7\x00\x72\x3e\x8e\xdb\xb3\x04\x00\x00")
+
+func boshLiteGcpTerraformBoshLite_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_boshLiteGcpTerraformBoshLite_overrideTf,
+		"bosh-lite-gcp/terraform/bosh-lite_override.tf",
+	)
+}
+
+func boshLiteGcpTerraformBoshLite_overrideTf() (*asset, error) {
+	bytes, err := boshLiteGcpTerraformBoshLite_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "bosh-lite-gcp/terraform/bosh-lite_override.tf", size: 1203, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _byobastionGcpReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x4f\x8f\xe4\xb4\x13\xbd\xe7\x53\x3c\xed\xfc\xb4\xb3\xab\x5f\x27\xcd\x5c\x38\x8c\x96\x45\xc0\x05\xa4\x15\x83\x60\x10\xec\x69\xbb\xe2\x54\x77\xcc\x38\xb6\x65\x97\xd3\xd3\x12\x1f\x1e\x95\xd3\x7f\xc5\x15\xf5\xa1\x95\xa4\xea\xd5\xf3\xab\xf7\x7c\x77\x87\x0f\x04\x4f\x13\x7f\x73\xdf\x1f\x42\x4f\x59\x6c\xf0\xed\xce\xc4\xfb\x8f\x1f\xd6\xf4\xf1\xf6\x5d\xd3\x3c\x07\x94\xcc\x38\x84\x92\x10\xf6\x1e\xc7\x8f\x08\x1e\x3b\x13\x57\x90\x91\xb1\xb5\x8e\x33\xac\xc7\xe6\xb6\x7b\xd3\xe4\x31\x14\x37\xa0\x67\x98\x10\x2d\x0f\x90\xb0\x40\xf5\xbd\x43\x16\x12\xc6\x60\x13\x1b\x09\xe9\xd0\x35\xcd\x1f\xe3\x01\xfb\xda\x71\x08\x05\x43\x80\x8c\x36\x7f\xdb\x34\x9f\x43\xc1\x9e\xbc\x68\xbb\xb2\x21\xcc\x93\xce\xab\x50\x73\x34\x90\x91\x04\x86\x3c\x32\xa7\x99\x1b\xca\x95\xd7\x89\xab\x04\x10\xfa\xde\xdd\x0f\x28\xf1\x3c\x70\x85\xbe\xc8\xd2\x39\xd1\x0b\xe7\xa6\x64\xeb\x77\xd7\x95\x7f\x95\x29\xf6\xe1\x15\x5b\x66\x87\xc4\x43\xf1\x03\x79\xe9\xf0\x3c\xda\x8c\x48\x62\xc6\x66\x6f\x9d\xc3\xc0\xd1\x85\x03\xe8\x0c\x7d\xe1\xd3\x33\xc8\x18\xce\x99\x87\xe3\x57\x77\xc0\x36\x85\x49\x09\x36\x27\x82\x7b\x2b\x63\x28\x02\x3a\x8d\x5c\xb8\xf9\x20\xe7\x5a\x38\x4a\x3b\x4e\xb0\x5e\x38\x79\x96\xdc\x35\xcd\xf3\xc8\xc8\xc2\x31\x63\xb2\xbb\x51\xe0\x42\x78\x81\xb3\x2f\x8c\x5c\xcc\xf8\xd8\x34\x0f\x1d\x7e\xf2\x3a\x49\x77\x05\x13\x7c\x0e\x8e\x57\x30\x89\x55\x79\x82\x67\xd9\x87\xf4\x02\xf2\x43\xd5\x74\x85\x58\x04\x56\x54\x5b\xc2\xc3\x57\x5d\xfd\xad\x1f\xbe\x46\x2e\xbd\x67\xe9\x14\x31\xe7\x51\xf7\x50\x8f\xa8\x2d\xd6\x67\x21\xe7\x54\xb6\x15\x84\x53\xa2\x6d\x48\xd3\xaa\x82\xea\xe8\x3e\xe4\x11\xc6\x59\xbc\xd3\x37\x56\xb2\xea\xc5\x7e\x60\x6f\x2c\xe7\xee\xbd\x62\xfe\xb0\x30\x3a\x59\xa3\x5d\xac\xa1\xf5\x14\xa3\x3b\x54\x1f\x20\x3a\xf2\x47\xd9\x01\x60\xb3\xd9\xd4\xff\xe9\x65\xb0\x09\x3d\x79\xf2\xd4\xb2\x9f\xf1\xf6\x2d\xcc\x70\xf5\xa2\xa9\x65\x26\xa2\x4d\x95\x4c\xdb\x87\x20\x2e\xd0\xc0\x69\xad\x98\x6d\xc5\xe4\xbc\xbe\x75\xef\xba\x43\xb7\xb4\xaa\x59\xb5\x10\x6d\xab\xc1\xb9\x86\x3e\x11\xa9\x87\x08\x7e\x6b\x77\x25\x71\x55\xbc\x82\x56\x11\xb4\xbd\xc4\xc7\x73\xad\xfe\xcf\x76\xc2\x4c\x29\xaf\x8f\x03\x3b\xd9\xea\x23\xee\x34\x4c\x0e\x6a\x86\x99\x92\xa5\x5e\x93\xa5\xf6\xa8\x98\xc7\x7d\xad\x8e\xeb\x58\x34\xe6\x57\x75\x04\x39\xd8\x58\x73\xad\x01\xbb\x9f\x19\x13\x0d\x0c\xfb\xaf\xf5\x5f\x8e\x54\x22\xee\x50\x0d\xcc\xaf\x56\xf0\x70\x43\xf0\xe7\x20\xfc\x88\xcd\x52\xb7\xc1\x96\xac\xcb\xd8\x8f\xd6\x31\x24\x1d\x34\x28\x12\x50\xe2\xa0\x7b\xd2\x09\xc6\x85\x32\xb4\xa6\x4a\x50\x01\x7a\x36\xa4\x59\xb5\x02\xca\xb9\x4c\x5c\x33\x99\x18\x36\x5f\x7c\x5e\x0f\x20\xc9\xea\xc7\xa0\x06\x15\x32\x9a\xc9\xcb\xb5\x50\xb1\x66\x4b\xd0\x70\xab\xf3\x8a\xf7\xec\xf0\x7f\xc4\x14\x5e\xd5\x18\x29\x94\xdd\x08\xab\xb1\x54\x42\xba\x57\xd0\x42\x07\x0b\x9d\x73\xbc\x6a\x8b\xf5\xbb\x8a\x79\xea\xac\x9e\x7b\xe7\x83\xe7\x57\x9b\x85\xbc\xbc\xbf\x84\x50\x6f\x21\x4d\x71\x2a\x7e\x49\xd3\xef\x0b\x7e\xed\xb9\x1e\x71\x23\x1d\xcf\xe4\xf0\xe6\x7f\xef\x54\xbb\x98\xac\x97\xea\xca\xbf\xb1\x4b\x1c\xd1\xce\xf8\xfe\xe9\xb7\x1f\xbf\x7c\xf7\xe9\xd3\x97\x5f\x7e\x7d\xfa\xf3\xf3\xfb\x37\xc7\x8d\x68\x4c\x16\x41\xdb\x6b\x31\x6f\x94\x5d\x5f\x3f\x74\x87\xc9\xa1\x0d\xb7\x05\x21\x66\x7d\x7f\x26\xf4\xd0\xe1\xc9\x9b\x63\xb4\xce\xf7\x93\xcd\xc7\x4b\x8b\x87\xcb\x29\x45\x6f\x98\x1a\x7f\x15\xec\xf1\xbf\x3a\xd3\xf1\x76\xec\xba\xae\x01\x80\xcd\x66\xd3\x34\xff\x0c\x00\xce\x97\x7e\x1b\x86\x06\x00\x00")
+
+func byobastionGcpReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_byobastionGcpReadmeMd,
+		"byobastion-gcp/README.md",
+	)
+}
+
+func byobastionGcpReadmeMd() (*asset, error) {
+	bytes, err := byobastionGcpReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "byobastion-gcp/README.md", size: 1670, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _byobastionGcpCreateDirectorOverrideSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xd1\x4e\x83\x30\x14\x86\xef\x79\x8a\x3a\xbd\xed\x78\x02\x2f\x18\x23\x6e\xba\x0c\xc2\xd0\x38\x63\xd2\x94\x72\x1c\x5d\xa0\x6d\xda\xb2\x38\xcd\xde\xdd\xb4\x68\xc6\x85\xc6\x71\xd9\xf6\xfb\xce\xc9\xff\xf7\xfa\x2a\x2c\xb9\x08\x4d\x1d\x74\xc2\x80\x45\xb3\x74\xb3\x20\xd1\x6a\x45\xb2\x3c\x7d\xde\x06\xa5\x34\x35\x62\x1a\xa8\x05\x0c\xe2\x80\x5e\x03\x84\x6e\x3e\x67\xb3\x15\xd9\x14\x51\x91\x90\xf9\x32\x3f\x85\x0e\xc2\x15\xa8\x46\x1e\x5b\x10\xd6\x9f\xa7\xc7\xb6\xf1\x34\xc6\xc6\x52\x0b\xbf\x68\x07\xaa\x8d\x67\x7b\x62\xba\x37\x52\x7c\x2b\xee\x09\x1b\x2b\xf5\x9f\x5e\xc5\x35\x30\x2b\xf5\x00\x1d\xac\xf4\x97\x6f\xbc\xb9\x50\x77\xe4\xd9\x96\x17\x44\xdc\x31\x15\x32\xc5\xc7\x49\xfb\xae\x55\xa5\x7c\xc7\x9d\x01\x3d\xce\xec\x28\x1d\x27\x30\x0d\x55\xdd\x95\xff\x48\x65\x83\xa5\xea\xe3\x1b\x1f\xa9\x1f\xf3\xd3\x0d\xa8\x1a\x5a\xd0\xb4\xc1\x5c\x39\xf0\x3c\xcd\xb5\xee\x35\x84\x76\x4c\x11\xb7\x0d\x84\xe5\xb4\x31\xc4\xfd\xe2\xed\xa4\x2f\xf0\x2e\xce\xc8\x26\xc9\x9f\x96\x71\x42\xa2\x38\x4e\x1f\xd7\x05\x79\x48\xb6\x24\x8b\x8a\xc5\x69\xd2\x8f\x3a\x20\xa4\xb4\xdc\x03\xb3\x84\x57\x43\x31\xcb\xd3\xfb\x24\x2e\xc8\x72\x3e\x40\x3f\xa4\x80\x21\xf4\x92\xae\x93\xd3\x04\x05\x5f\x03\x00\x9d\x80\x1a\xef\xc7\x02\x00\x00")
+
+func byobastionGcpCreateDirectorOverrideShBytes() ([]byte, error) {
+	return bindataRead(
+		_byobastionGcpCreateDirectorOverrideSh,
+		"byobastion-gcp/create-director-override.sh",
+	)
+}
+
+func byobastionGcpCreateDirectorOverrideSh() (*asset, error) {
+	bytes, err := byobastionGcpCreateDirectorOverrideShBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "byobastion-gcp/create-director-override.sh", size: 711, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _byobastionGcpCreateJumpboxOverrideSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x0a\x00\xf5\xff\x23\x21\x2f\x62\x69\x6e\x2f\x73\x68\x0a\x03\x00\x1d\x9d\xfb\x04\x0a\x00\x00\x00")
+
+func byobastionGcpCreateJumpboxOverrideShBytes() ([]byte, error) {
+	return bindataRead(
+		_byobastionGcpCreateJumpboxOverrideSh,
+		"byobastion-gcp/create-jumpbox-override.sh",
+	)
+}
+
+func byobastionGcpCreateJumpboxOverrideSh() (*asset, error) {
+	bytes, err := byobastionGcpCreateJumpboxOverrideShBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "byobastion-gcp/create-jumpbox-override.sh", size: 10, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _byobastionGcpDeleteDirectorOverrideSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xdf\x4e\xc2\x30\x14\xc6\xef\xf7\x14\x15\xbd\x2d\x7b\x02\x2f\xc6\x58\x04\x25\x6c\x19\xd3\x88\x31\x39\xd9\x9f\x23\x2b\xe9\xda\xa6\xed\x88\x68\x78\x77\xd3\x4d\xc3\x2e\x34\xb2\xcb\xb6\xbf\xdf\x39\xf9\xbe\x5e\x5f\xf9\x05\x13\xbe\xa9\xbd\x56\x18\xb4\x64\x16\x6f\x16\x10\xac\x56\x90\xa4\xf1\xf3\xd6\x2b\xa4\xa9\x49\x85\x1c\x2d\x52\x14\x07\xf2\xea\x11\x72\xf3\x39\x9b\xad\x60\x93\x05\x59\x04\xf3\x65\x7a\xf2\x1d\x44\x2b\x54\x5c\x1e\x1b\x14\xb6\x3b\x4f\x8f\x0d\xef\x68\x4a\x8d\xcd\x2d\xfe\xa2\x1d\x72\x6d\x3a\xb6\x27\xa6\x7b\x23\xc5\xb7\xe2\x9e\xa8\xb1\x52\xff\xe9\x55\x4c\x63\x69\xa5\x1e\xa0\x83\x95\xdd\xe5\x1b\xe3\x17\xea\x8e\x3c\xdb\xf2\x82\x88\xbb\x52\xf9\xa5\x62\xe3\xa4\x7d\xdb\xa8\x42\xbe\xd3\xd6\xa0\x1e\x67\xb6\x79\x3e\x4e\x28\x35\x56\x75\x5b\xfc\x23\x15\x9c\x4a\xd5\xc7\x37\x5d\xa4\x7e\xcc\x4f\x37\xa8\x6a\x6c\x50\xe7\x9c\x32\xe5\xc0\xf3\x34\xd7\x7a\xa7\x11\xb2\x2b\x15\xb8\x6d\x28\x2c\xcb\xb9\x01\xf7\x8b\xb7\x93\xbe\xc0\xbb\x30\x81\x4d\x94\x3e\x2d\xc3\x08\x82\x30\x8c\x1f\xd7\x19\x3c\x44\x5b\x48\x82\x6c\x71\x9a\xf4\xa3\x0e\x84\x28\x2d\xf7\x58\x5a\x60\xd5\x50\x4c\xd2\xf8\x3e\x0a\x33\x58\xce\x07\xe8\x87\x14\x38\x84\x5e\xe2\x75\x74\x9a\x10\xef\x6b\x00\xc7\xcf\x4e\x4a\xc7\x02\x00\x00")
+
+func byobastionGcpDeleteDirectorOverrideShBytes() ([]byte, error) {
+	return bindataRead(
+		_byobastionGcpDeleteDirectorOverrideSh,
+		"byobastion-gcp/delete-director-override.sh",
+	)
+}
+
+func byobastionGcpDeleteDirectorOverrideSh() (*asset, error) {
+	bytes, err := byobastionGcpDeleteDirectorOverrideShBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "byobastion-gcp/delete-director-override.sh", size: 711, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _byobastionGcpDeleteJumpboxOverrideSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x0a\x00\xf5\xff\x23\x21\x2f\x62\x69\x6e\x2f\x73\x68\x0a\x03\x00\x1d\x9d\xfb\x04\x0a\x00\x00\x00")
+
+func byobastionGcpDeleteJumpboxOverrideShBytes() ([]byte, error) {
+	return bindataRead(
+		_byobastionGcpDeleteJumpboxOverrideSh,
+		"byobastion-gcp/delete-jumpbox-override.sh",
+	)
+}
+
+func byobastionGcpDeleteJumpboxOverrideSh() (*asset, error) {
+	bytes, err := byobastionGcpDeleteJumpboxOverrideShBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "byobastion-gcp/delete-jumpbox-override.sh", size: 10, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _byobastionGcpTerraformBastion_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x94\xd1\x6e\xe2\x3c\x10\x85\xef\xf3\x14\x23\xeb\xbf\xfc\x13\x56\x14\x2a\x54\x29\x4f\x52\xa1\xc8\x49\x66\x53\xef\x1a\x8f\x65\x8f\xa1\xab\x8a\x77\x5f\x25\x0e\x90\x2c\x84\x36\xaa\x04\x37\x16\x56\xce\x37\x67\x8e\xed\xd9\x4b\xa7\x64\xa9\x11\x04\xbe\x2b\xcf\xca\x34\x69\x59\xea\xd4\x20\x1f\xc8\xfd\x16\xf0\x91\x00\xf0\x1f\x8b\x90\x83\x67\xa7\x4c\x93\x1c\x93\x64\x42\xe4\x43\x69\x90\x67\x68\xa4\x67\x45\x26\x95\x75\xed\xd0\xfb\x09\xa1\x43\x4f\xc1\x55\x08\xa2\x21\x6a\x34\x16\x15\xed\x6c\x60\x2c\xce\x1e\xc5\x95\xe3\x8a\x82\x61\xc8\xe1\xc7\x5d\x40\xf4\x3b\x60\x0c\x1b\xf8\x1a\xe2\x6c\x5d\xfc\x0a\x3b\x5b\xd2\x7b\xaa\xec\x0d\x7d\x2d\x59\x5e\x69\xc7\xb5\xcf\xff\x5a\xb1\x91\xbb\x36\xf1\xbd\x74\xd9\x28\xe1\xfe\xa3\x49\xe4\xdd\x8e\xa6\xa0\x51\x34\xc9\x9c\x6e\xf1\x26\x70\x7c\xa6\x77\xc3\xfb\xa9\x1c\x1e\xa4\xd6\xa2\xbd\x47\x8c\xce\x48\xdd\x83\x63\x0f\x90\x43\xeb\x28\xfb\x47\xd6\x77\x98\x0d\x02\xc9\x5a\x2b\x5f\xad\x55\x92\x7f\x4b\xc9\xa2\x19\x17\x8b\xbf\x99\x25\x01\x62\xbd\xc2\x49\xd3\xa0\x87\x1c\x5e\xc5\x7f\x1f\xb7\x10\x7d\x20\xd9\x25\xc4\xac\xdf\x3a\x2e\x9e\x96\x62\x9b\x24\x00\x52\x6b\x3a\x74\xa6\x00\x2c\x39\xf6\xd1\xd1\xab\x58\x2e\xc5\xff\x20\x9e\x37\xcf\x9b\x76\xdd\xac\x56\x4f\xdd\xba\x59\xad\xda\x75\xb9\x5e\xaf\xd7\x62\x1b\x65\x8e\x98\x2a\xd2\x90\x83\xe0\xca\x8a\x04\xe0\xd8\xa2\x59\xba\x06\xb9\x60\xd9\x9c\x4c\x76\x97\xcb\xec\x0b\x55\x1f\xd3\x2e\x92\x5a\x39\xac\x98\x9c\xd8\xce\x4a\xf2\x2c\x7b\xc0\xd1\x29\x13\xaf\x49\xca\xf4\xd0\xba\xa7\x43\x63\x4a\xbb\x8d\xc7\x75\xfa\xdd\x5a\x14\xd8\x06\x06\xd1\xef\x47\xdc\x5e\xea\x80\xdf\x80\x0d\xc7\xcc\x67\xbc\xcb\xb7\xd9\x65\xd8\x5c\x11\xfb\x80\x8b\xe0\xf4\xe7\xc8\xe9\xa7\x34\x44\x9e\xee\xc7\x65\x46\x0f\xb9\xe2\x8d\xd9\xfa\x97\xc5\x62\xf6\x6b\x7d\x89\xcf\x6d\x58\xea\x34\xbd\x0a\x65\xc7\x55\x66\xbb\xff\x3b\x00\x98\x35\x27\x83\x8f\x07\x00\x00")
+
+func byobastionGcpTerraformBastion_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_byobastionGcpTerraformBastion_overrideTf,
+		"byobastion-gcp/terraform/bastion_override.tf",
+	)
+}
+
+func byobastionGcpTerraformBastion_overrideTf() (*asset, error) {
+	bytes, err := byobastionGcpTerraformBastion_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "byobastion-gcp/terraform/bastion_override.tf", size: 1935, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _byobastionGcpVarsBastionTfvars = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xcb\xb1\x0d\x02\x31\x0c\x46\xe1\x3e\x63\xa4\xf7\x08\x0c\x63\x2b\x56\xb0\x08\xbf\x51\x6c\x44\xc6\xa7\x80\x93\xee\x9a\xd4\xef\x7d\xba\x2c\xd2\xd0\x49\x64\x10\x34\x3f\x3e\x1f\xb7\x0a\x07\x89\xc7\x9d\x9e\x0c\xee\xda\x48\x38\xd2\x1c\xc7\x51\xcb\xc5\xc5\x5b\xa0\xb9\x61\xbf\xe1\xac\xfe\x81\x5b\x9b\x1a\xb1\xa1\xba\x52\x27\x78\x90\xbd\x6a\xf9\x0e\x00\x07\xb5\x7b\xe3\xae\x00\x00\x00")
+
+func byobastionGcpVarsBastionTfvarsBytes() ([]byte, error) {
+	return bindataRead(
+		_byobastionGcpVarsBastionTfvars,
+		"byobastion-gcp/vars/bastion.tfvars",
+	)
+}
+
+func byobastionGcpVarsBastionTfvars() (*asset, error) {
+	bytes, err := byobastionGcpVarsBastionTfvarsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "byobastion-gcp/vars/bastion.tfvars", size: 174, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrAwsReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\x51\x6f\x1b\x39\x0e\x7e\xf7\xaf\x20\xa6\x46\x2f\x01\x2a\x4f\x83\xf6\x80\x9c\xd1\xe9\x5d\xaf\xe9\x02\x8b\x16\x8b\x62\xdb\x87\x5d\x20\x40\xac\xd1\xd0\x33\xaa\x35\x92\x20\x51\x4e\xdc\xc0\xff\x7d\x41\x8d\xed\x8c\xb3\xce\x6e\xf7\xc9\x03\x89\xe4\xc7\x8f\xfc\x48\xf9\x19\x7c\x96\xa4\xba\x39\xa8\xa5\x0a\x42\xde\xc6\xc9\xe4\x0b\xa1\x8f\x40\x0e\x1a\xf4\xc6\x6d\xf2\x0d\xdc\x6a\xea\xa0\xae\xcd\x7c\x32\xb9\x98\xc1\x97\xe4\xbd\xd9\x80\x84\x55\xaa\x31\x58\x24\x8c\xd0\xcb\x48\x18\xa0\x73\x91\x66\xf0\xbb\x4b\x01\x56\x97\x11\xa4\xd7\x70\xab\x8d\x81\x1a\x41\x12\x50\xa7\x63\x36\xb1\xb2\xc7\xd9\x04\x00\x60\xb1\x58\xe4\x5f\xbc\xf3\x2e\xd0\x28\xe2\xcd\x10\xf1\x86\xcd\x2b\x4e\x62\xb6\x71\x29\x88\xc6\xf5\x52\x5b\xd1\x61\xc0\x59\xad\xbf\x1f\x62\x5c\xcc\xe0\x27\x67\x8c\xbb\x05\xea\x10\xac\x0b\xbd\x34\x10\xf7\x5c\xea\xda\x40\xf2\x03\x0d\x09\x9e\x39\x1f\xa1\xf7\xab\x46\x07\xa8\xa5\x95\x56\x0a\xb4\x6b\x78\xfe\x1c\x54\x33\x3a\xc8\x56\x1c\xc5\x1b\x69\x41\x08\x26\xf0\xf8\x5a\x79\x10\x01\x6a\x17\x3b\x51\x3b\x47\xc6\xc9\x06\x43\xc9\x0e\x22\x23\x62\x2c\xf7\x65\x2e\x67\x30\xd0\x47\xd5\xb9\xa7\x48\x5f\x17\xd3\xfb\xd3\x57\xdb\xeb\x02\xde\xc2\x5a\x86\x21\xe2\x8c\x96\xfc\x3d\x39\x24\x99\x7c\xfe\xc4\xb5\x34\x50\x4c\xcf\x72\xde\x41\x5b\x62\x6a\xe7\xc5\x81\x39\xf7\x72\xc1\xf9\x42\xf2\x9c\xad\x08\x68\x50\x46\x84\x8e\xc8\xc7\x79\x59\x46\x72\x41\xb6\x38\x6b\x9d\x6b\x0d\x4a\xaf\xe3\x4c\xb9\xbe\x5c\xa5\xda\x09\x9f\x6a\xa3\xd5\xf0\xbd\xf3\x13\x46\x12\x46\x9a\x51\xfb\xfd\x44\xf0\x48\xd8\x2b\x34\xe6\x10\x9d\x91\x67\xda\x95\x4d\xb9\xbf\x8a\xf9\x8c\x0b\x24\xee\xd0\x8a\x6e\xdd\x8b\x54\x27\x4b\x49\x50\x48\x91\x36\xa2\x75\x37\xb2\x45\x4b\xd7\xff\x5d\x5f\x57\xaf\xfe\xfd\xfa\x62\xf6\x9f\x01\x69\x27\x9f\x8f\x57\x20\x23\xb0\x50\xb8\xc9\x1d\xb7\x3e\x27\x38\x68\xb9\x47\x4b\x10\x1d\xdf\x83\x92\x16\x94\xf3\x1b\xe1\xb9\xac\xb0\x0c\xae\x87\x1a\x59\x3f\x7a\x99\x0d\x22\x4f\x40\xd4\x01\x27\x0f\x3a\x69\x35\x81\x32\xce\x22\xb4\x9a\xfe\xd7\x6a\xea\x52\xcd\x15\x99\x2b\xe3\x52\xb3\x74\xc9\x36\x61\x23\xb4\x55\xa9\x96\xe4\x42\xf9\x08\x7b\xd6\x6a\x9a\x3c\x68\xfd\xe3\x55\x35\x3d\xf3\xb7\xcd\xf9\x63\xbb\x3d\x22\x13\xbb\xca\x99\x67\x51\x73\xab\xa1\x97\x56\x2f\x31\x1e\x6c\xb8\xe3\x5c\xe4\xdd\xb4\x8a\x66\x18\xd8\xe9\xfd\xc7\xab\x6d\xb9\x37\xde\xc9\x64\xd3\x1b\xb8\x66\x47\xe1\xfe\x64\xe0\x7c\x14\x4b\x6d\x30\x96\x5a\xca\x58\xca\xdb\x58\x66\x52\xc2\x07\xb7\xd6\x0d\x1e\x7b\x73\x38\xe1\x7c\x1c\x1d\x1a\x78\x93\x85\xe6\x12\xf9\x44\xf1\x7c\x4c\xe2\xbd\xb3\x4b\xdd\xa6\x80\x59\xea\x8a\x4c\x96\xea\xd7\x0e\x2d\x84\x64\x33\xb9\x65\x1e\x5e\x6d\x5b\xee\x59\xaf\xef\xf8\xb0\x07\x72\x2d\x52\x87\x01\xb4\x1d\x5a\x89\x8a\x8c\x90\xde\x07\xe7\x83\x96\xdc\x38\x17\xfa\x38\x1f\x55\x63\x57\xdc\x46\x07\x54\xe4\xc2\x0d\x4f\x6a\x35\x1d\xa7\x06\x42\xac\x70\x73\x6c\x71\x3e\x72\x95\x4d\x13\x30\xc6\xaa\xd8\x0b\xf5\xc9\x31\x9c\x5f\xbe\x7e\xfd\xaa\x18\xb9\x2a\x93\xf2\xfc\x66\xd0\x82\xdb\x3a\x9f\xde\x1f\x01\x6d\xe7\x5c\xbb\xb1\x4f\x8a\x3f\xe0\x20\x64\xd3\x6b\x7b\x04\xe5\x2c\xe1\x1d\xfd\xad\x67\x91\x6b\xad\x02\x36\x5d\xaa\xc1\xb8\x56\xdb\x51\x94\x1c\xf6\xc6\xcb\x18\x6f\x5d\x68\xb8\x4e\x2c\x26\x6d\x09\xde\x9c\xed\x7d\x5a\x24\x10\x16\x8a\xc7\xe1\xb3\xa8\x06\xed\xe6\x30\x62\x1f\xa6\x00\x21\x86\x5a\x8b\x6f\xd1\xd9\x73\x10\x82\xa7\xb1\x2a\xd7\xd2\x24\x3c\xcf\x09\x3d\x03\xd9\x34\xc3\x83\xb0\xc3\x11\x2d\x5a\x0c\x92\xb0\x81\xf7\xef\x58\x05\x79\x8c\xe3\x86\x97\x03\xac\x70\x13\x58\x1c\xc3\x70\xfe\xab\x01\xa3\x57\xc8\x46\x32\x51\x87\x96\xb4\x62\x31\xf0\x7e\x77\x89\x40\x08\x6d\x23\xaa\x14\x50\xc4\x95\xf6\x82\x4c\x14\x6b\x0c\x7a\xb9\xa9\x28\x24\x1c\xf1\xa7\xde\xdf\x28\x79\xc3\xca\xaf\x8a\xe9\x59\xbf\x22\xec\xfd\xb0\x22\xff\x71\x25\x18\xe5\x41\x28\x7f\x5d\x84\x52\x49\x78\xcb\x25\x1d\x25\xb0\x1d\x5a\xb5\x53\x39\xa8\x3c\x34\x10\x91\xc4\x4e\x56\x6c\x3f\x56\xd8\x96\x31\x22\x86\x35\x86\xaa\x98\xde\xef\x74\xbb\x2d\x7e\x84\xff\x29\x94\x80\x0d\x97\x52\x9a\xc8\x48\x07\x5d\xe6\x80\xe4\x56\x68\x07\x94\xb1\x62\xb6\xc5\x53\xc1\x06\x79\x72\xa0\xb1\x52\x73\xac\x1d\x87\xea\x14\x1d\x46\xad\x8e\xd1\x4f\x20\xa4\x88\x4f\x23\x1c\x36\x0f\x08\x58\xec\x1d\x59\xc4\xde\x35\x71\x31\x01\x01\x2a\xa0\x24\x7c\x01\x51\x49\x83\x2f\x40\xda\x26\xeb\x21\x22\x48\xef\xe3\xf0\x37\x81\x97\xd2\x43\x37\x81\x5f\x74\x25\x7b\x0f\x8d\x53\x2b\x5e\x48\xbd\x6c\x71\xbc\x77\xf6\x40\xbc\xd0\x1e\xfc\xc4\xc1\x4f\x88\xec\x52\x0d\xfe\xfc\xee\x7d\x73\x4a\x92\x34\xda\x96\x27\xec\xe7\xeb\x0b\x56\x8c\x0b\x54\x5d\xbe\xbc\x7c\x39\x46\xd8\x53\x19\x9f\xed\xd2\x7f\x78\x43\x4e\xc5\xe4\x36\x6e\x3c\x56\xc5\x27\x27\x9b\xff\x4b\x23\xad\xc2\x70\xd4\x40\x0e\xcd\x82\xd2\x0a\x23\x9f\x3f\xcb\x92\xff\xf0\xdb\xd7\x0f\xbf\xfe\xf2\xee\x93\xf8\xf9\x33\xef\xdb\x53\xf4\xd8\x58\xa5\x30\x3c\xee\x79\x65\x8e\x9c\xb6\xf3\x3d\x85\xc5\x62\x31\x99\xfc\x31\x00\xec\xbf\x64\x3c\x75\x0a\x00\x00")
+
+func cfcrAwsReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrAwsReadmeMd,
+		"cfcr-aws/README.md",
+	)
+}
+
+func cfcrAwsReadmeMd() (*asset, error) {
+	bytes, err := cfcrAwsReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-aws/README.md", size: 2677, mode: os.FileMode(480), modTime: time.Unix(1792208216, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrAwsCfcrOpsYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xcf\xb1\x4e\x2b\x31\x10\x85\xe1\xde\x4f\x31\x65\xb6\xb0\xdc\xaf\x74\x95\xe2\x96\x3c\x84\x35\x31\x87\x8d\x15\xaf\x6d\xcd\x8c\x17\x78\x7b\xb4\x5a\x14\xa0\x01\xa5\xb5\x8e\xff\x4f\xe3\xc9\xde\x3b\x66\x12\xf4\xc2\x09\x8e\xa8\xb3\x5d\x67\x0a\xb9\xaa\x71\x4d\x88\x8b\xb4\xd1\x35\x54\x5e\xf1\x6f\x65\x35\x48\xd8\xd6\x88\x37\x43\xd5\xdc\xaa\x9e\x83\x77\x44\x1b\x97\x81\x99\xd2\x4b\x12\x7f\xac\x7c\x2a\x6d\x3c\xfb\x2e\xad\x43\x2c\x43\x9d\x7b\x0c\x7b\x6d\x72\xfb\x1b\x3b\x56\x8f\x60\x1b\x4b\xe6\x4b\xc1\x27\x63\x45\xfd\x6d\x5c\x20\x15\x06\x0d\xad\xdb\x0e\x05\x2e\x06\xa9\x6c\x79\x43\xdc\x77\xfa\x5d\x3e\x9d\xbe\x7e\xc4\xe3\xdc\x78\x6d\x6a\xd3\xf4\x8b\x6b\xbc\xe8\xf9\xde\x70\x44\x44\x4f\xf7\xca\xff\x32\xf6\xca\xcf\xb4\x4f\xc7\xab\x37\x5e\xa6\xc9\x7d\x0c\x00\x87\x93\x9c\x8f\xac\x01\x00\x00")
+
+func cfcrAwsCfcrOpsYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrAwsCfcrOpsYml,
+		"cfcr-aws/cfcr-ops.yml",
+	)
+}
+
+func cfcrAwsCfcrOpsYml() (*asset, error) {
+	bytes, err := cfcrAwsCfcrOpsYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-aws/cfcr-ops.yml", size: 428, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrAwsCloudConfigCfcrCloudConfigOpsYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x8f\x3b\x8e\x03\x21\x10\x44\x73\x4e\x51\x21\x04\x68\x72\x2e\x83\x7a\xd9\x9e\x5d\xb4\xfc\x04\x3d\xb3\xf6\xed\xad\xf9\xc8\x4e\xec\xc8\x4e\xab\xba\x9e\xfa\x59\xc8\xb5\xb1\x43\xe7\x96\x28\xb0\x02\x1a\xc9\xaf\xc3\xb4\x66\xcf\x17\xe1\x32\x62\x2d\x63\xb2\x0a\x58\x29\x2d\xec\x14\x00\x14\xca\xec\x10\xe6\xd0\x6d\xa6\x21\xdc\x6d\x48\x75\xf9\xb6\xad\xd7\xc6\x5d\x22\x8f\xfd\x6c\x0f\xfd\x23\x3c\xc6\x40\xa4\xec\x63\x19\x42\x25\xf0\x56\xcf\x31\xb1\x83\xd6\x07\xcb\x3f\xab\x8d\x39\xb7\x9c\xbe\xee\x1c\x0b\xad\xb7\x27\xfc\x39\x14\xea\x3f\x2c\xbe\xd5\x9a\x8c\x51\xea\x6d\xb7\xff\xda\xff\x3e\xe5\x76\xb0\x5e\xb9\xdd\x06\x00\x24\x24\x0c\x28\x87\x01\x00\x00")
+
+func cfcrAwsCloudConfigCfcrCloudConfigOpsYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrAwsCloudConfigCfcrCloudConfigOpsYml,
+		"cfcr-aws/cloud-config/cfcr-cloud-config-ops.yml",
+	)
+}
+
+func cfcrAwsCloudConfigCfcrCloudConfigOpsYml() (*asset, error) {
+	bytes, err := cfcrAwsCloudConfigCfcrCloudConfigOpsYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-aws/cloud-config/cfcr-cloud-config-ops.yml", size: 391, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrAwsTerraformCfcr_dns_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x41\x4b\xc4\x30\x14\x84\xef\xf9\x15\x43\xf0\xba\x61\x61\xf1\xd8\x83\x88\x47\xf7\x0f\x88\x84\xd7\xe6\xb9\x06\xdb\x64\x79\x49\x2b\xba\xf4\xbf\xcb\x6b\xb7\x07\x45\xcd\x2d\xf0\x66\xe6\x9b\x99\x48\x22\xb5\x3d\xc3\xbe\x8d\x2d\x4b\xe2\xca\xc5\x0f\x54\x2a\x8b\x7f\xcd\xa5\x5a\x5c\x0c\x50\x3f\xce\x8c\x06\xa5\x4a\x4c\x27\x33\x1b\x23\x5c\xf2\x28\x1d\xc3\xd2\x7b\xf1\x92\xc7\xca\xb7\x07\xff\x99\x13\x5b\xd8\xee\xa5\x13\x1f\x52\xb9\xfe\xd5\x20\xd1\xa0\x06\x13\x89\xfb\x3d\xc7\x68\x0a\x9d\x0a\x9a\x25\x10\x38\xae\x0a\x7b\x73\x51\x11\xa7\xc9\xc7\x30\xef\xd4\x7a\xa7\xf7\x1c\x76\x8b\xbb\x01\xe6\xbf\x81\x84\xbb\x2c\x61\x43\xa2\x73\x5c\xeb\xa8\xd2\xc7\x80\x06\x3f\xe9\xdd\x37\x76\x17\xc3\xc6\x0e\xfc\x8f\x7f\xdd\x68\x39\xb3\xf7\xc7\xbb\xc7\x07\xab\x8d\x6a\x0f\x7d\x0d\x0e\xfb\xbd\x56\x5c\x81\xb4\xe5\x93\x46\x73\xdf\xba\x0d\xcd\xe9\x62\x89\x06\x7e\x36\xb3\xf9\x1a\x00\xa2\xe5\xd5\xcf\x97\x01\x00\x00")
+
+func cfcrAwsTerraformCfcr_dns_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrAwsTerraformCfcr_dns_overrideTf,
+		"cfcr-aws/terraform/cfcr_dns_override.tf",
+	)
+}
+
+func cfcrAwsTerraformCfcr_dns_overrideTf() (*asset, error) {
+	bytes, err := cfcrAwsTerraformCfcr_dns_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-aws/terraform/cfcr_dns_override.tf", size: 407, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrAwsTerraformCfcr_iam_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\x51\x6b\xdb\x30\x10\x7e\xf7\xaf\x10\x62\x4f\xa3\x09\x6d\x5f\x06\xa1\x7d\x48\x9b\x76\x2b\x74\xb4\x24\xa1\x7b\x18\x25\x28\xf2\x25\x11\xb1\xa5\x70\x92\x13\xd2\x92\xff\x3e\x64\x39\xa9\xe3\x38\x9e\xec\xb6\x30\x18\xed\x83\xc9\x7d\xdf\xdd\xe9\xee\x3b\xf9\x8c\xa0\x55\x82\x1c\x08\x65\x2b\x3d\x12\x2c\x1e\xa1\x8a\x60\xb4\x50\x91\xe0\x6b\x4a\x28\x9f\x70\x1c\xc5\x4c\x1b\x40\x4a\x5e\x03\x42\x24\x8b\x81\x5c\x12\xfa\xe5\x75\xc9\xb0\x0d\x72\x39\x12\xe1\xa6\x65\x61\xad\x0c\x16\x10\x62\x7d\x90\x4b\x92\x77\xd9\xce\x79\x6a\x8b\x30\x08\x08\x71\x41\xc8\x25\xb9\xb8\xb8\x79\xb8\x0d\xac\x77\xfa\x04\xa8\x85\x92\xb4\x43\xe8\xf9\xe9\xd9\x79\xeb\xec\xb4\x75\xf6\x8d\x9e\x04\x84\x10\x42\x07\x86\x19\x88\x41\x1a\xda\x21\xbf\xd3\x9f\x48\x9a\x53\xfa\x40\xe8\x40\x84\x96\x97\xa1\xed\x3f\xbd\x99\x4c\x80\x5b\x38\xed\x46\x91\x5a\xe5\x4d\x5d\x6e\x5c\xa0\xad\x27\xfb\x47\x81\x9f\x77\x7a\xa0\x39\x8a\x31\xdc\x49\x6d\x98\xe4\xa0\xe9\xc9\x31\x48\x5f\x25\x06\x86\x6c\x1c\x55\x81\x06\xc0\x13\x14\x66\xfd\x1d\x55\xb2\xa8\xc2\x25\x63\x09\xa6\x02\xf0\xa4\xa2\x24\x06\x4d\x77\xf6\xe7\x37\x28\xed\x67\x9d\x2c\x9e\xe8\x6b\x0e\x9e\x3d\x6d\x4e\x3e\xaf\x78\xd7\x08\xcc\x96\x64\x5a\x72\x8e\x9f\x2a\x14\x93\xf5\xb6\xac\x5d\x63\x50\x8c\x13\x03\x87\x40\xe7\x64\xaf\x6e\x87\xa0\x6e\x62\x66\x0a\xc5\xcb\x3e\xee\x4e\x4e\x11\x74\x49\xf0\x3e\x2c\xd5\xdc\x13\xdb\x83\x08\xfe\x1a\xdf\x25\x99\x2a\xe0\x98\x87\x23\x46\xc7\x74\xdd\x3c\xb4\x76\x8d\x61\x7c\x76\xcc\xda\x83\x6a\xab\x0d\x9b\x59\xff\x65\x99\xec\x24\xbd\xe0\xc5\xfa\x47\x4c\x1b\xc1\x23\xc5\xc2\x31\x8b\x98\xe4\x42\x4e\x3b\xdd\x30\x2c\x91\x54\x29\x32\xad\xde\xbd\x62\xe1\x55\xca\x06\x1c\xaa\xf2\xc1\x2a\x65\x2f\x16\xd1\x7a\xaf\xef\x7a\xa8\xf2\xce\x3c\x7c\xb8\xee\xbe\x9b\xf4\x98\xde\x8e\x8d\xa8\xf7\x42\x1b\x90\x80\x3e\x27\xbe\x56\x72\x22\xa6\x09\xc2\x0f\x60\x91\x99\x5d\xcf\x80\xcf\x3d\x68\x4e\x68\xf9\xa0\x8d\x48\x75\x32\xdd\x4a\x26\xcf\x6f\xca\xdb\xdd\x3d\x7e\x0e\x8a\x92\xba\x45\x15\xfb\x8b\xaa\x07\x08\x53\x7b\x50\xdc\xbd\x51\xac\x83\xbc\x43\x0f\x2f\xee\xf2\x6c\x7c\x88\x7e\x31\x85\x5f\xc2\xcc\x6a\xa6\x30\x00\x93\x67\xa4\x0a\x15\xa0\x6f\x15\x5e\x31\x3e\x07\x19\x0e\x00\x97\x80\x1f\x3a\xcf\x99\xba\x33\x9d\x78\x13\x86\x0c\xa7\x60\xca\x6e\xee\x0a\x61\xfa\x07\x71\x84\xba\x41\x32\x1d\x66\x61\x74\x1d\x4e\x49\xd9\x6b\xd0\x73\x89\xd6\x89\xea\x68\xee\x62\xf0\x57\xa8\x7f\x15\x9d\xa4\xeb\x55\x71\xab\x63\xc7\xd2\xcd\x55\xfb\x30\xd9\x65\xfa\xee\x17\x65\xea\xe0\x39\xd8\x04\x76\x8f\xdd\x04\xc1\xe1\x56\x2d\xb2\xb1\x1b\x2d\x50\x4d\x44\x04\x9f\xb6\x5a\x5b\x27\xe5\x29\xa0\x6a\x1c\x36\x20\x84\x69\x9d\xc4\x90\xff\x3a\xf0\x5d\xdc\x4b\xd6\xf6\xd7\xa0\xb8\x20\x50\x6d\x74\xa7\x9b\xc6\xe8\xdb\x3c\xb7\x8d\xa0\x8f\x28\x24\x17\x0b\x16\xd1\xce\xde\x1e\x02\xb8\x14\x69\x73\xec\x46\xd5\x66\x31\x7b\x51\x92\xad\x74\x9b\xab\x98\x16\xf7\x97\xa3\x2b\xca\x6e\x9d\x09\xb6\x6d\xac\x6c\x62\xc9\xa7\xd1\x4a\xe1\xdc\xa3\x90\x19\xac\xaa\x7f\x0e\xd2\xe8\xd3\xa8\xaa\xc2\xc5\x85\xed\x78\x2d\x4a\x96\xb5\xbd\x55\x6d\xf7\xe6\xa0\x41\x61\x5a\xca\x67\xe5\x6d\x52\x9e\xfd\xca\x7b\x6c\x46\x3e\xbc\xc6\x7e\x33\x52\x33\xec\xff\x38\x23\x7f\x06\x00\x50\x2d\x9d\x16\x3e\x10\x00\x00")
+
+func cfcrAwsTerraformCfcr_iam_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrAwsTerraformCfcr_iam_overrideTf,
+		"cfcr-aws/terraform/cfcr_iam_override.tf",
+	)
+}
+
+func cfcrAwsTerraformCfcr_iam_overrideTf() (*asset, error) {
+	bytes, err := cfcrAwsTerraformCfcr_iam_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-aws/terraform/cfcr_iam_override.tf", size: 4158, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrAwsTerraformCfcr_lb_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4d\x8b\xdb\x30\x10\xbd\xeb\x57\x0c\xa2\xd7\xb8\xd9\x76\xbb\x94\x82\x4f\x7b\x2c\x94\x1e\x7a\x2b\x41\xc8\xf2\xac\x2d\xa2\x48\x46\x1a\xa7\x84\xc5\xff\xbd\x28\xf2\xb7\x1d\x5a\xf9\x64\x8d\xde\xe8\xbd\x37\x4f\x1e\x83\x6b\xbd\x42\xe0\x5e\xda\xd2\x5d\x84\x2e\x39\xf0\x73\x5b\xa0\xb7\x48\x18\x84\x32\x6d\x20\xf4\x82\x64\xc5\xe1\x9d\x01\x14\x37\x42\x61\xd0\x56\x54\x43\x0e\x4f\x2f\xac\x63\x6c\xea\x22\xff\x04\x11\xda\xc2\x22\x71\xe0\x85\x0b\xf5\xf8\x17\xb1\x24\xab\x00\xf9\xbd\x0d\xc0\x0f\x79\x41\x58\xac\x1c\xf8\x87\xf7\xab\xf4\x19\xda\xab\xd0\x65\x77\x88\x0d\x0e\x7d\x83\x3b\xe6\xfb\x48\xec\x35\xf1\x82\x1c\x46\xe2\xd9\x3e\xed\xac\x78\x79\x66\x00\xdd\x0e\x51\x54\xad\xd7\x74\x13\x95\x77\x6d\xc3\x81\xab\x37\xe5\x85\x6c\x74\x52\x6a\x13\xc1\x91\x55\xa8\x9d\x27\x31\x70\x8b\x67\x0f\xb2\xd1\x07\xa9\x14\x86\xc0\x19\xc0\xb5\x51\x42\x97\x90\x83\x71\x4a\x9a\x2c\xfd\x32\x06\xa0\x6d\xe5\x31\x84\x5e\xf8\x9b\x77\x17\xd1\x38\x4f\x49\xf2\xd7\xe7\xe7\xcf\x11\x0d\x40\x6e\xd8\x5e\x15\x1a\xef\xc8\x29\x67\xfa\x02\xa9\x26\x01\x94\x2e\xbd\x28\x8c\x53\xe7\x68\xeb\x6f\x7e\xcc\xee\xdf\xc7\x23\x3f\xdd\x15\x33\x00\x7c\x7c\xf3\x71\xe7\xd2\xe3\xde\x7d\x87\xa7\xff\xbb\xee\x1f\x06\x0b\xdf\x1a\x9c\xb9\x2c\xc8\x09\x6d\x09\xbd\x95\x26\x39\xbe\x3a\xaf\xcb\x29\x19\xdb\x76\xd9\x80\xdd\xec\x97\x31\x6a\xb7\x66\x95\xae\xfe\xcb\x81\xf7\xe3\xe0\x6c\xa9\x74\xb1\x26\x9b\xe7\xa6\x2d\x56\x0e\x71\x74\x6c\x69\xe1\xfe\x89\x94\xba\x15\xd1\x94\x95\x1d\x61\x83\x41\x99\x2e\xb7\x9e\xa2\x29\x1e\x25\x75\xfb\x90\xf6\x23\x1b\x55\xa5\x57\x15\x06\x44\x8c\xcf\xf4\x76\xb3\xd9\xcb\xcd\x74\x79\xda\x8c\x26\x8c\xe7\x1f\x32\x3f\xc5\xe0\x1b\x1d\x08\x2d\xfa\x3e\x7f\xda\x06\x92\x56\xe1\x83\x98\x4f\xe5\x61\x2a\xf3\xb0\x9b\x62\xed\xf2\x02\x6c\x8a\x09\xb6\x1e\x61\x17\xb9\xd4\x28\x0d\xd5\x42\xd5\xa8\xce\x3d\x9f\xb4\x75\x13\x54\x7b\x0c\xb5\x33\x31\x6f\x39\x7c\xba\xd7\x5a\xbb\xad\x0e\x35\xd2\x17\x74\xed\x72\xe0\x63\x4d\xfa\x0a\x97\xa5\x38\x8f\x5f\xaf\x3f\xbf\xcd\x95\x12\xfa\xab\x5c\xc4\x2e\x87\x2f\x0c\xa0\x63\x1d\xfb\x3b\x00\x58\x36\x8a\x21\x95\x05\x00\x00")
+
+func cfcrAwsTerraformCfcr_lb_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrAwsTerraformCfcr_lb_overrideTf,
+		"cfcr-aws/terraform/cfcr_lb_override.tf",
+	)
+}
+
+func cfcrAwsTerraformCfcr_lb_overrideTf() (*asset, error) {
+	bytes, err := cfcrAwsTerraformCfcr_lb_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-aws/terraform/cfcr_lb_override.tf", size: 1429, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrAwsTerraformCfcr_outputs_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\xcf\xcd\x4e\xc5\x20\x10\x86\xe1\x3d\x57\x41\xce\xbe\xac\x8c\x3b\xaf\x65\x32\xa5\xd3\x4a\xca\x5f\x86\xa1\x5d\x98\xde\xbb\xd1\xa2\xd2\xd8\xe5\xd9\xc2\x9b\x87\x8f\x54\x25\x57\xd1\x8f\xb5\x8e\xc4\x91\x84\xca\x60\x7d\x2d\x42\x3c\x08\x2e\x0f\xfd\xa1\xb4\xde\xd0\x57\xd2\x6f\x9a\x31\x4e\x29\x80\x9b\xcc\x5f\x0d\xad\x06\xc1\xc5\x8c\xaf\x2f\xea\x50\xea\xc7\xb4\xb3\x65\x08\xd8\xae\x79\x21\x81\x9c\x92\x3f\xd1\x5f\x15\xf7\x02\xe4\x47\xf3\x5d\x63\x76\x26\x62\xa0\x9e\xe9\x1e\x6b\xd8\x7b\x2a\x72\x9d\xb6\x21\x9b\xfb\xae\x97\xda\xb1\xc3\x00\x2e\x16\xc1\x68\x09\x32\xa7\xd9\x79\xba\x72\x5f\x9b\xee\x2a\xd3\x7d\xe9\xdf\xce\x3d\xf1\xfa\x04\xfd\x64\x4c\xc4\x40\xea\x50\x9f\x03\x00\x18\xe2\xff\x65\xa0\x01\x00\x00")
+
+func cfcrAwsTerraformCfcr_outputs_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrAwsTerraformCfcr_outputs_overrideTf,
+		"cfcr-aws/terraform/cfcr_outputs_override.tf",
+	)
+}
+
+func cfcrAwsTerraformCfcr_outputs_overrideTf() (*asset, error) {
+	bytes, err := cfcrAwsTerraformCfcr_outputs_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-aws/terraform/cfcr_outputs_override.tf", size: 416, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrAwsVarsCfcrTfvars = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x7d\x00\x82\xff\x23\x20\x53\x75\x70\x70\x6c\x79\x20\x61\x20\x6b\x75\x62\x65\x72\x6e\x65\x74\x65\x73\x20\x6d\x61\x73\x74\x65\x72\x20\x68\x6f\x73\x74\x2e\x20\x59\x6f\x75\x72\x20\x6b\x38\x73\x20\x61\x70\x69\x20\x77\x69\x6c\x6c\x20\x62\x65\x20\x61\x74\x20\x74\x68\x69\x73\x20\x68\x6f\x73\x74\x6e\x61\x6d\x65\x2e\x0a\x6b\x75\x62\x65\x72\x6e\x65\x74\x65\x73\x5f\x6d\x61\x73\x74\x65\x72\x5f\x68\x6f\x73\x74\x3d\x22\x63\x66\x63\x72\x2e\x79\x6f\x75\x72\x2d\x64\x6f\x6d\x61\x69\x6e\x2d\x68\x65\x72\x65\x2e\x62\x69\x7a\x22\x0a\x03\x00\xe0\x9d\x8d\x6f\x7d\x00\x00\x00")
+
+func cfcrAwsVarsCfcrTfvarsBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrAwsVarsCfcrTfvars,
+		"cfcr-aws/vars/cfcr.tfvars",
+	)
+}
+
+func cfcrAwsVarsCfcrTfvars() (*asset, error) {
+	bytes, err := cfcrAwsVarsCfcrTfvarsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-aws/vars/cfcr.tfvars", size: 125, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrGcpReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x4f\x6f\x1b\xbf\x11\xbd\xeb\x53\x0c\x14\x21\xb5\xd1\x70\x37\x46\x73\x30\x84\x6c\xd0\xd4\x4e\x80\x20\x41\x11\x24\x39\xb4\x68\x0a\x8b\x4b\x8e\x76\x19\x71\x49\x82\x7f\xa4\x28\x86\xfa\xd9\x8b\xe1\xee\xca\x6b\x47\x6e\x93\xcb\xef\x64\x99\xe4\xbc\x37\x33\xef\x71\x96\x4f\xe0\x23\x8f\xa2\x5d\x82\x58\x0b\xcf\x1a\xe1\x66\xb3\xcf\x11\x5d\x80\x68\x41\xa2\xd3\x76\x9f\x77\x60\xa7\x62\x0b\x75\xad\x97\xb3\xd9\x45\x01\x9f\x93\x73\x7a\x0f\x1c\x36\xa9\x46\x6f\x30\x62\x80\x8e\x87\x88\x1e\x5a\x1b\x62\x01\xff\xb4\xc9\xc3\xe6\x32\x00\x77\x0a\x76\x4a\x6b\xa8\x11\x78\x84\xd8\xaa\x90\x8f\x18\xde\x61\x31\x03\x00\x58\xad\x56\xf9\x2f\x7e\x77\xd6\xc7\x09\xe2\x4d\x8f\x78\x43\xc7\x2b\x4a\xa2\xd8\xdb\xe4\x99\xb4\x1d\x57\x86\xb5\xe8\xb1\xa8\xd5\x8f\x23\xc6\x45\x01\x6f\xad\xd6\x76\x07\xb1\x45\x30\xd6\x77\x5c\x43\x18\x6b\xa9\x6b\x0d\xc9\xf5\x65\x70\x70\x54\xf3\x3d\xf6\x6e\x23\x95\x87\x9a\x1b\x6e\x38\x43\xb3\x85\xa7\x4f\x41\xc8\xc9\x42\x3e\x45\x28\x4e\x73\x03\x8c\x51\x01\x0f\xb7\x85\x03\xe6\xa1\xb6\xa1\x65\xb5\xb5\x51\x5b\x2e\xd1\x97\x14\xc0\x32\x23\x86\x72\x6c\x73\x59\x40\x71\x84\x4c\x2e\xff\x44\xd1\xda\xc7\xea\xff\x3a\x5f\xdc\x9e\xde\x3a\x7c\x9d\xc3\x2b\xd8\x72\xdf\x83\x17\x71\x4d\xbf\x67\x3d\xe2\x96\x6b\x98\x2f\xce\x88\xc4\x79\x65\x22\x95\x76\x3e\x3f\x56\x4e\x5a\xae\x28\x5f\x48\x8e\xb2\x65\x1e\x35\xf2\x80\xd0\xc6\xe8\xc2\xb2\x2c\x43\xb4\x9e\x37\x58\x34\xd6\x36\x1a\xb9\x53\xa1\x10\xb6\x2b\x37\xa9\xb6\xcc\xa5\x5a\x2b\xd1\xff\x1e\xe2\x98\xe6\x11\x43\x2c\x62\xf3\xe3\x04\x78\x88\xd8\x09\xd4\xfa\x88\x4e\xcc\x85\xb2\xa5\x2c\xc7\xad\x90\xd7\x58\x4f\xc7\x36\xdb\x8e\xa5\x3a\x99\x98\x58\xf4\x29\xc4\x3d\x6b\xec\x0d\x6f\xd0\xc4\x1e\x7d\xb0\xcc\xfb\x6b\xe0\x01\xc8\x1c\x24\x6c\x4b\x72\xe7\xa4\x7a\xff\x76\x68\x22\x04\x4b\xfb\x20\xb8\x01\x61\xdd\x9e\x39\xea\x1f\xac\xbd\xed\xa0\x46\xf2\x8c\x5a\xe7\x03\x81\x5c\x1f\x94\xef\xbd\x59\x23\x08\xee\x71\x9d\x34\x61\x8a\x16\xc5\x06\x6c\x22\x17\x23\x74\xdc\xa8\x35\x06\xfa\x87\x47\xe8\x7a\x79\xf3\xce\xb4\x21\x19\x54\xda\x9d\xa1\x06\xa0\x04\x5e\xdb\x6d\x8f\x3d\x18\xaf\x51\x11\x84\xb6\x06\xa1\x51\xf1\xaf\x8d\x8a\x6d\xaa\xa9\xc5\x4b\xa1\x6d\x92\x6b\x9b\x8c\xf4\x7b\xa6\x8c\x48\x35\x8f\xd6\x97\x0f\x0a\x2b\x1a\x15\x67\x77\x97\xe7\xfd\x75\xb5\x38\x73\x3b\x79\xfe\xf0\xdc\xc8\x48\x5d\xbb\xce\x6d\xc9\xa9\x92\x61\x8e\x95\x4c\xd3\x22\x15\xc6\xfb\xcf\x64\x3f\x02\x16\xb7\xef\xaf\x0f\xe5\x78\x7a\x70\xdb\xbe\xd3\xf0\x95\x22\x98\xfd\xe9\x80\x75\x81\xad\x95\xc6\x50\x2a\xce\x43\xd9\x08\x57\xe6\xaa\x98\xf3\x76\xab\x24\xde\x8f\x26\x38\x66\x5d\xc8\x8b\x6c\x3b\xb0\x53\xf2\x37\x74\xd7\xf2\x04\x18\x0e\x6b\x78\x99\x2d\x6d\x53\x74\x29\x86\xf3\x63\x75\x00\xf0\x2e\x0b\xf9\x27\x09\x5a\x6d\x90\x64\x4b\x01\x41\xd8\xce\x29\x8d\x12\x06\x59\xf2\x78\x0b\x0e\x51\xd2\x50\xc8\xce\xb9\xa3\x03\x6e\x24\xec\xac\xf7\x34\xe0\x6a\x15\x41\x63\x08\xa4\x5c\x1a\x74\x56\xa6\x19\x81\xfe\x3c\x56\x0b\x5b\xf4\x41\x59\x13\x26\x36\xf9\x17\xa5\xcc\x46\xee\x89\x1a\xff\x3e\x1b\x6f\xc0\x9d\xe0\x65\x88\xdc\x6f\xb8\x91\x3b\xbe\x37\x58\x3e\x16\x7a\x3e\x55\x69\x50\xfd\xea\xed\xd5\xa7\xab\xea\x3f\x65\x63\xcb\xe0\xc5\xef\x63\x3e\x2e\x78\x46\x3e\x9c\x52\x7a\xd8\xf9\xe3\x25\xbe\x28\xe0\xca\x9a\xb5\x6a\x92\xc7\x3c\x2c\x45\xd4\x79\xd8\x7d\x69\xd1\x80\x4f\x26\x1b\x7b\x9d\xbf\x04\x24\x54\xb4\xd0\xa9\xef\xb4\xd8\x41\xb4\x0d\xc6\x16\x3d\x28\xd3\xcf\x08\x14\x51\x33\xee\x9c\xb7\xce\x2b\x4e\x13\xc1\xfa\x2e\x2c\x7f\x6e\xb1\x54\x1e\x45\xb4\xbe\xcf\x73\x31\x4d\x0d\x18\xdb\xe0\xfe\xfe\x89\xf3\x49\x28\x97\xd2\x63\x08\xd5\x7c\xd4\xfc\xd1\x41\xbe\xbc\x7c\xf1\xe2\x2f\xf3\x49\xa8\xd0\x29\x7f\x01\x08\xb2\x9a\xd3\x95\x5e\x2e\x6e\xef\x11\x1d\x96\xd4\xb3\x69\x4c\x0a\xbf\x10\xc0\xb8\xec\x94\xb9\x47\x65\x4d\xc4\xef\xf1\xff\x46\xce\x73\xaf\x85\x47\xd9\xa6\x1a\xb4\x6d\x94\x99\xa0\x64\xd8\x1b\xc7\x43\xd8\x59\x2f\xab\xc5\x59\xb6\x95\x32\x11\x5e\x9e\x8d\x31\x0d\x46\x60\x06\xe6\x0f\xe1\xb3\xcb\xfa\xb9\x95\x61\xd8\x08\x33\x07\xc6\xfa\x5e\xb3\x6f\xc1\x9a\x73\x60\x8c\xc6\x7c\x55\x6e\xb9\x4e\x78\x9e\x13\x7a\x02\x5c\xca\xfe\x75\x31\xf0\xb0\x06\x0d\x7a\x1e\x51\xc2\xd5\x6b\xba\xee\xf9\x96\x87\x3d\x7d\x69\x60\x83\x7b\x4f\xe6\x50\x0f\x87\x05\x4f\xb1\x45\x13\x95\x20\x33\xd0\x63\x81\xc6\x3d\x63\xca\x04\x14\xc9\x23\x0b\x1b\xe5\x58\xd4\x81\x6d\xd1\xab\xf5\xbe\x8a\x3e\xe1\xa4\xfe\xd8\xb9\x1b\xc1\x6f\xe8\x46\x54\xf3\xc5\x59\xb7\x89\xd8\xb9\xfe\x7b\xfb\xdb\x9d\x20\x96\x3b\xa3\xfc\xef\x26\x94\x82\xc3\x2b\x6a\xe9\x24\x81\x43\x2f\xd5\xe0\x72\x10\xf9\xd2\x40\xc0\xc8\x06\x5b\xd1\xf9\xa9\xc3\x0e\xc4\x11\xd0\x6f\xd1\x57\xf3\xc5\xed\xe0\xdb\xc3\xfc\x57\xea\x3f\xc5\xe2\x51\x52\x2b\xb9\x0e\xc4\x74\xf4\x65\x06\x8c\x76\x83\xa6\x67\x99\x3a\xe6\x30\x7f\x0c\xac\xb7\x27\x01\x4d\x9d\x9a\xb1\x86\x1a\xaa\x53\xe5\x10\x6b\x75\x9f\xfd\x04\x43\x0a\xf8\x38\xc3\x71\xf2\x00\x83\xd5\x18\x48\x26\x76\x56\x86\xd5\x0c\x18\x08\x8f\x3c\xe2\x33\x08\x82\x6b\x7c\x96\x3f\x1f\xe4\x87\x80\xc0\x9d\x0b\xfd\x9b\x73\x78\x18\x0c\x6a\x02\x3d\x0f\x05\xef\x1c\x48\x2b\x36\x34\x90\x3a\xde\xe0\x74\xee\x8c\x44\x34\xd0\xee\xe2\xd8\x31\x8e\xb1\x1c\x52\xf5\xf1\xf4\x88\xfa\x66\x05\x8f\x5c\x2b\x53\x9e\x38\xbf\xdc\x5e\x90\x63\xac\x8f\xd5\xe5\xf3\xcb\xe7\x53\x86\xb1\x94\xe9\xda\x90\xfe\xdd\x7c\x3e\x85\x49\x32\xee\x1d\x56\xf3\x0f\x96\xcb\xbf\x71\xcd\x8d\x40\x7f\x4f\x40\x82\x26\x43\x29\x81\x81\xd6\x9f\x64\xcb\xbf\xf9\xc7\x97\x37\x9f\xfe\xfe\xfa\x03\x7b\xf7\x91\xe6\xed\xa9\xf2\xe8\xb0\x48\xbe\x7f\x29\xe6\x91\x39\x09\x3a\x2c\xc7\x12\x56\xab\xd5\x6c\xf6\xdf\x01\x00\x08\x70\xa3\x70\xc2\x0c\x00\x00")
+
+func cfcrGcpReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrGcpReadmeMd,
+		"cfcr-gcp/README.md",
+	)
+}
+
+func cfcrGcpReadmeMd() (*asset, error) {
+	bytes, err := cfcrGcpReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-gcp/README.md", size: 3266, mode: os.FileMode(480), modTime: time.Unix(1792208216, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrGcpCfcrOpsYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x90\xc1\x6a\xc3\x30\x10\x44\xef\xfa\x8a\x3d\xc6\x07\xa1\xbb\xa1\xf4\x53\x84\x2c\x4f\x1d\x25\xb2\x24\x76\xd7\x6e\xfb\xf7\xc5\xd8\x24\xa5\xa7\x26\x90\xeb\xce\x2c\x6f\x66\x2c\xe9\x77\x43\x4f\x8c\x96\x43\x84\x21\x6a\x41\xcf\x3d\xb9\x54\x44\x43\x89\xf0\x13\xd7\xa5\x89\x2b\x61\xc6\xdb\x1c\x44\xc1\x6e\x9d\x3d\xbe\x14\x45\x52\x2d\xf2\x6e\x88\xd6\x90\x17\xf4\x86\xc8\x52\xfc\x88\x6c\x77\x9f\x8d\xb9\x2e\xa3\x6d\x5c\x1b\x58\x13\xc4\x98\xc7\x70\x9f\x95\xaf\xff\xc1\xed\xbe\x47\x70\x6b\xe0\x14\x86\x8c\x03\xa4\x59\xec\x75\x19\xc0\x05\x0a\x71\xb5\xe9\x86\x72\x21\x2b\xb8\x04\x4d\x2b\xfc\xe6\x13\x67\x6f\x74\x3a\x9d\xee\x1f\x7e\x2f\xec\xcf\x55\xb4\xeb\x8c\x79\x6a\xd5\x4b\x1d\x8e\xc3\xad\xc8\x9a\x46\xb0\xbb\x37\x72\x7f\x94\x29\x62\x53\x2f\x88\x6a\xd3\xf8\x3b\xdb\x14\x9b\x3f\x14\x9f\xc6\xae\x7b\x72\xfa\xd7\x66\xfa\x19\x00\x51\x0d\x79\x93\x7d\x02\x00\x00")
+
+func cfcrGcpCfcrOpsYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrGcpCfcrOpsYml,
+		"cfcr-gcp/cfcr-ops.yml",
+	)
+}
+
+func cfcrGcpCfcrOpsYml() (*asset, error) {
+	bytes, err := cfcrGcpCfcrOpsYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-gcp/cfcr-ops.yml", size: 637, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrGcpCloudConfigCfcrCloudConfigOpsYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x50\x4d\x8b\x83\x30\x10\xbd\xe7\x57\xbc\xa3\x1e\x82\xf7\xfc\x99\x30\xc4\x59\x57\x36\x26\x61\x32\xba\xed\xbf\x2f\xd5\x62\x8b\x50\xa1\xf4\xfa\x3e\x79\xcf\x42\xaf\x85\x1d\x84\x4b\xa4\xc0\x06\x28\xa4\xbf\x0e\xdd\x32\x79\xbe\x28\xa7\x3a\xe6\x54\x3b\x6b\x80\x85\xe2\xcc\xce\x00\x40\xa2\x89\x1d\xc2\x4f\x10\x3b\x51\x55\x16\x1b\x62\x9e\x7b\x5b\x24\x17\x16\x1d\xb9\xae\xb2\x15\xf4\x4f\x70\x33\x03\x4a\x32\xb0\xfa\x92\x73\x74\x68\x9a\x7b\x8e\xdf\x72\xfc\x0b\xd5\xb6\x0f\x79\x65\x59\xc6\xc0\x9e\x42\xc8\x73\xd2\xa3\xe5\x40\x7b\xea\x7b\xe1\x5a\x77\xbb\xd2\xb0\x37\xdb\xd3\x3a\xf3\xf5\x1b\xff\x59\xfe\x3e\x7d\xe3\xdd\xbc\x2d\xeb\x64\xde\x6d\x00\xbc\x21\x92\xf4\xbc\x01\x00\x00")
+
+func cfcrGcpCloudConfigCfcrCloudConfigOpsYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrGcpCloudConfigCfcrCloudConfigOpsYml,
+		"cfcr-gcp/cloud-config/cfcr-cloud-config-ops.yml",
+	)
+}
+
+func cfcrGcpCloudConfigCfcrCloudConfigOpsYml() (*asset, error) {
+	bytes, err := cfcrGcpCloudConfigCfcrCloudConfigOpsYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-gcp/cloud-config/cfcr-cloud-config-ops.yml", size: 444, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrGcpTerraformCfcr_dns_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\x31\x6b\xc3\x30\x10\x85\x77\xfd\x8a\x87\xe8\x1a\x13\xe8\xec\xa1\xd0\xb9\x4b\xc7\x12\x84\x22\x5d\x1c\xd1\x58\x32\xa7\xb3\xa1\x0d\xfe\xef\x45\x8a\x1d\x1a\xda\x40\xb4\xe9\xf4\xde\xdd\xf7\x4e\x93\xe5\x60\xf7\x27\x82\xfe\x1c\xf7\xc4\x91\x84\xb2\xe9\x6d\x16\x62\x73\x4c\x59\x34\xce\x0a\x90\xaf\x81\xd0\x22\x0b\x87\xd8\xa9\x59\x29\xa6\x9c\x46\x76\x04\xdd\xa5\xd4\x9d\xc8\xf8\x58\x6c\xd1\x76\xe4\xcd\x77\x8a\xa4\xa1\xdd\xc1\x71\xad\x5f\xee\xa5\x4f\xb4\x3d\x61\x39\x2d\xf4\xd3\x79\xb2\xdc\x50\x9c\x4c\xf0\xf3\xa6\xe8\x37\x55\xab\x80\xe2\x5b\xd5\x57\xe5\xff\x88\x73\x53\x0d\x94\x1d\x87\x41\x42\x8a\x68\xa1\x5f\xdf\xde\x51\x7a\xe1\x90\x18\x72\x24\xdc\xcc\x42\x99\x05\x8a\x53\xe0\x14\x7b\x8a\xa2\xef\x86\x62\x72\x89\xbd\xc9\x24\x6b\x24\x3b\x84\xf2\xf2\x27\x51\x8b\x3b\xbb\x68\x6e\x36\xd1\xac\xd1\x2a\xf4\x40\xd1\x67\x53\x99\x3f\x16\xbb\x4b\xfd\x30\x0a\x19\xeb\x3d\x53\xce\x17\xb7\xb8\x61\xb7\x7e\xc4\x75\x7f\x2f\x25\xb8\xc8\x69\xa9\xa0\xc5\xf3\x76\xab\x14\xf0\x7b\xfa\xc3\x5c\x95\x49\x01\xcc\xde\x8a\xcd\x0f\x10\x35\x4b\x61\xa7\x66\xa5\x7e\x06\x00\x11\xcc\x24\x19\x4a\x02\x00\x00")
+
+func cfcrGcpTerraformCfcr_dns_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrGcpTerraformCfcr_dns_overrideTf,
+		"cfcr-gcp/terraform/cfcr_dns_override.tf",
+	)
+}
+
+func cfcrGcpTerraformCfcr_dns_overrideTf() (*asset, error) {
+	bytes, err := cfcrGcpTerraformCfcr_dns_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-gcp/terraform/cfcr_dns_override.tf", size: 586, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrGcpTerraformCfcr_iam_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x92\xc1\x6e\xe3\x20\x18\x84\xef\x3c\xc5\x08\xe5\x90\x48\x0e\x9b\xbd\xae\xe4\x43\x9e\x63\xb5\x42\x04\xff\xeb\xd2\x1a\xb0\x00\xa7\x8a\x22\xbf\x7b\x05\x76\x9a\x44\xcd\x21\x3d\x54\x6a\xe3\x0b\x18\xbe\x7f\x98\xe1\x27\x50\xf4\x43\xd0\x04\xde\x7a\xdf\x76\x24\x23\x85\xbd\xd1\x24\x95\xd6\x7e\x70\x89\x83\x5b\x15\x13\x05\x8e\x23\x03\xe6\x55\x69\x1a\x00\x35\xf8\xe2\x18\x87\x5d\x4c\x61\xb9\x57\x41\x90\xdb\x4b\xd3\x54\xd8\x54\xb0\xc6\x2d\x3b\x72\x6d\x7a\xba\xd8\x59\x55\xf8\xbd\x59\xad\xc6\xb5\xfe\xaf\xc3\x7a\x96\x65\x40\x63\x62\xdf\xa9\x83\x74\xca\xd2\x24\x7a\xae\x19\x71\x05\x8f\x8c\xdd\xe1\xf8\xd5\x87\x97\x2f\x70\x3c\xcb\xde\xe7\xf8\x04\xdf\x72\xdc\x07\xff\x4c\x3a\x49\xa3\xac\xec\x7d\x67\xf4\x81\x83\x9f\x26\xd9\xf4\x0c\x20\x7f\x35\xf2\x6d\xbc\x97\x34\x79\xbb\xa0\xb2\x51\x49\xa1\x46\x1e\xc4\xac\x7c\x56\x14\xaa\xb1\xc6\x89\x0b\x94\x8d\x8c\x65\x16\xfc\x03\xcc\xc1\x0b\x3e\x9d\xbe\x33\xae\x31\xae\x2d\x73\x20\xf8\xae\x64\xcc\x63\xfc\xa5\xbd\xed\x87\x44\x22\x26\x1f\x54\x4b\xdb\x52\xc5\x0a\x68\xc9\xee\x28\x44\xd4\xf8\x5b\xfe\x01\x3e\xb7\x66\x3b\x75\xe6\xcf\xe2\x78\xbb\x65\x62\x7a\x0b\x82\xac\x32\xdd\xc8\xab\xcf\x96\x4f\x77\x7d\x5d\xfe\x8f\x01\x23\xbb\x33\x8d\xa3\x94\x35\x1e\x24\x4d\x24\x3d\x04\x93\x0e\x0f\x12\xc7\xb8\x98\x94\xd3\x3f\xfd\xad\x19\x65\xc5\xf5\x21\x5b\x9d\x7c\xf8\xc6\x81\x46\xf6\x36\x00\x12\x96\xa0\xd4\x1e\x06\x00\x00")
+
+func cfcrGcpTerraformCfcr_iam_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrGcpTerraformCfcr_iam_overrideTf,
+		"cfcr-gcp/terraform/cfcr_iam_override.tf",
+	)
+}
+
+func cfcrGcpTerraformCfcr_iam_overrideTf() (*asset, error) {
+	bytes, err := cfcrGcpTerraformCfcr_iam_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-gcp/terraform/cfcr_iam_override.tf", size: 1566, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrGcpTerraformCfcr_lb_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xb1\x6e\xc3\x30\x0c\x44\x77\x7e\x05\x21\x74\xb5\x97\x66\xe8\x92\xa9\x3f\xd0\xa1\x5b\x11\x08\x8a\xcc\x08\x42\x14\x51\xa0\xe4\x78\x08\xfc\xef\x85\x6a\x25\x08\x82\x38\xf5\x64\x91\xc4\x3b\xdc\x9d\x50\xe6\x51\x2c\xa1\x72\xcc\x2e\x90\xb6\x7c\x4a\x63\x21\x6d\x86\x41\x28\x67\x85\xca\x1e\xac\xe8\x62\x93\xc2\x0b\x20\x46\x73\x22\xdc\xa2\x7a\xbb\x9c\x8d\xf4\x14\xcf\xda\x0f\x73\x57\x6f\x14\xcc\x00\xab\xbc\x62\xc4\x51\xd1\x89\x39\xdc\x31\x75\x1a\xf7\xc1\xdb\x05\x8d\x28\xe4\x3c\x47\xdc\x62\x65\x2f\x0f\x40\x7c\x25\xda\x15\x9b\xba\x06\x79\xa9\x7f\x60\x99\x8c\x0c\x3e\x3a\x2d\x63\xa0\xe7\xbe\xda\xb7\xaa\xa4\x00\x71\x31\x72\x3d\x5c\x77\xd9\x3f\x78\xec\x33\x85\x83\x0e\x3e\x1e\x01\x31\xb1\x14\x2d\x26\x3a\xaa\x10\xf5\xb1\xd9\xbc\x57\xb6\x4f\x3a\x09\x17\xb6\x1c\xea\xf8\xfb\xf3\xab\x4d\x5b\x1b\x4f\x14\xdb\xe6\xa6\xd6\xb7\xc1\xeb\x30\xbc\xd0\x64\xc2\x6a\x13\xd7\x30\xfe\x8f\x1c\x31\x52\x99\x58\x8e\xb7\xe8\x1e\xa4\xda\xba\xdf\xef\x43\x77\xfd\xaf\x78\x00\x44\x13\x02\x4f\xad\xfa\x7b\xdf\x2d\xe9\x25\xa6\x5c\xb9\x5b\xfc\x59\x42\xda\x01\xe2\x0c\xb7\x1a\x74\x31\x2e\xff\x6d\x4f\x26\x17\x12\xb5\x83\x19\x7e\x07\x00\x88\x66\x45\x0f\xd4\x02\x00\x00")
+
+func cfcrGcpTerraformCfcr_lb_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrGcpTerraformCfcr_lb_overrideTf,
+		"cfcr-gcp/terraform/cfcr_lb_override.tf",
+	)
+}
+
+func cfcrGcpTerraformCfcr_lb_overrideTf() (*asset, error) {
+	bytes, err := cfcrGcpTerraformCfcr_lb_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-gcp/terraform/cfcr_lb_override.tf", size: 724, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrGcpTerraformCfcr_outputs_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\xd0\x4b\x6e\x84\x30\x0c\x06\xe0\x7d\x4e\x11\x71\x80\xdc\xa0\x67\xb1\x8c\x71\xd3\x94\x04\x47\x8e\x43\x17\x15\x77\xaf\x3a\x68\x34\xcc\xc0\x6e\xd6\xfe\xfd\xf9\x21\xdd\x6a\x37\x3f\xd0\x27\x29\x14\x6c\xc6\x0a\x86\x1a\xd9\xa0\x8a\xe4\xc1\xff\x3a\xef\x57\xcc\x9d\xfd\x87\x8f\x22\x31\x33\x90\x94\xda\x8d\x8f\xb9\x70\xeb\x37\xaa\x50\xfb\x98\x13\x85\x05\x0b\xbb\xcd\xb9\x2b\xbf\xb1\xae\x89\x18\x90\x48\xfa\x62\x80\xd3\xa4\xdc\xda\xe5\xac\x97\x6c\xd8\x57\x0c\x5c\x30\xe5\x93\xff\x23\x3a\xbf\xe9\xef\xc4\xd9\x9f\xfb\xc8\xba\xb0\x71\xbb\x5f\xf1\x25\xcd\x9e\xc9\x15\x35\x5c\xe7\x8e\x52\xfc\x7f\x92\xca\x37\x93\x41\x9a\xce\xc2\xa3\xe6\x36\xf7\x37\x00\xa7\x20\x6a\x3c\x9f\x01\x00\x00")
+
+func cfcrGcpTerraformCfcr_outputs_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrGcpTerraformCfcr_outputs_overrideTf,
+		"cfcr-gcp/terraform/cfcr_outputs_override.tf",
+	)
+}
+
+func cfcrGcpTerraformCfcr_outputs_overrideTf() (*asset, error) {
+	bytes, err := cfcrGcpTerraformCfcr_outputs_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-gcp/terraform/cfcr_outputs_override.tf", size: 415, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrGcpVarsCfcrTfvars = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x68\x00\x97\xff\x23\x20\x70\x69\x63\x6b\x20\x61\x20\x68\x6f\x73\x74\x6e\x61\x6d\x65\x20\x66\x6f\x72\x20\x79\x6f\x75\x72\x20\x6b\x75\x62\x65\x72\x6e\x65\x74\x65\x73\x20\x61\x70\x69\x20\x61\x6e\x64\x20\x65\x6e\x74\x65\x72\x20\x69\x74\x20\x68\x65\x72\x65\x0a\x6b\x75\x62\x65\x72\x6e\x65\x74\x65\x73\x5f\x6d\x61\x73\x74\x65\x72\x5f\x68\x6f\x73\x74\x3d\x63\x66\x63\x72\x2e\x79\x6f\x75\x72\x2d\x64\x6f\x6d\x61\x69\x6e\x2e\x62\x6f\x7a\x0a\x03\x00\xf5\x89\xd2\xda\x68\x00\x00\x00")
+
+func cfcrGcpVarsCfcrTfvarsBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrGcpVarsCfcrTfvars,
+		"cfcr-gcp/vars/cfcr.tfvars",
+	)
+}
+
+func cfcrGcpVarsCfcrTfvars() (*asset, error) {
+	bytes, err := cfcrGcpVarsCfcrTfvarsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-gcp/vars/cfcr.tfvars", size: 104, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrOpenstackReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x6d\x6f\x1b\xb9\x11\xfe\xae\x5f\x31\x70\x8c\xb3\x0d\x88\xab\xbc\x1c\x8a\xc0\x88\x82\xfa\xe2\x14\x75\x73\x4d\x04\xdb\x41\xbe\x14\x90\xb9\xdc\xd1\x2e\x23\x2e\x49\x90\x43\xd9\x6a\xe2\xff\x5e\x0c\x77\x25\xad\xfc\xd2\x5e\xef\x93\x6c\xee\xbc\xf3\x79\x66\x86\x2f\x60\x26\x49\x35\xa7\xa0\x16\x2a\x08\xe7\xd1\x46\x92\x6a\x39\x1a\xbd\x78\x01\x9f\xac\xbb\xb5\x70\x11\x63\xc2\x38\x1a\x09\xb8\x6e\x74\x04\xcf\xe2\x50\x39\x8c\x60\x1d\x41\x4c\xde\xbb\x40\x50\xd9\x58\xec\x8b\xe8\x08\xa4\xb1\x02\x72\x70\xb3\x4c\xa5\x13\x01\x0d\xca\x88\xb0\x7a\x59\xbc\xfa\x4b\xf1\xf2\x06\xa4\xad\xe0\x56\x1b\x03\xaa\x91\xb6\x46\xb8\xd5\xd4\x00\x35\x08\x16\xef\x08\x7a\xf1\x91\xe8\xd5\x2b\xf4\xc6\xad\x5b\xb4\x74\x03\x3a\x42\x8a\xda\xd6\xb0\xc4\x75\x24\x67\x11\x56\xaf\xb3\xb9\x9b\xd2\xc5\xe6\x7f\x89\xbe\x29\x46\xdf\x10\x1a\xb9\x42\x0e\xae\x77\xce\x7e\x65\xa2\x06\x52\x30\x70\x4c\x8d\x24\xb8\x45\xc0\x3b\x8f\x8a\x58\xac\x64\xc5\x13\xf0\xc1\xad\x74\xd5\xa5\x55\x96\x86\x7f\x52\xc4\x1c\xf5\xea\x35\xa0\xad\xbc\xd3\x96\x8a\x5c\xbf\x2b\x42\x1f\x47\xa3\xfc\xc3\x82\x5d\x58\xb9\xd2\x5d\xae\x65\x69\x4e\x47\xa3\x57\x05\xcc\xb4\x5a\x82\x84\x95\x34\xba\x82\x85\x71\x92\x38\xb9\x8b\x19\x70\x1c\x47\x11\xe4\x4a\x6a\x23\x4b\xd3\xd5\x48\x5b\x58\xbb\x14\x60\x7b\x5b\x20\x95\x72\xc9\x52\x2e\x01\xde\xe5\x0b\xd1\x04\xd1\x71\x0a\x4a\x5a\x90\x44\x52\x35\xa0\x73\x26\x1c\xeb\xf2\x6d\x84\x56\x46\xc2\x50\x8c\x00\xe0\xe6\xe6\x86\x7f\x7a\xd5\x65\x2a\x31\x58\x24\x8c\xf3\x4e\x66\xde\xb8\x48\xd3\xe8\x5a\x14\xda\x6f\xe4\x39\xee\xbf\x69\x5b\x0d\x9d\xb2\x69\x1f\xdc\x77\xae\xd9\xc5\x39\xd4\x49\x57\x20\x63\x74\x4a\x4b\x42\xbe\x6c\x6a\xe0\xf0\xb7\xdf\x7e\x9f\x7f\x99\x7d\xfc\x7c\x75\x7d\xf6\xe1\xd3\x7c\x76\xf9\xe5\x1f\x1f\x3f\x5c\x3f\x8e\x62\x27\x72\x39\xcb\x22\xf3\x8b\xf3\x2e\x86\xa4\xab\xbd\x20\x9c\x31\xee\x36\x5f\x81\x75\xa1\x95\x06\xe2\xa6\xe2\x7c\x43\xc9\x77\x7e\x65\x07\xdd\x9c\xef\xc6\x15\xb4\xcb\x4a\x07\x28\xa5\x95\x56\x0a\xb4\x2b\xf8\xe5\x17\x50\xd5\xe0\x20\x4b\xb1\x19\x6f\xa4\x05\x21\xac\x6c\xf1\xe1\x67\xe5\x41\x04\xc8\xc8\x2b\x9d\x23\xe3\x64\x85\x61\xc2\x0a\x22\xbb\xc4\x38\xd9\x67\xd7\xa4\x80\x62\x6b\x38\xe5\x8a\x02\xae\xa4\x81\x83\xc3\x63\x3e\xf2\x41\x5b\xe2\x70\x4e\x0e\xb6\xd1\x72\xa6\x19\xdd\x90\x3c\x7b\xd8\xd2\xa9\x21\xf2\xf1\x74\x32\x89\xe4\x82\xac\xb1\xa8\x9d\xab\x0d\x4a\xaf\x63\xa1\x5c\x3b\xc9\xdc\xf1\xa9\x34\x5a\x4d\x86\x34\x14\x46\x12\x46\x2a\xa8\xfe\x77\xae\xfa\x7b\xf8\xec\x08\x4f\x81\x98\xc1\x3a\x82\x84\x0a\x57\x68\x9c\x67\x1a\x6d\xc8\x38\x06\xbd\x60\xec\xc1\xad\x64\xb4\x41\xa4\x0c\x4a\x66\x15\x9f\xc6\xc6\x25\x53\x01\xc9\xba\xc6\x0a\xf6\x48\x4f\x32\x94\xd2\x18\x30\xda\x2e\x23\xb8\xc5\x02\x6a\x4d\x4d\x2a\x0b\xf8\x86\xc0\x81\x60\x05\xb2\x96\xda\x46\xda\x34\x88\xe2\x71\xce\x91\xb0\x55\x68\xcc\x36\x69\xfe\x58\x68\x37\xd9\x7c\x88\xf9\x64\x57\x69\x81\xf1\x4e\x8b\x54\x26\x4b\x49\x50\x48\x91\xd6\xa2\x76\x73\x59\x73\x1b\xc9\xf6\x3f\x76\x68\xbb\xf9\x74\x7e\x03\x32\x72\x72\x81\x81\xd2\xec\xfa\xd6\xb0\x9b\x44\xc7\x12\x99\x53\xca\xf9\xb5\xf0\xcc\x0f\x58\x04\xd7\x42\x89\x8c\xc2\xbe\x3e\x91\xd9\x1e\x75\xc0\x7c\xcf\x25\xb3\x30\xe0\x22\xe5\x7e\xa1\x1a\x54\x4b\x70\xa9\xa3\x4b\x2b\xad\x5e\x60\xe4\x7f\x24\x41\xdb\xe1\x25\x7f\xd9\xab\x1f\x7b\xed\x2e\x9e\x0b\x55\xba\x15\x0e\x89\x5b\x6b\x02\x65\xf8\x1a\x6a\x4d\x7f\xed\x2b\xab\x5c\x7b\xaa\x8c\x4b\xd5\xc2\x25\x5b\x85\xb5\xd0\x56\xa5\x52\x92\x0b\x93\x07\x79\x15\xb5\xa6\x01\xf3\x3e\x9d\x4f\x0f\x8f\xfd\x6d\x75\xf2\x50\x6e\xc8\xba\xf3\x5c\x95\x1c\x28\x83\x7b\x9b\x47\x01\x57\xda\x2a\x1c\x34\x27\x25\xed\x11\x75\x8d\x33\x6a\x67\x81\x93\x80\x52\x1a\x69\x15\x86\x08\x0b\x17\x46\x29\x8e\xe1\x16\x8f\x02\x42\xed\xb8\xf3\xed\x9a\x65\x4f\x5d\xee\xe0\x06\xfb\x96\xb5\x3d\x44\x62\x04\x92\x56\x70\x31\x2b\x46\x83\x7a\x30\x08\x40\x54\x5d\xab\xed\x2d\x1d\xfe\xf8\x74\x7e\x3f\xd9\x84\xd9\x51\xb2\x58\xb7\x06\xfe\xc5\x8a\xc2\x3d\x12\x70\x3e\x8a\x85\x36\x18\x27\x5a\xca\x38\xd9\x26\x34\xc9\x45\x15\xfd\x24\xf8\x93\x36\xba\x4c\x44\x17\xbe\xd0\x7e\x60\x65\xf5\x5c\x03\x3e\xfc\xf1\xf4\x87\xfb\xad\xe2\xd6\xfe\x3c\x45\x0c\xdc\xab\xa6\x87\x3f\xf6\xfb\xed\xd7\xab\x8f\x97\x9f\xcf\xfe\xf9\xf1\x29\x25\x2f\x63\xbc\x75\xa1\x7a\xa4\x34\x3b\xbb\xba\xfa\xf6\xe5\xf2\xfc\x49\xa5\xae\xdf\xcf\x35\xab\x0d\x54\x2e\x37\x4d\x7b\xa3\x64\xe0\x5d\xee\x6e\x2e\x91\x4f\x14\x4f\xb6\xb6\x78\xec\xce\x53\x30\xd3\xc3\xe3\x88\x15\x1c\xc5\x9f\xab\x37\x3f\x57\xaf\x8b\x97\x3f\x8f\xe0\xdd\x31\xaa\xc6\x3d\x1c\x1a\x67\x5f\xaf\xff\x3e\xff\x7a\xf9\xfb\xc9\xc9\x16\x92\xdb\x16\x06\x17\x99\x82\x47\x15\x18\xbd\x44\x90\xd0\x26\x43\x5a\xf4\xd0\xe1\x5b\x1f\xe7\xef\xc6\x80\xc5\x6e\x90\xd7\x0e\x4a\x1e\xa3\xe4\xf2\xe8\x00\x67\x71\xcc\x86\xdf\x43\x44\xc3\xb3\x4c\x42\xc8\x4b\x82\x5b\xc0\x9b\x7e\x4a\x5f\xcc\xe2\x18\x02\x42\x59\x1a\x91\xfc\x38\x4f\xc1\x80\xad\xe3\xa5\xa2\x41\xb8\xe9\x10\xdb\xbb\xe5\xdb\xbd\x01\xe7\x23\x63\xa1\x6b\x17\x4c\x9c\xbe\x65\xd8\x95\x5b\x4a\xd2\xce\x66\x4a\xbf\x87\xeb\x06\xe1\x49\x7c\x6c\x0c\x68\x0b\x0f\x98\x09\xad\xae\x1b\xca\x1b\x99\x37\x72\x0d\xb7\xdc\x24\x33\x4d\xde\xec\x28\x12\xbb\x8e\xfa\xc1\xd9\x85\xae\x53\x40\x36\x82\x8a\x4c\x66\xce\x75\x83\x16\x42\xb2\x39\xfa\x45\x1e\xa9\x3d\x13\x5b\x7d\xc7\x87\x2d\x90\xab\x91\x1a\x0c\xa0\x2d\xb9\x8d\xb2\x90\xde\x07\xe7\x03\x4f\x78\xe6\x72\x1b\x4f\x1f\xcf\xf2\x4a\x07\x54\xe4\xc2\xbc\x83\xe4\x10\x06\x20\xc4\x12\xd7\xfb\x12\x27\x03\x55\x59\x55\x01\x63\x9c\x1e\x6c\x5a\xfe\xb3\x2c\x38\x7d\xfb\xeb\xaf\x6f\x0e\x06\xaa\xca\x24\x2e\x62\xe7\xf4\x80\x2b\x76\x7a\xf8\x63\xcf\xd1\xfd\x29\xe3\x61\xa8\x93\xe2\x1f\x50\x10\xb2\x6a\xb5\xdd\x73\xe5\x2c\xe1\x1d\xfd\x01\x57\xac\xa4\x02\x56\x4d\x2a\xc1\xb8\x5a\xdb\x81\x95\x6c\x76\xc0\xc2\xe3\xdc\xc7\xb4\x25\x78\x77\xbc\xd1\xa9\x91\x40\x58\x38\x78\x68\x3e\xf7\xb3\xae\x61\x67\x33\x62\x63\xe6\x00\x84\xe8\x28\x27\xbe\x47\x67\x4f\x40\x08\x9e\x6f\xd3\xc9\x4a\x9a\x84\x7b\x04\xba\x18\x0c\x77\x72\xdd\x86\x2c\x81\x4c\x14\x11\x55\x0a\xdd\x4c\xef\x4b\x0f\xd2\xeb\x47\x44\x92\x55\xb5\x49\xee\x28\x42\x8d\x16\x43\x5e\xfc\x3e\x9c\x31\xcd\xf2\x6c\xcd\x43\x38\x1f\x45\x9e\xfd\x47\xbc\x13\x20\x3b\xca\xf3\x4f\x46\x90\x16\xf0\x4e\x61\x50\x3a\x66\x40\x31\xf6\x78\x9a\x04\x9e\x5c\xc3\xa1\xf7\x62\x53\x36\x6a\xfd\x5c\xc9\x39\x73\x6b\x7a\x70\x78\xdc\x2e\x09\x5b\xdf\xad\x4e\x2f\xe0\xff\x2e\x21\xa7\xbb\x4b\xf3\xbf\x57\x6f\xa2\x24\xbc\x67\x43\x83\x10\xee\x0f\x86\x25\xed\x63\xed\xc9\x02\x2a\x73\x0f\x22\x92\xe8\xd1\xc9\xda\x43\xa0\xde\xb3\xc7\x88\x61\x85\x61\x7a\x70\xf8\xa3\x87\x7f\x3e\xd5\xb6\xbb\x06\x11\x97\xda\x0b\x8e\x73\x85\x41\x2f\xd6\x53\x0a\x09\x9f\xf3\x12\xb0\x42\x4b\x5a\x9a\xc8\x9e\xb6\xf0\xce\x06\xc9\x2d\xd1\x76\x5e\x86\xc0\xbb\x3f\x78\xce\x58\x87\x72\x36\xd4\xff\xb9\xb3\xd5\xe7\x30\x7d\x2a\x1d\xf6\x3a\xdd\xf7\xfe\x84\x87\x14\xf1\x79\x0f\xdb\x9a\xbe\x2a\xe0\x43\x40\x49\x38\x86\xa8\xa4\xc1\xf1\xf6\xfd\x11\x11\xa4\xf7\x71\xf7\x8c\x1c\x80\x95\xf7\x72\x25\x5b\x0f\x95\x53\x4b\xee\x60\x2d\xaf\xca\xa3\x59\x5e\x85\xb9\x6d\xf6\xf8\xa3\x66\x6f\x73\xd9\x1b\xf4\xe3\x6e\xa0\x6c\x3f\x8f\x77\xaf\xe0\xcc\x94\xbd\xbd\x66\xfb\x30\x2e\x93\x36\x04\xda\x8e\xfb\xdd\x91\x01\xbf\x79\x7a\xf2\xcb\xd1\xba\x0a\x59\x30\xee\x2d\x30\x9b\xd2\x70\x43\xde\xa5\x21\xb6\x69\x08\x91\x33\x98\x76\xe9\xf0\x06\xfc\xdd\x29\x49\xd2\x68\x3b\x79\x42\xfe\x74\xf5\x8a\x81\xeb\x02\x4d\xdf\xbe\x7c\xfb\x72\xe8\x81\xc9\xe0\x5d\x15\x87\x67\x7d\x35\x07\xe3\xe5\xe9\x18\x68\xed\x11\x3e\xbb\x0a\x67\x4c\xc3\xfe\x71\xb4\x7c\xbb\x93\xc9\x40\xd6\x0a\xb7\xb7\x07\x00\x67\x0b\x46\x3d\x57\x62\x85\xa0\x5c\xeb\x0d\x72\x8b\xe0\x77\xc7\x18\x5c\x9e\x2f\xbd\x56\x7c\xe6\xb5\xdb\xe3\x6b\xf3\xdc\x28\x11\xf2\x2b\x84\x1c\x04\xe4\x67\xee\x53\xc1\x3a\x0b\xd2\xae\xe1\xd6\x85\x25\x86\xa3\x08\x9f\x5d\x85\x33\x17\xa8\x18\xfd\x67\x00\x16\xcf\x99\xfd\x02\x11\x00\x00")
+
+func cfcrOpenstackReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrOpenstackReadmeMd,
+		"cfcr-openstack/README.md",
+	)
+}
+
+func cfcrOpenstackReadmeMd() (*asset, error) {
+	bytes, err := cfcrOpenstackReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-openstack/README.md", size: 4354, mode: os.FileMode(480), modTime: time.Unix(1792208216, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrOpenstackCloudConfigCfcrOverridesYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x8e\x3d\x6b\xf3\x30\x14\x85\x77\xfd\x8a\x03\x19\xde\x49\xc9\x9b\xd5\x63\x20\xd0\x40\x29\xa1\x69\xbb\x1a\xd9\xbe\xa9\x2e\x95\x74\x85\x3e\x1c\xfc\xef\x8b\x6d\x3a\x66\x48\x3b\x9e\xc3\x3d\xcf\x73\x37\x48\x14\x9d\xe9\x09\xc5\x12\x4e\x67\x74\xe4\xe4\x86\x1b\x17\x0b\x09\x73\x6b\xca\xbf\xbc\x64\x0e\x38\x1c\x9e\xdb\x8f\xcb\xf9\xe9\xf8\x7a\x6c\x2f\xef\x87\x97\xe3\x9b\xda\xa0\xab\x05\x9c\x11\xa4\x20\x51\xa6\x34\xd2\xb0\xc5\xe9\x9c\xb1\xff\x0f\x49\xf0\x92\x08\xa6\x93\x71\x86\x11\x3a\x93\x09\x72\xc5\x24\x35\xa1\xe7\x21\x21\x5b\xa9\x6e\x40\x47\xc8\xe6\x4a\x5b\xa5\xb5\x56\x1a\x65\x8a\xd4\xfc\x3c\xa7\x80\x68\x8a\x6d\xb0\x0b\x54\x6e\x92\xbe\xf2\x4e\x2b\x60\x34\xae\x52\xa3\x00\x20\x18\x4f\x0d\x46\x8e\x4b\x5a\xc7\x73\xba\x4f\x1a\x7d\x3b\x9f\xdd\x21\x79\x0e\xec\x8d\x5b\x9a\xde\x49\x1d\xda\x98\x24\x52\x2a\x4c\x79\x35\x02\x1c\x72\x31\xa1\xa7\x85\xd3\xc0\xef\xb7\x85\xc3\xf4\x6b\x65\xf6\xc6\x3d\x2a\x5c\x37\x7f\x32\x6a\xcb\x9f\xd6\x93\x7f\xd0\xec\x69\xe0\xea\xd5\xf7\x00\x1c\xfd\x41\xf4\x40\x02\x00\x00")
+
+func cfcrOpenstackCloudConfigCfcrOverridesYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrOpenstackCloudConfigCfcrOverridesYml,
+		"cfcr-openstack/cloud-config/cfcr-overrides.yml",
+	)
+}
+
+func cfcrOpenstackCloudConfigCfcrOverridesYml() (*asset, error) {
+	bytes, err := cfcrOpenstackCloudConfigCfcrOverridesYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-openstack/cloud-config/cfcr-overrides.yml", size: 576, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrVsphereReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\xdf\x6f\x1b\xb9\x11\x7e\xd7\x5f\x31\x50\x8c\xb3\x0d\x88\x5a\x1b\xb9\x43\x0d\x21\x4a\x7b\xb9\xe4\xd0\x20\x45\x6a\x34\xd7\xf6\xa5\x80\xcc\x25\x47\xbb\x3c\x71\x49\x82\x43\xca\x56\x03\xff\xef\xc5\x70\x77\xe5\x75\x62\xf7\x2e\xf7\x64\x99\xcb\xf9\xf5\xf1\x9b\x6f\xc8\x17\x70\x2d\x93\x6a\x57\xa0\xb6\x2a\x8a\x3d\x85\x16\x23\xce\x66\x9f\x12\x06\x82\xe4\x41\x63\xb0\xfe\x50\xbe\xc2\xad\x49\x2d\xd4\xb5\x5d\xcd\x66\x97\x4b\xb8\x36\x6a\x07\x12\xf6\xd2\x1a\x0d\xef\xaf\x21\xb5\x32\x9d\x52\xd9\x64\x1c\x1c\x7c\x8e\xf0\xe6\xcd\xdf\x36\xff\xfa\x74\xfd\xd7\x77\xff\x78\xb7\xf9\xf4\xcf\x37\x1f\xdf\xfd\xc2\x2e\x6b\x84\xd4\x22\xec\xae\x08\x64\x30\xf0\xfe\x7a\x39\x03\x80\xf7\xd7\x04\x97\x17\xe0\x23\x74\x3e\x22\xc8\xda\xef\xfb\x7d\xb5\x24\x04\xbf\xed\x3d\x2a\xa3\x23\x50\xeb\xb3\xd5\x50\x23\x90\xdc\xe2\x02\xea\x9c\x20\xb5\x86\xc0\x10\xb4\xa6\x69\xed\x81\xd3\x46\xa7\xd1\x25\xf0\x0e\x4c\x31\x3e\x8d\x08\x8d\x37\xae\x99\x94\x25\xdd\x21\xb5\xbc\x84\x96\x90\xd7\x8b\x1b\x6d\x22\xaa\xe4\x63\xc9\xeb\xe6\xe6\x86\xff\xe0\x5d\xf0\x31\xc1\x2e\xd7\x18\x1d\x26\xa4\x4d\x27\x29\x61\xdc\xb4\x9e\xd2\xfa\xf2\x62\x79\xf5\xa7\xe5\xcb\x1f\x96\x2f\x7f\x18\x6d\x2e\x97\xf0\xb3\xb7\xd6\xdf\x96\x22\x9c\x8f\x9d\xb4\x40\x23\xac\x75\x6d\x21\x87\x02\x16\x48\x08\x7c\x04\x7d\x1d\x21\xfa\xbd\xd1\x38\x05\x76\xeb\x23\xe7\x1f\x61\x9b\x53\x8e\x0f\xc0\x19\x07\x37\xca\xfa\xac\x85\xf2\x6e\x6b\x9a\x8a\x0f\x49\xf8\x3d\xc6\x68\x34\xd2\xf2\xd0\xd9\x9b\x52\xc2\x58\x03\x74\x3b\x6d\x22\xd4\xd2\x49\x27\x05\xba\x3d\x7c\xf7\x1d\x28\x3d\x59\x28\xbb\x39\xb7\x60\xa5\x03\x21\x9c\xec\xf0\xcb\xcf\x2a\x80\x88\x50\x7b\x6a\x45\xed\x7d\xb2\x5e\x6a\x8c\x15\x1b\x88\x52\x07\x52\x35\xe5\x52\xb5\x84\x3e\x09\x25\x13\xbc\x86\xdf\x48\x18\x5e\xbd\x82\x77\x7f\xff\x79\x26\x84\x98\x09\x48\x87\x80\x2b\x88\x18\xac\x54\x38\x03\xc6\xa9\x5d\x41\xe5\x30\xdd\xfa\xb8\xa3\x8a\xd3\x5b\x6b\xdc\xca\x6c\x53\x45\xb9\x76\x98\xa8\xba\xa8\x28\xc9\x64\xd4\x9f\x67\xc0\x18\x66\x5c\xcd\x00\x04\x9c\x7c\x7e\xfa\xec\xee\x67\x1c\x6f\xac\x3b\x87\x92\x2b\xee\xa5\x85\xf9\xc9\x19\x2f\x85\x68\x5c\xe2\xea\xcf\xe7\x47\x30\x99\xff\x37\x0c\x01\xe4\xc0\x00\x88\x88\x16\x99\xa7\x6d\x4a\x81\x56\x55\x45\xc9\x47\xd9\xe0\xb2\xf1\xbe\xb1\x28\x83\xa1\xa5\xf2\x5d\xb5\xcb\xb5\x17\x21\xd7\xd6\xa8\xfe\xf7\x60\x27\xac\x4c\x48\x69\x99\x9a\xff\x3e\xe1\x9c\x12\x76\x0a\xad\x3d\x7a\xe7\xc8\x4b\xe3\xab\xf1\x03\x95\x95\x11\x71\x81\x74\x67\x44\xae\xb3\x4b\x59\xa4\x98\x29\x1d\x44\xe3\x37\xb2\x41\x97\x7a\xef\x03\x99\x3f\xbc\x05\x49\x3d\xb5\x18\x59\xe6\x65\x49\xaa\x6f\x8e\x8e\xbb\x87\x3c\x7f\x07\x25\x1d\x28\x1f\x0e\x22\x30\x70\xb0\x8d\xbe\x83\x1a\x99\xdc\x7d\x6f\x01\x71\x4b\x91\x89\x58\xce\xba\x46\x50\x32\xe2\x36\x5b\xf6\xa9\x5a\x54\x3b\xf0\xa5\x49\x11\x3a\xe9\xcc\x16\x89\xff\x91\x09\xba\x9e\x31\xe5\xcb\x14\x90\xe2\x54\xfb\x5b\xc7\x00\xa0\xee\xe5\x60\xda\x8f\x8d\x49\xcc\x25\x87\xd0\x98\xf4\x97\xc6\xa4\x36\xd7\x0c\xf1\xaa\x10\x6c\xeb\xb3\xd3\xf1\x20\x8c\x53\xb9\x96\xc9\xc7\xea\x8b\xc2\x96\x8d\x49\x93\xb6\xfe\xf0\x76\x7d\x72\x16\x6e\xf5\xf9\x97\xfb\xc6\x88\x8c\xda\xdb\x02\x4b\x49\x95\x09\x7e\xac\x64\x09\x9f\x8c\x53\x08\x03\xfc\x0c\xd6\xe9\xd0\xc8\x64\xbc\x03\x2e\x01\x6a\x69\xa5\x53\x18\xa9\xb4\x73\xa6\x05\xdc\xe2\x53\x8a\x34\x28\x02\x19\xd7\x58\x06\x8b\x35\xe6\xb8\x88\x09\x7a\x6e\x8f\xa2\x39\x80\xc1\xa7\x0f\x42\xf7\x2a\x3d\x38\x3a\xf9\xfc\xe1\xed\x7d\x35\xe6\xd8\xf7\x24\x4b\x02\xfc\x87\x0d\x85\xff\x6a\x83\x0f\x24\xb6\xc6\x22\x55\x46\x4a\xaa\x86\x6a\xaa\x82\xa7\x18\x64\xe9\xf7\x7a\xe8\x0c\xa9\xaa\xaf\x41\xf4\x35\xfc\x91\xd0\xbd\xa5\xe8\x4b\x16\x26\x4c\x7c\xec\x9f\x53\xe2\xf9\xb3\x7d\x3e\xff\xd6\xf0\x84\x49\xb0\xce\x18\xd7\x08\x6d\xa2\x70\x5e\xc4\x69\x0e\x16\x5e\x15\x81\xf0\x39\x85\x9c\xe8\x7c\x72\x20\xaf\xe1\xa3\x4f\x08\xef\x4b\x73\x9c\x6a\xb0\x66\xc7\x92\xde\x65\x9b\xcc\x00\x48\x39\xac\x45\xf9\x6e\x2d\x38\x44\xcd\xbd\xd2\x78\xa8\xa5\xda\xf1\x4f\x9e\x15\xe0\x1d\x2e\x80\xd0\xa2\x4a\x20\x21\x4a\xd7\x94\x59\xf8\xf2\x38\x1d\x68\x01\x11\x79\x28\x8b\x1c\x16\x20\x9d\x86\x88\xdd\x38\x3a\x6f\xbe\x3a\x82\x1b\xf0\x81\xf8\x8c\xfa\x1e\xe6\x4d\x43\x1f\xbb\xbd\xdf\xc9\x64\xbc\x5b\x16\x89\xf8\xa9\x8c\x94\x32\x6e\x72\x8d\x2a\xd9\x19\x97\xf7\x4b\x8b\x0e\x62\x76\xa5\x09\xb6\x65\xbc\x0d\xf4\xed\xcc\x1d\x2f\x76\x90\x7c\x83\xa9\xc5\x08\xc6\xf5\x7a\xc2\xc6\x42\x86\x10\x7d\x88\x46\xb2\x7a\xf8\xd8\xd1\x6a\x82\xd6\xd0\x84\xe3\xd8\xdd\x14\x59\x3f\x99\x62\x0b\x42\xec\xf0\xf0\x78\xc7\xf9\xc4\x54\x6a\x1d\x91\x68\x3d\x1f\x15\xf2\x59\x16\xac\xae\xbe\xff\xfe\xe5\x7c\x62\xaa\x6c\x2e\xec\x29\x41\xe7\xdc\xfe\xab\x93\xcf\x8f\x02\xdd\xaf\xf8\xa8\xa6\x36\x99\x7e\x87\x81\x90\xba\x33\xee\x51\x28\xef\x12\xde\xa5\xdf\xb4\x9c\x17\xac\x55\x44\xdd\xe6\x1a\xac\x6f\x8c\x9b\x78\x29\x6e\x37\x41\x12\xdd\xfa\xa8\xd7\x27\x67\xa5\xfb\x8d\x4b\xf0\xea\x6c\xb4\x69\x30\x81\x70\x30\xff\xd2\x7d\x51\x81\x5e\xe3\x8a\x1b\x31\xba\x99\x83\x10\x3d\xd6\xe2\x57\xf2\xee\x1c\x84\xe0\x91\xb0\xae\xca\x04\x3d\x52\x9b\xff\xf6\xa4\x86\x5b\xe9\x12\xd3\xb4\x95\x7b\xa6\x76\xb2\x24\x08\x55\x8e\xa8\x27\xad\xc9\x17\xbc\xaf\x38\x2e\xb5\x86\x21\xd1\x53\x82\x06\x1d\x46\x99\x50\xc3\x4f\x3f\xb2\xbf\x32\x8e\xca\xd4\x2a\x4b\xb4\x84\x7f\xe3\xa9\xb5\x60\x91\x03\x95\xa1\x21\x09\xa4\x03\xbc\xc3\xa8\x0c\x15\x3e\x31\xf5\xc0\x07\x76\xf4\xf8\xda\xf6\x62\x44\x2d\x75\x61\xa3\xe4\x86\xa9\xbf\x9e\x9f\x9c\x75\xbb\x84\x5d\xe8\x27\xfa\x0b\xf8\x66\x04\xb9\xda\x87\x2a\xff\x3f\x78\x95\x92\xf0\x9a\x1d\x4d\x52\xb8\x9f\x4f\x11\x1d\x72\x1d\x7a\x05\xfa\xcb\x11\xb0\xfc\x0c\xe4\x64\xeb\x29\x4f\xef\x39\x22\x61\xdc\x63\x5c\xcf\x4f\x3e\x0f\xec\x2f\xab\xc6\xf5\xa7\x20\x68\x67\x82\xe0\x3c\xf7\x18\xcd\xf6\xb0\x4e\x31\xe3\x73\x51\x22\xf2\x55\xd9\x48\x4b\x1c\xe9\xc8\xee\xe2\x30\xf9\x1d\xba\x3e\xca\x94\x77\xf7\xf3\xe7\x9c\xf5\x24\x67\x47\xc3\xcf\x07\x5f\x43\x0d\xeb\xa7\xca\xe1\xa8\xeb\xc7\xd1\x9f\x88\x90\x09\x9f\x8f\x70\xc4\x94\xf5\x2b\xa2\x4c\xac\x9d\x4a\x5a\xec\x85\x91\x99\x40\x08\x32\x84\xfe\x95\x32\x5e\x3a\x46\xae\xf2\x6d\x56\xc9\x2e\x80\xf6\x6a\xc7\x02\xd6\xf1\x0d\x6e\x76\x5d\x6e\x68\xe0\x58\xd0\x0b\xfd\xd8\x6c\x98\x11\xf0\x78\x3a\x2e\x7a\xa1\x1f\x3e\x2e\x40\x7b\x24\x36\xec\x9b\xe4\xd1\x3d\x00\x28\x87\xd2\xce\x75\x36\x36\x81\x71\x8b\xe1\xae\xc5\x5c\x2f\xdb\x93\x87\x4c\x08\xce\x6b\xe4\x8d\x34\xa5\xf5\x88\x0a\x4b\xf1\x43\x05\xe2\x58\x81\x10\x25\xf9\x75\x5f\x09\x5f\x15\x7f\xf5\x4a\x26\x69\x8d\xab\x9e\xd8\xbf\xda\x5f\x32\x67\x7d\x4c\xeb\xab\x8b\xab\x8b\x69\x04\xee\x83\xe0\x35\x4d\xd7\x06\x20\x1f\x6e\x49\xcf\xe4\xc0\xd7\x77\xf8\xe8\x35\x5e\x73\xa1\xc3\x6b\x62\x77\xf5\xb0\xa7\x70\xd8\x28\x9c\x54\xf6\xe3\x96\xf9\xce\x38\xec\x11\x94\xef\x82\x45\xd6\x06\x7e\x97\x2d\xc0\x97\xc1\x32\x18\x3d\x7e\x6a\x8e\x07\x32\xbc\x0d\x46\x59\x9f\xbc\x15\x65\x6d\xcb\x13\x2f\xa2\x54\xed\x93\x19\x7b\x07\xd2\x1d\x80\x67\x3e\xc6\x53\x3a\xa6\xbe\x9c\xcd\xfe\x37\x00\x1c\x62\xa4\xc9\x27\x0f\x00\x00")
+
+func cfcrVsphereReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrVsphereReadmeMd,
+		"cfcr-vsphere/README.md",
+	)
+}
+
+func cfcrVsphereReadmeMd() (*asset, error) {
+	bytes, err := cfcrVsphereReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-vsphere/README.md", size: 3879, mode: os.FileMode(480), modTime: time.Unix(1792208216, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrVsphereCloudConfigCfcrOverridesYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x8e\xcf\x4a\xf4\x40\x10\x07\xcf\xdf\x3c\xc5\x0f\xf6\xf0\x9d\xf2\x67\x11\x2f\x01\x11\x02\x01\x17\x44\x82\xab\x5e\x97\x9e\xa4\x43\x82\xb3\xd3\x61\xba\x27\x61\xdf\x5e\x56\xf0\x58\x75\x28\xea\x80\xc4\x6b\xa0\x81\x61\x33\xe3\xd4\xc3\x73\x90\x1d\xfb\x62\x33\x24\xde\x2d\xd9\x7f\xfd\xe5\x25\xa2\x6d\x5f\x2f\x5f\xe7\xfe\xa5\x7b\xef\x2e\xe7\xcf\xf6\xad\xfb\x70\x07\xf8\x6c\x58\x14\x51\x0c\x89\x95\xd3\xc6\x63\x89\x53\xaf\x38\xd6\x90\x84\xab\x24\x06\x79\xd9\xee\x31\x86\x27\x65\xc8\x84\x9b\xe4\x84\x61\x19\x13\x74\x96\x1c\x46\x78\x86\xd2\xc4\xa5\x2b\x8a\xc2\x15\xb0\xdb\xca\xcd\xdf\x9c\x03\x56\xb2\xb9\x41\x15\xd9\x76\x49\xdf\x5a\x45\xba\xf2\xd3\xc8\x13\xe5\x60\x95\x66\x1f\xd9\xb4\xaa\x2b\x35\xb2\x65\x78\x76\xc0\x46\x21\x73\xe3\xfe\x15\x38\xd6\x65\x5d\xd6\xe5\xc3\xa3\xfb\x19\x00\x27\xb3\xae\xcc\xee\x00\x00\x00")
+
+func cfcrVsphereCloudConfigCfcrOverridesYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrVsphereCloudConfigCfcrOverridesYml,
+		"cfcr-vsphere/cloud-config/cfcr-overrides.yml",
+	)
+}
+
+func cfcrVsphereCloudConfigCfcrOverridesYml() (*asset, error) {
+	bytes, err := cfcrVsphereCloudConfigCfcrOverridesYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-vsphere/cloud-config/cfcr-overrides.yml", size: 238, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _cfcrVsphereTerraformOutputsOverrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\x2f\x2d\x29\x28\x2d\x51\x50\x2a\x4b\x4e\xcd\x2b\x49\x2d\x8a\xcf\x4d\x2c\x06\x51\xa5\xc5\xa9\x45\x4a\x0a\xd5\x5c\x0a\x0a\x65\x89\x39\xa5\xa9\x0a\xb6\x0a\x65\x89\x45\x7a\x30\x45\x20\x59\xae\x5a\x2e\x2e\x1c\x9a\x0b\x12\x8b\x8b\xcb\xf3\x8b\x52\x70\x1b\x00\x53\x81\xcd\x90\xf2\xfc\xa2\x6c\xb2\x5d\x00\xd5\x4c\x92\x0b\x00\x03\x00\x5a\x51\x0c\x7b\x03\x01\x00\x00")
+
+func cfcrVsphereTerraformOutputsOverrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_cfcrVsphereTerraformOutputsOverrideTf,
+		"cfcr-vsphere/terraform/outputs-override.tf",
+	)
+}
+
+func cfcrVsphereTerraformOutputsOverrideTf() (*asset, error) {
+	bytes, err := cfcrVsphereTerraformOutputsOverrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cfcr-vsphere/terraform/outputs-override.tf", size: 259, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _colocateGorouterSshProxyGcpCloudConfigColocatedGorouterSshProxyYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\xcd\xbd\xae\x83\x30\x0c\x05\xe0\x9d\xa7\xf0\x08\x83\xc5\x9e\x97\x89\x72\x83\x05\x88\x10\x47\xb6\xf9\x7b\xfb\x2b\x4a\xd5\xa2\x0e\xed\x98\x9c\x73\x3e\x23\xd8\x51\xc8\x81\x50\x49\x21\x52\x05\x50\x82\x0d\x0e\xda\x75\xf6\xb4\x1b\x65\x1d\x39\x6b\x8b\x15\xc0\x1a\xd2\x42\xae\x02\x00\xc8\x61\x26\x07\x3d\x0b\x2f\x46\x82\x21\x77\xa8\x3a\x60\x11\xde\x0f\xcc\x64\x1b\xcb\x74\xbe\x0a\x89\x8d\xa4\x8f\x4d\x4c\xbc\x74\xfe\xfd\x79\x49\x00\x7f\x21\x4e\x94\x3b\xaf\x24\xeb\x18\xc9\x41\x5d\x5f\xac\xff\x48\x9a\xe6\xb9\xb0\x20\x3d\x99\x2f\xcc\xe9\x6c\x6f\xea\x55\x87\x53\xde\x0f\x7f\xcb\x6e\xfd\xfe\x75\x0d\x7f\xf3\xf8\xdd\xfc\x1f\x00\x53\x9c\xce\x6e\x32\x01\x00\x00")
+
+func colocateGorouterSshProxyGcpCloudConfigColocatedGorouterSshProxyYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_colocateGorouterSshProxyGcpCloudConfigColocatedGorouterSshProxyYml,
+		"colocate-gorouter-ssh-proxy-gcp/cloud-config/colocated-gorouter-ssh-proxy.yml",
+	)
+}
+
+func colocateGorouterSshProxyGcpCloudConfigColocatedGorouterSshProxyYml() (*asset, error) {
+	bytes, err := colocateGorouterSshProxyGcpCloudConfigColocatedGorouterSshProxyYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "colocate-gorouter-ssh-proxy-gcp/cloud-config/colocated-gorouter-ssh-proxy.yml", size: 306, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _colocateGorouterSshProxyGcpTerraformColocatedGorouterSshProxy_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x90\xbf\x6a\xf3\x30\x14\xc5\x77\x3d\xc5\x45\x7c\xab\xcd\x87\x9b\xa1\x4b\xa6\xd2\x35\xed\xd0\xad\x14\xa1\xca\xd7\xb6\x88\xa2\x2b\x74\xe5\xfc\x21\xe4\xdd\x8b\x62\x37\x09\xa1\x81\xa6\x25\xd4\x9b\x8f\x8e\x74\xce\xf9\x45\x64\xea\xa3\x41\x90\x2d\x51\xeb\x50\x19\x5a\x84\x3e\xa1\x4a\x3a\xb6\x98\x54\x20\x72\x12\xa4\x69\x8a\x15\x17\xcc\x5d\x11\x22\xad\x37\x12\xb6\x02\xc0\xeb\x05\xc2\x14\xe4\xbf\xed\x52\xc7\x12\xfd\x52\xd9\x7a\x57\x9c\x5b\x85\x00\x60\x64\xb6\xe4\x95\x6e\x1a\xeb\x6d\xda\xe4\x5b\xb3\xa7\xd9\xe3\xfe\xb0\x43\xed\x52\xa7\x4c\x87\x66\xce\x30\x85\xd7\xb3\x26\x5d\x4a\x41\x9d\x9a\x4a\xd3\x14\xa1\x7f\x77\xd6\x14\x83\x5c\xec\xef\x96\xb9\xcf\x9b\xd8\x09\x71\x71\x54\x43\x71\xa5\x63\x6d\x7d\xab\x62\xef\xf0\x30\x2c\x47\xf0\xc9\xa8\xf1\xbb\xb0\x6d\x70\x0b\x80\x81\xd1\xa7\xf7\x32\xc0\xf2\x8c\x49\xc9\xe8\x1a\xe5\xac\x9f\x0b\x80\x40\x31\xa9\xa8\x7d\x8b\xf9\x11\x39\x99\xdc\x49\x01\x60\x83\x0a\x91\x12\x19\x72\x59\x7d\x79\x78\x1e\x55\x5d\xd7\x11\x99\xbf\x08\x1c\x4f\x86\xb0\x72\xfc\xfb\x31\x8e\xab\x68\xdc\x0a\xc6\xfd\xff\xbf\x65\x71\x28\xf9\x3d\x1a\x47\xfb\x8d\x78\x54\x55\x55\xfd\x9e\xc8\x31\x6a\x14\x33\x18\xea\x53\xe8\x13\xc8\x15\x2b\xe6\x2e\x3f\xbf\xde\x9c\xd6\x1c\x08\x2c\xb5\xeb\xf1\xba\x39\x5e\x2f\x50\xec\xc4\xc7\x00\x44\xaf\x26\x6b\x69\x04\x00\x00")
+
+func colocateGorouterSshProxyGcpTerraformColocatedGorouterSshProxy_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_colocateGorouterSshProxyGcpTerraformColocatedGorouterSshProxy_overrideTf,
+		"colocate-gorouter-ssh-proxy-gcp/terraform/colocated-gorouter-ssh-proxy_override.tf",
+	)
+}
+
+func colocateGorouterSshProxyGcpTerraformColocatedGorouterSshProxy_overrideTf() (*asset, error) {
+	bytes, err := colocateGorouterSshProxyGcpTerraformColocatedGorouterSshProxy_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "colocate-gorouter-ssh-proxy-gcp/terraform/colocated-gorouter-ssh-proxy_override.tf", size: 1129, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _iamProfileAwsReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\xcd\x8e\xdc\x40\x08\x84\xef\x3c\x45\x49\x2b\xed\x21\x8a\x3d\xf7\x7d\x8a\x1c\x56\xca\xd5\xd8\x66\xa6\xd1\xb4\x9b\x4e\x83\xbd\x99\xb7\x8f\xda\x89\x36\x3f\xca\xde\x4a\x50\x14\xf0\x3d\x3d\x41\x79\x1b\x6a\xb3\xab\x66\x19\xf8\xcd\x89\x5e\x0d\xbb\x0b\xb8\x40\xbe\xab\x87\x96\x5b\xf7\x40\x8b\x07\x97\x45\xf0\xcb\x0c\x2b\xe0\x37\xff\x8c\x48\x82\x3e\xed\xd0\x82\x48\xea\x58\xb5\xc9\x12\xd6\x1e\xe4\xc9\xf6\xbc\x62\x16\x2c\x56\x55\x56\x84\xe1\x61\x7b\xc3\x3c\x67\x78\x70\xc8\x6f\xf3\x48\xf4\x9a\x04\x1e\x52\x1d\x9b\xde\x52\x20\x9b\xdd\x91\xf5\x2e\xf0\x7d\x49\x2f\x44\xd3\x34\xd1\x76\x5f\xb5\x61\xe6\xc2\x85\x07\x29\x07\x9e\x9f\xb1\xac\x7f\x14\x88\x7a\x7a\xcd\x5c\x30\x0c\x85\x37\xf9\xab\xb7\x54\x0c\x0d\xb3\x79\x1a\x66\xb3\xc8\xc6\xab\xb4\x4b\x77\x0f\x95\x63\x49\xe2\x97\x7f\x90\x5c\x46\x8c\xe7\x66\xfa\xda\x34\xe4\x7c\xf8\x8c\xb5\xeb\xa9\xff\x4b\x47\x0b\xa6\x83\xdb\x19\x36\xc6\xb5\xcb\x69\xfc\xf9\x40\xbf\x6e\xaf\xa7\xa4\x2f\xcd\x0e\x5d\x3b\xe3\x0f\x93\x7a\xa3\x9f\xfb\x4e\x0a\x9b\x70\x71\x44\xe2\x78\x9f\xaa\x96\x75\x79\xe0\x6a\x8d\x7a\x69\x77\x69\x1d\x34\x6e\x7a\x48\x87\xde\x77\xae\x26\x8e\x62\x81\x26\xdf\x76\x6d\x82\x49\x79\x7b\xf9\x34\xa1\x4a\xdb\xd4\x5d\xad\xf8\x48\x44\x3f\x06\x00\x11\x0c\xb9\x99\x15\x02\x00\x00")
+
+func iamProfileAwsReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_iamProfileAwsReadmeMd,
+		"iam-profile-aws/README.md",
+	)
+}
+
+func iamProfileAwsReadmeMd() (*asset, error) {
+	bytes, err := iamProfileAwsReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "iam-profile-aws/README.md", size: 533, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _iamProfileAwsVarsIamTfvars = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x41\x00\xbe\xff\x62\x6f\x73\x68\x5f\x69\x61\x6d\x5f\x69\x6e\x73\x74\x61\x6e\x63\x65\x5f\x70\x72\x6f\x66\x69\x6c\x65\x20\x3d\x20\x22\x65\x78\x69\x73\x74\x69\x6e\x67\x2d\x69\x61\x6d\x2d\x69\x6e\x73\x74\x61\x6e\x63\x65\x2d\x70\x72\x6f\x66\x69\x6c\x65\x2d\x6e\x61\x6d\x65\x22\x0a\x03\x00\x7e\x09\xa4\x13\x41\x00\x00\x00")
+
+func iamProfileAwsVarsIamTfvarsBytes() ([]byte, error) {
+	return bindataRead(
+		_iamProfileAwsVarsIamTfvars,
+		"iam-profile-aws/vars/iam.tfvars",
+	)
+}
+
+func iamProfileAwsVarsIamTfvars() (*asset, error) {
+	bytes, err := iamProfileAwsVarsIamTfvarsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "iam-profile-aws/vars/iam.tfvars", size: 65, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _isoSegsAwsReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x90\xc1\x6a\x23\x31\x10\x44\xef\xfd\x15\x85\x0d\x3e\xad\x6c\xf6\xba\xb0\x87\x5c\xf2\x01\xc1\xe4\xea\x91\x34\x6d\x4b\x8c\x46\x2d\xd4\x3d\x31\xf3\xf7\x61\xe2\x60\x7c\xec\xea\xe2\x41\xbd\xfd\x1e\x59\xc5\x29\xdf\xd4\xf9\xbb\x12\x9d\x05\xb1\xb3\x37\x86\xaf\xdb\xab\x78\xcb\x52\xa1\x7c\x9b\xb9\x1a\xa4\xc2\xdf\xf5\x0f\x2c\x31\xae\xb9\xb0\x22\x57\x58\xca\x8a\x31\x77\x8e\x26\x7d\x25\x4d\xb2\x94\x11\x81\x11\xa5\x65\x1e\x61\x82\x55\x96\x8e\x10\x0a\xd4\x36\xf6\xb3\x7c\x24\x3a\x27\x86\x1a\x37\xc5\x9c\x6f\xc9\x50\x44\x26\x94\x3c\x31\x74\x89\xe9\x1f\xd1\x30\x0c\x34\x4f\x63\xee\x08\xbe\xfa\xea\x1d\xd7\x2f\x1c\x0e\x88\xe3\x4b\x40\xb4\xd1\x5b\xf1\x15\xce\x55\x3f\xf3\x6b\xd9\xb9\x12\x9c\xad\x8d\x11\xaf\x8f\x23\x72\x37\x94\x70\x8c\xdd\x1e\xc1\xc4\xeb\x76\x4f\xbc\x12\xc5\x06\xd7\x11\x44\x93\x0b\x22\x56\xc4\x8f\xdc\x4f\x1b\xdb\x35\x6f\x31\xb1\x9e\x5e\xa5\x9d\x8e\xd8\x66\xbc\x5f\x3e\xdf\x3e\x2e\x4f\x65\x97\x5f\x65\xfa\x7f\xf7\x77\xf7\x33\x7d\x69\x34\x0c\x03\x7d\x0f\x00\x7f\xbf\x81\x82\x73\x01\x00\x00")
+
+func isoSegsAwsReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_isoSegsAwsReadmeMd,
+		"iso-segs-aws/README.md",
+	)
+}
+
+func isoSegsAwsReadmeMd() (*asset, error) {
+	bytes, err := isoSegsAwsReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "iso-segs-aws/README.md", size: 371, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _isoSegsAwsCloudConfigIsoSegsOpsYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x95\xcd\x6e\xdb\x30\x0c\x80\xef\x7e\x0a\x1e\xa3\x83\x60\xb4\x8b\x74\x30\x90\x27\x19\x06\x81\x96\x69\x47\x98\x6a\x19\x92\x9c\x36\x7e\xfa\xc1\x7f\x6b\xf3\xdf\xa6\x59\x96\x23\x45\xd1\xfc\xf8\xd1\x4e\x38\xc4\x6d\x43\x19\x78\x6a\x2c\x6a\x4a\x00\x1a\x8c\xeb\x0c\xd2\x9a\xe2\xab\xf3\xbf\x43\x5a\xe3\x0b\xad\x0a\x2a\xb1\xb5\x31\x0d\x6d\x5e\x53\x0c\x29\x76\xab\xee\x29\xd5\xd6\xb5\x85\x6a\xbc\x6b\xc8\x47\x43\x21\x0d\xa4\x5b\x6f\xe2\x56\x55\xde\xb5\x4d\x48\x79\x02\xb0\x41\xdb\x52\x06\x8b\x85\x09\x4e\x85\x35\x7a\x2a\xd4\xee\x3d\x65\x0a\xc6\x92\xe4\x1b\x28\xcf\x8f\x83\xf2\xe3\x5e\x28\xd8\x7d\x7c\x52\x02\x00\xd0\xfb\xc9\xa0\x5b\x0e\xc1\x3e\x46\x36\x9c\x02\xe0\x06\x8d\xc5\xdc\xd8\x7e\x4f\x9d\xab\x07\x0a\xec\x96\xaa\xaf\xbe\xb2\xa3\xb8\xa6\xa3\xf8\x4e\x47\x79\x4d\x47\x79\xb9\xe3\xf9\x05\xef\xb1\x60\xf7\xd7\x76\x85\x91\x5e\x71\x3b\xbb\x9c\x42\xc6\x86\xa4\xc7\xba\x9a\x10\x96\x6a\x08\xe6\x04\x05\xf2\x1b\x2a\x46\x99\x7c\xbe\x31\x9d\xaa\x27\xc6\x8e\x27\x9e\xa7\x44\x88\x18\x8d\xde\xad\x1e\xcf\x18\x3b\x2b\x68\x1c\x68\x46\x1a\xa3\xa9\x04\x60\xef\x7d\x9d\x6b\xf8\xfc\xb6\xde\xf0\x8b\x39\x26\x54\x1c\x0a\x15\xa7\x85\x8a\x8b\x42\xc5\x29\xa1\xe2\x33\x42\xc5\xd7\x85\x8a\x07\x13\x2a\x0f\x85\xca\xd3\x42\xe5\x45\xa1\xf2\x94\x50\xf9\x19\xa1\xf2\xeb\x42\xe5\x3f\x15\xba\x79\x51\xf4\x16\xa9\x0e\xc6\xd5\x07\x02\x7b\xc9\x19\x98\xe0\x78\xa0\x8a\xeb\x92\x7b\xd7\x46\xf2\x7c\xda\x02\x7f\x07\x3f\x3b\x0d\xd9\x3c\x64\xf0\x73\xb1\xd0\xa5\xea\xbf\xa1\xf1\x29\xca\xe6\xd3\x2f\xd2\xaf\xcb\x83\xe9\xf2\x43\xd5\xee\x3d\xc6\x6e\x28\xa0\x07\x5a\x9d\x1b\xf4\x5e\x7f\x70\x47\xb0\x0a\x43\x95\xe3\x21\xac\x7b\xef\x6f\xdb\xc7\x82\xd3\x25\x8f\xba\x79\x48\x6f\x13\x93\xcd\xff\x23\xc3\xfb\xd6\x6e\x8d\xf1\x67\x00\xc7\x73\x03\x58\xc4\x0a\x00\x00")
+
+func isoSegsAwsCloudConfigIsoSegsOpsYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_isoSegsAwsCloudConfigIsoSegsOpsYml,
+		"iso-segs-aws/cloud-config/iso-segs-ops.yml",
+	)
+}
+
+func isoSegsAwsCloudConfigIsoSegsOpsYml() (*asset, error) {
+	bytes, err := isoSegsAwsCloudConfigIsoSegsOpsYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "iso-segs-aws/cloud-config/iso-segs-ops.yml", size: 2756, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _isoSegsGcpReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x90\x31\xae\xdb\x40\x0c\x44\xfb\x3d\xc5\x04\xbf\x48\x13\x59\x7d\xda\x1f\x20\x48\xf7\x8f\x60\x6a\x97\x96\x98\x50\xa4\xb2\xe4\xda\xf0\xed\x03\x19\x29\x82\xf4\x6f\x06\x6f\xe6\xed\x0d\x12\x3e\x05\xaf\x31\xad\xf5\x28\xe5\xbd\x33\x25\x07\x08\x21\xb6\x2a\xa3\xfb\x48\xb1\xf5\xc4\x94\x52\xdc\x10\xbc\xee\x6c\x09\x37\x7c\x7f\xff\xf8\x02\xb1\xaa\xa3\x9d\x4c\xe3\x26\x95\x92\x1b\xd4\xa9\x61\x21\x25\xab\xdc\x03\x64\x0d\x37\xe9\xfc\x20\x55\xf4\xa1\x1c\x97\x52\xae\xd7\x6b\xa9\x07\xa6\x8e\xc5\x63\x9b\x16\xf7\x3c\x63\xdc\xe7\x43\xc9\xa6\x83\xb2\x6e\x1c\xf3\xbf\x7e\xf3\x05\xe1\x3b\x4f\x6c\xf7\xb9\x2c\x8b\x62\x1c\xaf\x9a\xf2\x4d\xa2\x2a\xc9\xce\xfd\x2b\x72\x93\x80\x9c\x13\x92\xe3\x74\x9f\x1b\xdf\x59\xfd\x78\x59\xff\x1e\xa4\x92\x4f\xbc\xea\x2f\xc0\x8f\xc4\x46\x01\xf3\xc4\xc2\x6c\x88\xb1\xfc\xe4\x9a\x48\x3f\x3f\xe0\x3a\xfa\x49\x77\xbe\x0b\x3f\x30\x4d\xc8\x8d\xff\x9b\x82\x9d\x9e\x7f\xf3\xb8\x0d\xd5\x27\xd4\xeb\x2f\x6e\x68\xfe\xb0\x4b\xf9\x50\xa6\x60\x34\xb7\xcf\x89\x3e\x0c\x92\x10\xc3\xd1\xbd\x8d\x9a\xe2\xf6\xa9\xfc\x19\x00\x5f\xea\x47\xdf\x86\x01\x00\x00")
+
+func isoSegsGcpReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_isoSegsGcpReadmeMd,
+		"iso-segs-gcp/README.md",
+	)
+}
+
+func isoSegsGcpReadmeMd() (*asset, error) {
+	bytes, err := isoSegsGcpReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "iso-segs-gcp/README.md", size: 390, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _isoSegsGcpCloudConfigRoutingIsoSegsYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcd\x4d\x4e\xc5\x30\x0c\x04\xe0\x7d\x4e\xe1\xe5\x7b\x0b\xeb\xed\x73\x99\x28\xa4\x43\x89\x9a\xc6\x91\xed\xb6\x70\x7b\x24\xca\x4f\x85\x84\xc4\x76\x3c\xf3\x39\x30\xf9\xdb\x40\x24\xc5\x68\xb9\x20\x10\x8d\xec\x2f\x91\x1e\xfb\x9a\xf0\xea\xe8\x56\xa5\xdb\x83\x03\xd1\x9e\xdb\x86\x18\x88\x88\x7a\x5e\x11\xa9\x9a\xb0\x61\xe6\xf2\xcc\x2a\x9b\x43\xb9\xc3\x0f\xd1\x85\x87\xca\x80\x7a\x85\x7d\xd4\x4b\x93\x6d\x4a\x3f\xe1\x89\x10\x3d\xe5\xb2\xa0\x4f\xc9\xa0\x7b\x2d\x88\x74\xbb\x55\x93\x74\x62\xe9\xd7\xf5\x7e\xff\x5c\x79\xd6\x19\x9e\x86\x48\xfb\x5a\x1c\x96\x2e\xe9\xa5\x39\x7f\xff\xe2\xff\xe1\xfc\x97\xf8\x3e\x00\x87\xde\x19\x82\x2a\x01\x00\x00")
+
+func isoSegsGcpCloudConfigRoutingIsoSegsYmlBytes() ([]byte, error) {
+	return bindataRead(
+		_isoSegsGcpCloudConfigRoutingIsoSegsYml,
+		"iso-segs-gcp/cloud-config/routing-iso-segs.yml",
+	)
+}
+
+func isoSegsGcpCloudConfigRoutingIsoSegsYml() (*asset, error) {
+	bytes, err := isoSegsGcpCloudConfigRoutingIsoSegsYmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "iso-segs-gcp/cloud-config/routing-iso-segs.yml", size: 298, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _isoSegsGcpTerraformRoutingIsoSegsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x98\x51\x6f\xdb\x36\x10\xc7\xdf\xf5\x29\x0e\x42\x9f\x86\xd1\x93\x9d\x6e\x48\x07\xf8\x61\x18\x06\xec\xa9\x2b\xb0\xbc\x15\x01\x41\x51\x27\x85\x08\x4d\x0a\x24\x15\x2f\x0b\xf2\xdd\x87\x93\x28\x4b\x96\x15\xc7\x86\xb3\x36\xed\x83\x25\x92\x77\x3c\xfe\xee\xcf\x13\x19\xdb\x84\xba\x09\x90\x2a\x6f\xb9\xb3\x4d\x40\xc7\x73\x21\xef\xd1\x14\xdc\xa3\x7b\x50\x12\x53\x78\x4a\x00\x1e\x84\x6e\x10\xd6\x50\x59\x5b\x69\xe4\xd2\x6e\xea\x26\xe0\x74\xec\x42\x79\xcb\x3a\x37\x4c\xe7\x2c\xf6\xb2\xbe\xd7\x88\x0d\x26\xcf\x49\xe2\xd0\xdb\xc6\x49\x84\x74\xe2\xae\xd2\x36\x17\x9a\x8b\xa2\x70\xe8\x7d\xda\x86\xc5\x64\xc9\x76\x0d\x14\x0a\x79\x81\x35\xa4\x1f\x9e\x1e\x84\x5b\xa0\x79\xe0\xaa\x78\x66\xdd\xc8\xf4\x14\xf7\xa5\x75\x5b\xe1\x0a\x65\x2a\xee\x1a\x8d\xc3\x34\x77\x21\xd4\x6c\xe8\x65\x5d\xef\x6e\xce\xee\xdf\x0b\x33\xb7\xc6\x69\x02\xa0\xea\x3e\xfe\x43\x5c\xfb\xeb\x5b\x44\xcb\xfe\x35\xfe\x26\x00\x41\xb8\x0a\x43\x9c\x6e\xe2\xa3\xeb\xe3\x34\x1d\xaf\x9d\xfd\xe7\xb1\x77\x43\x2d\x44\xbd\x6b\xf4\xa8\x4b\xae\x95\xb9\x4f\x00\x6a\xeb\x02\x77\xc2\x54\x94\xc1\xf4\x3a\xbb\x9c\x92\xbf\x08\x93\xff\xc6\x9c\xfc\x21\x28\x7f\x0a\xa9\x8f\x1f\xaf\x8e\xa3\x1a\xcd\xd1\x4d\x31\xd1\x52\x3f\xc7\x01\x9d\x79\x3c\xad\x4d\xe7\x27\x01\x28\xd0\x4b\xa7\xea\xa0\xac\xa1\xe1\x0e\x85\xd6\x8f\x20\x40\x5b\x51\x40\x2e\xb4\x30\x12\x1d\xe4\x4d\x00\xad\x7c\xc0\x02\x84\x07\x61\x80\x9c\xc0\xce\x49\xe3\x34\xdf\x88\x7a\x1e\x51\xec\x3c\xe0\xd2\x38\xcd\xa8\x7d\x20\x73\x22\x04\x3f\x47\xc1\x1f\xc1\x70\x9c\x85\x9f\x87\xd1\x1b\x9d\x43\xc4\xcf\x23\xb9\x94\x0b\x80\xf7\x9a\x4b\x74\x41\x95\x4a\x8a\x80\x1e\xd6\xf0\x75\xe2\x6e\x32\x64\x21\x4b\x46\xaf\x83\x9b\xdb\xa3\x7c\x63\x34\x33\x50\x63\x3c\x23\xac\xb3\x28\xa3\x49\x9a\xb4\xa2\x2a\x45\xa3\x43\x5f\xb0\x2f\xad\xe8\xa7\x29\x64\xe2\x33\xae\xe4\x45\xaf\x87\x32\x99\x5d\xd6\xce\x3e\xed\x37\x6e\x34\x59\x43\xba\xab\x32\xb5\xb3\xc1\x4a\xab\xa3\x97\x3f\x6f\x6e\xbe\xfc\x4d\xe3\x83\xda\xa0\x6d\x88\x83\x84\x35\x7c\xca\xb2\x04\x00\x8d\xc8\x89\x7b\x61\x68\x6c\x29\xb4\x47\x42\x16\xa3\x6b\x83\x02\xa8\x9c\x6d\xea\x43\x6c\xca\xf8\x40\x1b\x92\xb7\xfd\x13\x6a\xd9\x88\x13\xc0\xf3\xdb\x38\x5d\xfe\x1f\x4e\x57\x87\x4e\xef\x50\xe8\x70\xc7\xe5\x1d\xca\xfb\x39\x75\x8f\xfb\x49\xda\x75\x93\x6b\x25\x59\xd7\xcc\x5a\xb3\x53\x95\xbe\x1f\xda\x81\x4c\xb2\x73\x85\xc1\x32\xd6\x78\xb6\x45\x1f\x96\x4c\xcc\x94\x54\xe5\xad\x16\x54\x5f\x99\xc7\x6a\x83\x26\xfc\x0a\x01\x9d\x13\xa5\x75\x1b\xa8\xd0\xa0\x13\x54\x45\xfa\xb0\x22\xd1\x70\x27\x02\x28\x0f\x9b\x46\x07\xc5\xfe\xb5\x06\xa1\xb4\x2e\x56\x19\x2a\xce\x5d\x25\x52\xa6\xa2\x29\xdb\xfe\x21\xda\x71\x3c\x71\x2d\x05\x27\xf1\xc6\xa4\xf5\xbb\x78\x27\xe0\x4e\xda\xbb\xaf\x11\xc9\xe7\x02\x84\xcb\xb3\x11\x2e\x07\x84\xf9\x3b\x43\x98\x7f\x17\x84\xab\xb3\x11\xae\x06\x84\xf2\x9d\x21\x94\x17\x21\x1c\x5f\x1e\xb6\xbe\x3f\x08\xd4\xd6\xea\xa3\x97\x86\xd1\xb8\xfe\x13\xbb\xf5\xaf\x5f\x0e\xe2\xb1\x2f\x26\xa4\x35\x1a\xa5\x62\x36\x07\xdd\xa0\xa3\x5e\xf7\x82\x3e\xdb\x73\x02\xe0\xd1\x7b\x65\x0d\x17\x65\xa9\x8c\x0a\x8f\x34\xfe\xf3\x5f\x9f\xff\x48\x4f\x2a\x9e\x74\x72\x3c\xa5\x82\x52\x24\xc7\x8b\xe7\x8b\xe7\xf5\xad\x8f\x87\xee\x61\x49\x83\x12\x5e\x58\xd9\x70\x4c\x1f\x1d\xb1\x4f\xcd\xe4\x0b\x07\xea\x91\x7a\x54\xcd\x77\x1f\xe6\x35\xa4\x37\xbf\x7f\x99\xdc\x08\x0e\xa7\x8a\x3d\xa3\x69\x62\xcb\x99\x50\x64\xd9\x2a\x23\x2e\xf1\x6c\x26\x6f\x8f\xe4\x3a\xfb\x26\x44\x0a\xe3\xb9\x43\x69\x1d\x1d\xc3\x42\x54\xc7\x56\xe9\x42\x0a\x57\xb0\xc2\x1c\xca\x63\x0d\xe9\x0f\xad\x73\x8f\xd5\xe2\xc3\xd3\xc8\xcf\x46\x18\x51\x61\xc1\xe9\xdb\xd6\x72\x22\xe7\xed\x0b\x3d\x10\xcd\x67\x8a\xbe\xc0\x1a\x4d\xe1\xb9\x35\x33\xca\x9f\x5c\xf1\x86\xeb\xdd\x2d\x11\x7e\xac\x47\x51\xfc\x46\xce\x42\xd0\xb1\x05\xd6\x70\x95\x65\xb4\xbb\xc6\x71\x0c\x7c\x8e\x47\x48\xd1\x91\xad\x73\x85\x08\xc2\xbf\x1e\x59\xc4\xdb\xbf\xc6\xdf\x57\xf6\xa2\x72\xb8\x15\xba\xaf\x28\xfd\x2b\x93\xe5\x1c\xe5\x59\xbd\xd9\x1a\xcd\xab\x10\x0d\x86\xad\x75\xf7\x8b\x3c\xd7\x2c\x3e\x13\xbe\xf8\x38\xab\xcf\x19\x93\x1d\x13\xa1\xb5\xdd\xc6\x4f\xc0\x58\x8d\x41\xd6\xc3\x47\xc0\xd3\xc3\x1a\xbe\xd2\xdf\x11\x7e\xec\x76\xf4\x6d\x7f\x56\xec\x34\xd7\xed\xf7\x16\x6d\x9a\x2d\xda\xff\x3f\x65\xe9\x6d\xb2\xdb\x3a\x3c\x88\x6a\x8e\xfc\xe4\x9e\x30\x39\x9b\x4e\x6e\x09\xa7\x94\xc4\xfd\x34\xc8\x72\xaf\xa6\x9e\x9c\x8a\x3d\xa3\x77\x9e\x92\x2e\x29\xd7\xd9\xb1\x9c\x2c\xaf\xb2\xc5\x6a\xb9\x6c\xf3\xb2\x5a\xd1\xf8\xab\x9f\x17\xcb\x4f\x5d\xc3\xf2\x97\xf4\x76\x92\x28\x78\xb3\x54\xfd\x37\x00\x6e\x76\x1a\xc3\x6e\x14\x00\x00")
+
+func isoSegsGcpTerraformRoutingIsoSegsTfBytes() ([]byte, error) {
+	return bindataRead(
+		_isoSegsGcpTerraformRoutingIsoSegsTf,
+		"iso-segs-gcp/terraform/routing-iso-segs.tf",
+	)
+}
+
+func isoSegsGcpTerraformRoutingIsoSegsTf() (*asset, error) {
+	bytes, err := isoSegsGcpTerraformRoutingIsoSegsTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "iso-segs-gcp/terraform/routing-iso-segs.tf", size: 5230, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _restrictedInstanceGroupsGcpReadmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\x3d\x6e\xc3\x30\x0c\xc5\xf1\x5d\xa7\x78\x80\xd7\xaa\xba\x4c\x0f\x20\x89\xa6\x3f\x00\x97\x24\x28\xda\x45\x73\xfa\x00\x09\xb2\x64\xc8\xf8\xf0\x7e\xc3\x7f\x9a\xe0\x3c\xc2\x77\x0a\x9e\xf3\x2e\x23\x9a\x10\xe7\xd5\xf5\xb4\x91\x57\xb2\x94\x7e\x14\xe4\xdc\x82\x11\x7f\x8a\x17\xc1\x93\x3c\x36\xb7\x19\xba\xa0\xc9\xdb\x8b\x45\x1d\x7c\xb1\xff\xe3\xa6\xc2\x50\xc1\x4a\xf6\x95\x6a\xad\x89\x0c\xd9\xd1\x75\x6c\xb9\xab\xc6\xa1\x6d\x66\x2f\x76\x34\xc9\xd6\x82\x36\x1e\xe5\x73\x58\xf9\xc6\xd0\x5f\xce\x2c\x57\x49\xbd\x1f\x38\x2d\xd5\x5a\xd3\x7d\x00\x3e\xb6\xbb\xad\xd2\x00\x00\x00")
+
+func restrictedInstanceGroupsGcpReadmeMdBytes() ([]byte, error) {
+	return bindataRead(
+		_restrictedInstanceGroupsGcpReadmeMd,
+		"restricted-instance-groups-gcp/README.md",
+	)
+}
+
+func restrictedInstanceGroupsGcpReadmeMd() (*asset, error) {
+	bytes, err := restrictedInstanceGroupsGcpReadmeMdBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "restricted-instance-groups-gcp/README.md", size: 210, mode: os.FileMode(480), modTime: time.Unix(1525105712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _restrictedInstanceGroupsGcpTerraformRestricted_instance_groups_overrideTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x92\x3f\x6f\xdb\x30\x10\xc5\x77\x7e\x8a\x03\xd1\x95\x86\x1b\x7b\xe9\xa0\xbd\x63\x81\x66\x2b\x0a\x82\xa2\x4e\x12\x61\x8a\x27\x90\x47\x0f\x0e\xf4\xdd\x0b\x32\x6c\x94\xa4\x28\x50\xd4\x43\x34\xde\x9f\xa7\x1f\xdf\xbb\x88\x89\x72\xb4\x08\x72\x22\x9a\x3c\x6a\x4b\xcb\x9a\x19\x75\x6f\xec\x05\xc3\xa0\x13\xc6\xab\xb3\x28\x41\x46\xca\x8c\x51\xf9\x5e\xb5\x9e\x7a\xe9\x3d\x09\x80\x60\x16\x84\xf6\x75\x20\x3f\x3d\x5d\x4d\x3c\x60\xb8\x6a\x37\x6c\xea\x65\x57\x0a\x80\x95\x22\xeb\x36\xde\x81\x9c\x99\xd7\x54\xeb\x91\x98\x2c\xf9\xa6\xf0\xf5\xf1\xf1\xdb\xf7\x52\x67\xb7\x20\x65\xd6\x09\x2d\x74\xf0\xe5\x78\x14\x00\x18\x4c\x5f\x68\x87\x50\x66\x47\xe3\x13\x0a\x01\xd0\xc8\x2a\x10\xc0\x14\x29\xaf\xd0\xc1\xbb\xa7\xb9\x90\xd8\x04\x8b\xba\xf6\x0f\xfb\xbb\x8e\x87\x84\x7e\xd4\xde\x85\x8b\x00\xd8\xee\x17\xfc\xfc\xa7\xe0\x8c\xc6\xf3\xac\xed\x8c\xf6\x92\xa0\x83\x1f\xef\xb4\x5e\xf7\x0f\x76\x54\x6b\xee\xbd\xb3\xea\xb9\xac\xea\xda\x2e\xfa\x53\x6c\x42\xfc\x35\xc2\xb7\x58\x6f\x12\x7c\x90\xd5\x22\x4b\x39\xf0\x9e\xd9\x51\xfe\x73\x8e\xea\x41\xdd\x4e\x65\x7c\xc0\x64\xa3\x5b\xd9\x51\x28\x12\x8c\x31\x9a\x91\xe2\x02\x13\x06\x8c\x86\x71\x80\xdf\x1c\xcd\x3e\x9e\x0d\x83\x4b\xb0\x64\xcf\x4e\xdd\x28\x20\x8c\x14\xa1\x9e\x01\x78\x32\x43\x6f\xbc\x09\xd6\x85\xa9\xe8\xd7\xfe\x8e\x53\x7e\xda\x20\x07\x5d\x0e\xa9\x25\x53\xa9\x5f\x1f\xd3\xf3\x99\x95\x95\xf3\xb9\x82\x6e\xff\xeb\xd5\xe9\x6e\xaf\x4e\xea\x76\xfe\x08\xaf\xce\xf7\x78\xf5\x6b\x00\xc4\xd6\x23\xbb\x1a\x04\x00\x00")
+
+func restrictedInstanceGroupsGcpTerraformRestricted_instance_groups_overrideTfBytes() ([]byte, error) {
+	return bindataRead(
+		_restrictedInstanceGroupsGcpTerraformRestricted_instance_groups_overrideTf,
+		"restricted-instance-groups-gcp/terraform/restricted_instance_groups_override.tf",
+	)
+}
+
+func restrictedInstanceGroupsGcpTerraformRestricted_instance_groups_overrideTf() (*asset, error) {
+	bytes, err := restrictedInstanceGroupsGcpTerraformRestricted_instance_groups_overrideTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "restricted-instance-groups-gcp/terraform/restricted_instance_groups_override.tf", size: 1050, mode: os.FileMode(480), modTime: time.Unix(1792204224, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"1-az-aws/PATCH.md":                                                                  _1AzAwsPatchMd,
+	"1-az-aws/vars/zone.tfvars":                                                          _1AzAwsVarsZoneTfvars,
+	"README.md":                                                                          readmeMd,
+	"acm-aws/README.md":                                                                  acmAwsReadmeMd,
+	"acm-aws/terraform/cert_override.tf":                                                 acmAwsTerraformCert_overrideTf,
+	"acm-aws/terraform/dns_override.tf":                                                  acmAwsTerraformDns_overrideTf,
+	"acm-aws/terraform/lb_override.tf":                                                   acmAwsTerraformLb_overrideTf,
+	"alb-aws/README.md":                                                                  albAwsReadmeMd,
+	"alb-aws/cloud-config/lb-ops.yml":                                                    albAwsCloudConfigLbOpsYml,
+	"alb-aws/terraform/cf-lb_override.tf":                                                albAwsTerraformCfLb_overrideTf,
+	"bosh-lite-gcp/README.md":                                                            boshLiteGcpReadmeMd,
+	"bosh-lite-gcp/cloud-config/bosh-lite.yml":                                           boshLiteGcpCloudConfigBoshLiteYml,
+	"bosh-lite-gcp/create-director-override.sh":                                          boshLiteGcpCreateDirectorOverrideSh,
+	"bosh-lite-gcp/create-jumpbox-override.sh":                                           boshLiteGcpCreateJumpboxOverrideSh,
+	"bosh-lite-gcp/delete-director-override.sh":                                          boshLiteGcpDeleteDirectorOverrideSh,
+	"bosh-lite-gcp/delete-jumpbox-override.sh":                                           boshLiteGcpDeleteJumpboxOverrideSh,
+	"bosh-lite-gcp/external-ip-gcp.yml":                                                  boshLiteGcpExternalIpGcpYml,
+	"bosh-lite-gcp/ip-forwarding.yml":                                                    boshLiteGcpIpForwardingYml,
+	"bosh-lite-gcp/terraform/bosh-lite_override.tf":                                      boshLiteGcpTerraformBoshLite_overrideTf,
+	"byobastion-gcp/README.md":                                                           byobastionGcpReadmeMd,
+	"byobastion-gcp/create-director-override.sh":                                         byobastionGcpCreateDirectorOverrideSh,
+	"byobastion-gcp/create-jumpbox-override.sh":                                          byobastionGcpCreateJumpboxOverrideSh,
+	"byobastion-gcp/delete-director-override.sh":                                         byobastionGcpDeleteDirectorOverrideSh,
+	"byobastion-gcp/delete-jumpbox-override.sh":                                          byobastionGcpDeleteJumpboxOverrideSh,
+	"byobastion-gcp/terraform/bastion_override.tf":                                       byobastionGcpTerraformBastion_overrideTf,
+	"byobastion-gcp/vars/bastion.tfvars":                                                 byobastionGcpVarsBastionTfvars,
+	"cfcr-aws/README.md":                                                                 cfcrAwsReadmeMd,
+	"cfcr-aws/cfcr-ops.yml":                                                              cfcrAwsCfcrOpsYml,
+	"cfcr-aws/cloud-config/cfcr-cloud-config-ops.yml":                                    cfcrAwsCloudConfigCfcrCloudConfigOpsYml,
+	"cfcr-aws/terraform/cfcr_dns_override.tf":                                            cfcrAwsTerraformCfcr_dns_overrideTf,
+	"cfcr-aws/terraform/cfcr_iam_override.tf":                                            cfcrAwsTerraformCfcr_iam_overrideTf,
+	"cfcr-aws/terraform/cfcr_lb_override.tf":                                             cfcrAwsTerraformCfcr_lb_overrideTf,
+	"cfcr-aws/terraform/cfcr_outputs_override.tf":                                        cfcrAwsTerraformCfcr_outputs_overrideTf,
+	"cfcr-aws/vars/cfcr.tfvars":                                                          cfcrAwsVarsCfcrTfvars,
+	"cfcr-gcp/README.md":                                                                 cfcrGcpReadmeMd,
+	"cfcr-gcp/cfcr-ops.yml":                                                              cfcrGcpCfcrOpsYml,
+	"cfcr-gcp/cloud-config/cfcr-cloud-config-ops.yml":                                    cfcrGcpCloudConfigCfcrCloudConfigOpsYml,
+	"cfcr-gcp/terraform/cfcr_dns_override.tf":                                            cfcrGcpTerraformCfcr_dns_overrideTf,
+	"cfcr-gcp/terraform/cfcr_iam_override.tf":                                            cfcrGcpTerraformCfcr_iam_overrideTf,
+	"cfcr-gcp/terraform/cfcr_lb_override.tf":                                             cfcrGcpTerraformCfcr_lb_overrideTf,
+	"cfcr-gcp/terraform/cfcr_outputs_override.tf":                                        cfcrGcpTerraformCfcr_outputs_overrideTf,
+	"cfcr-gcp/vars/cfcr.tfvars":                                                          cfcrGcpVarsCfcrTfvars,
+	"cfcr-openstack/README.md":                                                           cfcrOpenstackReadmeMd,
+	"cfcr-openstack/cloud-config/cfcr-overrides.yml":                                     cfcrOpenstackCloudConfigCfcrOverridesYml,
+	"cfcr-vsphere/README.md":                                                             cfcrVsphereReadmeMd,
+	"cfcr-vsphere/cloud-config/cfcr-overrides.yml":                                       cfcrVsphereCloudConfigCfcrOverridesYml,
+	"cfcr-vsphere/terraform/outputs-override.tf":                                         cfcrVsphereTerraformOutputsOverrideTf,
+	"colocate-gorouter-ssh-proxy-gcp/cloud-config/colocated-gorouter-ssh-proxy.yml":      colocateGorouterSshProxyGcpCloudConfigColocatedGorouterSshProxyYml,
+	"colocate-gorouter-ssh-proxy-gcp/terraform/colocated-gorouter-ssh-proxy_override.tf": colocateGorouterSshProxyGcpTerraformColocatedGorouterSshProxy_overrideTf,
+	"iam-profile-aws/README.md":                                                          iamProfileAwsReadmeMd,
+	"iam-profile-aws/vars/iam.tfvars":                                                    iamProfileAwsVarsIamTfvars,
+	"iso-segs-aws/README.md":                                                             isoSegsAwsReadmeMd,
+	"iso-segs-aws/cloud-config/iso-segs-ops.yml":                                         isoSegsAwsCloudConfigIsoSegsOpsYml,
+	"iso-segs-gcp/README.md":                                                             isoSegsGcpReadmeMd,
+	"iso-segs-gcp/cloud-config/routing-iso-segs.yml":                                     isoSegsGcpCloudConfigRoutingIsoSegsYml,
+	"iso-segs-gcp/terraform/routing-iso-segs.tf":                                         isoSegsGcpTerraformRoutingIsoSegsTf,
+	"restricted-instance-groups-gcp/README.md":                                           restrictedInstanceGroupsGcpReadmeMd,
+	"restricted-instance-groups-gcp/terraform/restricted_instance_groups_override.tf":    restrictedInstanceGroupsGcpTerraformRestricted_instance_groups_overrideTf,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+
+var _bintree = &bintree{nil, map[string]*bintree{
+	"1-az-aws": &bintree{nil, map[string]*bintree{
+		"PATCH.md": &bintree{_1AzAwsPatchMd, map[string]*bintree{}},
+		"vars": &bintree{nil, map[string]*bintree{
+			"zone.tfvars": &bintree{_1AzAwsVarsZoneTfvars, map[string]*bintree{}},
+		}},
+	}},
+	"README.md": &bintree{readmeMd, map[string]*bintree{}},
+	"acm-aws": &bintree{nil, map[string]*bintree{
+		"README.md": &bintree{acmAwsReadmeMd, map[string]*bintree{}},
+		"terraform": &bintree{nil, map[string]*bintree{
+			"cert_override.tf": &bintree{acmAwsTerraformCert_overrideTf, map[string]*bintree{}},
+			"dns_override.tf":  &bintree{acmAwsTerraformDns_overrideTf, map[string]*bintree{}},
+			"lb_override.tf":   &bintree{acmAwsTerraformLb_overrideTf, map[string]*bintree{}},
+		}},
+	}},
+	"alb-aws": &bintree{nil, map[string]*bintree{
+		"README.md": &bintree{albAwsReadmeMd, map[string]*bintree{}},
+		"cloud-config": &bintree{nil, map[string]*bintree{
+			"lb-ops.yml": &bintree{albAwsCloudConfigLbOpsYml, map[string]*bintree{}},
+		}},
+		"terraform": &bintree{nil, map[string]*bintree{
+			"cf-lb_override.tf": &bintree{albAwsTerraformCfLb_overrideTf, map[string]*bintree{}},
+		}},
+	}},
+	"bosh-lite-gcp": &bintree{nil, map[string]*bintree{
+		"README.md": &bintree{boshLiteGcpReadmeMd, map[string]*bintree{}},
+		"cloud-config": &bintree{nil, map[string]*bintree{
+			"bosh-lite.yml": &bintree{boshLiteGcpCloudConfigBoshLiteYml, map[string]*bintree{}},
+		}},
+		"create-director-override.sh": &bintree{boshLiteGcpCreateDirectorOverrideSh, map[string]*bintree{}},
+		"create-jumpbox-override.sh":  &bintree{boshLiteGcpCreateJumpboxOverrideSh, map[string]*bintree{}},
+		"delete-director-override.sh": &bintree{boshLiteGcpDeleteDirectorOverrideSh, map[string]*bintree{}},
+		"delete-jumpbox-override.sh":  &bintree{boshLiteGcpDeleteJumpboxOverrideSh, map[string]*bintree{}},
+		"external-ip-gcp.yml":         &bintree{boshLiteGcpExternalIpGcpYml, map[string]*bintree{}},
+		"ip-forwarding.yml":           &bintree{boshLiteGcpIpForwardingYml, map[string]*bintree{}},
+		"terraform": &bintree{nil, map[string]*bintree{
+			"bosh-lite_override.tf": &bintree{boshLiteGcpTerraformBoshLite_overrideTf, map[string]*bintree{}},
+		}},
+	}},
+	"byobastion-gcp": &bintree{nil, map[string]*bintree{
+		"README.md":                   &bintree{byobastionGcpReadmeMd, map[string]*bintree{}},
+		"create-director-override.sh": &bintree{byobastionGcpCreateDirectorOverrideSh, map[string]*bintree{}},
+		"create-jumpbox-override.sh":  &bintree{byobastionGcpCreateJumpboxOverrideSh, map[string]*bintree{}},
+		"delete-director-override.sh": &bintree{byobastionGcpDeleteDirectorOverrideSh, map[string]*bintree{}},
+		"delete-jumpbox-override.sh":  &bintree{byobastionGcpDeleteJumpboxOverrideSh, map[string]*bintree{}},
+		"terraform": &bintree{nil, map[string]*bintree{
+			"bastion_override.tf": &bintree{byobastionGcpTerraformBastion_overrideTf, map[string]*bintree{}},
+		}},
+		"vars": &bintree{nil, map[string]*bintree{
+			"bastion.tfvars": &bintree{byobastionGcpVarsBastionTfvars, map[string]*bintree{}},
+		}},
+	}},
+	"cfcr-aws": &bintree{nil, map[string]*bintree{
+		"README.md":    &bintree{cfcrAwsReadmeMd, map[string]*bintree{}},
+		"cfcr-ops.yml": &bintree{cfcrAwsCfcrOpsYml, map[string]*bintree{}},
+		"cloud-config": &bintree{nil, map[string]*bintree{
+			"cfcr-cloud-config-ops.yml": &bintree{cfcrAwsCloudConfigCfcrCloudConfigOpsYml, map[string]*bintree{}},
+		}},
+		"terraform": &bintree{nil, map[string]*bintree{
+			"cfcr_dns_override.tf":     &bintree{cfcrAwsTerraformCfcr_dns_overrideTf, map[string]*bintree{}},
+			"cfcr_iam_override.tf":     &bintree{cfcrAwsTerraformCfcr_iam_overrideTf, map[string]*bintree{}},
+			"cfcr_lb_override.tf":      &bintree{cfcrAwsTerraformCfcr_lb_overrideTf, map[string]*bintree{}},
+			"cfcr_outputs_override.tf": &bintree{cfcrAwsTerraformCfcr_outputs_overrideTf, map[string]*bintree{}},
+		}},
+		"vars": &bintree{nil, map[string]*bintree{
+			"cfcr.tfvars": &bintree{cfcrAwsVarsCfcrTfvars, map[string]*bintree{}},
+		}},
+	}},
+	"cfcr-gcp": &bintree{nil, map[string]*bintree{
+		"README.md":    &bintree{cfcrGcpReadmeMd, map[string]*bintree{}},
+		"cfcr-ops.yml": &bintree{cfcrGcpCfcrOpsYml, map[string]*bintree{}},
+		"cloud-config": &bintree{nil, map[string]*bintree{
+			"cfcr-cloud-config-ops.yml": &bintree{cfcrGcpCloudConfigCfcrCloudConfigOpsYml, map[string]*bintree{}},
+		}},
+		"terraform": &bintree{nil, map[string]*bintree{
+			"cfcr_dns_override.tf":     &bintree{cfcrGcpTerraformCfcr_dns_overrideTf, map[string]*bintree{}},
+			"cfcr_iam_override.tf":     &bintree{cfcrGcpTerraformCfcr_iam_overrideTf, map[string]*bintree{}},
+			"cfcr_lb_override.tf":      &bintree{cfcrGcpTerraformCfcr_lb_overrideTf, map[string]*bintree{}},
+			"cfcr_outputs_override.tf": &bintree{cfcrGcpTerraformCfcr_outputs_overrideTf, map[string]*bintree{}},
+		}},
+		"vars": &bintree{nil, map[string]*bintree{
+			"cfcr.tfvars": &bintree{cfcrGcpVarsCfcrTfvars, map[string]*bintree{}},
+		}},
+	}},
+	"cfcr-openstack": &bintree{nil, map[string]*bintree{
+		"README.md": &bintree{cfcrOpenstackReadmeMd, map[string]*bintree{}},
+		"cloud-config": &bintree{nil, map[string]*bintree{
+			"cfcr-overrides.yml": &bintree{cfcrOpenstackCloudConfigCfcrOverridesYml, map[string]*bintree{}},
+		}},
+	}},
+	"cfcr-vsphere": &bintree{nil, map[string]*bintree{
+		"README.md": &bintree{cfcrVsphereReadmeMd, map[string]*bintree{}},
+		"cloud-config": &bintree{nil, map[string]*bintree{
+			"cfcr-overrides.yml": &bintree{cfcrVsphereCloudConfigCfcrOverridesYml, map[string]*bintree{}},
+		}},
+		"terraform": &bintree{nil, map[string]*bintree{
+			"outputs-override.tf": &bintree{cfcrVsphereTerraformOutputsOverrideTf, map[string]*bintree{}},
+		}},
+	}},
+	"colocate-gorouter-ssh-proxy-gcp": &bintree{nil, map[string]*bintree{
+		"cloud-config": &bintree{nil, map[string]*bintree{
+			"colocated-gorouter-ssh-proxy.yml": &bintree{colocateGorouterSshProxyGcpCloudConfigColocatedGorouterSshProxyYml, map[string]*bintree{}},
+		}},
+		"terraform": &bintree{nil, map[string]*bintree{
+			"colocated-gorouter-ssh-proxy_override.tf": &bintree{colocateGorouterSshProxyGcpTerraformColocatedGorouterSshProxy_overrideTf, map[string]*bintree{}},
+		}},
+	}},
+	"iam-profile-aws": &bintree{nil, map[string]*bintree{
+		"README.md": &bintree{iamProfileAwsReadmeMd, map[string]*bintree{}},
+		"vars": &bintree{nil, map[string]*bintree{
+			"iam.tfvars": &bintree{iamProfileAwsVarsIamTfvars, map[string]*bintree{}},
+		}},
+	}},
+	"iso-segs-aws": &bintree{nil, map[string]*bintree{
+		"README.md": &bintree{isoSegsAwsReadmeMd, map[string]*bintree{}},
+		"cloud-config": &bintree{nil, map[string]*bintree{
+			"iso-segs-ops.yml": &bintree{isoSegsAwsCloudConfigIsoSegsOpsYml, map[string]*bintree{}},
+		}},
+	}},
+	"iso-segs-gcp": &bintree{nil, map[string]*bintree{
+		"README.md": &bintree{isoSegsGcpReadmeMd, map[string]*bintree{}},
+		"cloud-config": &bintree{nil, map[string]*bintree{
+			"routing-iso-segs.yml": &bintree{isoSegsGcpCloudConfigRoutingIsoSegsYml, map[string]*bintree{}},
+		}},
+		"terraform": &bintree{nil, map[string]*bintree{
+			"routing-iso-segs.tf": &bintree{isoSegsGcpTerraformRoutingIsoSegsTf, map[string]*bintree{}},
+		}},
+	}},
+	"restricted-instance-groups-gcp": &bintree{nil, map[string]*bintree{
+		"README.md": &bintree{restrictedInstanceGroupsGcpReadmeMd, map[string]*bintree{}},
+		"terraform": &bintree{nil, map[string]*bintree{
+			"restricted_instance_groups_override.tf": &bintree{restrictedInstanceGroupsGcpTerraformRestricted_instance_groups_overrideTf, map[string]*bintree{}},
+		}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
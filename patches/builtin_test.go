@@ -0,0 +1,122 @@
+package patches_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/patches"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Builtin", func() {
+	var builtin patches.Builtin
+
+	BeforeEach(func() {
+		builtin = patches.NewBuiltin()
+	})
+
+	Describe("Available", func() {
+		It("lists the patch directories with their purpose from the README", func() {
+			available := builtin.Available()
+
+			Expect(available).To(ContainElement(patches.Info{
+				Name:        "iso-segs-aws",
+				Description: "Add Isolation Segments",
+			}))
+			Expect(available).To(ContainElement(patches.Info{
+				Name:        "cfcr-vsphere",
+				Description: "Deploy a CFCR with a single master static IP and the vsphere cloud-provider",
+			}))
+			Expect(available[0].Name).To(Equal("1-az-aws"))
+			for _, info := range available {
+				Expect(info.Name).NotTo(Equal("README.md"))
+			}
+		})
+
+		It("leaves out the tf-backend patches that bbl plan --terraform-backend replaces", func() {
+			for _, info := range builtin.Available() {
+				Expect(info.Name).NotTo(HavePrefix("tf-backend-"))
+			}
+
+			_, err := builtin.ReadDir("tf-backend-aws")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Describe("ReadDir", func() {
+		It("lists the files and directories of an embedded directory", func() {
+			infos, err := builtin.ReadDir("cfcr-aws")
+			Expect(err).NotTo(HaveOccurred())
+
+			names := []string{}
+			for _, info := range infos {
+				names = append(names, info.Name())
+				Expect(info.IsDir()).To(Equal(!strings.Contains(info.Name(), ".")))
+			}
+			Expect(names).To(Equal([]string{"README.md", "cfcr-ops.yml", "cloud-config", "terraform", "vars"}))
+		})
+
+		It("gives the override scripts a mode that lets them run", func() {
+			infos, err := builtin.ReadDir("bosh-lite-gcp")
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, info := range infos {
+				if info.Name() == "create-director-override.sh" {
+					Expect(info.Mode().Perm() & 0100).NotTo(BeZero())
+				}
+			}
+		})
+
+		Context("when the directory is not embedded", func() {
+			It("returns a not exist error", func() {
+				_, err := builtin.ReadDir("missing-patch")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("ReadFile", func() {
+		Context("when the file is not embedded", func() {
+			It("returns a not exist error", func() {
+				_, err := builtin.ReadFile("cfcr-aws/missing.yml")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	It("embeds the plan-patches directory as it is", func() {
+		root := filepath.Join("..", "plan-patches")
+		embedded := 0
+
+		err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				if strings.HasPrefix(info.Name(), "tf-backend-") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			relative, err := filepath.Rel(root, path)
+			Expect(err).NotTo(HaveOccurred())
+
+			onDisk, err := ioutil.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := builtin.ReadFile(relative)
+			Expect(err).NotTo(HaveOccurred(), "%s is not embedded, run scripts/update_builtin_patches", relative)
+			Expect(string(contents)).To(Equal(string(onDisk)), "%s is out of date, run scripts/update_builtin_patches", relative)
+
+			embedded++
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(embedded).To(Equal(len(patches.AssetNames())))
+	})
+})
@@ -0,0 +1,13 @@
+package patches_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPatches(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "patches")
+}
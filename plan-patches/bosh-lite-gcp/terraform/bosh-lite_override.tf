@@ -1,6 +1,6 @@
 resource "google_compute_firewall" "bosh-director-lite" {
   name    = "${var.env_id}-bosh-director-lite"
-  network = "${google_compute_network.bbl-network.name}"
+  network = google_compute_network.bbl-network.name
 
   source_ranges = ["0.0.0.0/0"]
 
@@ -19,16 +19,16 @@ resource "google_compute_address" "bosh-director-ip" {
 resource "google_compute_route" "bosh-lite-vms" {
   name        = "${var.env_id}-bosh-lite-vms"
   dest_range  = "10.244.0.0/16"
-  network     = "${google_compute_network.bbl-network.name}"
+  network     = google_compute_network.bbl-network.name
   next_hop_ip = "10.0.0.6"
   priority    = 1
 
-  depends_on = ["google_compute_subnetwork.bbl-subnet"]
+  depends_on = [google_compute_subnetwork.bbl-subnet]
 }
 
 resource "google_compute_firewall" "bosh-director-lite-tcp-routing" {
   name    = "${var.env_id}-bosh-director-lite-tcp-routing"
-  network = "${google_compute_network.bbl-network.name}"
+  network = google_compute_network.bbl-network.name
 
   source_ranges = ["0.0.0.0/0"]
 
@@ -41,9 +41,9 @@ resource "google_compute_firewall" "bosh-director-lite-tcp-routing" {
 }
 
 output "external_ip" {
-  value = "${google_compute_address.bosh-director-ip.address}"
+  value = google_compute_address.bosh-director-ip.address
 }
 
 output "jumpbox__external_ip" {
-  value = "${google_compute_address.jumpbox-ip.address}"
+  value = google_compute_address.jumpbox-ip.address
 }
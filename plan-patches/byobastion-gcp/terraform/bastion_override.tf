@@ -1,13 +1,13 @@
 variable "existing-bbl-network" {
-  type = "string"
+  type = string
 }
 
 variable "existing-bbl-subnet" {
-  type = "string"
+  type = string
 }
 
 variable "existing-bastion-address" {
-  type = "string"
+  type = string
 }
 
 resource "google_compute_network" "bbl-network" {
@@ -23,23 +23,23 @@ resource "google_compute_address" "jumpbox-ip" {
 }
 
 data "google_compute_network" "bbl-network" {
-  name = "${var.existing-bbl-network}"
+  name = var.existing-bbl-network
 }
 
 data "google_compute_subnetwork" "bbl-subnet" {
-  name = "${var.existing-bbl-subnet}"
+  name = var.existing-bbl-subnet
 }
 
 data "google_compute_address" "jumpbox-ip" {
-  name = "${var.existing-bastion-address}"
+  name = var.existing-bastion-address
 }
 
 resource "google_compute_firewall" "external" {
-  network = "${data.google_compute_network.bbl-network.name}"
+  network = data.google_compute_network.bbl-network.name
 }
 
 resource "google_compute_firewall" "bosh-open" {
-  network       = "${data.google_compute_network.bbl-network.name}"
+  network       = data.google_compute_network.bbl-network.name
   source_ranges = ["${data.google_compute_address.jumpbox-ip.address}/32"]
 
   allow {
@@ -51,31 +51,31 @@ resource "google_compute_firewall" "bosh-open" {
 }
 
 resource "google_compute_firewall" "bosh-director" {
-  network = "${data.google_compute_network.bbl-network.name}"
+  network = data.google_compute_network.bbl-network.name
 }
 
 resource "google_compute_firewall" "internal-to-director" {
-  network = "${data.google_compute_network.bbl-network.name}"
+  network = data.google_compute_network.bbl-network.name
 }
 
 resource "google_compute_firewall" "jumpbox-to-all" {
-  network = "${data.google_compute_network.bbl-network.name}"
+  network = data.google_compute_network.bbl-network.name
 }
 
 resource "google_compute_firewall" "internal" {
-  network = "${data.google_compute_network.bbl-network.name}"
+  network = data.google_compute_network.bbl-network.name
 }
 
 output "network" {
-  value = "${data.google_compute_network.bbl-network.name}"
+  value = data.google_compute_network.bbl-network.name
 }
 
 output "subnetwork" {
-  value = "${data.google_compute_subnetwork.bbl-subnet.name}"
+  value = data.google_compute_subnetwork.bbl-subnet.name
 }
 
 output "jumpbox_url" {
-  value = "${data.google_compute_address.jumpbox-ip.address}"
+  value = data.google_compute_address.jumpbox-ip.address
 }
 
 output "director_address" {
@@ -83,5 +83,5 @@ output "director_address" {
 }
 
 output "external_ip" {
-  value = "${data.google_compute_address.jumpbox-ip.address}"
+  value = data.google_compute_address.jumpbox-ip.address
 }
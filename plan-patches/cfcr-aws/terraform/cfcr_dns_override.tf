@@ -1,20 +1,20 @@
 variable "kubernetes_master_host" {
-  type = "string"
+  type = string
 }
 
 resource "aws_route53_zone" "cfcr_dns_zone" {
-  name = "${var.kubernetes_master_host}"
+  name = var.kubernetes_master_host
 
-  tags {
+  tags = {
     Name = "${var.env_id}-cfcr-hosted-zone"
   }
 }
 
 resource "aws_route53_record" "cfcr_api" {
-  zone_id = "${aws_route53_zone.cfcr_dns_zone.id}"
-  name    = "${var.kubernetes_master_host}"
+  zone_id = aws_route53_zone.cfcr_dns_zone.id
+  name    = var.kubernetes_master_host
   type    = "CNAME"
   ttl     = 300
 
-  records = ["${aws_elb.cfcr_api.dns_name}"]
+  records = [aws_elb.cfcr_api.dns_name]
 }
@@ -3,15 +3,15 @@ resource "random_id" "kubernetes_cluster_tag" {
 }
 
 resource "aws_subnet" "bosh_subnet" {
-  tags {
+  tags = {
     Name              = "${var.env_id}-bosh-subnet"
-    KubernetesCluster = "${random_id.kubernetes_cluster_tag.b64}"
+    KubernetesCluster = random_id.kubernetes_cluster_tag.b64
   }
 }
 
 resource "aws_security_group" "cfcr_api" {
   name   = "${var.short_env_id}-cfcr-api-access"
-  vpc_id = "${local.vpc_id}"
+  vpc_id = local.vpc_id
 
   ingress {
     from_port   = "8443"
@@ -29,18 +29,18 @@ resource "aws_security_group" "cfcr_api" {
 }
 
 resource "aws_security_group_rule" "cfcr_api_to_internal" {
-  security_group_id        = "${aws_security_group.internal_security_group.id}"
+  security_group_id        = aws_security_group.internal_security_group.id
   type                     = "ingress"
   protocol                 = "tcp"
   from_port                = 8443
   to_port                  = 8443
-  source_security_group_id = "${aws_security_group.cfcr_api.id}"
+  source_security_group_id = aws_security_group.cfcr_api.id
 }
 
 resource "aws_elb" "cfcr_api" {
   name            = "${var.short_env_id}-cfcr-api"
-  subnets         = ["${aws_subnet.bosh_subnet.id}"]
-  security_groups = ["${aws_security_group.cfcr_api.id}"]
+  subnets         = [aws_subnet.bosh_subnet.id]
+  security_groups = [aws_security_group.cfcr_api.id]
 
   listener {
     instance_port     = "8443"
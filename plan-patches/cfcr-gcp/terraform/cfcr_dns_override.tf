@@ -1,5 +1,5 @@
 variable "kubernetes_master_host" {
-  type = "string"
+  type = string
 }
 
 resource "google_dns_managed_zone" "cfcr_dns_zone" {
@@ -9,13 +9,13 @@ resource "google_dns_managed_zone" "cfcr_dns_zone" {
 }
 
 resource "google_dns_record_set" "cfcr_api_dns" {
-  name       = "${google_dns_managed_zone.cfcr_dns_zone.dns_name}"
-  depends_on = ["google_compute_address.cfcr_tcp"]
+  name       = google_dns_managed_zone.cfcr_dns_zone.dns_name
+  depends_on = [google_compute_address.cfcr_tcp]
   type       = "A"
   ttl        = 300
 
-  managed_zone = "${google_dns_managed_zone.cfcr_dns_zone.name}"
+  managed_zone = google_dns_managed_zone.cfcr_dns_zone.name
 
-  rrdatas = ["${google_compute_address.cfcr_tcp.address}"]
+  rrdatas = [google_compute_address.cfcr_tcp.address]
 }
 
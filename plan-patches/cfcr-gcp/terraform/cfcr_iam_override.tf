@@ -9,8 +9,8 @@ resource "google_service_account" "worker" {
 }
 
 resource "google_project_iam_policy" "policy" {
-  project     = "${var.project_id}"
-  policy_data = "${data.google_iam_policy.admin.policy_data}"
+  project     = var.project_id
+  policy_data = data.google_iam_policy.admin.policy_data
 }
 
 data "google_iam_policy" "admin" {
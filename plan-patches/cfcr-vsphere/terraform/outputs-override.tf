@@ -1,15 +1,15 @@
 output "vcenter_master_user" {
-  value = "${var.vcenter_user}"
+  value = var.vcenter_user
 }
 
 output "vcenter_master_password" {
-  value = "${var.vcenter_password}"
+  value = var.vcenter_password
 }
 
 output "vcenter_worker_user" {
-  value = "${var.vcenter_user}"
+  value = var.vcenter_user
 }
 
 output "vcenter_worker_password" {
-  value = "${var.vcenter_password}"
+  value = var.vcenter_password
 }
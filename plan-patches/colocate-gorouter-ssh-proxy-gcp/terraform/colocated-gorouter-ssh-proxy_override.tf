@@ -3,33 +3,33 @@ resource "google_compute_target_pool" "cf-ws-ssh-proxy" {
 
   session_affinity = "NONE"
 
-  health_checks = ["${google_compute_http_health_check.cf-public-health-check.name}"]
+  health_checks = [google_compute_http_health_check.cf-public-health-check.name]
 }
 
 resource "google_compute_forwarding_rule" "cf-ws-https" {
   name        = "${var.env_id}-cf-ws-https"
-  target      = "${google_compute_target_pool.cf-ws-ssh-proxy.self_link}"
+  target      = google_compute_target_pool.cf-ws-ssh-proxy.self_link
   port_range  = "443"
   ip_protocol = "TCP"
-  ip_address  = "${google_compute_address.cf-ws.address}"
+  ip_address  = google_compute_address.cf-ws.address
 }
 
 resource "google_compute_forwarding_rule" "cf-ws-http" {
   name        = "${var.env_id}-cf-ws-http"
-  target      = "${google_compute_target_pool.cf-ws-ssh-proxy.self_link}"
+  target      = google_compute_target_pool.cf-ws-ssh-proxy.self_link
   port_range  = "80"
   ip_protocol = "TCP"
-  ip_address  = "${google_compute_address.cf-ws.address}"
+  ip_address  = google_compute_address.cf-ws.address
 }
 
 resource "google_compute_forwarding_rule" "cf-ssh-proxy" {
   name        = "${var.env_id}-cf-ssh-proxy"
-  target      = "${google_compute_target_pool.cf-ws-ssh-proxy.self_link}"
+  target      = google_compute_target_pool.cf-ws-ssh-proxy.self_link
   port_range  = "2222"
   ip_protocol = "TCP"
-  ip_address  = "${google_compute_address.cf-ssh-proxy.address}"
+  ip_address  = google_compute_address.cf-ssh-proxy.address
 }
 
 output "ws_ssh_proxy_target_pool" {
-  value = "${google_compute_target_pool.cf-ws-ssh-proxy.name}"
+  value = google_compute_target_pool.cf-ws-ssh-proxy.name
 }
@@ -6,14 +6,14 @@ resource "google_compute_backend_service" "router-lb-backend-service" {
   enable_cdn  = false
 
   backend {
-    group = "${google_compute_instance_group.router-lb-0.self_link}"
+    group = google_compute_instance_group.router-lb-0.self_link
   }
 
   backend {
-    group = "${google_compute_instance_group.router-lb-1.self_link}"
+    group = google_compute_instance_group.router-lb-1.self_link
   }
 
-  health_checks = ["${google_compute_health_check.cf-public-health-check.self_link}"]
+  health_checks = [google_compute_health_check.cf-public-health-check.self_link]
 }
 
 resource "google_compute_instance_group" "router-lb-2" {
@@ -0,0 +1,22 @@
+package storage
+
+type StateBackend interface {
+	Pull(dir string) error
+	Push(dir string) error
+}
+
+// LocalBackend keeps the state directory on the local filesystem, which
+// is where bbl reads and writes it, so there is nothing to synchronize.
+type LocalBackend struct{}
+
+func NewLocalBackend() LocalBackend {
+	return LocalBackend{}
+}
+
+func (LocalBackend) Pull(dir string) error {
+	return nil
+}
+
+func (LocalBackend) Push(dir string) error {
+	return nil
+}
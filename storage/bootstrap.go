@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -15,12 +16,14 @@ type logger interface {
 
 type StateBootstrap struct {
 	logger     logger
+	cipher     Cipher
 	bblVersion string
 }
 
-func NewStateBootstrap(logger logger, bblVersion string) StateBootstrap {
+func NewStateBootstrap(logger logger, cipher Cipher, bblVersion string) StateBootstrap {
 	return StateBootstrap{
 		logger:     logger,
+		cipher:     cipher,
 		bblVersion: bblVersion,
 	}
 }
@@ -31,7 +34,7 @@ func (b StateBootstrap) GetState(dir string) (State, error) {
 		return State{}, err
 	}
 
-	file, err := os.Open(filepath.Join(dir, STATE_FILE))
+	contents, err := ioutil.ReadFile(filepath.Join(dir, STATE_FILE))
 	if err != nil {
 		if os.IsNotExist(err) {
 			return State{}, nil
@@ -39,8 +42,13 @@ func (b StateBootstrap) GetState(dir string) (State, error) {
 		return State{}, err
 	}
 
+	contents, err = b.cipher.Decrypt(contents)
+	if err != nil {
+		return State{}, err
+	}
+
 	state := State{}
-	err = json.NewDecoder(file).Decode(&state)
+	err = json.Unmarshal(contents, &state)
 	if err != nil {
 		return state, err
 	}
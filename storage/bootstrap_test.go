@@ -24,7 +24,7 @@ var _ = Describe("StateBootstrap", func() {
 		BeforeEach(func() {
 			logger = &fakes.Logger{}
 			latestVersion = "latest"
-			bootstrap = storage.NewStateBootstrap(logger, latestVersion)
+			bootstrap = storage.NewStateBootstrap(logger, storage.NewCipher(nil), latestVersion)
 
 			var err error
 			tempDir, err = ioutil.TempDir("", "")
@@ -104,6 +104,40 @@ var _ = Describe("StateBootstrap", func() {
 			})
 		})
 
+		Context("when the state file is encrypted", func() {
+			BeforeEach(func() {
+				encrypted, err := storage.NewCipher([]byte("some-passphrase")).Encrypt([]byte(`{
+					"version": 13,
+					"bblVersion": "some-bbl-version",
+					"iaas": "gcp"
+				}`))
+				Expect(err).NotTo(HaveOccurred())
+
+				err = ioutil.WriteFile(filepath.Join(tempDir, "bbl-state.json"), encrypted, storage.StateMode)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("decrypts the stored state information", func() {
+				bootstrap = storage.NewStateBootstrap(logger, storage.NewCipher([]byte("some-passphrase")), latestVersion)
+
+				state, err := bootstrap.GetState(tempDir)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(state).To(Equal(storage.State{
+					Version:    13,
+					BBLVersion: "some-bbl-version",
+					IAAS:       "gcp",
+				}))
+			})
+
+			Context("when no state key is provided", func() {
+				It("returns an error", func() {
+					_, err := bootstrap.GetState(tempDir)
+					Expect(err).To(Equal(storage.ErrMissingStateKey))
+				})
+			})
+		})
+
 		Context("when there is a state file missing BBL version", func() {
 			BeforeEach(func() {
 				err := ioutil.WriteFile(filepath.Join(tempDir, "bbl-state.json"), []byte(`{
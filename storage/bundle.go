@@ -0,0 +1,344 @@
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+)
+
+const BUNDLE_MANIFEST = "manifest.json"
+
+var bundleNow = time.Now
+
+// bundledDirs hold the terraform overrides, cloud-config ops files and
+// user ops and vars files, which go into a bundle along with bbl-state.json,
+// the override scripts, the ops files at the top of the state dir and the
+// bbl managed vars files. Everything else is regenerated by bbl plan, or
+// only makes sense on the machine that created it.
+var (
+	bundledDirs = map[string]struct{}{
+		"terraform":     {},
+		"cloud-config":  {},
+		"jumpbox-ops":   {},
+		"director-ops":  {},
+		"director-vars": {},
+	}
+	bundleRegenerated = map[string]struct{}{
+		"cloud-config/cloud-config.yml": {},
+		"cloud-config/ops.yml":          {},
+		"terraform/bbl-template.tf":     {},
+		"terraform/bbl-backend.tf":      {},
+	}
+	bundleExcludedVars = map[string]struct{}{
+		"terraform.tfstate.backup":   {},
+		"terraform.tfstate.migrated": {},
+	}
+)
+
+type BundleManifest struct {
+	BBLVersion  string       `json:"bbl_version"`
+	StateSchema int          `json:"state_schema"`
+	Created     time.Time    `json:"created"`
+	Files       []BundleFile `json:"files"`
+}
+
+type BundleFile struct {
+	Path   string      `json:"path"`
+	Mode   os.FileMode `json:"mode"`
+	SHA256 string      `json:"sha256"`
+}
+
+type bundleFs interface {
+	fileio.FileReader
+	fileio.FileWriter
+	fileio.AllMkdirer
+	fileio.DirReader
+}
+
+// Bundler packages the parts of a state directory that bbl plan cannot
+// regenerate into a portable tarball, and lays such a tarball back down.
+// Files are read and written through fs, so a bundle holds plaintext unless
+// it is encrypted as a whole.
+type Bundler struct {
+	fs         bundleFs
+	cipher     Cipher
+	bblVersion string
+}
+
+func NewBundler(fs bundleFs, cipher Cipher, bblVersion string) Bundler {
+	return Bundler{
+		fs:         fs,
+		cipher:     cipher,
+		bblVersion: bblVersion,
+	}
+}
+
+func (b Bundler) Export(dir string, encrypt bool) ([]byte, error) {
+	if encrypt && !b.cipher.IsEnabled() {
+		return nil, errors.New("BBL_STATE_PASSPHRASE or BBL_STATE_KEY_FILE must be set to encrypt the bundle")
+	}
+
+	files, err := walkFiles(b.fs, dir, func(name string, info os.FileInfo) bool {
+		return info.IsDir() && !isBundledDir(name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for name := range files {
+		if isBundledFile(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	manifest := BundleManifest{
+		BBLVersion:  b.bblVersion,
+		StateSchema: STATE_SCHEMA,
+		Created:     bundleNow().UTC(),
+		Files:       []BundleFile{},
+	}
+	contents := map[string][]byte{}
+	for _, name := range names {
+		data, err := b.fs.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return nil, fmt.Errorf("Read %s: %s", name, err)
+		}
+		contents[name] = data
+
+		sum := sha256.Sum256(data)
+		manifest.Files = append(manifest.Files, BundleFile{
+			Path:   name,
+			Mode:   files[name].Perm(),
+			SHA256: hex.EncodeToString(sum[:]),
+		})
+	}
+
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err // not tested
+	}
+
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err = writeTarEntry(tarWriter, BUNDLE_MANIFEST, 0644, manifestJSON)
+	if err != nil {
+		return nil, err // not tested
+	}
+	for _, file := range manifest.Files {
+		err = writeTarEntry(tarWriter, file.Path, file.Mode, contents[file.Path])
+		if err != nil {
+			return nil, err // not tested
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, err // not tested
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, err // not tested
+	}
+
+	if !encrypt {
+		return buffer.Bytes(), nil
+	}
+
+	return b.cipher.Encrypt(buffer.Bytes())
+}
+
+// Import verifies a bundle against its manifest and writes its files
+// into dir.
+func (b Bundler) Import(dir string, bundle []byte) (BundleManifest, error) {
+	bundle, err := b.cipher.Decrypt(bundle)
+	if err != nil {
+		return BundleManifest{}, err
+	}
+
+	entries, err := readTarEntries(bundle)
+	if err != nil {
+		return BundleManifest{}, fmt.Errorf("Read bundle: %s", err)
+	}
+
+	manifestJSON, ok := entries[BUNDLE_MANIFEST]
+	if !ok {
+		return BundleManifest{}, errors.New("Read bundle: the bundle has no manifest")
+	}
+	delete(entries, BUNDLE_MANIFEST)
+
+	var manifest BundleManifest
+	err = json.Unmarshal(manifestJSON, &manifest)
+	if err != nil {
+		return BundleManifest{}, fmt.Errorf("Read bundle manifest: %s", err)
+	}
+
+	if manifest.StateSchema > STATE_SCHEMA {
+		return BundleManifest{}, fmt.Errorf("The bundle was exported by bbl %s with a newer state schema (%d) than this bbl supports (%d)", manifest.BBLVersion, manifest.StateSchema, STATE_SCHEMA)
+	}
+
+	contents := map[string][]byte{}
+	for _, file := range manifest.Files {
+		if !isBundlePath(file.Path) || !isBundledFile(file.Path) {
+			return BundleManifest{}, fmt.Errorf("Verify bundle: invalid path %s", file.Path)
+		}
+
+		data, ok := entries[file.Path]
+		if !ok {
+			return BundleManifest{}, fmt.Errorf("Verify bundle: %s is missing", file.Path)
+		}
+
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != file.SHA256 {
+			return BundleManifest{}, fmt.Errorf("Verify bundle: checksum mismatch for %s", file.Path)
+		}
+
+		contents[file.Path] = data
+		delete(entries, file.Path)
+	}
+
+	for name := range entries {
+		return BundleManifest{}, fmt.Errorf("Verify bundle: %s is not in the manifest", name)
+	}
+
+	for _, file := range manifest.Files {
+		path := filepath.Join(dir, filepath.FromSlash(file.Path))
+		err = b.fs.MkdirAll(filepath.Dir(path), os.ModePerm)
+		if err != nil {
+			return BundleManifest{}, fmt.Errorf("Create %s dir: %s", filepath.Dir(file.Path), err)
+		}
+
+		err = b.fs.WriteFile(path, contents[file.Path], file.Mode.Perm()&StateMode)
+		if err != nil {
+			return BundleManifest{}, fmt.Errorf("Write %s: %s", file.Path, err)
+		}
+	}
+
+	return manifest, nil
+}
+
+// isBundledDir tells whether a dir of the state dir can hold files that go
+// into a bundle.
+func isBundledDir(name string) bool {
+	if path.Base(name) == ".terraform" {
+		return false
+	}
+	if name == "vars" {
+		return true
+	}
+	_, ok := bundledDirs[strings.SplitN(name, "/", 2)[0]]
+	return ok
+}
+
+// isBundledFile tells whether a file of the state dir goes into a bundle.
+func isBundledFile(name string) bool {
+	dir, base := path.Split(name)
+	switch dir {
+	case "":
+		for _, script := range OverrideScripts {
+			if name == script {
+				return true
+			}
+		}
+		return name == STATE_FILE || path.Ext(name) == ".yml"
+	case "vars/":
+		return isBundledVarsFile(base)
+	}
+
+	if _, ok := bundleRegenerated[name]; ok {
+		return false
+	}
+	return isBundledDir(strings.TrimSuffix(dir, "/"))
+}
+
+// isBundledVarsFile tells whether a file of the vars dir goes into a bundle:
+// the bbl managed vars files and the terraform variables files, but not the
+// saved terraform plans or the copies of the terraform state that terraform
+// and bbl leave behind.
+func isBundledVarsFile(name string) bool {
+	if _, ok := bundleExcludedVars[name]; ok {
+		return false
+	}
+	if isTerraformPlanFile(name) {
+		return false
+	}
+	if _, ok := bblManaged[name]; ok {
+		return true
+	}
+	return strings.HasSuffix(name, ".tfvars") || strings.HasSuffix(name, ".tfvars.json")
+}
+
+func isBundlePath(name string) bool {
+	if name == "" || path.IsAbs(name) || path.Clean(name) != name {
+		return false
+	}
+	for _, segment := range strings.Split(name, "/") {
+		if segment == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+func writeTarEntry(writer *tar.Writer, name string, mode os.FileMode, contents []byte) error {
+	err := writer.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    int64(mode.Perm()),
+		Size:    int64(len(contents)),
+		ModTime: bundleNow(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(contents)
+	return err
+}
+
+func readTarEntries(bundle []byte) (map[string][]byte, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(bundle))
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	entries := map[string][]byte{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeRegA {
+			continue
+		}
+
+		data, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return nil, err // not tested
+		}
+		entries[header.Name] = data
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,314 @@
+package storage_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bundler", func() {
+	var (
+		fs      *afero.Afero
+		bundler storage.Bundler
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+		bundler = storage.NewBundler(fs, storage.NewCipher(nil), "1.2.3")
+
+		now = time.Date(2018, time.March, 1, 12, 0, 0, 0, time.UTC)
+		storage.SetBundleNow(func() time.Time { return now })
+
+		fs.WriteFile("/state/bbl-state.json", []byte("some-state"), 0644)
+		fs.WriteFile("/state/vars/bosh-state.json", []byte("some-bosh-state"), storage.StateMode)
+		fs.WriteFile("/state/vars/bbl.tfplan", []byte("some-plan"), storage.StateMode)
+		fs.WriteFile("/state/vars/reviewed.tfplan", []byte("some-plan"), storage.StateMode)
+		fs.WriteFile("/state/vars/reviewed.tfplan.checksum", []byte("some-checksum"), storage.StateMode)
+		fs.WriteFile("/state/vars/terraform.tfstate.backup", []byte("some-old-tfstate"), storage.StateMode)
+		fs.WriteFile("/state/vars/stray.txt", []byte("some-notes"), storage.StateMode)
+		fs.WriteFile("/state/director-ops/user-ops-file.yml", []byte("some-ops"), storage.StateMode)
+		fs.WriteFile("/state/.migration-backup/files/bbl-state.json", []byte("old-state"), 0644)
+		fs.WriteFile("/state/notes.txt", []byte("some-notes"), 0644)
+		fs.WriteFile("/state/create-director-override.sh", []byte("some-script"), 0755)
+		fs.WriteFile("/state/create-director.sh", []byte("generated"), 0755)
+		fs.WriteFile("/state/bbl.lock", []byte("lock"), 0644)
+		fs.WriteFile("/state/terraform/bbl-template.tf", []byte("generated"), 0644)
+		fs.WriteFile("/state/terraform/my-override.tf", []byte("some-override"), 0644)
+		fs.WriteFile("/state/terraform/.terraform/plugins/some-plugin", []byte("plugin"), 0755)
+		fs.WriteFile("/state/cloud-config/cloud-config.yml", []byte("generated"), 0644)
+		fs.WriteFile("/state/cloud-config/my-ops.yml", []byte("some-cloud-config-ops"), 0644)
+		fs.WriteFile("/state/bosh-deployment/bosh.yml", []byte("generated"), 0644)
+		fs.WriteFile("/state/.snapshots/20180301T120000Z/bbl-state.json", []byte("old-state"), 0644)
+	})
+
+	AfterEach(func() {
+		storage.ResetBundleNow()
+	})
+
+	Describe("Export", func() {
+		It("packages the files plan cannot regenerate with a manifest", func() {
+			bundle, err := bundler.Export("/state", false)
+			Expect(err).NotTo(HaveOccurred())
+
+			entries := readBundle(bundle)
+			Expect(entries).To(HaveLen(7))
+			Expect(entries).To(HaveKeyWithValue("bbl-state.json", "some-state"))
+			Expect(entries).To(HaveKeyWithValue("vars/bosh-state.json", "some-bosh-state"))
+			Expect(entries).To(HaveKeyWithValue("director-ops/user-ops-file.yml", "some-ops"))
+			Expect(entries).To(HaveKeyWithValue("create-director-override.sh", "some-script"))
+			Expect(entries).To(HaveKeyWithValue("terraform/my-override.tf", "some-override"))
+			Expect(entries).To(HaveKeyWithValue("cloud-config/my-ops.yml", "some-cloud-config-ops"))
+
+			var manifest storage.BundleManifest
+			Expect(json.Unmarshal([]byte(entries["manifest.json"]), &manifest)).To(Succeed())
+			Expect(manifest.BBLVersion).To(Equal("1.2.3"))
+			Expect(manifest.StateSchema).To(Equal(storage.STATE_SCHEMA))
+			Expect(manifest.Created).To(Equal(now))
+			Expect(manifest.Files).To(HaveLen(6))
+			Expect(manifest.Files[0]).To(Equal(storage.BundleFile{
+				Path:   "bbl-state.json",
+				Mode:   0644,
+				SHA256: "d4317108fa2496b59a6e17cf3bd6439a4382e3dd301af223d32b7ee7c4de91d6",
+			}))
+		})
+
+		Context("when encryption is requested without a key", func() {
+			It("returns an error", func() {
+				_, err := bundler.Export("/state", true)
+				Expect(err).To(MatchError("BBL_STATE_PASSPHRASE or BBL_STATE_KEY_FILE must be set to encrypt the bundle"))
+			})
+		})
+
+		Context("when encryption is requested", func() {
+			It("encrypts the bundle", func() {
+				bundler = storage.NewBundler(fs, storage.NewCipher([]byte("some-passphrase")), "1.2.3")
+
+				bundle, err := bundler.Export("/state", true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(storage.IsEncrypted(bundle)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Import", func() {
+		var bundle []byte
+
+		BeforeEach(func() {
+			var err error
+			bundle, err = bundler.Export("/state", false)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("verifies the bundle and writes its files", func() {
+			manifest, err := bundler.Import("/new-state", bundle)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifest.BBLVersion).To(Equal("1.2.3"))
+
+			contents, err := fs.ReadFile("/new-state/terraform/my-override.tf")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-override"))
+
+			info, err := fs.Stat("/new-state/create-director-override.sh")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(storage.StateMode)))
+
+			_, err = fs.Stat("/new-state/create-director.sh")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		Context("when the bundle is encrypted", func() {
+			BeforeEach(func() {
+				bundler = storage.NewBundler(fs, storage.NewCipher([]byte("some-passphrase")), "1.2.3")
+
+				var err error
+				bundle, err = bundler.Export("/state", true)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("decrypts it", func() {
+				_, err := bundler.Import("/new-state", bundle)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("and no key is set", func() {
+				It("returns an error", func() {
+					bundler = storage.NewBundler(fs, storage.NewCipher(nil), "1.2.3")
+
+					_, err := bundler.Import("/new-state", bundle)
+					Expect(err).To(Equal(storage.ErrMissingStateKey))
+				})
+			})
+		})
+
+		Context("when a file does not match its checksum", func() {
+			It("returns an error and writes nothing", func() {
+				entries := readBundle(bundle)
+				entries["bbl-state.json"] = "some-tampered-state"
+
+				_, err := bundler.Import("/new-state", writeBundle(entries))
+				Expect(err).To(MatchError("Verify bundle: checksum mismatch for bbl-state.json"))
+
+				_, err = fs.Stat("/new-state")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when a file is missing", func() {
+			It("returns an error", func() {
+				entries := readBundle(bundle)
+				delete(entries, "vars/bosh-state.json")
+
+				_, err := bundler.Import("/new-state", writeBundle(entries))
+				Expect(err).To(MatchError("Verify bundle: vars/bosh-state.json is missing"))
+			})
+		})
+
+		Context("when a file is not in the manifest", func() {
+			It("returns an error", func() {
+				entries := readBundle(bundle)
+				entries["some-extra-file"] = "some-contents"
+
+				_, err := bundler.Import("/new-state", writeBundle(entries))
+				Expect(err).To(MatchError("Verify bundle: some-extra-file is not in the manifest"))
+			})
+		})
+
+		Context("when a path escapes the state directory", func() {
+			It("returns an error", func() {
+				manifest, err := json.Marshal(storage.BundleManifest{
+					Files: []storage.BundleFile{{Path: "../outside", Mode: 0644}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = bundler.Import("/new-state", writeBundle(map[string]string{
+					"manifest.json": string(manifest),
+					"../outside":    "some-contents",
+				}))
+				Expect(err).To(MatchError("Verify bundle: invalid path ../outside"))
+			})
+		})
+
+		Context("when a path is absolute", func() {
+			It("returns an error", func() {
+				manifest, err := json.Marshal(storage.BundleManifest{
+					Files: []storage.BundleFile{{Path: "/etc/director-ops/some-ops.yml", Mode: 0644}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = bundler.Import("/new-state", writeBundle(map[string]string{
+					"manifest.json":                  string(manifest),
+					"/etc/director-ops/some-ops.yml": "some-contents",
+				}))
+				Expect(err).To(MatchError("Verify bundle: invalid path /etc/director-ops/some-ops.yml"))
+			})
+		})
+
+		Context("when a file is not one that bbl bundles", func() {
+			It("returns an error", func() {
+				manifest, err := json.Marshal(storage.BundleManifest{
+					Files: []storage.BundleFile{{Path: "create-director.sh", Mode: 0755}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = bundler.Import("/new-state", writeBundle(map[string]string{
+					"manifest.json":      string(manifest),
+					"create-director.sh": "some-script",
+				}))
+				Expect(err).To(MatchError("Verify bundle: invalid path create-director.sh"))
+			})
+		})
+
+		Context("when the manifest gives a file a permissive mode", func() {
+			It("writes it with the mode of the state files", func() {
+				manifest, err := json.Marshal(storage.BundleManifest{
+					Files: []storage.BundleFile{{
+						Path:   "director-ops/some-ops.yml",
+						Mode:   os.ModeSetuid | 0777,
+						SHA256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+					}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = bundler.Import("/new-state", writeBundle(map[string]string{
+					"manifest.json":             string(manifest),
+					"director-ops/some-ops.yml": "",
+				}))
+				Expect(err).NotTo(HaveOccurred())
+
+				info, err := fs.Stat("/new-state/director-ops/some-ops.yml")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Mode()).To(Equal(os.FileMode(storage.StateMode)))
+			})
+		})
+
+		Context("when the bundle has no manifest", func() {
+			It("returns an error", func() {
+				_, err := bundler.Import("/new-state", writeBundle(map[string]string{"bbl-state.json": "{}"}))
+				Expect(err).To(MatchError("Read bundle: the bundle has no manifest"))
+			})
+		})
+
+		Context("when the bundle is not a tarball", func() {
+			It("returns an error", func() {
+				_, err := bundler.Import("/new-state", []byte("some-garbage"))
+				Expect(err).To(MatchError(ContainSubstring("Read bundle: ")))
+			})
+		})
+
+		Context("when the bundle has a newer state schema", func() {
+			It("returns an error", func() {
+				manifest, err := json.Marshal(storage.BundleManifest{
+					BBLVersion:  "99.0.0",
+					StateSchema: storage.STATE_SCHEMA + 1,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = bundler.Import("/new-state", writeBundle(map[string]string{"manifest.json": string(manifest)}))
+				Expect(err).To(MatchError(ContainSubstring("The bundle was exported by bbl 99.0.0 with a newer state schema")))
+			})
+		})
+	})
+})
+
+func readBundle(bundle []byte) map[string]string {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(bundle))
+	Expect(err).NotTo(HaveOccurred())
+
+	entries := map[string]string{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			break
+		}
+		contents, err := ioutil.ReadAll(tarReader)
+		Expect(err).NotTo(HaveOccurred())
+		entries[header.Name] = string(contents)
+	}
+	return entries
+}
+
+func writeBundle(entries map[string]string) []byte {
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, contents := range entries {
+		Expect(tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(contents))})).To(Succeed())
+		_, err := tarWriter.Write([]byte(contents))
+		Expect(err).NotTo(HaveOccurred())
+	}
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzipWriter.Close()).To(Succeed())
+	return buffer.Bytes()
+}
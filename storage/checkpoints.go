@@ -0,0 +1,213 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+)
+
+const (
+	PHASE_TERRAFORM    = "terraform"
+	PHASE_JUMPBOX      = "jumpbox"
+	PHASE_DIRECTOR     = "director"
+	PHASE_CLOUD_CONFIG = "cloud-config"
+)
+
+// phaseInputs lists, relative to the state dir, the files and directories
+// that decide the outcome of each phase of bbl up. A pattern matches the
+// files of its dirs, like the globs that terraform and the create-env scripts
+// read: all the terraform variables files, the ops files that plan patches
+// put at the top of the state dir for the override scripts, and the jumpbox
+// ops files that bbl plan generates into bbl-ops-files.
+var phaseInputs = map[string][]string{
+	PHASE_TERRAFORM: {
+		"terraform",
+		"vars/*.tfvars",
+		"vars/*.tfvars.json",
+		"vars/bbl.tfbackend",
+	},
+	PHASE_JUMPBOX: {
+		"jumpbox-deployment",
+		"jumpbox-ops",
+		"bbl-ops-files/*/jumpbox-*.yml",
+		"create-jumpbox.sh",
+		"create-jumpbox-override.sh",
+		"*.yml",
+	},
+	PHASE_DIRECTOR: {
+		"bosh-deployment",
+		"bbl-ops-files",
+		"director-ops",
+		"director-vars",
+		"create-director.sh",
+		"create-director-override.sh",
+		"*.yml",
+	},
+	PHASE_CLOUD_CONFIG: {
+		"cloud-config",
+	},
+}
+
+type fingerprintFs interface {
+	fileio.FileReader
+	fileio.Stater
+	fileio.DirReader
+}
+
+// Fingerprinter hashes the inputs of a phase of bbl up, so that a rerun can
+// tell whether the phase needs to run again.
+type Fingerprinter struct {
+	dir string
+	fs  fingerprintFs
+}
+
+func NewFingerprinter(dir string, fs fingerprintFs) Fingerprinter {
+	return Fingerprinter{
+		dir: dir,
+		fs:  fs,
+	}
+}
+
+// Fingerprint hashes the files the phase reads from the state dir together
+// with values, which carry the inputs that only exist in memory such as the
+// deployment vars. Missing files are hashed as missing, and terraform's
+// .terraform directory is ignored.
+func (f Fingerprinter) Fingerprint(phase string, values ...string) (string, error) {
+	inputs, ok := phaseInputs[phase]
+	if !ok {
+		return "", fmt.Errorf("Unknown phase %q", phase)
+	}
+
+	hash := sha256.New()
+	for _, value := range values {
+		fmt.Fprintf(hash, "value %d\n", len(value))
+		io.WriteString(hash, value)
+	}
+
+	for _, input := range inputs {
+		var err error
+		if strings.ContainsAny(input, "*?[") {
+			err = f.hashPattern(hash, input)
+		} else {
+			err = f.hashInput(hash, input)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (f Fingerprinter) hashInput(hash io.Writer, input string) error {
+	inputPath := filepath.Join(f.dir, filepath.FromSlash(input))
+
+	info, err := f.fs.Stat(inputPath)
+	if os.IsNotExist(err) {
+		fmt.Fprintf(hash, "missing %s\n", input)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Stat %s: %s", input, err)
+	}
+
+	if !info.IsDir() {
+		return f.hashFile(hash, input)
+	}
+
+	files, err := walkFiles(f.fs, inputPath, func(name string, info os.FileInfo) bool {
+		return info.IsDir() && info.Name() == ".terraform"
+	})
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		err := f.hashFile(hash, path.Join(input, name))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f Fingerprinter) hashPattern(hash io.Writer, pattern string) error {
+	names, err := f.matchFiles("", strings.Split(pattern, "/"))
+	if err != nil {
+		return fmt.Errorf("Read %s: %s", pattern, err)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		err := f.hashFile(hash, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// matchFiles returns the files under dir that match the pattern segments,
+// one segment per level of dirs.
+func (f Fingerprinter) matchFiles(dir string, segments []string) ([]string, error) {
+	infos, err := f.fs.ReadDir(filepath.Join(f.dir, filepath.FromSlash(dir)))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, info := range infos {
+		if matched, _ := path.Match(segments[0], info.Name()); !matched {
+			continue
+		}
+
+		name := path.Join(dir, info.Name())
+		if len(segments) == 1 {
+			if !info.IsDir() {
+				names = append(names, name)
+			}
+			continue
+		}
+
+		if info.IsDir() {
+			dirNames, err := f.matchFiles(name, segments[1:])
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, dirNames...)
+		}
+	}
+
+	return names, nil
+}
+
+func (f Fingerprinter) hashFile(hash io.Writer, name string) error {
+	contents, err := f.fs.ReadFile(filepath.Join(f.dir, filepath.FromSlash(name)))
+	if err != nil {
+		return fmt.Errorf("Read %s: %s", name, err)
+	}
+
+	fmt.Fprintf(hash, "file %s %d\n", name, len(contents))
+	hash.Write(contents)
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package storage_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fingerprinter", func() {
+	var (
+		fs            *afero.Afero
+		fingerprinter storage.Fingerprinter
+	)
+
+	BeforeEach(func() {
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+		fs.WriteFile("/state/terraform/bbl-template.tf", []byte("some-template"), storage.StateMode)
+		fs.WriteFile("/state/vars/bbl.tfvars.json", []byte("some-tfvars"), storage.StateMode)
+		fs.WriteFile("/state/create-jumpbox.sh", []byte("some-script"), 0750)
+
+		fingerprinter = storage.NewFingerprinter("/state", fs)
+	})
+
+	It("is stable while the inputs are unchanged", func() {
+		first, err := fingerprinter.Fingerprint(storage.PHASE_TERRAFORM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first).To(HaveLen(64))
+
+		second, err := fingerprinter.Fingerprint(storage.PHASE_TERRAFORM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second).To(Equal(first))
+	})
+
+	It("ignores .terraform and files of other phases", func() {
+		before, err := fingerprinter.Fingerprint(storage.PHASE_TERRAFORM)
+		Expect(err).NotTo(HaveOccurred())
+
+		fs.WriteFile("/state/terraform/.terraform/some-plugin", []byte("some-plugin"), 0755)
+		fs.WriteFile("/state/create-jumpbox.sh", []byte("some-other-script"), 0750)
+
+		after, err := fingerprinter.Fingerprint(storage.PHASE_TERRAFORM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(Equal(before))
+	})
+
+	It("changes with the files of the phase", func() {
+		before, err := fingerprinter.Fingerprint(storage.PHASE_TERRAFORM)
+		Expect(err).NotTo(HaveOccurred())
+
+		fs.WriteFile("/state/terraform/my-override.tf", []byte("some-override"), storage.StateMode)
+
+		after, err := fingerprinter.Fingerprint(storage.PHASE_TERRAFORM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).NotTo(Equal(before))
+	})
+
+	It("changes with the user provided terraform variables files", func() {
+		for _, name := range []string{"my.tfvars", "my.tfvars.json"} {
+			before, err := fingerprinter.Fingerprint(storage.PHASE_TERRAFORM)
+			Expect(err).NotTo(HaveOccurred())
+
+			fs.WriteFile("/state/vars/"+name, []byte("some-vars"), storage.StateMode)
+
+			after, err := fingerprinter.Fingerprint(storage.PHASE_TERRAFORM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after).NotTo(Equal(before))
+		}
+	})
+
+	It("changes with the ops files at the top of the state dir", func() {
+		for _, phase := range []string{storage.PHASE_JUMPBOX, storage.PHASE_DIRECTOR} {
+			before, err := fingerprinter.Fingerprint(phase)
+			Expect(err).NotTo(HaveOccurred())
+
+			fs.WriteFile("/state/my-ops-"+phase+".yml", []byte("some-ops"), storage.StateMode)
+
+			after, err := fingerprinter.Fingerprint(phase)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after).NotTo(Equal(before))
+		}
+	})
+
+	It("changes with the jumpbox ops files that bbl plan generates", func() {
+		for _, name := range []string{"jumpbox-sizing-ops.yml", "jumpbox-offline-assets-ops.yml"} {
+			before, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX)
+			Expect(err).NotTo(HaveOccurred())
+
+			fs.WriteFile("/state/bbl-ops-files/aws/"+name, []byte("some-ops"), storage.StateMode)
+
+			after, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after).NotTo(Equal(before))
+		}
+	})
+
+	It("ignores the director ops files that bbl plan generates for the jumpbox", func() {
+		before, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX)
+		Expect(err).NotTo(HaveOccurred())
+
+		fs.WriteFile("/state/bbl-ops-files/aws/director-sizing-ops.yml", []byte("some-ops"), storage.StateMode)
+
+		after, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(Equal(before))
+	})
+
+	It("changes with the values", func() {
+		before, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX, "some-vars")
+		Expect(err).NotTo(HaveOccurred())
+
+		after, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX, "some-other-vars")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).NotTo(Equal(before))
+	})
+
+	It("tells a missing override from an empty one", func() {
+		before, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX)
+		Expect(err).NotTo(HaveOccurred())
+
+		fs.WriteFile("/state/create-jumpbox-override.sh", []byte{}, 0750)
+
+		after, err := fingerprinter.Fingerprint(storage.PHASE_JUMPBOX)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).NotTo(Equal(before))
+	})
+
+	It("changes with the ops files and vars files of the director", func() {
+		before, err := fingerprinter.Fingerprint(storage.PHASE_DIRECTOR)
+		Expect(err).NotTo(HaveOccurred())
+
+		fs.WriteFile("/state/director-vars/my-vars.yml", []byte("some-vars"), storage.StateMode)
+
+		after, err := fingerprinter.Fingerprint(storage.PHASE_DIRECTOR)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).NotTo(Equal(before))
+	})
+
+	Context("when the phase is unknown", func() {
+		It("returns an error", func() {
+			_, err := fingerprinter.Fingerprint("bosh")
+			Expect(err).To(MatchError(`Unknown phase "bosh"`))
+		})
+	})
+})
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	cipherSaltSize   = 16
+	cipherKeySize    = 32
+	cipherIterations = 100000
+)
+
+var (
+	cipherHeader       = []byte("BBLENC1\n")
+	ErrMissingStateKey = errors.New("bbl state is encrypted. Set BBL_STATE_PASSPHRASE or BBL_STATE_KEY_FILE to decrypt it.")
+)
+
+type Cipher struct {
+	passphrase []byte
+}
+
+func NewCipher(passphrase []byte) Cipher {
+	return Cipher{
+		passphrase: passphrase,
+	}
+}
+
+func (c Cipher) IsEnabled() bool {
+	return len(c.passphrase) > 0
+}
+
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, cipherHeader)
+}
+
+func (c Cipher) Encrypt(plaintext []byte) ([]byte, error) {
+	if !c.IsEnabled() {
+		return plaintext, nil
+	}
+
+	salt := make([]byte, cipherSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("Generate salt: %s", err)
+	}
+
+	gcm, err := c.gcm(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("Generate nonce: %s", err)
+	}
+
+	ciphertext := append([]byte{}, cipherHeader...)
+	ciphertext = append(ciphertext, salt...)
+	ciphertext = append(ciphertext, nonce...)
+	return gcm.Seal(ciphertext, nonce, plaintext, cipherHeader), nil
+}
+
+func (c Cipher) Decrypt(data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+
+	if !c.IsEnabled() {
+		return nil, ErrMissingStateKey
+	}
+
+	data = data[len(cipherHeader):]
+	if len(data) < cipherSaltSize {
+		return nil, errors.New("Encrypted data is truncated")
+	}
+	salt := data[:cipherSaltSize]
+	data = data[cipherSaltSize:]
+
+	gcm, err := c.gcm(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("Encrypted data is truncated")
+	}
+	nonce := data[:gcm.NonceSize()]
+
+	plaintext, err := gcm.Open(nil, nonce, data[gcm.NonceSize():], cipherHeader)
+	if err != nil {
+		return nil, errors.New("Decrypt: the state key is incorrect or the data has been tampered with")
+	}
+
+	return plaintext, nil
+}
+
+func (c Cipher) gcm(salt []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(pbkdf2.Key(c.passphrase, salt, cipherIterations, cipherKeySize, sha256.New))
+	if err != nil {
+		return nil, fmt.Errorf("Create cipher: %s", err) // not tested
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,87 @@
+package storage_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cipher", func() {
+	var cipher storage.Cipher
+
+	BeforeEach(func() {
+		cipher = storage.NewCipher([]byte("some-passphrase"))
+	})
+
+	Describe("Encrypt", func() {
+		It("encrypts the plaintext so it can be decrypted", func() {
+			encrypted, err := cipher.Encrypt([]byte("some-secret"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(string(encrypted)).NotTo(ContainSubstring("some-secret"))
+			Expect(storage.IsEncrypted(encrypted)).To(BeTrue())
+
+			decrypted, err := cipher.Decrypt(encrypted)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(decrypted)).To(Equal("some-secret"))
+		})
+
+		It("uses a different salt and nonce every time", func() {
+			first, err := cipher.Encrypt([]byte("some-secret"))
+			Expect(err).NotTo(HaveOccurred())
+
+			second, err := cipher.Encrypt([]byte("some-secret"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(first).NotTo(Equal(second))
+		})
+
+		Context("when no passphrase is provided", func() {
+			It("returns the plaintext", func() {
+				encrypted, err := storage.NewCipher(nil).Encrypt([]byte("some-secret"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(encrypted)).To(Equal("some-secret"))
+			})
+		})
+	})
+
+	Describe("Decrypt", func() {
+		It("returns data that is not encrypted as is", func() {
+			decrypted, err := cipher.Decrypt([]byte("not-encrypted"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(decrypted)).To(Equal("not-encrypted"))
+		})
+
+		Context("failure cases", func() {
+			var encrypted []byte
+
+			BeforeEach(func() {
+				var err error
+				encrypted, err = cipher.Encrypt([]byte("some-secret"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when no passphrase is provided", func() {
+				It("returns an error", func() {
+					_, err := storage.NewCipher(nil).Decrypt(encrypted)
+					Expect(err).To(Equal(storage.ErrMissingStateKey))
+				})
+			})
+
+			Context("when the passphrase is wrong", func() {
+				It("returns an error", func() {
+					_, err := storage.NewCipher([]byte("wrong")).Decrypt(encrypted)
+					Expect(err).To(MatchError("Decrypt: the state key is incorrect or the data has been tampered with"))
+				})
+			})
+
+			Context("when the data is truncated", func() {
+				It("returns an error", func() {
+					_, err := cipher.Decrypt(encrypted[:12])
+					Expect(err).To(MatchError("Encrypted data is truncated"))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,251 @@
+package storage
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/afero/mem"
+)
+
+var encryptedFiles = map[string]struct{}{
+	STATE_FILE:                   struct{}{},
+	"bbl.tfbackend":              struct{}{},
+	"bbl.tfvars":                 struct{}{},
+	"bbl.tfvars.json":            struct{}{},
+	"bosh-state.json":            struct{}{},
+	"director-manifest.yml":      struct{}{},
+	"director-vars-file.yml":     struct{}{},
+	"director-vars-store.yml":    struct{}{},
+	"jumpbox-manifest.yml":       struct{}{},
+	"jumpbox-state.json":         struct{}{},
+	"jumpbox-vars-file.yml":      struct{}{},
+	"jumpbox-vars-store.yml":     struct{}{},
+	"terraform.tfstate":          struct{}{},
+	"terraform.tfstate.backup":   struct{}{},
+	"terraform.tfstate.migrated": struct{}{},
+}
+
+// EncryptedFs is an afero.Fs that transparently decrypts the files it
+// opens and encrypts the sensitive files of a state directory when they
+// are closed after being written.
+type EncryptedFs struct {
+	afero.Fs
+	cipher Cipher
+}
+
+func NewEncryptedFs(fs afero.Fs, cipher Cipher) EncryptedFs {
+	return EncryptedFs{
+		Fs:     fs,
+		cipher: cipher,
+	}
+}
+
+func (e EncryptedFs) Name() string {
+	return "EncryptedFs"
+}
+
+func (e EncryptedFs) Create(name string) (afero.File, error) {
+	return e.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+func (e EncryptedFs) Open(name string) (afero.File, error) {
+	return e.OpenFile(name, os.O_RDONLY, 0)
+}
+
+func (e EncryptedFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	file, err := e.Fs.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		return file, nil
+	}
+
+	readOnly := flag&(os.O_WRONLY|os.O_RDWR) == 0
+	if readOnly && !hasEncryptedHeader(file) {
+		return file, nil
+	}
+	if !readOnly && !isEncryptedFile(name) {
+		return file, nil
+	}
+
+	var contents []byte
+	if flag&os.O_TRUNC == 0 {
+		contents, err = afero.ReadFile(e.Fs, name)
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+
+	plaintext, err := e.cipher.Decrypt(contents)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("Decrypt %s: %s", name, err)
+	}
+
+	data := mem.CreateFile(name)
+	buffer := mem.NewFileHandle(data)
+	if _, err := buffer.Write(plaintext); err != nil {
+		file.Close()
+		return nil, err // not tested
+	}
+	mem.SetMode(data, info.Mode())
+	mem.SetModTime(data, info.ModTime())
+
+	if readOnly {
+		return &encryptedFile{File: mem.NewReadOnlyFileHandle(data), file: file}, nil
+	}
+
+	if flag&os.O_APPEND == 0 {
+		buffer.Seek(0, io.SeekStart)
+	}
+
+	return &encryptedFile{
+		File:     buffer,
+		file:     file,
+		cipher:   e.cipher,
+		writable: true,
+		dirty:    flag&os.O_TRUNC != 0,
+	}, nil
+}
+
+// Rename encrypts a plaintext file that is renamed to the name of a
+// sensitive file.
+func (e EncryptedFs) Rename(oldname, newname string) error {
+	if !isEncryptedFile(newname) || isEncryptedFile(oldname) || !e.cipher.IsEnabled() {
+		return e.Fs.Rename(oldname, newname)
+	}
+
+	info, err := e.Fs.Stat(oldname)
+	if err != nil {
+		return err
+	}
+
+	contents, err := afero.ReadFile(e, oldname)
+	if err != nil {
+		return err
+	}
+
+	err = afero.WriteFile(e, newname, contents, info.Mode())
+	if err != nil {
+		return err
+	}
+
+	return e.Fs.Remove(oldname)
+}
+
+// encryptedFile buffers the plaintext of a file in memory and writes it
+// back, encrypted if it is sensitive, when it is closed.
+type encryptedFile struct {
+	*mem.File
+	file     afero.File
+	cipher   Cipher
+	writable bool
+	dirty    bool
+}
+
+func (f *encryptedFile) Write(b []byte) (int, error) {
+	f.dirty = true
+	return f.File.Write(b)
+}
+
+func (f *encryptedFile) WriteAt(b []byte, off int64) (int, error) {
+	f.dirty = true
+	return f.File.WriteAt(b, off)
+}
+
+func (f *encryptedFile) WriteString(s string) (int, error) {
+	return f.Write([]byte(s))
+}
+
+func (f *encryptedFile) Truncate(size int64) error {
+	f.dirty = true
+	return f.File.Truncate(size)
+}
+
+func (f *encryptedFile) Sync() error {
+	if err := f.flush(); err != nil {
+		return err
+	}
+	return f.file.Sync()
+}
+
+func (f *encryptedFile) Close() error {
+	err := f.flush()
+	if closeErr := f.file.Close(); err == nil {
+		err = closeErr
+	}
+	f.File.Close()
+	return err
+}
+
+func (f *encryptedFile) flush() error {
+	if !f.writable || !f.dirty {
+		return nil
+	}
+
+	if _, err := f.File.Seek(0, io.SeekStart); err != nil {
+		return err // not tested
+	}
+	plaintext, err := ioutil.ReadAll(f.File)
+	if err != nil {
+		return err // not tested
+	}
+
+	data := plaintext
+	if isEncryptedFile(f.Name()) {
+		data, err = f.cipher.Encrypt(plaintext)
+		if err != nil {
+			return fmt.Errorf("Encrypt %s: %s", f.Name(), err)
+		}
+	}
+
+	if err := f.file.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := f.file.Seek(0, io.SeekStart); err != nil {
+		return err // not tested
+	}
+	if _, err := f.file.Write(data); err != nil {
+		return err
+	}
+
+	f.dirty = false
+	return nil
+}
+
+func hasEncryptedHeader(file afero.File) bool {
+	header := make([]byte, len(cipherHeader))
+	n, _ := file.ReadAt(header, 0)
+	file.Seek(0, io.SeekStart)
+	return IsEncrypted(header[:n])
+}
+
+func isEncryptedFile(filename string) bool {
+	_, ok := encryptedFiles[filepath.Base(filename)]
+	return ok || IsTerraformPlan(filename)
+}
+
+// IsTerraformPlan matches the plans that bbl plan --terraform-plan-out and
+// bbl up --dry-run save, which hold every variable that terraform was given.
+func IsTerraformPlan(filename string) bool {
+	return filepath.Ext(filename) == ".tfplan"
+}
+
+// isTerraformPlanFile matches a saved plan or the checksum bbl keeps next
+// to it.
+func isTerraformPlanFile(filename string) bool {
+	return IsTerraformPlan(strings.TrimSuffix(filename, ".checksum"))
+}
@@ -0,0 +1,168 @@
+package storage_test
+
+import (
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EncryptedFs", func() {
+	var (
+		fs          *afero.Afero
+		cipher      storage.Cipher
+		encryptedFs *afero.Afero
+	)
+
+	BeforeEach(func() {
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+		cipher = storage.NewCipher([]byte("some-passphrase"))
+		encryptedFs = &afero.Afero{Fs: storage.NewEncryptedFs(fs, cipher)}
+	})
+
+	Describe("WriteFile", func() {
+		It("encrypts sensitive files", func() {
+			err := encryptedFs.WriteFile("/state/vars/director-vars-store.yml", []byte("admin_password: secret"), storage.StateMode)
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/vars/director-vars-store.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storage.IsEncrypted(contents)).To(BeTrue())
+		})
+
+		It("encrypts terraform plans of any name", func() {
+			err := encryptedFs.WriteFile("/state/vars/reviewed.tfplan", []byte("some-plan"), storage.StateMode)
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/vars/reviewed.tfplan")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storage.IsEncrypted(contents)).To(BeTrue())
+		})
+
+		It("does not encrypt other files", func() {
+			err := encryptedFs.WriteFile("/state/terraform/bbl-template.tf", []byte("some-template"), storage.StateMode)
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/terraform/bbl-template.tf")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-template"))
+		})
+
+		Context("when encryption is not enabled", func() {
+			It("writes sensitive files in plaintext", func() {
+				encryptedFs = &afero.Afero{Fs: storage.NewEncryptedFs(fs, storage.NewCipher(nil))}
+
+				err := encryptedFs.WriteFile("/state/bbl-state.json", []byte("{}"), storage.StateMode)
+				Expect(err).NotTo(HaveOccurred())
+
+				contents, err := fs.ReadFile("/state/bbl-state.json")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("{}"))
+			})
+		})
+	})
+
+	Describe("OpenFile", func() {
+		It("encrypts sensitive files when they are closed", func() {
+			file, err := encryptedFs.OpenFile("/state/vars/terraform.tfstate", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, storage.StateMode)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = file.WriteString("some-tfstate")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+
+			contents, err := fs.ReadFile("/state/vars/terraform.tfstate")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storage.IsEncrypted(contents)).To(BeTrue())
+
+			contents, err = encryptedFs.ReadFile("/state/vars/terraform.tfstate")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-tfstate"))
+		})
+
+		It("appends to the plaintext of sensitive files", func() {
+			err := encryptedFs.WriteFile("/state/vars/bbl.tfvars", []byte("a=1\n"), storage.StateMode)
+			Expect(err).NotTo(HaveOccurred())
+
+			file, err := encryptedFs.OpenFile("/state/vars/bbl.tfvars", os.O_WRONLY|os.O_APPEND, storage.StateMode)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = file.WriteString("b=2\n")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+
+			contents, err := encryptedFs.ReadFile("/state/vars/bbl.tfvars")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("a=1\nb=2\n"))
+		})
+	})
+
+	Describe("Create", func() {
+		It("encrypts sensitive files when they are closed", func() {
+			file, err := encryptedFs.Create("/state/bbl-state.json")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = file.Write([]byte("{}"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+
+			contents, err := fs.ReadFile("/state/bbl-state.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storage.IsEncrypted(contents)).To(BeTrue())
+		})
+	})
+
+	Describe("Rename", func() {
+		It("encrypts plaintext files renamed to a sensitive file", func() {
+			err := fs.WriteFile("/state/vars/terraform.tfstate.tmp", []byte("some-tfstate"), storage.StateMode)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = encryptedFs.Rename("/state/vars/terraform.tfstate.tmp", "/state/vars/terraform.tfstate")
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/vars/terraform.tfstate")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storage.IsEncrypted(contents)).To(BeTrue())
+
+			exists, err := fs.Exists("/state/vars/terraform.tfstate.tmp")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+	})
+
+	Describe("ReadFile", func() {
+		It("decrypts encrypted files", func() {
+			encrypted, err := cipher.Encrypt([]byte("admin_password: secret"))
+			Expect(err).NotTo(HaveOccurred())
+			err = fs.WriteFile("/state/vars/director-vars-store.yml", encrypted, storage.StateMode)
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := encryptedFs.ReadFile("/state/vars/director-vars-store.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("admin_password: secret"))
+		})
+
+		It("reads plaintext files as is", func() {
+			err := fs.WriteFile("/state/vars/director-vars-store.yml", []byte("admin_password: secret"), storage.StateMode)
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := encryptedFs.ReadFile("/state/vars/director-vars-store.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("admin_password: secret"))
+		})
+
+		Context("when the file cannot be decrypted", func() {
+			It("returns an error", func() {
+				encrypted, err := cipher.Encrypt([]byte("admin_password: secret"))
+				Expect(err).NotTo(HaveOccurred())
+				err = fs.WriteFile("/state/vars/director-vars-store.yml", encrypted, storage.StateMode)
+				Expect(err).NotTo(HaveOccurred())
+
+				encryptedFs = &afero.Afero{Fs: storage.NewEncryptedFs(fs, storage.NewCipher(nil))}
+
+				_, err = encryptedFs.ReadFile("/state/vars/director-vars-store.yml")
+				Expect(err).To(MatchError(ContainSubstring("Decrypt /state/vars/director-vars-store.yml: bbl state is encrypted")))
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"encoding/json"
+	"time"
 
 	uuid "github.com/nu7hatch/gouuid"
 )
@@ -21,3 +22,27 @@ func SetUUIDNewV4(f func() (uuid *uuid.UUID, err error)) {
 func ResetUUIDNewV4() {
 	uuidNewV4 = uuid.NewV4
 }
+
+func SetLockPollInterval(interval time.Duration) {
+	lockPollInterval = interval
+}
+
+func ResetLockPollInterval() {
+	lockPollInterval = 500 * time.Millisecond
+}
+
+func SetSnapshotNow(f func() time.Time) {
+	snapshotNow = f
+}
+
+func ResetSnapshotNow() {
+	snapshotNow = time.Now
+}
+
+func SetBundleNow(f func() time.Time) {
+	bundleNow = f
+}
+
+func ResetBundleNow() {
+	bundleNow = time.Now
+}
@@ -6,17 +6,25 @@ import (
 	"path/filepath"
 )
 
+// bblManaged are the vars files that bbl writes. The terraform plans it
+// saves can have any name ending in .tfplan, so they are matched by
+// isTerraformPlanFile instead.
 var bblManaged = map[string]struct{}{
-	"bbl.tfvars":               struct{}{},
-	"bosh-state.json":          struct{}{},
-	"cloud-config-vars.yml":    struct{}{},
-	"director-vars-file.yml":   struct{}{},
-	"director-vars-store.yml":  struct{}{},
-	"jumpbox-state.json":       struct{}{},
-	"jumpbox-vars-file.yml":    struct{}{},
-	"jumpbox-vars-store.yml":   struct{}{},
-	"terraform.tfstate":        struct{}{},
-	"terraform.tfstate.backup": struct{}{},
+	"bbl.tfbackend":              struct{}{},
+	"bbl.tfvars":                 struct{}{},
+	"bbl.tfvars.json":            struct{}{},
+	"bosh-state.json":            struct{}{},
+	"cloud-config-vars.yml":      struct{}{},
+	"director-manifest.yml":      struct{}{},
+	"director-vars-file.yml":     struct{}{},
+	"director-vars-store.yml":    struct{}{},
+	"jumpbox-manifest.yml":       struct{}{},
+	"jumpbox-state.json":         struct{}{},
+	"jumpbox-vars-file.yml":      struct{}{},
+	"jumpbox-vars-store.yml":     struct{}{},
+	"terraform.tfstate":          struct{}{},
+	"terraform.tfstate.backup":   struct{}{},
+	"terraform.tfstate.migrated": struct{}{},
 }
 
 type GarbageCollector struct {
@@ -53,7 +61,7 @@ func (g GarbageCollector) Remove(dir string) error {
 	vDir := filepath.Join(dir, "vars")
 	vFiles, _ := g.fs.ReadDir(vDir)
 	for _, f := range vFiles {
-		if _, ok := bblManaged[f.Name()]; ok {
+		if _, ok := bblManaged[f.Name()]; ok || isTerraformPlanFile(f.Name()) {
 			_ = g.fs.Remove(filepath.Join(vDir, f.Name()))
 		}
 	}
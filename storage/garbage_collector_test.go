@@ -94,7 +94,7 @@ var _ = Describe("garbage collector", func() {
 			Context("when the vars directory contains only bbl files", func() {
 				BeforeEach(func() {
 					fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
-						fakes.FileInfo{FileName: "bbl.tfvars"},
+						fakes.FileInfo{FileName: "bbl.tfvars.json"},
 						fakes.FileInfo{FileName: "bosh-state.json"},
 						fakes.FileInfo{FileName: "cloud-config-vars.yml"},
 						fakes.FileInfo{FileName: "director-vars-file.yml"},
@@ -112,7 +112,7 @@ var _ = Describe("garbage collector", func() {
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(fileIO.RemoveCall.Receives).To(ContainElement(fakes.RemoveReceive{
-						Name: filepath.Join("some-dir", "vars", "bbl.tfvars"),
+						Name: filepath.Join("some-dir", "vars", "bbl.tfvars.json"),
 					}))
 					Expect(fileIO.RemoveCall.Receives).To(ContainElement(fakes.RemoveReceive{
 						Name: filepath.Join("some-dir", "vars"),
@@ -120,6 +120,32 @@ var _ = Describe("garbage collector", func() {
 				})
 			})
 
+			Context("when the vars directory contains terraform plans", func() {
+				BeforeEach(func() {
+					fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
+						fakes.FileInfo{FileName: "bbl.tfplan"},
+						fakes.FileInfo{FileName: "bbl.tfplan.checksum"},
+						fakes.FileInfo{FileName: "reviewed.tfplan"},
+						fakes.FileInfo{FileName: "reviewed.tfplan.checksum"},
+						fakes.FileInfo{FileName: "reviewed.tfplan.notes"},
+					}
+				})
+
+				It("removes the plans saved under any name", func() {
+					err := gc.Remove("some-dir")
+					Expect(err).NotTo(HaveOccurred())
+
+					for _, name := range []string{"bbl.tfplan", "bbl.tfplan.checksum", "reviewed.tfplan", "reviewed.tfplan.checksum"} {
+						Expect(fileIO.RemoveCall.Receives).To(ContainElement(fakes.RemoveReceive{
+							Name: filepath.Join("some-dir", "vars", name),
+						}))
+					}
+					Expect(fileIO.RemoveCall.Receives).NotTo(ContainElement(fakes.RemoveReceive{
+						Name: filepath.Join("some-dir", "vars", "reviewed.tfplan.notes"),
+					}))
+				})
+			})
+
 			Context("when the vars directory contains user managed files", func() {
 				BeforeEach(func() {
 					fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
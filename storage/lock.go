@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+)
+
+const LOCK_FILE = "bbl.lock"
+
+var lockPollInterval = 500 * time.Millisecond
+
+type LockInfo struct {
+	PID     int       `json:"pid"`
+	Host    string    `json:"host"`
+	Command string    `json:"command"`
+	Started time.Time `json:"started"`
+}
+
+func (i LockInfo) String() string {
+	return fmt.Sprintf("bbl %s (pid %d on %s) since %s", i.Command, i.PID, i.Host, i.Started.Format(time.RFC3339))
+}
+
+type lockFs interface {
+	fileio.FileOpener
+	fileio.FileReader
+	fileio.Remover
+	fileio.AllMkdirer
+}
+
+// Locker guards the state directory with an advisory lock file so that
+// two mutating commands cannot run against it at the same time.
+type Locker struct {
+	dir     string
+	fs      lockFs
+	timeout time.Duration
+}
+
+func NewLocker(dir string, fs lockFs, timeout time.Duration) Locker {
+	return Locker{
+		dir:     dir,
+		fs:      fs,
+		timeout: timeout,
+	}
+}
+
+// Lock creates the lock file, and the state directory when it does not
+// exist yet, waiting up to the lock timeout for another run to release it.
+func (l Locker) Lock(command string) error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("Get hostname: %s", err) // not tested
+	}
+
+	err = l.fs.MkdirAll(l.dir, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("Create state dir: %s", err)
+	}
+
+	contents, err := json.Marshal(LockInfo{
+		PID:     os.Getpid(),
+		Host:    hostname,
+		Command: command,
+		Started: time.Now().UTC(),
+	})
+	if err != nil {
+		return err // not tested
+	}
+
+	deadline := time.Now().Add(l.timeout)
+	for {
+		file, err := l.fs.OpenFile(l.path(), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			_, err = file.Write(contents)
+			file.Close()
+			if err != nil {
+				return fmt.Errorf("Write lock file: %s", err) // not tested
+			}
+			return nil
+		}
+
+		if !os.IsExist(err) {
+			return fmt.Errorf("Create lock file: %s", err)
+		}
+
+		if l.removeStaleLock(hostname) {
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return l.lockedError()
+		}
+
+		time.Sleep(lockPollInterval)
+	}
+}
+
+// Unlock removes the lock file if it is held by this process.
+func (l Locker) Unlock() error {
+	info, err := l.Info()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if info.PID != os.Getpid() {
+		return nil
+	}
+
+	return l.remove()
+}
+
+// ForceUnlock removes the lock file regardless of who holds it and
+// returns the details of the run that held it.
+func (l Locker) ForceUnlock() (LockInfo, error) {
+	info, err := l.Info()
+	if err != nil {
+		return LockInfo{}, err
+	}
+
+	return info, l.remove()
+}
+
+func (l Locker) Info() (LockInfo, error) {
+	contents, err := l.fs.ReadFile(l.path())
+	if err != nil {
+		return LockInfo{}, err
+	}
+
+	var info LockInfo
+	err = json.Unmarshal(contents, &info)
+	if err != nil {
+		return LockInfo{}, fmt.Errorf("Read lock file: %s", err)
+	}
+
+	return info, nil
+}
+
+// removeStaleLock removes a lock held by a process on this host that no
+// longer exists, such as a run that was killed.
+func (l Locker) removeStaleLock(hostname string) bool {
+	info, err := l.Info()
+	if err != nil || info.Host != hostname || processExists(info.PID) {
+		return false
+	}
+
+	return l.remove() == nil
+}
+
+func (l Locker) lockedError() error {
+	info, err := l.Info()
+	if err != nil {
+		return errors.New("The state directory is locked. Run bbl force-unlock if no other bbl command is running.")
+	}
+
+	return fmt.Errorf("The state directory is locked by %s. Run bbl force-unlock if that command is no longer running.", info)
+}
+
+func (l Locker) remove() error {
+	err := l.fs.Remove(l.path())
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("Remove lock file: %s", err)
+	}
+	return nil
+}
+
+func (l Locker) path() string {
+	return filepath.Join(l.dir, LOCK_FILE)
+}
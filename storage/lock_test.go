@@ -0,0 +1,199 @@
+package storage_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Locker", func() {
+	var (
+		stateDir string
+		lockPath string
+		fs       *afero.Afero
+		locker   storage.Locker
+	)
+
+	BeforeEach(func() {
+		var err error
+		stateDir, err = ioutil.TempDir("", "")
+		Expect(err).NotTo(HaveOccurred())
+		lockPath = filepath.Join(stateDir, "bbl.lock")
+
+		fs = &afero.Afero{Fs: afero.NewOsFs()}
+		locker = storage.NewLocker(stateDir, fs, 0)
+
+		storage.SetLockPollInterval(10 * time.Millisecond)
+	})
+
+	AfterEach(func() {
+		storage.ResetLockPollInterval()
+		os.RemoveAll(stateDir)
+	})
+
+	var writeLock = func(info storage.LockInfo) {
+		contents, err := json.Marshal(info)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(lockPath, contents, 0644)).To(Succeed())
+	}
+
+	Describe("Lock", func() {
+		It("records the owner of the lock", func() {
+			err := locker.Lock("up")
+			Expect(err).NotTo(HaveOccurred())
+
+			info, err := locker.Info()
+			Expect(err).NotTo(HaveOccurred())
+
+			hostname, _ := os.Hostname()
+			Expect(info.PID).To(Equal(os.Getpid()))
+			Expect(info.Host).To(Equal(hostname))
+			Expect(info.Command).To(Equal("up"))
+			Expect(info.Started).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		Context("when the state directory does not exist", func() {
+			It("creates it", func() {
+				locker = storage.NewLocker(filepath.Join(stateDir, "new-state-dir"), fs, 0)
+
+				err := locker.Lock("state import")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(filepath.Join(stateDir, "new-state-dir", "bbl.lock")).To(BeAnExistingFile())
+			})
+		})
+
+		Context("when the state dir is already locked", func() {
+			BeforeEach(func() {
+				writeLock(storage.LockInfo{
+					PID:     1234,
+					Host:    "some-host",
+					Command: "destroy",
+					Started: time.Date(2018, time.March, 1, 12, 0, 0, 0, time.UTC),
+				})
+			})
+
+			It("returns an error describing the owner", func() {
+				err := locker.Lock("up")
+				Expect(err).To(MatchError("The state directory is locked by bbl destroy (pid 1234 on some-host) since 2018-03-01T12:00:00Z. Run bbl force-unlock if that command is no longer running."))
+			})
+
+			Context("when a lock timeout is set", func() {
+				BeforeEach(func() {
+					locker = storage.NewLocker(stateDir, fs, time.Second)
+				})
+
+				It("waits for the lock to be released", func() {
+					go func() {
+						time.Sleep(50 * time.Millisecond)
+						os.Remove(lockPath)
+					}()
+
+					err := locker.Lock("up")
+					Expect(err).NotTo(HaveOccurred())
+
+					info, err := locker.Info()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(info.Command).To(Equal("up"))
+				})
+
+				It("gives up after the timeout", func() {
+					locker = storage.NewLocker(stateDir, fs, 50*time.Millisecond)
+
+					err := locker.Lock("up")
+					Expect(err).To(MatchError(ContainSubstring("The state directory is locked by bbl destroy")))
+				})
+			})
+		})
+
+		Context("when the lock was left by a process on this host that no longer runs", func() {
+			It("replaces the stale lock", func() {
+				hostname, _ := os.Hostname()
+				writeLock(storage.LockInfo{PID: 1 << 30, Host: hostname, Command: "destroy"})
+
+				err := locker.Lock("up")
+				Expect(err).NotTo(HaveOccurred())
+
+				info, err := locker.Info()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.PID).To(Equal(os.Getpid()))
+				Expect(info.Command).To(Equal("up"))
+			})
+		})
+
+		Context("when the lock is held by a running process on this host", func() {
+			It("returns an error", func() {
+				hostname, _ := os.Hostname()
+				writeLock(storage.LockInfo{PID: os.Getppid(), Host: hostname, Command: "destroy"})
+
+				err := locker.Lock("up")
+				Expect(err).To(MatchError(ContainSubstring("The state directory is locked by bbl destroy")))
+			})
+		})
+
+		Context("when the state dir cannot be created", func() {
+			It("returns an error", func() {
+				Expect(ioutil.WriteFile(filepath.Join(stateDir, "some-file"), []byte{}, 0644)).To(Succeed())
+				locker = storage.NewLocker(filepath.Join(stateDir, "some-file"), fs, 0)
+
+				err := locker.Lock("up")
+				Expect(err).To(MatchError(ContainSubstring("Create state dir:")))
+			})
+		})
+	})
+
+	Describe("Unlock", func() {
+		It("removes the lock held by this process", func() {
+			Expect(locker.Lock("up")).To(Succeed())
+
+			err := locker.Unlock()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = os.Stat(lockPath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("leaves a lock held by another process", func() {
+			writeLock(storage.LockInfo{PID: os.Getpid() + 1, Command: "up"})
+
+			err := locker.Unlock()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = os.Stat(lockPath)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("does nothing when there is no lock", func() {
+			Expect(locker.Unlock()).To(Succeed())
+		})
+	})
+
+	Describe("ForceUnlock", func() {
+		It("removes the lock and returns its owner", func() {
+			writeLock(storage.LockInfo{PID: 1234, Host: "some-host", Command: "destroy"})
+
+			info, err := locker.ForceUnlock()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.PID).To(Equal(1234))
+			Expect(info.Command).To(Equal("destroy"))
+
+			_, err = os.Stat(lockPath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		Context("when there is no lock", func() {
+			It("returns an error", func() {
+				_, err := locker.ForceUnlock()
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+})
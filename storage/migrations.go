@@ -0,0 +1,527 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	MIGRATION_BACKUP_DIR = ".migration-backup"
+
+	migrationRecordFile = "migration.json"
+	migrationFilesDir   = "files"
+)
+
+var migrationNow = time.Now
+
+// liveStateFiles are the files that terraform and create-env keep up to date
+// with the infrastructure. Restoring older copies of them would make bbl
+// lose track of what it deployed.
+var liveStateFiles = []string{
+	"vars/terraform.tfstate",
+	"vars/bosh-state.json",
+	"vars/jumpbox-state.json",
+	"vars/director-vars-store.yml",
+	"vars/jumpbox-vars-store.yml",
+}
+
+type migrationDirs struct {
+	vars        string
+	terraform   string
+	cloudConfig string
+	oldBbl      string
+}
+
+// migration upgrades a state directory to the layout of schema version.
+// changes describes the file moves and state edits that apply would make,
+// and returns nothing when the directory is already in that layout.
+// Migrations marked always run on every state, whatever its schema.
+type migration struct {
+	version int
+	always  bool
+	name    string
+	changes func(m Migrator, state State, dirs migrationDirs) []string
+	apply   func(m Migrator, state State, dirs migrationDirs) (State, error)
+}
+
+func (mg migration) appliesTo(state State) bool {
+	return mg.always || state.Version < mg.version
+}
+
+// migrations are applied in order to states whose schema is older than
+// their version. The ones registered at schema 14 predate the registry:
+// earlier versions of bbl ran them on every state without recording which
+// had run, so they keep running on every state. They change nothing once
+// the directory is in their layout.
+var migrations = []migration{
+	{
+		version: 14,
+		always:  true,
+		name:    "move the terraform state out of bbl-state.json",
+		changes: func(m Migrator, state State, dirs migrationDirs) []string {
+			if state.TFState == "" {
+				return nil
+			}
+			return []string{"move tfState from bbl-state.json to vars/terraform.tfstate"}
+		},
+		apply: func(m Migrator, state State, dirs migrationDirs) (State, error) {
+			return m.MigrateTerraformState(state, dirs.vars)
+		},
+	},
+	{
+		version: 14,
+		always:  true,
+		name:    "rename the terraform template",
+		changes: func(m Migrator, state State, dirs migrationDirs) []string {
+			return m.renameChange(dirs.terraform, "terraform", "template.tf", "bbl-template.tf")
+		},
+		apply: func(m Migrator, state State, dirs migrationDirs) (State, error) {
+			return state, m.MigrateTerraformTemplate(dirs.terraform)
+		},
+	},
+	{
+		version: 14,
+		always:  true,
+		name:    "move the director state out of bbl-state.json",
+		changes: func(m Migrator, state State, dirs migrationDirs) []string {
+			if len(state.BOSH.State) == 0 {
+				return nil
+			}
+			return []string{"move bosh.state from bbl-state.json to vars/bosh-state.json"}
+		},
+		apply: func(m Migrator, state State, dirs migrationDirs) (State, error) {
+			return m.MigrateDirectorState(state, dirs.vars)
+		},
+	},
+	{
+		version: 14,
+		always:  true,
+		name:    "move the jumpbox state out of bbl-state.json",
+		changes: func(m Migrator, state State, dirs migrationDirs) []string {
+			if len(state.Jumpbox.State) == 0 {
+				return nil
+			}
+			return []string{"move jumpbox.state from bbl-state.json to vars/jumpbox-state.json"}
+		},
+		apply: func(m Migrator, state State, dirs migrationDirs) (State, error) {
+			return m.MigrateJumpboxState(state, dirs.vars)
+		},
+	},
+	{
+		version: 14,
+		always:  true,
+		name:    "move the cloud-config out of the .bbl directory",
+		changes: func(m Migrator, state State, dirs migrationDirs) []string {
+			if _, err := m.fs.Stat(dirs.oldBbl); err != nil {
+				return nil
+			}
+			return []string{
+				"move .bbl/cloudconfig/* to cloud-config/",
+				"remove .bbl",
+			}
+		},
+		apply: func(m Migrator, state State, dirs migrationDirs) (State, error) {
+			return state, m.MigrateCloudConfigDir(dirs.oldBbl, dirs.cloudConfig)
+		},
+	},
+	{
+		version: 14,
+		always:  true,
+		name:    "rename the bbl provided terraform variables",
+		changes: func(m Migrator, state State, dirs migrationDirs) []string {
+			return m.renameChange(dirs.vars, "vars", "terraform.tfvars", "bbl.tfvars")
+		},
+		apply: func(m Migrator, state State, dirs migrationDirs) (State, error) {
+			return state, m.MigrateTerraformVars(dirs.vars)
+		},
+	},
+	{
+		version: 14,
+		always:  true,
+		name:    "move the director vars store out of bbl-state.json",
+		changes: func(m Migrator, state State, dirs migrationDirs) []string {
+			return m.varsStoreChanges(state.BOSH.Variables, "bosh.variables", "director", dirs.vars)
+		},
+		apply: func(m Migrator, state State, dirs migrationDirs) (State, error) {
+			return m.MigrateDirectorVars(state, dirs.vars)
+		},
+	},
+	{
+		version: 14,
+		always:  true,
+		name:    "rename the director vars file",
+		changes: func(m Migrator, state State, dirs migrationDirs) []string {
+			return m.renameChange(dirs.vars, "vars", "director-deployment-vars.yml", "director-vars-file.yml")
+		},
+		apply: func(m Migrator, state State, dirs migrationDirs) (State, error) {
+			return state, m.MigrateDirectorVarsFile(dirs.vars)
+		},
+	},
+	{
+		version: 14,
+		always:  true,
+		name:    "move the jumpbox vars store out of bbl-state.json",
+		changes: func(m Migrator, state State, dirs migrationDirs) []string {
+			return m.varsStoreChanges(state.Jumpbox.Variables, "jumpbox.variables", "jumpbox", dirs.vars)
+		},
+		apply: func(m Migrator, state State, dirs migrationDirs) (State, error) {
+			return m.MigrateJumpboxVars(state, dirs.vars)
+		},
+	},
+	{
+		version: 14,
+		always:  true,
+		name:    "rename the jumpbox vars file",
+		changes: func(m Migrator, state State, dirs migrationDirs) []string {
+			return m.renameChange(dirs.vars, "vars", "jumpbox-deployment-vars.yml", "jumpbox-vars-file.yml")
+		},
+		apply: func(m Migrator, state State, dirs migrationDirs) (State, error) {
+			return state, m.MigrateJumpboxVarsFile(dirs.vars)
+		},
+	},
+	{
+		version: 15,
+		name:    "write the bbl provided terraform variables as JSON",
+		changes: func(m Migrator, state State, dirs migrationDirs) []string {
+			for _, name := range []string{"bbl.tfvars", "terraform.tfvars"} {
+				if _, err := m.fs.Stat(filepath.Join(dirs.vars, name)); err == nil {
+					return []string{"convert vars/bbl.tfvars to vars/bbl.tfvars.json"}
+				}
+			}
+			return nil
+		},
+		apply: func(m Migrator, state State, dirs migrationDirs) (State, error) {
+			return state, m.MigrateTerraformVarsToJSON(dirs.vars)
+		},
+	},
+}
+
+// PlannedMigration is a migration that would change the state directory.
+type PlannedMigration struct {
+	Version int
+	Name    string
+	Changes []string
+}
+
+// MigrationRecord is kept with the backup of the state directory taken
+// before the last migration. LiveState has the sha256 of each of the live
+// state files that the migration left behind.
+type MigrationRecord struct {
+	Created      time.Time              `json:"created"`
+	FromSchema   int                    `json:"from_schema"`
+	ToSchema     int                    `json:"to_schema"`
+	Migrations   []string               `json:"migrations"`
+	BackedUp     map[string]os.FileMode `json:"backed_up"`
+	CreatedFiles []string               `json:"created_files"`
+	LiveState    map[string]string      `json:"live_state"`
+}
+
+// migratableFile is a file of the state directory as it was before a
+// migration.
+type migratableFile struct {
+	contents []byte
+	mode     os.FileMode
+}
+
+// Plan returns the migrations that Migrate would apply to state, without
+// changing the state directory.
+func (m Migrator) Plan(state State) []PlannedMigration {
+	stateDir := m.store.GetStateDir()
+	dirs := migrationDirs{
+		vars:        filepath.Join(stateDir, "vars"),
+		terraform:   filepath.Join(stateDir, "terraform"),
+		cloudConfig: filepath.Join(stateDir, "cloud-config"),
+		oldBbl:      m.store.GetOldBblDir(),
+	}
+
+	return m.plan(state, dirs)
+}
+
+// Revert restores the state directory from the backup taken before the
+// last migration, and removes the files that the migration created. It
+// refuses to when a live state file has changed since the migration, since
+// restoring the backup would roll it back to before that change.
+func (m Migrator) Revert() (MigrationRecord, error) {
+	backupDir := filepath.Join(m.store.GetStateDir(), MIGRATION_BACKUP_DIR)
+
+	record, err := m.readMigrationRecord(backupDir)
+	if err != nil {
+		return MigrationRecord{}, err
+	}
+
+	changed, err := m.changedLiveState(record)
+	if err != nil {
+		return MigrationRecord{}, err
+	}
+	if len(changed) > 0 {
+		return MigrationRecord{}, fmt.Errorf("%s changed after the migration, so reverting it would roll the state of the environment back. Restore the files you need from %s by hand instead.", strings.Join(changed, ", "), MIGRATION_BACKUP_DIR)
+	}
+
+	stateDir := m.store.GetStateDir()
+	for _, name := range record.CreatedFiles {
+		err = m.fs.Remove(filepath.Join(stateDir, filepath.FromSlash(name)))
+		if err != nil && !os.IsNotExist(err) {
+			return MigrationRecord{}, fmt.Errorf("Remove %s: %s", name, err)
+		}
+	}
+
+	for name, mode := range record.BackedUp {
+		contents, err := m.fs.ReadFile(filepath.Join(backupDir, migrationFilesDir, filepath.FromSlash(name)))
+		if err != nil {
+			return MigrationRecord{}, fmt.Errorf("Read backup of %s: %s", name, err)
+		}
+
+		path := filepath.Join(stateDir, filepath.FromSlash(name))
+		err = m.fs.MkdirAll(filepath.Dir(path), os.ModePerm)
+		if err != nil {
+			return MigrationRecord{}, fmt.Errorf("Create %s dir: %s", filepath.Dir(name), err)
+		}
+
+		err = m.fs.WriteFile(path, contents, mode)
+		if err != nil {
+			return MigrationRecord{}, fmt.Errorf("Restore %s: %s", name, err)
+		}
+	}
+
+	err = m.fs.RemoveAll(backupDir)
+	if err != nil {
+		return MigrationRecord{}, fmt.Errorf("Remove migration backup: %s", err)
+	}
+
+	return record, nil
+}
+
+func (m Migrator) plan(state State, dirs migrationDirs) []PlannedMigration {
+	planned := []PlannedMigration{}
+	for _, migration := range migrations {
+		if !migration.appliesTo(state) {
+			continue
+		}
+
+		changes := migration.changes(m, state, dirs)
+		if len(changes) == 0 {
+			continue
+		}
+
+		planned = append(planned, PlannedMigration{
+			Version: migration.version,
+			Name:    migration.name,
+			Changes: changes,
+		})
+	}
+	return planned
+}
+
+// readMigratableFiles reads the state directory, except for the files that
+// bbl plan regenerates, before the migrations are applied.
+func (m Migrator) readMigratableFiles() (map[string]migratableFile, error) {
+	modes, err := m.migratableFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]migratableFile{}
+	for name, mode := range modes {
+		contents, err := m.fs.ReadFile(filepath.Join(m.store.GetStateDir(), filepath.FromSlash(name)))
+		if err != nil {
+			return nil, fmt.Errorf("Read %s: %s", name, err)
+		}
+		files[name] = migratableFile{contents: contents, mode: mode.Perm()}
+	}
+
+	return files, nil
+}
+
+// backup replaces the backup of the last migration with the files as they
+// were before the migrations in planned, when the migrations changed any of
+// them. A migration that changed nothing keeps the earlier backup, so that
+// it can still be reverted.
+func (m Migrator) backup(state State, planned []PlannedMigration, before map[string]migratableFile) error {
+	stateDir := m.store.GetStateDir()
+	backupDir := filepath.Join(stateDir, MIGRATION_BACKUP_DIR)
+
+	after, err := m.migratableFiles()
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	createdFiles := []string{}
+	for name := range after {
+		file, ok := before[name]
+		if !ok {
+			createdFiles = append(createdFiles, name)
+			continue
+		}
+
+		contents, err := m.fs.ReadFile(filepath.Join(stateDir, filepath.FromSlash(name)))
+		if err != nil {
+			return fmt.Errorf("Read %s: %s", name, err)
+		}
+		if !bytes.Equal(contents, file.contents) {
+			changed = true
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			changed = true
+		}
+	}
+	sort.Strings(createdFiles)
+
+	if !changed && len(createdFiles) == 0 {
+		return nil
+	}
+
+	err = m.fs.RemoveAll(backupDir)
+	if err != nil {
+		return fmt.Errorf("Remove previous migration backup: %s", err)
+	}
+
+	record := MigrationRecord{
+		Created:      migrationNow().UTC(),
+		FromSchema:   state.Version,
+		ToSchema:     STATE_SCHEMA,
+		Migrations:   []string{},
+		BackedUp:     map[string]os.FileMode{},
+		CreatedFiles: createdFiles,
+	}
+	for _, migration := range planned {
+		record.Migrations = append(record.Migrations, migration.Name)
+	}
+
+	for name, file := range before {
+		path := filepath.Join(backupDir, migrationFilesDir, filepath.FromSlash(name))
+		err = m.fs.MkdirAll(filepath.Dir(path), os.ModePerm)
+		if err != nil {
+			return fmt.Errorf("Create migration backup dir: %s", err)
+		}
+
+		err = m.fs.WriteFile(path, file.contents, StateMode)
+		if err != nil {
+			return fmt.Errorf("Back up %s: %s", name, err)
+		}
+		record.BackedUp[name] = file.mode
+	}
+
+	record.LiveState, err = m.liveStateSums()
+	if err != nil {
+		return err
+	}
+
+	return m.writeMigrationRecord(backupDir, record)
+}
+
+// liveStateSums has the sha256 of each live state file in the state
+// directory.
+func (m Migrator) liveStateSums() (map[string]string, error) {
+	sums := map[string]string{}
+	for _, name := range liveStateFiles {
+		contents, err := m.fs.ReadFile(filepath.Join(m.store.GetStateDir(), filepath.FromSlash(name)))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("Read %s: %s", name, err)
+		}
+		sums[name] = sha256Hex(contents)
+	}
+	return sums, nil
+}
+
+// changedLiveState lists the live state files that were written, removed
+// or created since the migration of record.
+func (m Migrator) changedLiveState(record MigrationRecord) ([]string, error) {
+	sums, err := m.liveStateSums()
+	if err != nil {
+		return nil, err
+	}
+
+	changed := []string{}
+	for _, name := range liveStateFiles {
+		if sums[name] != record.LiveState[name] {
+			changed = append(changed, name)
+		}
+	}
+	return changed, nil
+}
+
+func (m Migrator) migratableFiles() (map[string]os.FileMode, error) {
+	return walkFiles(m.fs, m.store.GetStateDir(), func(name string, info os.FileInfo) bool {
+		if info.IsDir() {
+			return info.Name() == ".terraform" || name == MIGRATION_BACKUP_DIR || name == SNAPSHOTS_DIR ||
+				name == "bosh-deployment" || name == "jumpbox-deployment"
+		}
+		return name == LOCK_FILE || name == SYNC_FILE
+	})
+}
+
+func (m Migrator) readMigrationRecord(backupDir string) (MigrationRecord, error) {
+	contents, err := m.fs.ReadFile(filepath.Join(backupDir, migrationRecordFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return MigrationRecord{}, errors.New("There is no migration to revert.")
+		}
+		return MigrationRecord{}, fmt.Errorf("Read migration record: %s", err)
+	}
+
+	var record MigrationRecord
+	err = json.Unmarshal(contents, &record)
+	if err != nil {
+		return MigrationRecord{}, fmt.Errorf("Read migration record: %s", err)
+	}
+
+	return record, nil
+}
+
+func (m Migrator) writeMigrationRecord(backupDir string, record MigrationRecord) error {
+	contents, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err // not tested
+	}
+
+	err = m.fs.MkdirAll(backupDir, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("Create migration backup dir: %s", err)
+	}
+
+	err = m.fs.WriteFile(filepath.Join(backupDir, migrationRecordFile), contents, StateMode)
+	if err != nil {
+		return fmt.Errorf("Write migration record: %s", err)
+	}
+
+	return nil
+}
+
+func (m Migrator) renameChange(dir, relativeDir, from, to string) []string {
+	if _, err := m.fs.Stat(filepath.Join(dir, from)); err != nil {
+		return nil
+	}
+	return []string{fmt.Sprintf("move %s/%s to %s/%s", relativeDir, from, relativeDir, to)}
+}
+
+func (m Migrator) varsStoreChanges(variables, field, deployment, varsDir string) []string {
+	changes := []string{}
+
+	legacyVarsStore := fmt.Sprintf("%s-variables.yml", deployment)
+	if _, err := m.fs.Stat(filepath.Join(varsDir, legacyVarsStore)); err == nil {
+		if variables == "" {
+			changes = append(changes, fmt.Sprintf("move vars/%s to vars/%s-vars-store.yml", legacyVarsStore, deployment))
+		} else {
+			changes = append(changes, fmt.Sprintf("remove vars/%s", legacyVarsStore))
+		}
+	}
+
+	if variables != "" {
+		changes = append(changes, fmt.Sprintf("move %s from bbl-state.json to vars/%s-vars-store.yml", field, deployment))
+	}
+
+	return changes
+}
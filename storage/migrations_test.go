@@ -0,0 +1,223 @@
+package storage_test
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Migrations", func() {
+	var (
+		fs       *afero.Afero
+		store    *fakes.StateStore
+		migrator storage.Migrator
+		state    storage.State
+	)
+
+	BeforeEach(func() {
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+		store = &fakes.StateStore{}
+		store.GetStateDirCall.Returns.Directory = "/state"
+		store.GetVarsDirCall.Returns.Directory = "/state/vars"
+		store.GetTerraformDirCall.Returns.Directory = "/state/terraform"
+		store.GetCloudConfigDirCall.Returns.Directory = "/state/cloud-config"
+		store.GetOldBblDirCall.Returns.Directory = "/state/.bbl"
+		migrator = storage.NewMigrator(store, fs)
+
+		state = storage.State{
+			Version: 13,
+			EnvID:   "some-env",
+			BOSH:    storage.BOSH{Variables: "some-director-vars"},
+		}
+
+		fs.WriteFile("/state/bbl-state.json", []byte("some-old-state"), 0644)
+		fs.WriteFile("/state/vars/terraform.tfvars", []byte(`env_id="some-env"`), storage.StateMode)
+		fs.WriteFile("/state/create-director-override.sh", []byte("some-script"), 0755)
+		fs.WriteFile("/state/terraform/.terraform/some-plugin", []byte("some-plugin"), 0755)
+	})
+
+	Describe("Plan", func() {
+		It("describes the changes without making them", func() {
+			planned := migrator.Plan(state)
+			Expect(planned).To(Equal([]storage.PlannedMigration{
+				{
+					Version: 14,
+					Name:    "rename the bbl provided terraform variables",
+					Changes: []string{"move vars/terraform.tfvars to vars/bbl.tfvars"},
+				},
+				{
+					Version: 14,
+					Name:    "move the director vars store out of bbl-state.json",
+					Changes: []string{"move bosh.variables from bbl-state.json to vars/director-vars-store.yml"},
+				},
+				{
+					Version: 15,
+					Name:    "write the bbl provided terraform variables as JSON",
+					Changes: []string{"convert vars/bbl.tfvars to vars/bbl.tfvars.json"},
+				},
+			}))
+
+			_, err := fs.Stat("/state/vars/terraform.tfvars")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = fs.Stat("/state/.migration-backup")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		Context("when the state has the current schema", func() {
+			It("plans only the migrations that predate the registry", func() {
+				state.Version = storage.STATE_SCHEMA
+				Expect(migrator.Plan(state)).To(Equal([]storage.PlannedMigration{
+					{
+						Version: 14,
+						Name:    "rename the bbl provided terraform variables",
+						Changes: []string{"move vars/terraform.tfvars to vars/bbl.tfvars"},
+					},
+					{
+						Version: 14,
+						Name:    "move the director vars store out of bbl-state.json",
+						Changes: []string{"move bosh.variables from bbl-state.json to vars/director-vars-store.yml"},
+					},
+				}))
+			})
+
+			Context("when the state dir is in the current layout", func() {
+				It("plans nothing", func() {
+					state.Version = storage.STATE_SCHEMA
+					state.BOSH.Variables = ""
+					Expect(fs.Remove("/state/vars/terraform.tfvars")).To(Succeed())
+
+					Expect(migrator.Plan(state)).To(BeEmpty())
+				})
+			})
+		})
+	})
+
+	Describe("Migrate", func() {
+		It("backs up the state directory and records the migration", func() {
+			migrated, err := migrator.Migrate(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrated.BOSH.Variables).To(BeEmpty())
+
+			contents, err := fs.ReadFile("/state/.migration-backup/files/vars/terraform.tfvars")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal(`env_id="some-env"`))
+
+			_, err = fs.Stat("/state/.migration-backup/files/terraform/.terraform/some-plugin")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			contents, err = fs.ReadFile("/state/.migration-backup/migration.json")
+			Expect(err).NotTo(HaveOccurred())
+
+			var record storage.MigrationRecord
+			Expect(json.Unmarshal(contents, &record)).To(Succeed())
+			Expect(record.FromSchema).To(Equal(13))
+			Expect(record.ToSchema).To(Equal(storage.STATE_SCHEMA))
+			Expect(record.Migrations).To(Equal([]string{
+				"rename the bbl provided terraform variables",
+				"move the director vars store out of bbl-state.json",
+				"write the bbl provided terraform variables as JSON",
+			}))
+			Expect(record.CreatedFiles).To(Equal([]string{"vars/bbl.tfvars.json", "vars/director-vars-store.yml"}))
+
+			contents, err = fs.ReadFile("/state/vars/bbl.tfvars.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(contents).To(MatchJSON(`{"env_id": "some-env"}`))
+		})
+
+		Context("when a later migration changes nothing", func() {
+			It("keeps the backup of the earlier migration", func() {
+				_, err := migrator.Migrate(state)
+				Expect(err).NotTo(HaveOccurred())
+
+				state.BOSH.Variables = ""
+				fs.WriteFile("/state/vars/bbl.tfvars", []byte("ca_cert = <<EOF\nsome-cert\nEOF\n"), storage.StateMode)
+				Expect(migrator.Plan(state)).NotTo(BeEmpty())
+
+				_, err = migrator.Migrate(state)
+				Expect(err).NotTo(HaveOccurred())
+
+				contents, err := fs.ReadFile("/state/.migration-backup/files/vars/terraform.tfvars")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal(`env_id="some-env"`))
+
+				contents, err = fs.ReadFile("/state/.migration-backup/migration.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var record storage.MigrationRecord
+				Expect(json.Unmarshal(contents, &record)).To(Succeed())
+				Expect(record.CreatedFiles).To(Equal([]string{"vars/bbl.tfvars.json", "vars/director-vars-store.yml"}))
+			})
+		})
+
+		Context("when nothing needs migrating", func() {
+			It("does not take a backup", func() {
+				state.Version = storage.STATE_SCHEMA
+				state.BOSH.Variables = ""
+				Expect(fs.Remove("/state/vars/terraform.tfvars")).To(Succeed())
+
+				_, err := migrator.Migrate(state)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = fs.Stat("/state/.migration-backup")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Revert", func() {
+		It("restores the state directory as it was before the migration", func() {
+			_, err := migrator.Migrate(state)
+			Expect(err).NotTo(HaveOccurred())
+
+			record, err := migrator.Revert()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record.FromSchema).To(Equal(13))
+
+			contents, err := fs.ReadFile("/state/vars/terraform.tfvars")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal(`env_id="some-env"`))
+
+			info, err := fs.Stat("/state/create-director-override.sh")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+
+			_, err = fs.Stat("/state/vars/bbl.tfvars")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+			_, err = fs.Stat("/state/vars/bbl.tfvars.json")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+			_, err = fs.Stat("/state/.migration-backup")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		Context("when the live state changed after the migration", func() {
+			It("returns an error without restoring anything", func() {
+				_, err := migrator.Migrate(state)
+				Expect(err).NotTo(HaveOccurred())
+
+				fs.WriteFile("/state/vars/terraform.tfstate", []byte("some-applied-state"), storage.StateMode)
+				fs.WriteFile("/state/vars/director-vars-store.yml", []byte("some-new-vars"), storage.StateMode)
+
+				_, err = migrator.Revert()
+				Expect(err).To(MatchError("vars/terraform.tfstate, vars/director-vars-store.yml changed after the migration, so reverting it would roll the state of the environment back. Restore the files you need from .migration-backup by hand instead."))
+
+				_, err = fs.Stat("/state/vars/terraform.tfvars")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+				_, err = fs.Stat("/state/.migration-backup/migration.json")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when there is no backup", func() {
+			It("returns an error", func() {
+				_, err := migrator.Revert()
+				Expect(err).To(MatchError("There is no migration to revert."))
+			})
+		})
+	})
+})
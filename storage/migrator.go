@@ -11,6 +11,7 @@ import (
 
 type store interface {
 	Set(state State) error
+	GetStateDir() string
 	GetVarsDir() (string, error)
 	GetTerraformDir() (string, error)
 	GetOldBblDir() string
@@ -25,6 +26,7 @@ type migratorFs interface {
 	fileio.FileWriter
 	fileio.Remover
 	fileio.AllRemover
+	fileio.AllMkdirer
 }
 
 type Migrator struct {
@@ -36,6 +38,8 @@ func NewMigrator(store store, fs migratorFs) Migrator {
 	return Migrator{store: store, fs: fs}
 }
 
+// Migrate applies the registered migrations that the state directory
+// needs, after backing it up so that the migration can be reverted.
 func (m Migrator) Migrate(state State) (State, error) {
 	if reflect.DeepEqual(state, State{}) {
 		return state, nil
@@ -46,64 +50,57 @@ func (m Migrator) Migrate(state State) (State, error) {
 		return State{}, fmt.Errorf("migrating state: %s", err)
 	}
 
-	state, err = m.MigrateTerraformState(state, varsDir)
-	if err != nil {
-		return State{}, err
-	}
-
 	terraformDir, err := m.store.GetTerraformDir()
 	if err != nil {
 		return State{}, fmt.Errorf("migrating terraform: %s", err)
 	}
 
-	err = m.MigrateTerraformTemplate(terraformDir)
+	cloudConfigDir, err := m.store.GetCloudConfigDir()
 	if err != nil {
-		return State{}, err
+		return State{}, fmt.Errorf("getting cloud-config dir: %s", err)
 	}
 
-	state, err = m.MigrateDirectorState(state, varsDir)
-	if err != nil {
-		return State{}, err
+	dirs := migrationDirs{
+		vars:        varsDir,
+		terraform:   terraformDir,
+		cloudConfig: cloudConfigDir,
+		oldBbl:      m.store.GetOldBblDir(),
 	}
 
-	state, err = m.MigrateJumpboxState(state, varsDir)
-	if err != nil {
-		return State{}, err
+	planned := m.plan(state, dirs)
+	if len(planned) == 0 {
+		return m.applyMigrations(state, dirs)
 	}
 
-	bblDir := m.store.GetOldBblDir()
-	cloudConfigDir, err := m.store.GetCloudConfigDir()
-	if err != nil {
-		return State{}, fmt.Errorf("getting cloud-config dir: %s", err)
-	}
-	err = m.MigrateCloudConfigDir(bblDir, cloudConfigDir)
+	before, err := m.readMigratableFiles()
 	if err != nil {
-		return State{}, err
+		return State{}, fmt.Errorf("backing up state: %s", err)
 	}
 
-	err = m.MigrateTerraformVars(varsDir)
-	if err != nil {
-		return State{}, err
-	}
+	migrated, err := m.applyMigrations(state, dirs)
 
-	state, err = m.MigrateDirectorVars(state, varsDir)
+	backupErr := m.backup(state, planned, before)
 	if err != nil {
 		return State{}, err
 	}
-
-	err = m.MigrateDirectorVarsFile(varsDir)
-	if err != nil {
-		return State{}, err
+	if backupErr != nil {
+		return State{}, fmt.Errorf("backing up state: %s", backupErr)
 	}
 
-	state, err = m.MigrateJumpboxVars(state, varsDir)
-	if err != nil {
-		return State{}, err
-	}
+	return migrated, nil
+}
 
-	err = m.MigrateJumpboxVarsFile(varsDir)
-	if err != nil {
-		return State{}, err
+func (m Migrator) applyMigrations(state State, dirs migrationDirs) (State, error) {
+	var err error
+	for _, migration := range migrations {
+		if !migration.appliesTo(state) {
+			continue
+		}
+
+		state, err = migration.apply(m, state, dirs)
+		if err != nil {
+			return State{}, err
+		}
 	}
 
 	err = m.store.Set(state)
@@ -211,6 +208,44 @@ func (m Migrator) MigrateTerraformVars(varsDir string) error {
 	return nil
 }
 
+// MigrateTerraformVarsToJSON rewrites the HCL variables file that bbl used
+// to write as bbl.tfvars.json. A bbl.tfvars that was edited beyond what bbl
+// wrote, with heredocs or expressions, is left in place for terraform to read
+// and LegacyTerraformOverrides warns about it.
+func (m Migrator) MigrateTerraformVarsToJSON(varsDir string) error {
+	hclVarsPath := filepath.Join(varsDir, "bbl.tfvars")
+	if _, err := m.fs.Stat(hclVarsPath); err != nil {
+		return nil
+	}
+
+	contents, err := m.fs.ReadFile(hclVarsPath)
+	if err != nil {
+		return fmt.Errorf("reading tfvars: %s", err)
+	}
+
+	vars, err := parseTFVars(string(contents))
+	if err != nil {
+		return nil
+	}
+
+	varsJSON, err := json.MarshalIndent(vars, "", "  ")
+	if err != nil {
+		return fmt.Errorf("converting tfvars to JSON: %s", err) //not tested
+	}
+
+	err = m.fs.WriteFile(filepath.Join(varsDir, "bbl.tfvars.json"), append(varsJSON, '\n'), StateMode)
+	if err != nil {
+		return fmt.Errorf("writing tfvars: %s", err)
+	}
+
+	err = m.fs.Remove(hclVarsPath)
+	if err != nil {
+		return fmt.Errorf("removing tfvars: %s", err)
+	}
+
+	return nil
+}
+
 func (m Migrator) burnAfterReadingLegacyVarsStore(varsDir, deployment string) (string, error) {
 	legacyVarsStore := filepath.Join(varsDir, fmt.Sprintf("%s-variables.yml", deployment))
 	if _, err := m.fs.Stat(legacyVarsStore); err == nil {
@@ -251,6 +251,76 @@ var _ = Describe("Migrator", func() {
 		})
 	})
 
+	Describe("MigrateTerraformVarsToJSON", func() {
+		BeforeEach(func() {
+			fileIO.ReadFileCall.Returns.Contents = []byte(`
+availability_zones=["us-east-1a","us-east-1b"]
+isolation_segments=1
+ssl_certificate="-----BEGIN CERTIFICATE-----\nsome \"quoted\" $${cert}\n"
+system_domain="some-domain"`)
+		})
+
+		It("converts bbl.tfvars to bbl.tfvars.json", func() {
+			err := migrator.MigrateTerraformVarsToJSON(varsDir)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fileIO.ReadFileCall.Receives.Filename).To(Equal(filepath.Join(varsDir, "bbl.tfvars")))
+			Expect(fileIO.WriteFileCall.Receives[0].Filename).To(Equal(filepath.Join(varsDir, "bbl.tfvars.json")))
+			Expect(fileIO.WriteFileCall.Receives[0].Contents).To(MatchJSON(`{
+				"availability_zones": ["us-east-1a", "us-east-1b"],
+				"isolation_segments": 1,
+				"ssl_certificate": "-----BEGIN CERTIFICATE-----\nsome \"quoted\" ${cert}\n",
+				"system_domain": "some-domain"
+			}`))
+			Expect(fileIO.RemoveCall.Receives).To(Equal([]fakes.RemoveReceive{{Name: filepath.Join(varsDir, "bbl.tfvars")}}))
+		})
+
+		Context("when there is no bbl.tfvars", func() {
+			It("does nothing", func() {
+				fileIO.StatCall.Returns.Error = errors.New("no such file")
+
+				err := migrator.MigrateTerraformVarsToJSON(varsDir)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fileIO.WriteFileCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when bbl.tfvars has values that bbl did not write", func() {
+			It("leaves it in place", func() {
+				fileIO.ReadFileCall.Returns.Contents = []byte("region = \"us-east-1\"\nca_cert = <<EOF\nsome-cert\nEOF\n")
+
+				err := migrator.MigrateTerraformVarsToJSON(varsDir)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fileIO.WriteFileCall.CallCount).To(Equal(0))
+				Expect(fileIO.RemoveCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("failure cases", func() {
+			It("returns an error when bbl.tfvars cannot be read", func() {
+				fileIO.ReadFileCall.Returns.Error = errors.New("mango")
+
+				err := migrator.MigrateTerraformVarsToJSON(varsDir)
+				Expect(err).To(MatchError("reading tfvars: mango"))
+			})
+
+			It("returns an error when bbl.tfvars.json cannot be written", func() {
+				fileIO.WriteFileCall.Returns = []fakes.WriteFileReturn{{Error: errors.New("melon")}}
+
+				err := migrator.MigrateTerraformVarsToJSON(varsDir)
+				Expect(err).To(MatchError("writing tfvars: melon"))
+				Expect(fileIO.RemoveCall.CallCount).To(Equal(0))
+			})
+
+			It("returns an error when bbl.tfvars cannot be removed", func() {
+				fileIO.RemoveCall.Returns = []fakes.RemoveReturn{{Error: errors.New("lychee")}}
+
+				err := migrator.MigrateTerraformVarsToJSON(varsDir)
+				Expect(err).To(MatchError("removing tfvars: lychee"))
+			})
+		})
+	})
+
 	Describe("MigrateCloudConfigDir", func() {
 		Context("when the state has a populated .bbl directory", func() {
 			BeforeEach(func() {
@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+)
+
+// OverrideScripts are the scripts that bbl runs instead of the ones bbl plan
+// generates, when they are in the state dir.
+var OverrideScripts = []string{
+	"create-jumpbox-override.sh",
+	"create-director-override.sh",
+	"delete-jumpbox-override.sh",
+	"delete-director-override.sh",
+}
+
+// Override records the base script that an override script was written
+// against, so that bbl can tell when bbl plan has changed the base script
+// since.
+type Override struct {
+	Script         string `json:"script"`
+	BaseSHA256     string `json:"base_sha256"`
+	OverrideSHA256 string `json:"override_sha256"`
+}
+
+// BaseScript is the script bbl plan generates that script overrides.
+func BaseScript(script string) string {
+	return strings.Replace(script, "-override", "", 1)
+}
+
+// OverrideTracker fingerprints the override scripts in a state dir and the
+// base scripts they were derived from.
+type OverrideTracker struct {
+	fs fileio.FileReader
+}
+
+func NewOverrideTracker(fs fileio.FileReader) OverrideTracker {
+	return OverrideTracker{
+		fs: fs,
+	}
+}
+
+// Track returns overrides with a record for each override script in
+// stateDir. An override script that is new, or was edited since it was
+// recorded, is taken to match the base script as it is now. Override scripts
+// without a base script yet are recorded once bbl plan has written one.
+func (o OverrideTracker) Track(stateDir string, overrides []Override) ([]Override, error) {
+	recorded := map[string]Override{}
+	for _, override := range overrides {
+		recorded[override.Script] = override
+	}
+
+	var tracked []Override
+	for _, script := range OverrideScripts {
+		overrideSum, err := o.sum(stateDir, script)
+		if err != nil {
+			return nil, err
+		}
+		if overrideSum == "" {
+			continue
+		}
+
+		if override, ok := recorded[script]; ok && override.OverrideSHA256 == overrideSum {
+			tracked = append(tracked, override)
+			continue
+		}
+
+		baseSum, err := o.sum(stateDir, BaseScript(script))
+		if err != nil {
+			return nil, err
+		}
+		if baseSum == "" {
+			continue
+		}
+
+		tracked = append(tracked, Override{
+			Script:         script,
+			BaseSHA256:     baseSum,
+			OverrideSHA256: overrideSum,
+		})
+	}
+
+	return tracked, nil
+}
+
+// Stale returns the overrides whose base script has changed since the
+// override script was written.
+func (o OverrideTracker) Stale(stateDir string, overrides []Override) ([]Override, error) {
+	stale := []Override{}
+	for _, override := range overrides {
+		baseSum, err := o.sum(stateDir, BaseScript(override.Script))
+		if err != nil {
+			return nil, err
+		}
+
+		if baseSum != override.BaseSHA256 {
+			stale = append(stale, override)
+		}
+	}
+
+	return stale, nil
+}
+
+// sum is the checksum of a script in stateDir, or empty when there is no
+// such script.
+func (o OverrideTracker) sum(stateDir, script string) (string, error) {
+	contents, err := o.fs.ReadFile(filepath.Join(stateDir, script))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("Read %s: %s", script, err)
+	}
+
+	return sha256Hex(contents), nil
+}
@@ -0,0 +1,110 @@
+package storage_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OverrideTracker", func() {
+	var (
+		fs      *afero.Afero
+		tracker storage.OverrideTracker
+	)
+
+	sum := func(contents string) string {
+		return fmt.Sprintf("%x", sha256.Sum256([]byte(contents)))
+	}
+
+	BeforeEach(func() {
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+		tracker = storage.NewOverrideTracker(fs)
+
+		fs.WriteFile("/state/create-director.sh", []byte("bosh create-env"), 0755)
+		fs.WriteFile("/state/create-director-override.sh", []byte("bosh create-env -o my-ops.yml"), 0755)
+		fs.WriteFile("/state/create-jumpbox.sh", []byte("bosh create-env jumpbox"), 0755)
+	})
+
+	Describe("Track", func() {
+		It("records each override script against its base script", func() {
+			overrides, err := tracker.Track("/state", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(overrides).To(Equal([]storage.Override{{
+				Script:         "create-director-override.sh",
+				BaseSHA256:     sum("bosh create-env"),
+				OverrideSHA256: sum("bosh create-env -o my-ops.yml"),
+			}}))
+		})
+
+		It("keeps the base script an unchanged override was written against", func() {
+			recorded := []storage.Override{{
+				Script:         "create-director-override.sh",
+				BaseSHA256:     "old-base-sum",
+				OverrideSHA256: sum("bosh create-env -o my-ops.yml"),
+			}}
+
+			overrides, err := tracker.Track("/state", recorded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(overrides).To(Equal(recorded))
+		})
+
+		It("takes an edited override to match the base script as it is now", func() {
+			overrides, err := tracker.Track("/state", []storage.Override{{
+				Script:         "create-director-override.sh",
+				BaseSHA256:     "old-base-sum",
+				OverrideSHA256: "old-override-sum",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(overrides).To(HaveLen(1))
+			Expect(overrides[0].BaseSHA256).To(Equal(sum("bosh create-env")))
+		})
+
+		It("forgets override scripts that were removed", func() {
+			fs.Remove("/state/create-director-override.sh")
+
+			overrides, err := tracker.Track("/state", []storage.Override{{
+				Script:         "create-director-override.sh",
+				BaseSHA256:     sum("bosh create-env"),
+				OverrideSHA256: "some-override-sum",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(overrides).To(BeEmpty())
+		})
+
+		Context("when bbl plan has not written the base script yet", func() {
+			It("does not record the override", func() {
+				fs.WriteFile("/state/delete-director-override.sh", []byte("bosh delete-env"), 0755)
+
+				overrides, err := tracker.Track("/state", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(overrides).To(HaveLen(1))
+				Expect(overrides[0].Script).To(Equal("create-director-override.sh"))
+			})
+		})
+	})
+
+	Describe("Stale", func() {
+		It("returns the overrides whose base script has changed", func() {
+			current := storage.Override{
+				Script:     "create-director-override.sh",
+				BaseSHA256: sum("bosh create-env"),
+			}
+			stale := storage.Override{
+				Script:     "create-jumpbox-override.sh",
+				BaseSHA256: sum("bosh create-env jumpbox --old-flag"),
+			}
+
+			overrides, err := tracker.Stale("/state", []storage.Override{current, stale})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(overrides).To(Equal([]storage.Override{stale}))
+		})
+	})
+})
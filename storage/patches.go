@@ -0,0 +1,351 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+)
+
+// Patch records a plan patch that bbl plan --patch copied into the state
+// dir, with the checksum of each file so that edits can be noticed.
+type Patch struct {
+	Name   string            `json:"name"`
+	Source string            `json:"source"`
+	SHA256 string            `json:"sha256"`
+	Files  map[string]string `json:"files"`
+}
+
+// PatchDrift is a file from a patch that no longer matches what the patch
+// copied into the state dir.
+type PatchDrift struct {
+	Patch  string
+	Path   string
+	Status string
+}
+
+const (
+	PATCH_FILE_EDITED  = "edited"
+	PATCH_FILE_MISSING = "missing"
+)
+
+// BuiltinPatchPrefix marks a patch that is embedded in bbl rather than read
+// from a directory, as in bbl plan --patch builtin:iso-segs-aws.
+const BuiltinPatchPrefix = "builtin:"
+
+// replacedPatches are the plan patches that a bbl plan flag has replaced,
+// with the flag to use instead. Their terraform files would fight with the
+// backend that bbl writes to terraform/bbl-backend.tf.
+var replacedPatches = map[string]string{
+	"tf-backend-aws": "--terraform-backend s3",
+	"tf-backend-gcp": "--terraform-backend gcs",
+}
+
+// patchDocs are read by people applying the patch and are not copied.
+var patchDocs = map[string]struct{}{
+	"README.md": {},
+	"PATCH.md":  {},
+}
+
+var patchScripts = map[string]struct{}{
+	"create-jumpbox-override.sh":  {},
+	"create-director-override.sh": {},
+	"delete-jumpbox-override.sh":  {},
+	"delete-director-override.sh": {},
+}
+
+// patchGenerated are written by bbl plan, which would undo a patch of them.
+var patchGenerated = map[string]struct{}{
+	"terraform/bbl-template.tf":     {},
+	"terraform/bbl-backend.tf":      {},
+	"cloud-config/cloud-config.yml": {},
+	"cloud-config/ops.yml":          {},
+}
+
+type patcherFs interface {
+	fileio.FileReader
+	fileio.FileWriter
+	fileio.AllMkdirer
+	fileio.DirReader
+	fileio.Remover
+}
+
+type patchSource interface {
+	fileio.DirReader
+	fileio.FileReader
+}
+
+// Patcher copies plan patches into a state dir and keeps track of the files
+// they own.
+type Patcher struct {
+	fs       patcherFs
+	builtins patchSource
+}
+
+func NewPatcher(fs patcherFs, builtins patchSource) Patcher {
+	return Patcher{
+		fs:       fs,
+		builtins: builtins,
+	}
+}
+
+// Apply copies the patch in patchDir into stateDir and returns patches with
+// a record of it. A patchDir of builtin:<name> applies the embedded patch of
+// that name. A patch that was applied before is upgraded: its files are
+// replaced and the ones the new version no longer has are removed.
+func (p Patcher) Apply(stateDir, patchDir string, patches []Patch) ([]Patch, error) {
+	var source patchSource = p.fs
+	name := filepath.Base(filepath.Clean(patchDir))
+
+	builtin := strings.HasPrefix(patchDir, BuiltinPatchPrefix)
+	if builtin {
+		name = strings.TrimPrefix(patchDir, BuiltinPatchPrefix)
+	}
+
+	if flag, ok := replacedPatches[name]; ok {
+		return nil, fmt.Errorf("Patch %s is replaced by bbl plan --terraform-backend. Use bbl plan %s instead.", name, flag)
+	}
+
+	if builtin {
+		if !p.isBuiltin(name) {
+			return nil, fmt.Errorf("There is no builtin patch named %s. Run bbl patches available to list them.", name)
+		}
+		source, patchDir = p.builtins, name
+	}
+
+	files, err := walkFiles(source, patchDir, func(name string, info os.FileInfo) bool {
+		_, isDoc := patchDocs[name]
+		return isDoc
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Read patch %s: %s", name, err)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("Patch %s has no files.", name)
+	}
+
+	owners := map[string]Patch{}
+	var previous Patch
+	for _, patch := range patches {
+		if patch.Name == name {
+			previous = patch
+			continue
+		}
+		for file := range patch.Files {
+			owners[file] = patch
+		}
+	}
+
+	contents := map[string][]byte{}
+	sums := map[string]string{}
+	for file := range files {
+		if err := validatePatchFile(file); err != nil {
+			return nil, fmt.Errorf("Patch %s: %s", name, err)
+		}
+
+		if owner, ok := owners[file]; ok {
+			return nil, fmt.Errorf("Patch %s: %s is already from patch %s.", name, file, owner.Name)
+		}
+
+		data, err := source.ReadFile(filepath.Join(patchDir, filepath.FromSlash(file)))
+		if err != nil {
+			return nil, fmt.Errorf("Read %s: %s", file, err)
+		}
+		contents[file] = data
+		sums[file] = sha256Hex(data)
+
+		if _, ok := previous.Files[file]; ok {
+			continue
+		}
+
+		existing, err := p.fs.ReadFile(filepath.Join(stateDir, filepath.FromSlash(file)))
+		if err == nil && sha256Hex(existing) != sums[file] {
+			return nil, fmt.Errorf("Patch %s: %s already exists in the state dir and is not from a patch. Remove it first.", name, file)
+		}
+	}
+
+	for file := range previous.Files {
+		if _, ok := files[file]; ok {
+			continue
+		}
+		err := p.fs.Remove(filepath.Join(stateDir, filepath.FromSlash(file)))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("Remove %s: %s", file, err)
+		}
+	}
+
+	for file, data := range contents {
+		target := filepath.Join(stateDir, filepath.FromSlash(file))
+		err := p.fs.MkdirAll(filepath.Dir(target), os.ModePerm)
+		if err != nil {
+			return nil, fmt.Errorf("Create %s: %s", path.Dir(file), err)
+		}
+
+		err = p.fs.WriteFile(target, data, files[file].Perm())
+		if err != nil {
+			return nil, fmt.Errorf("Write %s: %s", file, err)
+		}
+	}
+
+	applied := Patch{
+		Name:   name,
+		Source: patchSourceName(builtin, patchDir),
+		SHA256: patchSum(sums),
+		Files:  sums,
+	}
+
+	result := []Patch{}
+	for _, patch := range patches {
+		if patch.Name != name {
+			result = append(result, patch)
+		}
+	}
+	result = append(result, applied)
+
+	return result, nil
+}
+
+// isBuiltin checks that name is a top level directory of the embedded patches.
+func (p Patcher) isBuiltin(name string) bool {
+	if name == "" || strings.ContainsAny(name, `/\.`) {
+		return false
+	}
+	_, err := p.builtins.ReadDir(name)
+	return err == nil
+}
+
+// Remove deletes the files of the named patch from stateDir and returns
+// patches without it.
+func (p Patcher) Remove(stateDir, name string, patches []Patch) ([]Patch, error) {
+	result := []Patch{}
+	var removed *Patch
+	for i, patch := range patches {
+		if patch.Name == name {
+			removed = &patches[i]
+			continue
+		}
+		result = append(result, patch)
+	}
+
+	if removed == nil {
+		return nil, fmt.Errorf("There is no applied patch named %s.", name)
+	}
+
+	for _, file := range sortedKeys(removed.Files) {
+		err := p.fs.Remove(filepath.Join(stateDir, filepath.FromSlash(file)))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("Remove %s: %s", file, err)
+		}
+	}
+
+	return result, nil
+}
+
+// Drift returns the files from patches that were edited or removed since
+// the patches were applied.
+func (p Patcher) Drift(stateDir string, patches []Patch) ([]PatchDrift, error) {
+	drift := []PatchDrift{}
+	for _, patch := range patches {
+		for _, file := range sortedKeys(patch.Files) {
+			data, err := p.fs.ReadFile(filepath.Join(stateDir, filepath.FromSlash(file)))
+			if err != nil {
+				if os.IsNotExist(err) {
+					drift = append(drift, PatchDrift{Patch: patch.Name, Path: file, Status: PATCH_FILE_MISSING})
+					continue
+				}
+				return nil, fmt.Errorf("Read %s: %s", file, err)
+			}
+
+			if sha256Hex(data) != patch.Files[file] {
+				drift = append(drift, PatchDrift{Patch: patch.Name, Path: file, Status: PATCH_FILE_EDITED})
+			}
+		}
+	}
+
+	return drift, nil
+}
+
+// validatePatchFile accepts the files a plan patch may hold: terraform
+// templates, cloud-config, jumpbox and director ops files, terraform and
+// director variables, override scripts and the ops files those scripts use.
+func validatePatchFile(file string) error {
+	if _, ok := patchGenerated[file]; ok {
+		return fmt.Errorf("%s is generated by bbl plan and cannot be patched.", file)
+	}
+
+	dir, base := path.Split(file)
+	switch dir {
+	case "":
+		if _, ok := patchScripts[base]; ok || strings.HasSuffix(base, ".yml") {
+			return nil
+		}
+	case "terraform/":
+		if strings.HasSuffix(base, ".tf") {
+			return nil
+		}
+	case "cloud-config/", "jumpbox-ops/", "director-ops/":
+		if strings.HasSuffix(base, ".yml") {
+			return nil
+		}
+	case "director-vars/":
+		return nil
+	case "vars/":
+		if _, ok := bblManaged[base]; ok {
+			return fmt.Errorf("%s is managed by bbl and cannot be patched.", file)
+		}
+		if isTFVarsFile(base) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s does not belong in a plan patch. Patches hold terraform/*.tf, cloud-config/*.yml, jumpbox-ops/*.yml, director-ops/*.yml, director-vars, vars/*.tfvars and override scripts.", file)
+}
+
+// patchSourceName is where a patch came from, for bbl patches list: the
+// absolute path of its directory, or builtin:<name>.
+func patchSourceName(builtin bool, patchDir string) string {
+	if builtin {
+		return BuiltinPatchPrefix + patchDir
+	}
+
+	absolutePatchDir, err := filepath.Abs(patchDir)
+	if err != nil {
+		return patchDir //not tested
+	}
+	return absolutePatchDir
+}
+
+func isTFVarsFile(name string) bool {
+	return strings.HasSuffix(name, ".tfvars") || strings.HasSuffix(name, ".tfvars.json")
+}
+
+// patchSum is the checksum of a patch as a whole, from the checksums of its
+// files.
+func patchSum(sums map[string]string) string {
+	hash := sha256.New()
+	for _, file := range sortedKeys(sums) {
+		fmt.Fprintf(hash, "%s  %s\n", sums[file], file)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
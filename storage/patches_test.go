@@ -0,0 +1,239 @@
+package storage_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/patches"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Patcher", func() {
+	var (
+		fs       *afero.Afero
+		builtins *afero.Afero
+		patcher  storage.Patcher
+	)
+
+	BeforeEach(func() {
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+		builtins = &afero.Afero{Fs: afero.NewMemMapFs()}
+		patcher = storage.NewPatcher(fs, builtins)
+
+		fs.WriteFile("/patches/some-patch/README.md", []byte("read me"), 0644)
+		fs.WriteFile("/patches/some-patch/create-director-override.sh", []byte("some-script"), 0755)
+		fs.WriteFile("/patches/some-patch/terraform/some_override.tf", []byte("some-override"), 0644)
+		fs.WriteFile("/patches/some-patch/cloud-config/some-ops.yml", []byte("some-ops"), 0644)
+		fs.WriteFile("/patches/some-patch/director-ops/some-director-ops.yml", []byte("some-director-ops"), 0644)
+		fs.WriteFile("/state/terraform/bbl-template.tf", []byte("generated"), 0644)
+	})
+
+	Describe("Apply", func() {
+		It("copies the patch into the state dir and records it", func() {
+			patches, err := patcher.Apply("/state", "/patches/some-patch", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fs.ReadFile("/state/terraform/some_override.tf")).To(Equal([]byte("some-override")))
+			Expect(fs.ReadFile("/state/cloud-config/some-ops.yml")).To(Equal([]byte("some-ops")))
+			Expect(fs.ReadFile("/state/create-director-override.sh")).To(Equal([]byte("some-script")))
+			Expect(fs.ReadFile("/state/director-ops/some-director-ops.yml")).To(Equal([]byte("some-director-ops")))
+			Expect(fs.Exists("/state/README.md")).To(BeFalse())
+
+			info, err := fs.Stat("/state/create-director-override.sh")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+
+			Expect(patches).To(HaveLen(1))
+			Expect(patches[0].Name).To(Equal("some-patch"))
+			Expect(patches[0].Source).To(Equal("/patches/some-patch"))
+			Expect(patches[0].SHA256).To(HaveLen(64))
+			Expect(patches[0].Files).To(HaveKeyWithValue("terraform/some_override.tf", fmt.Sprintf("%x", sha256.Sum256([]byte("some-override")))))
+			Expect(patches[0].Files).To(HaveLen(4))
+		})
+
+		It("keeps the other patches and gives the same sum for the same files", func() {
+			other := storage.Patch{Name: "other-patch", Files: map[string]string{"terraform/other_override.tf": "abc"}}
+
+			first, err := patcher.Apply("/state", "/patches/some-patch", []storage.Patch{other})
+			Expect(err).NotTo(HaveOccurred())
+
+			second, err := patcher.Apply("/state", "/patches/some-patch/", first)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(second).To(HaveLen(2))
+			Expect(second[0]).To(Equal(other))
+			Expect(second[1].SHA256).To(Equal(first[1].SHA256))
+		})
+
+		Context("when the patch is builtin", func() {
+			BeforeEach(func() {
+				builtins.WriteFile("iso-segs-aws/README.md", []byte("read me"), 0740)
+				builtins.WriteFile("iso-segs-aws/terraform/iso-segs_override.tf", []byte("iso-segs"), 0740)
+			})
+
+			It("copies the embedded patch into the state dir", func() {
+				patches, err := patcher.Apply("/state", "builtin:iso-segs-aws", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fs.ReadFile("/state/terraform/iso-segs_override.tf")).To(Equal([]byte("iso-segs")))
+				Expect(patches).To(HaveLen(1))
+				Expect(patches[0].Name).To(Equal("iso-segs-aws"))
+				Expect(patches[0].Source).To(Equal("builtin:iso-segs-aws"))
+				Expect(patches[0].Files).To(HaveLen(1))
+			})
+
+			It("applies every patch that is built into bbl", func() {
+				builtin := patches.NewBuiltin()
+				patcher = storage.NewPatcher(fs, builtin)
+
+				for _, info := range builtin.Available() {
+					_, err := patcher.Apply("/builtin-state/"+info.Name, storage.BuiltinPatchPrefix+info.Name, nil)
+					Expect(err).NotTo(HaveOccurred(), info.Name)
+				}
+			})
+
+			Context("when there is no builtin patch with the name", func() {
+				It("returns an error", func() {
+					_, err := patcher.Apply("/state", "builtin:missing-patch", nil)
+					Expect(err).To(MatchError("There is no builtin patch named missing-patch. Run bbl patches available to list them."))
+
+					_, err = patcher.Apply("/state", "builtin:iso-segs-aws/terraform", nil)
+					Expect(err).To(MatchError("There is no builtin patch named iso-segs-aws/terraform. Run bbl patches available to list them."))
+				})
+			})
+		})
+
+		Context("when a newer version of an applied patch is applied", func() {
+			It("replaces its files and removes the ones it no longer has", func() {
+				patches, err := patcher.Apply("/state", "/patches/some-patch", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				fs.Remove("/patches/some-patch/cloud-config/some-ops.yml")
+				fs.WriteFile("/patches/some-patch/terraform/some_override.tf", []byte("new-override"), 0644)
+
+				patches, err = patcher.Apply("/state", "/patches/some-patch", patches)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fs.ReadFile("/state/terraform/some_override.tf")).To(Equal([]byte("new-override")))
+				Expect(fs.Exists("/state/cloud-config/some-ops.yml")).To(BeFalse())
+				Expect(patches).To(HaveLen(1))
+				Expect(patches[0].Files).NotTo(HaveKey("cloud-config/some-ops.yml"))
+			})
+		})
+
+		Context("failure cases", func() {
+			It("returns an error when the patch dir does not exist", func() {
+				_, err := patcher.Apply("/state", "/patches/missing-patch", nil)
+				Expect(err).To(MatchError(ContainSubstring("Read patch missing-patch: ")))
+			})
+
+			It("returns an error when the patch has been replaced by --terraform-backend", func() {
+				fs.WriteFile("/patches/tf-backend-aws/terraform/s3_backend_override.tf", []byte("some-backend"), 0644)
+
+				_, err := patcher.Apply("/state", "/patches/tf-backend-aws", nil)
+				Expect(err).To(MatchError("Patch tf-backend-aws is replaced by bbl plan --terraform-backend. Use bbl plan --terraform-backend s3 instead."))
+
+				_, err = patcher.Apply("/state", "builtin:tf-backend-gcp", nil)
+				Expect(err).To(MatchError("Patch tf-backend-gcp is replaced by bbl plan --terraform-backend. Use bbl plan --terraform-backend gcs instead."))
+			})
+
+			It("returns an error when the patch has no files", func() {
+				fs.WriteFile("/patches/empty-patch/README.md", []byte("read me"), 0644)
+
+				_, err := patcher.Apply("/state", "/patches/empty-patch", nil)
+				Expect(err).To(MatchError("Patch empty-patch has no files."))
+			})
+
+			It("returns an error when the patch replaces a file that bbl plan generates", func() {
+				fs.WriteFile("/patches/some-patch/cloud-config/cloud-config.yml", []byte("cloud-config"), 0644)
+
+				_, err := patcher.Apply("/state", "/patches/some-patch", nil)
+				Expect(err).To(MatchError("Patch some-patch: cloud-config/cloud-config.yml is generated by bbl plan and cannot be patched."))
+			})
+
+			It("returns an error when the patch replaces a var file that bbl manages", func() {
+				fs.WriteFile("/patches/some-patch/vars/bbl.tfvars.json", []byte("{}"), 0644)
+
+				_, err := patcher.Apply("/state", "/patches/some-patch", nil)
+				Expect(err).To(MatchError("Patch some-patch: vars/bbl.tfvars.json is managed by bbl and cannot be patched."))
+			})
+
+			It("returns an error when the patch has a file that does not belong in one", func() {
+				fs.WriteFile("/patches/some-patch/terraform/notes.txt", []byte("notes"), 0644)
+
+				_, err := patcher.Apply("/state", "/patches/some-patch", nil)
+				Expect(err).To(MatchError("Patch some-patch: terraform/notes.txt does not belong in a plan patch. Patches hold terraform/*.tf, cloud-config/*.yml, jumpbox-ops/*.yml, director-ops/*.yml, director-vars, vars/*.tfvars and override scripts."))
+			})
+
+			It("returns an error when a file is already from another patch", func() {
+				other := storage.Patch{Name: "other-patch", Files: map[string]string{"terraform/some_override.tf": "abc"}}
+
+				_, err := patcher.Apply("/state", "/patches/some-patch", []storage.Patch{other})
+				Expect(err).To(MatchError("Patch some-patch: terraform/some_override.tf is already from patch other-patch."))
+			})
+
+			It("returns an error when a different file with the same name is in the state dir", func() {
+				fs.WriteFile("/state/terraform/some_override.tf", []byte("my-override"), 0644)
+
+				_, err := patcher.Apply("/state", "/patches/some-patch", nil)
+				Expect(err).To(MatchError("Patch some-patch: terraform/some_override.tf already exists in the state dir and is not from a patch. Remove it first."))
+				Expect(fs.ReadFile("/state/terraform/some_override.tf")).To(Equal([]byte("my-override")))
+			})
+		})
+	})
+
+	Describe("Remove", func() {
+		var patches []storage.Patch
+
+		BeforeEach(func() {
+			var err error
+			patches, err = patcher.Apply("/state", "/patches/some-patch", []storage.Patch{{Name: "other-patch"}})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("removes the files of the patch and forgets it", func() {
+			fs.Remove("/state/cloud-config/some-ops.yml")
+
+			remaining, err := patcher.Remove("/state", "some-patch", patches)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(remaining).To(Equal([]storage.Patch{{Name: "other-patch"}}))
+			Expect(fs.Exists("/state/terraform/some_override.tf")).To(BeFalse())
+			Expect(fs.Exists("/state/create-director-override.sh")).To(BeFalse())
+			Expect(fs.Exists("/state/terraform/bbl-template.tf")).To(BeTrue())
+		})
+
+		Context("when the patch has not been applied", func() {
+			It("returns an error", func() {
+				_, err := patcher.Remove("/state", "missing-patch", patches)
+				Expect(err).To(MatchError("There is no applied patch named missing-patch."))
+			})
+		})
+	})
+
+	Describe("Drift", func() {
+		It("returns the files that were edited or removed since the patch was applied", func() {
+			patches, err := patcher.Apply("/state", "/patches/some-patch", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			drift, err := patcher.Drift("/state", patches)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(BeEmpty())
+
+			fs.WriteFile("/state/terraform/some_override.tf", []byte("edited"), 0644)
+			fs.Remove("/state/cloud-config/some-ops.yml")
+
+			drift, err = patcher.Drift("/state", patches)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(Equal([]storage.PatchDrift{
+				{Patch: "some-patch", Path: "cloud-config/some-ops.yml", Status: storage.PATCH_FILE_MISSING},
+				{Patch: "some-patch", Path: "terraform/some_override.tf", Status: storage.PATCH_FILE_EDITED},
+			}))
+		})
+	})
+})
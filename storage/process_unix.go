@@ -0,0 +1,18 @@
+//go:build !windows
+// +build !windows
+
+package storage
+
+import "syscall"
+
+// processExists reports whether a process with the pid runs on this host.
+// Signal 0 only checks that the process exists; EPERM means it belongs to
+// another user.
+func processExists(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
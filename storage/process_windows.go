@@ -0,0 +1,13 @@
+package storage
+
+import "os"
+
+// processExists reports whether a process with the pid runs on this host.
+func processExists(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	process.Release()
+	return true
+}
@@ -0,0 +1,443 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	awslib "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+)
+
+const (
+	s3DefaultRegion = "us-east-1"
+	s3ModeMetadata  = "Mode"
+	s3HashMetadata  = "Sha256"
+
+	SYNC_FILE = ".bbl-sync.json"
+)
+
+type S3BackendConfig struct {
+	Bucket          string
+	Prefix          string
+	Endpoint        string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+type s3Client interface {
+	ListObjectsV2Pages(*s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool) error
+	GetObject(*s3.GetObjectInput) (*s3.GetObjectOutput, error)
+	HeadObject(*s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
+	PutObject(*s3.PutObjectInput) (*s3.PutObjectOutput, error)
+	DeleteObject(*s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
+}
+
+type backendFs interface {
+	fileio.FileReader
+	fileio.FileWriter
+	fileio.Remover
+	fileio.AllMkdirer
+	fileio.DirReader
+}
+
+// S3Backend mirrors the state directory to a bucket that speaks the S3 API.
+type S3Backend struct {
+	client s3Client
+	bucket string
+	prefix string
+	fs     backendFs
+}
+
+func NewS3Backend(config S3BackendConfig, fs backendFs) S3Backend {
+	region := config.Region
+	if region == "" {
+		region = s3DefaultRegion
+	}
+
+	awsConfig := &awslib.Config{
+		Region: awslib.String(region),
+	}
+	if config.Endpoint != "" {
+		awsConfig.Endpoint = awslib.String(config.Endpoint)
+		awsConfig.S3ForcePathStyle = awslib.Bool(true)
+	}
+	if config.AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, "")
+	}
+
+	return S3Backend{
+		client: s3.New(session.New(awsConfig)),
+		bucket: config.Bucket,
+		prefix: strings.Trim(config.Prefix, "/"),
+		fs:     fs,
+	}
+}
+
+// Pull makes dir a copy of the bucket. An empty bucket leaves dir
+// untouched so that an existing state directory can be pushed into it.
+// Local files that changed since the last sync, for instance because bbl
+// was killed before it pushed them, are kept so that the next push
+// uploads them.
+func (b S3Backend) Pull(dir string) error {
+	remote, err := b.remoteFiles()
+	if err != nil {
+		return err
+	}
+
+	if len(remote) == 0 {
+		return nil
+	}
+
+	synced, err := b.readSyncRecord(dir)
+	if err != nil {
+		return err
+	}
+
+	local, err := b.localHashes(dir)
+	if err != nil {
+		return err
+	}
+
+	for name := range remote {
+		output, err := b.client.GetObject(&s3.GetObjectInput{
+			Bucket: awslib.String(b.bucket),
+			Key:    awslib.String(b.key(name)),
+		})
+		if err != nil {
+			return fmt.Errorf("Download %s: %s", name, err)
+		}
+
+		contents, err := ioutil.ReadAll(output.Body)
+		output.Body.Close()
+		if err != nil {
+			return fmt.Errorf("Download %s: %s", name, err) // not tested
+		}
+
+		remoteHash := contentHash(contents)
+		localHash, exists := local[name]
+		if localHash == remoteHash {
+			synced.Files[name] = remoteHash
+			continue
+		}
+
+		if exists && synced.changedLocally(name, localHash) {
+			if synced.Files[name] == remoteHash {
+				continue
+			}
+			return syncConflictError(name)
+		}
+
+		localPath := filepath.Join(dir, filepath.FromSlash(name))
+		err = b.fs.MkdirAll(filepath.Dir(localPath), os.ModePerm)
+		if err != nil {
+			return fmt.Errorf("Create directory for %s: %s", name, err)
+		}
+
+		err = b.fs.WriteFile(localPath, contents, objectMode(output.Metadata))
+		if err != nil {
+			return fmt.Errorf("Write %s: %s", name, err)
+		}
+		synced.Files[name] = remoteHash
+	}
+
+	for name, localHash := range local {
+		if _, ok := remote[name]; ok {
+			continue
+		}
+		if !synced.exists || synced.changedLocally(name, localHash) {
+			continue
+		}
+
+		err = b.fs.Remove(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return fmt.Errorf("Remove %s: %s", name, err)
+		}
+		delete(synced.Files, name)
+	}
+
+	return b.writeSyncRecord(dir, synced)
+}
+
+// Push uploads the files in dir that changed since the last sync and
+// deletes the objects that were removed from dir. Objects that another
+// run changed in the meantime are left alone.
+func (b S3Backend) Push(dir string) error {
+	remote, err := b.remoteFiles()
+	if err != nil {
+		return err
+	}
+
+	synced, err := b.readSyncRecord(dir)
+	if err != nil {
+		return err
+	}
+
+	local, err := b.localFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	for name, mode := range local {
+		contents, err := b.fs.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return fmt.Errorf("Read %s: %s", name, err)
+		}
+
+		localHash := contentHash(contents)
+		var remoteHash string
+		_, exists := remote[name]
+		if exists {
+			remoteHash, err = b.remoteHash(name)
+			if err != nil {
+				return err
+			}
+		}
+		if localHash == remoteHash {
+			synced.Files[name] = localHash
+			continue
+		}
+
+		if !exists && synced.Files[name] != "" && !synced.changedLocally(name, localHash) {
+			continue
+		}
+
+		if exists && synced.exists && remoteHash != synced.Files[name] {
+			if !synced.changedLocally(name, localHash) {
+				continue
+			}
+			return syncConflictError(name)
+		}
+
+		_, err = b.client.PutObject(&s3.PutObjectInput{
+			Bucket: awslib.String(b.bucket),
+			Key:    awslib.String(b.key(name)),
+			Body:   bytes.NewReader(contents),
+			Metadata: map[string]*string{
+				s3ModeMetadata: awslib.String(strconv.FormatUint(uint64(mode.Perm()), 8)),
+				s3HashMetadata: awslib.String(localHash),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Upload %s: %s", name, err)
+		}
+		synced.Files[name] = localHash
+	}
+
+	for name := range remote {
+		if _, ok := local[name]; ok {
+			continue
+		}
+		if !synced.exists {
+			continue
+		}
+
+		remoteHash, err := b.remoteHash(name)
+		if err != nil {
+			return err
+		}
+		if remoteHash != synced.Files[name] {
+			continue
+		}
+
+		_, err = b.client.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: awslib.String(b.bucket),
+			Key:    awslib.String(b.key(name)),
+		})
+		if err != nil {
+			return fmt.Errorf("Delete %s: %s", name, err)
+		}
+		delete(synced.Files, name)
+	}
+
+	return b.writeSyncRecord(dir, synced)
+}
+
+func (b S3Backend) key(name string) string {
+	return path.Join(b.prefix, name)
+}
+
+// remoteFiles returns the names of the objects under the prefix, as
+// slash separated paths relative to the prefix.
+func (b S3Backend) remoteFiles() (map[string]struct{}, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: awslib.String(b.bucket),
+	}
+	if b.prefix != "" {
+		input.Prefix = awslib.String(b.prefix + "/")
+	}
+
+	files := map[string]struct{}{}
+	err := b.client.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			name := strings.TrimPrefix(awslib.StringValue(object.Key), awslib.StringValue(input.Prefix))
+			if name == "" || strings.HasSuffix(name, "/") {
+				continue
+			}
+			files[name] = struct{}{}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("List state bucket %s: %s", b.bucket, err)
+	}
+
+	return files, nil
+}
+
+// remoteHash returns the content hash that Push stored with the object,
+// or hashes the object when it was uploaded without one. The ETag is not
+// used, since it is not an md5 of the contents for multipart uploads.
+func (b S3Backend) remoteHash(name string) (string, error) {
+	output, err := b.client.HeadObject(&s3.HeadObjectInput{
+		Bucket: awslib.String(b.bucket),
+		Key:    awslib.String(b.key(name)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Read %s metadata: %s", name, err)
+	}
+
+	if hash := metadataValue(output.Metadata, s3HashMetadata); hash != "" {
+		return hash, nil
+	}
+
+	object, err := b.client.GetObject(&s3.GetObjectInput{
+		Bucket: awslib.String(b.bucket),
+		Key:    awslib.String(b.key(name)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Download %s: %s", name, err) // not tested
+	}
+	defer object.Body.Close()
+
+	contents, err := ioutil.ReadAll(object.Body)
+	if err != nil {
+		return "", fmt.Errorf("Download %s: %s", name, err) // not tested
+	}
+
+	return contentHash(contents), nil
+}
+
+// localFiles returns the mode of every file in dir, keyed by its slash
+// separated path relative to dir. Terraform plugins are skipped because
+// terraform init downloads them again, and the lock file only guards
+// this working copy.
+func (b S3Backend) localFiles(dir string) (map[string]os.FileMode, error) {
+	return walkFiles(b.fs, dir, func(name string, info os.FileInfo) bool {
+		return info.Name() == ".terraform" || name == LOCK_FILE || name == SYNC_FILE
+	})
+}
+
+func (b S3Backend) localHashes(dir string) (map[string]string, error) {
+	hashes := map[string]string{}
+	if _, err := b.fs.ReadDir(dir); os.IsNotExist(err) {
+		return hashes, nil
+	}
+
+	local, err := b.localFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for name := range local {
+		contents, err := b.fs.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return nil, fmt.Errorf("Read %s: %s", name, err)
+		}
+		hashes[name] = contentHash(contents)
+	}
+
+	return hashes, nil
+}
+
+// syncRecord holds the content hash of every file as of the last pull or
+// push, which tells changes made in the state dir apart from changes made
+// in the bucket.
+type syncRecord struct {
+	Files  map[string]string `json:"files"`
+	exists bool
+}
+
+func (r syncRecord) changedLocally(name, localHash string) bool {
+	if !r.exists {
+		return false
+	}
+	return r.Files[name] != localHash
+}
+
+func (b S3Backend) readSyncRecord(dir string) (syncRecord, error) {
+	record := syncRecord{Files: map[string]string{}}
+
+	contents, err := b.fs.ReadFile(filepath.Join(dir, SYNC_FILE))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return record, nil
+		}
+		return syncRecord{}, fmt.Errorf("Read sync record: %s", err)
+	}
+
+	err = json.Unmarshal(contents, &record)
+	if err != nil {
+		return syncRecord{}, fmt.Errorf("Read sync record: %s", err)
+	}
+	if record.Files == nil {
+		record.Files = map[string]string{}
+	}
+	record.exists = true
+
+	return record, nil
+}
+
+func (b S3Backend) writeSyncRecord(dir string, record syncRecord) error {
+	contents, err := json.Marshal(record)
+	if err != nil {
+		return err // not tested
+	}
+
+	err = b.fs.WriteFile(filepath.Join(dir, SYNC_FILE), contents, StateMode)
+	if err != nil {
+		return fmt.Errorf("Write sync record: %s", err)
+	}
+
+	return nil
+}
+
+func syncConflictError(name string) error {
+	return fmt.Errorf("%s changed both in the state dir and in the state bucket since the last sync. Move one of them aside and run the command again.", name)
+}
+
+func contentHash(contents []byte) string {
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:])
+}
+
+func objectMode(metadata map[string]*string) os.FileMode {
+	mode, err := strconv.ParseUint(metadataValue(metadata, s3ModeMetadata), 8, 32)
+	if err != nil {
+		return StateMode
+	}
+
+	return os.FileMode(mode)
+}
+
+func metadataValue(metadata map[string]*string, name string) string {
+	for key, value := range metadata {
+		if strings.EqualFold(key, name) {
+			return awslib.StringValue(value)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,427 @@
+package storage_test
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("S3Backend", func() {
+	var (
+		server  *httptest.Server
+		bucket  *fakeBucket
+		fs      *afero.Afero
+		backend storage.S3Backend
+	)
+
+	BeforeEach(func() {
+		bucket = newFakeBucket("some-bucket")
+		server = httptest.NewServer(bucket)
+
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+
+		backend = storage.NewS3Backend(storage.S3BackendConfig{
+			Bucket:          "some-bucket",
+			Prefix:          "some-env/",
+			Endpoint:        server.URL,
+			AccessKeyID:     "some-access-key-id",
+			SecretAccessKey: "some-secret-access-key",
+		}, fs)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("Push", func() {
+		BeforeEach(func() {
+			fs.WriteFile("/state/bbl-state.json", []byte("some-state"), 0644)
+			fs.WriteFile("/state/create-director.sh", []byte("some-script"), storage.ScriptMode)
+			fs.WriteFile("/state/vars/bosh-state.json", []byte("some-bosh-state"), storage.StateMode)
+			fs.WriteFile("/state/terraform/.terraform/plugins/some-plugin", []byte("some-plugin"), storage.ScriptMode)
+			fs.WriteFile("/state/bbl.lock", []byte("{}"), 0644)
+		})
+
+		It("uploads the state dir under the prefix", func() {
+			err := backend.Push("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bucket.keys()).To(Equal([]string{
+				"some-env/bbl-state.json",
+				"some-env/create-director.sh",
+				"some-env/vars/bosh-state.json",
+			}))
+			Expect(bucket.object("some-env/vars/bosh-state.json").contents).To(Equal("some-bosh-state"))
+			Expect(bucket.object("some-env/create-director.sh").mode).To(Equal("750"))
+		})
+
+		It("only uploads the files that changed", func() {
+			err := backend.Push("/state")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bucket.puts).To(Equal(3))
+
+			fs.WriteFile("/state/bbl-state.json", []byte("some-new-state"), 0644)
+
+			err = backend.Push("/state")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bucket.puts).To(Equal(4))
+			Expect(bucket.object("some-env/bbl-state.json").contents).To(Equal("some-new-state"))
+		})
+
+		It("stores a hash of the contents with each object", func() {
+			err := backend.Push("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bucket.object("some-env/bbl-state.json").hash).To(Equal(fmt.Sprintf("%x", sha256.Sum256([]byte("some-state")))))
+		})
+
+		It("deletes objects that were removed from the state dir since the last sync", func() {
+			bucket.put("some-other-env/bbl-state.json", "some-other-state", "644")
+
+			err := backend.Push("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			fs.Remove("/state/vars/bosh-state.json")
+
+			err = backend.Push("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bucket.keys()).To(Equal([]string{
+				"some-env/bbl-state.json",
+				"some-env/create-director.sh",
+				"some-other-env/bbl-state.json",
+			}))
+		})
+
+		It("leaves objects that another run added since the last sync", func() {
+			err := backend.Push("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			bucket.put("some-env/vars/jumpbox-state.json", "some-jumpbox-state", "740")
+
+			err = backend.Push("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bucket.keys()).To(ContainElement("some-env/vars/jumpbox-state.json"))
+		})
+
+		Context("when there is no sync record", func() {
+			It("does not delete any objects", func() {
+				bucket.put("some-env/vars/jumpbox-state.json", "some-jumpbox-state", "740")
+
+				err := backend.Push("/state")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(bucket.keys()).To(ContainElement("some-env/vars/jumpbox-state.json"))
+			})
+		})
+
+		Context("when a file changed both in the state dir and in the bucket since the last sync", func() {
+			It("returns an error without overwriting the object", func() {
+				err := backend.Push("/state")
+				Expect(err).NotTo(HaveOccurred())
+
+				bucket.put("some-env/bbl-state.json", "some-other-run-state", "644")
+				fs.WriteFile("/state/bbl-state.json", []byte("some-new-state"), 0644)
+
+				err = backend.Push("/state")
+				Expect(err).To(MatchError("bbl-state.json changed both in the state dir and in the state bucket since the last sync. Move one of them aside and run the command again."))
+				Expect(bucket.object("some-env/bbl-state.json").contents).To(Equal("some-other-run-state"))
+			})
+		})
+
+		Context("when the bucket cannot be listed", func() {
+			BeforeEach(func() {
+				bucket.denied = true
+			})
+
+			It("returns an error", func() {
+				err := backend.Push("/state")
+				Expect(err).To(MatchError(ContainSubstring("List state bucket some-bucket: AccessDenied")))
+			})
+		})
+
+		Context("when the state dir cannot be read", func() {
+			It("returns an error", func() {
+				err := backend.Push("/missing")
+				Expect(err).To(MatchError(ContainSubstring("Read state dir:")))
+			})
+		})
+	})
+
+	Describe("Pull", func() {
+		BeforeEach(func() {
+			bucket.put("some-env/bbl-state.json", "some-state", "644")
+			bucket.put("some-env/create-director.sh", "some-script", "750")
+			bucket.put("some-env/vars/bosh-state.json", "some-bosh-state", "")
+			bucket.put("some-other-env/bbl-state.json", "some-other-state", "644")
+		})
+
+		It("downloads the objects under the prefix into the state dir", func() {
+			err := backend.Pull("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/vars/bosh-state.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-bosh-state"))
+
+			info, err := fs.Stat("/state/create-director.sh")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(storage.ScriptMode)))
+
+			info, err = fs.Stat("/state/vars/bosh-state.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(storage.StateMode)))
+
+			contents, err = fs.ReadFile("/state/bbl-state.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-state"))
+		})
+
+		It("removes local files that were removed from the bucket since the last sync", func() {
+			fs.WriteFile("/state/terraform/.terraform/plugins/some-plugin", []byte("some-plugin"), storage.ScriptMode)
+
+			err := backend.Pull("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			bucket.remove("some-env/create-director.sh")
+
+			err = backend.Pull("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = fs.Stat("/state/create-director.sh")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			_, err = fs.Stat("/state/terraform/.terraform/plugins/some-plugin")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("keeps local files that were added since the last sync", func() {
+			err := backend.Pull("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			fs.WriteFile("/state/vars/jumpbox-state.json", []byte("some-jumpbox-state"), storage.StateMode)
+
+			err = backend.Pull("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/vars/jumpbox-state.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-jumpbox-state"))
+		})
+
+		It("keeps local changes that were not pushed", func() {
+			err := backend.Pull("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			fs.WriteFile("/state/bbl-state.json", []byte("some-unpushed-state"), 0644)
+
+			err = backend.Pull("/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/bbl-state.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-unpushed-state"))
+		})
+
+		Context("when there is no sync record", func() {
+			It("keeps local files that are not in the bucket", func() {
+				fs.WriteFile("/state/vars/jumpbox-state.json", []byte("some-jumpbox-state"), storage.StateMode)
+
+				err := backend.Pull("/state")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = fs.Stat("/state/vars/jumpbox-state.json")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when a file changed both in the state dir and in the bucket since the last sync", func() {
+			It("returns an error", func() {
+				err := backend.Pull("/state")
+				Expect(err).NotTo(HaveOccurred())
+
+				fs.WriteFile("/state/bbl-state.json", []byte("some-unpushed-state"), 0644)
+				bucket.put("some-env/bbl-state.json", "some-other-run-state", "644")
+
+				err = backend.Pull("/state")
+				Expect(err).To(MatchError(ContainSubstring("bbl-state.json changed both in the state dir and in the state bucket")))
+			})
+		})
+
+		Context("when the bucket is empty", func() {
+			BeforeEach(func() {
+				bucket.objects = map[string]fakeObject{}
+			})
+
+			It("leaves the state dir untouched", func() {
+				fs.WriteFile("/state/bbl-state.json", []byte("some-local-state"), 0644)
+
+				err := backend.Pull("/state")
+				Expect(err).NotTo(HaveOccurred())
+
+				contents, err := fs.ReadFile("/state/bbl-state.json")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-local-state"))
+			})
+		})
+
+		Context("when the bucket cannot be listed", func() {
+			BeforeEach(func() {
+				bucket.denied = true
+			})
+
+			It("returns an error", func() {
+				err := backend.Pull("/state")
+				Expect(err).To(MatchError(ContainSubstring("List state bucket some-bucket: AccessDenied")))
+			})
+		})
+	})
+})
+
+type fakeObject struct {
+	contents string
+	mode     string
+	hash     string
+}
+
+type fakeBucket struct {
+	name    string
+	denied  bool
+	puts    int
+	mutex   sync.Mutex
+	objects map[string]fakeObject
+}
+
+func newFakeBucket(name string) *fakeBucket {
+	return &fakeBucket{
+		name:    name,
+		objects: map[string]fakeObject{},
+	}
+}
+
+func (b *fakeBucket) put(key, contents, mode string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.objects[key] = fakeObject{contents: contents, mode: mode}
+}
+
+func (b *fakeBucket) remove(key string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	delete(b.objects, key)
+}
+
+func (b *fakeBucket) object(key string) fakeObject {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.objects[key]
+}
+
+func (b *fakeBucket) keys() []string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	keys := []string{}
+	for key := range b.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type listBucketResult struct {
+	XMLName     xml.Name `xml:"ListBucketResult"`
+	Name        string
+	Prefix      string
+	KeyCount    int
+	IsTruncated bool
+	Contents    []listBucketContents
+}
+
+type listBucketContents struct {
+	Key  string
+	ETag string
+	Size int
+}
+
+func (b *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if b.denied {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/"+b.name)
+	key := strings.TrimPrefix(path, "/")
+
+	switch {
+	case r.Method == "GET" && key == "":
+		prefix := r.URL.Query().Get("prefix")
+		result := listBucketResult{Name: b.name, Prefix: prefix}
+		for _, k := range b.keys() {
+			if !strings.HasPrefix(k, prefix) {
+				continue
+			}
+			object := b.object(k)
+			result.Contents = append(result.Contents, listBucketContents{
+				Key:  k,
+				ETag: fmt.Sprintf(`"%x"`, md5.Sum([]byte(object.contents))),
+				Size: len(object.contents),
+			})
+		}
+		result.KeyCount = len(result.Contents)
+		w.Header().Set("Content-Type", "application/xml")
+		xml.NewEncoder(w).Encode(result)
+	case r.Method == "GET" || r.Method == "HEAD":
+		b.mutex.Lock()
+		object, ok := b.objects[key]
+		b.mutex.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code></Error>`)
+			return
+		}
+		if object.mode != "" {
+			w.Header().Set("X-Amz-Meta-Mode", object.mode)
+		}
+		if object.hash != "" {
+			w.Header().Set("X-Amz-Meta-Sha256", object.hash)
+		}
+		if r.Method == "HEAD" {
+			return
+		}
+		fmt.Fprint(w, object.contents)
+	case r.Method == "PUT":
+		contents, _ := ioutil.ReadAll(r.Body)
+		b.mutex.Lock()
+		b.objects[key] = fakeObject{
+			contents: string(contents),
+			mode:     r.Header.Get("X-Amz-Meta-Mode"),
+			hash:     r.Header.Get("X-Amz-Meta-Sha256"),
+		}
+		b.puts++
+		b.mutex.Unlock()
+	case r.Method == "DELETE":
+		b.mutex.Lock()
+		delete(b.objects, key)
+		b.mutex.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,46 @@
+package storage
+
+// Sizing is the size of a VM that bbl deploys with bosh create-env. Disk
+// sizes are in MB. Zero values keep the sizes from bosh-deployment or
+// jumpbox-deployment.
+type Sizing struct {
+	VMType             string `json:"vmType,omitempty"`
+	PersistentDiskSize int    `json:"persistentDiskSize,omitempty"`
+	EphemeralDiskSize  int    `json:"ephemeralDiskSize,omitempty"`
+}
+
+func (s Sizing) IsEmpty() bool {
+	return s == Sizing{}
+}
+
+// DefaultVMType and DefaultDiskSize in the changes given to Merge put a
+// value back to the one from bosh-deployment or jumpbox-deployment.
+const (
+	DefaultVMType   = "default"
+	DefaultDiskSize = -1
+)
+
+// Merge returns s with the sizes that are set in changes.
+func (s Sizing) Merge(changes Sizing) Sizing {
+	switch changes.VMType {
+	case "":
+	case DefaultVMType:
+		s.VMType = ""
+	default:
+		s.VMType = changes.VMType
+	}
+	s.PersistentDiskSize = mergeDiskSize(s.PersistentDiskSize, changes.PersistentDiskSize)
+	s.EphemeralDiskSize = mergeDiskSize(s.EphemeralDiskSize, changes.EphemeralDiskSize)
+	return s
+}
+
+func mergeDiskSize(size, change int) int {
+	switch change {
+	case 0:
+		return size
+	case DefaultDiskSize:
+		return 0
+	default:
+		return change
+	}
+}
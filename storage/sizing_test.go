@@ -0,0 +1,37 @@
+package storage_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sizing", func() {
+	Describe("Merge", func() {
+		var sizing storage.Sizing
+
+		BeforeEach(func() {
+			sizing = storage.Sizing{VMType: "m4.large", PersistentDiskSize: 65536, EphemeralDiskSize: 20000}
+		})
+
+		It("keeps the sizes that are not changed", func() {
+			Expect(sizing.Merge(storage.Sizing{PersistentDiskSize: 131072})).To(Equal(storage.Sizing{
+				VMType:             "m4.large",
+				PersistentDiskSize: 131072,
+				EphemeralDiskSize:  20000,
+			}))
+		})
+
+		It("puts the sizes that are changed to the default back to the default", func() {
+			merged := sizing.Merge(storage.Sizing{
+				VMType:            storage.DefaultVMType,
+				EphemeralDiskSize: storage.DefaultDiskSize,
+			})
+			Expect(merged).To(Equal(storage.Sizing{PersistentDiskSize: 65536}))
+
+			merged = merged.Merge(storage.Sizing{PersistentDiskSize: storage.DefaultDiskSize})
+			Expect(merged.IsEmpty()).To(BeTrue())
+		})
+	})
+})
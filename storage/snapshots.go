@@ -0,0 +1,313 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+)
+
+const (
+	SNAPSHOTS_DIR  = ".snapshots"
+	SNAPSHOT_LIMIT = 20
+
+	snapshotIDFormat = "20060102T150405Z"
+)
+
+var snapshotNow = time.Now
+
+// terraformStateFiles, along with the saved terraform plans, are left
+// alone by Restore unless it is asked to roll back the terraform state.
+var terraformStateFiles = map[string]struct{}{
+	"terraform.tfstate":          {},
+	"terraform.tfstate.backup":   {},
+	"terraform.tfstate.migrated": {},
+}
+
+type Snapshot struct {
+	ID      string
+	Created time.Time
+	Files   []string
+}
+
+type snapshotsFs interface {
+	fileio.FileReader
+	fileio.FileWriter
+	fileio.Stater
+	fileio.Remover
+	fileio.AllRemover
+	fileio.AllMkdirer
+	fileio.DirReader
+}
+
+// Snapshots keeps a bounded history of bbl-state.json and the bbl managed
+// vars files, so that a bad plan or a failed upgrade can be rolled back.
+type Snapshots struct {
+	dir   string
+	fs    snapshotsFs
+	limit int
+}
+
+func NewSnapshots(dir string, fs snapshotsFs, limit int) Snapshots {
+	return Snapshots{
+		dir:   dir,
+		fs:    fs,
+		limit: limit,
+	}
+}
+
+// Take records the current state files, unless they are unchanged since
+// the latest snapshot, and drops the oldest snapshots beyond the limit.
+func (s Snapshots) Take() error {
+	current, err := s.readFiles(s.dir)
+	if err != nil {
+		return err
+	}
+
+	if len(current) == 0 {
+		return nil
+	}
+
+	snapshots, err := s.List()
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots) > 0 {
+		latest, err := s.Read(snapshots[len(snapshots)-1].ID)
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(latest, current) {
+			return nil
+		}
+	}
+
+	id := s.newID(snapshots)
+	for name, contents := range current {
+		path := filepath.Join(s.snapshotDir(id), name)
+		err = s.fs.MkdirAll(filepath.Dir(path), os.ModePerm)
+		if err != nil {
+			return fmt.Errorf("Create snapshot dir: %s", err)
+		}
+
+		err = s.fs.WriteFile(path, contents, StateMode)
+		if err != nil {
+			return fmt.Errorf("Write snapshot %s: %s", id, err)
+		}
+	}
+
+	snapshots = append(snapshots, Snapshot{ID: id})
+	for len(snapshots) > s.limit {
+		err = s.fs.RemoveAll(s.snapshotDir(snapshots[0].ID))
+		if err != nil {
+			return fmt.Errorf("Remove snapshot %s: %s", snapshots[0].ID, err)
+		}
+		snapshots = snapshots[1:]
+	}
+
+	return nil
+}
+
+// List returns the snapshots from oldest to newest.
+func (s Snapshots) List() ([]Snapshot, error) {
+	infos, err := s.fs.ReadDir(filepath.Join(s.dir, SNAPSHOTS_DIR))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Snapshot{}, nil
+		}
+		return nil, fmt.Errorf("Read snapshots dir: %s", err)
+	}
+
+	snapshots := []Snapshot{}
+	for _, info := range infos {
+		if !info.IsDir() || len(info.Name()) < len(snapshotIDFormat) {
+			continue
+		}
+
+		created, err := time.Parse(snapshotIDFormat, info.Name()[:len(snapshotIDFormat)])
+		if err != nil {
+			continue
+		}
+
+		files, err := s.readFiles(s.snapshotDir(info.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		names := []string{}
+		for name := range files {
+			names = append(names, filepath.ToSlash(name))
+		}
+		sort.Strings(names)
+
+		snapshots = append(snapshots, Snapshot{
+			ID:      info.Name(),
+			Created: created,
+			Files:   names,
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		if snapshots[i].Created.Equal(snapshots[j].Created) {
+			return snapshotSequence(snapshots[i].ID) < snapshotSequence(snapshots[j].ID)
+		}
+		return snapshots[i].Created.Before(snapshots[j].Created)
+	})
+
+	return snapshots, nil
+}
+
+// Read returns the contents of the files in a snapshot, keyed by their
+// path relative to the state directory.
+func (s Snapshots) Read(id string) (map[string][]byte, error) {
+	if id == "" || filepath.Base(id) != id {
+		return nil, fmt.Errorf("Snapshot %s does not exist", id)
+	}
+
+	_, err := s.fs.Stat(s.snapshotDir(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("Snapshot %s does not exist", id)
+		}
+		return nil, fmt.Errorf("Read snapshot %s: %s", id, err)
+	}
+
+	return s.readFiles(s.snapshotDir(id))
+}
+
+// Restore copies the files of a snapshot into the state directory and
+// removes the state files that did not exist when it was taken. The
+// terraform state and saved plans describe infrastructure that exists
+// now, so they are only rolled back when includeTerraformState is set.
+func (s Snapshots) Restore(id string, includeTerraformState bool) error {
+	files, err := s.Read(id)
+	if err != nil {
+		return err
+	}
+
+	names, err := s.snapshotFiles(s.dir, s.snapshotDir(id))
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if isTerraformStateFile(name) && !includeTerraformState {
+			continue
+		}
+
+		path := filepath.Join(s.dir, name)
+
+		contents, ok := files[name]
+		if !ok {
+			err = s.fs.Remove(path)
+			if err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("Remove %s: %s", name, err)
+			}
+			continue
+		}
+
+		err = s.fs.MkdirAll(filepath.Dir(path), os.ModePerm)
+		if err != nil {
+			return fmt.Errorf("Create %s dir: %s", filepath.Dir(name), err)
+		}
+
+		err = s.fs.WriteFile(path, contents, StateMode)
+		if err != nil {
+			return fmt.Errorf("Restore %s: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s Snapshots) readFiles(dir string) (map[string][]byte, error) {
+	names, err := s.snapshotFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+	for _, name := range names {
+		contents, err := s.fs.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("Read %s: %s", name, err)
+		}
+		files[name] = contents
+	}
+	return files, nil
+}
+
+func (s Snapshots) newID(snapshots []Snapshot) string {
+	base := snapshotNow().UTC().Format(snapshotIDFormat)
+
+	taken := map[string]struct{}{}
+	for _, snapshot := range snapshots {
+		taken[snapshot.ID] = struct{}{}
+	}
+
+	id := base
+	for i := 1; ; i++ {
+		if _, ok := taken[id]; !ok {
+			return id
+		}
+		id = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+func (s Snapshots) snapshotDir(id string) string {
+	return filepath.Join(s.dir, SNAPSHOTS_DIR, id)
+}
+
+// snapshotFiles returns the paths, relative to the state directory, of
+// bbl-state.json, the bbl managed vars files and the terraform plans saved
+// in the vars dir of any of dirs.
+func (s Snapshots) snapshotFiles(dirs ...string) ([]string, error) {
+	unique := map[string]struct{}{STATE_FILE: {}}
+	for name := range bblManaged {
+		unique[filepath.Join("vars", name)] = struct{}{}
+	}
+
+	for _, dir := range dirs {
+		infos, err := s.fs.ReadDir(filepath.Join(dir, "vars"))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("Read vars dir: %s", err)
+		}
+
+		for _, info := range infos {
+			if !info.IsDir() && isTerraformPlanFile(info.Name()) {
+				unique[filepath.Join("vars", info.Name())] = struct{}{}
+			}
+		}
+	}
+
+	names := []string{}
+	for name := range unique {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func isTerraformStateFile(name string) bool {
+	_, ok := terraformStateFiles[filepath.Base(name)]
+	return ok || isTerraformPlanFile(filepath.Base(name))
+}
+
+func snapshotSequence(id string) int {
+	var sequence int
+	if len(id) > len(snapshotIDFormat) {
+		fmt.Sscanf(id[len(snapshotIDFormat):], "-%d", &sequence)
+	}
+	return sequence
+}
@@ -0,0 +1,237 @@
+package storage_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Snapshots", func() {
+	var (
+		fs        *afero.Afero
+		snapshots storage.Snapshots
+		now       time.Time
+	)
+
+	BeforeEach(func() {
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+		snapshots = storage.NewSnapshots("/state", fs, 3)
+
+		now = time.Date(2018, time.March, 1, 12, 0, 0, 0, time.UTC)
+		storage.SetSnapshotNow(func() time.Time { return now })
+
+		fs.WriteFile("/state/bbl-state.json", []byte("some-state"), 0644)
+		fs.WriteFile("/state/vars/bosh-state.json", []byte("some-bosh-state"), storage.StateMode)
+		fs.WriteFile("/state/vars/user-ops-file.yml", []byte("some-ops"), storage.StateMode)
+	})
+
+	AfterEach(func() {
+		storage.ResetSnapshotNow()
+	})
+
+	Describe("Take", func() {
+		It("copies bbl-state.json and the bbl managed vars files", func() {
+			err := snapshots.Take()
+			Expect(err).NotTo(HaveOccurred())
+
+			list, err := snapshots.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list).To(Equal([]storage.Snapshot{{
+				ID:      "20180301T120000Z",
+				Created: now,
+				Files:   []string{"bbl-state.json", "vars/bosh-state.json"},
+			}}))
+
+			contents, err := fs.ReadFile("/state/.snapshots/20180301T120000Z/vars/bosh-state.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-bosh-state"))
+		})
+
+		It("copies the terraform plans saved under any name", func() {
+			fs.WriteFile("/state/vars/reviewed.tfplan", []byte("some-plan"), storage.StateMode)
+			fs.WriteFile("/state/vars/reviewed.tfplan.checksum", []byte("some-checksum"), storage.StateMode)
+
+			err := snapshots.Take()
+			Expect(err).NotTo(HaveOccurred())
+
+			list, err := snapshots.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list[0].Files).To(Equal([]string{
+				"bbl-state.json",
+				"vars/bosh-state.json",
+				"vars/reviewed.tfplan",
+				"vars/reviewed.tfplan.checksum",
+			}))
+		})
+
+		It("skips the snapshot when nothing changed", func() {
+			Expect(snapshots.Take()).To(Succeed())
+			now = now.Add(time.Minute)
+			Expect(snapshots.Take()).To(Succeed())
+
+			list, err := snapshots.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list).To(HaveLen(1))
+		})
+
+		It("gives snapshots taken in the same second distinct ids", func() {
+			Expect(snapshots.Take()).To(Succeed())
+			fs.WriteFile("/state/bbl-state.json", []byte("some-new-state"), 0644)
+			Expect(snapshots.Take()).To(Succeed())
+
+			list, err := snapshots.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list[0].ID).To(Equal("20180301T120000Z"))
+			Expect(list[1].ID).To(Equal("20180301T120000Z-1"))
+		})
+
+		It("keeps only the newest snapshots", func() {
+			for i := 0; i < 5; i++ {
+				fs.WriteFile("/state/bbl-state.json", []byte{byte(i)}, 0644)
+				Expect(snapshots.Take()).To(Succeed())
+				now = now.Add(time.Hour)
+			}
+
+			list, err := snapshots.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list).To(HaveLen(3))
+			Expect(list[0].ID).To(Equal("20180301T140000Z"))
+			Expect(list[2].ID).To(Equal("20180301T160000Z"))
+		})
+
+		Context("when there is no state", func() {
+			It("does nothing", func() {
+				fs.Remove("/state/bbl-state.json")
+				fs.Remove("/state/vars/bosh-state.json")
+
+				Expect(snapshots.Take()).To(Succeed())
+
+				_, err := fs.Stat("/state/.snapshots")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("List", func() {
+		It("returns nothing when no snapshot was taken", func() {
+			list, err := snapshots.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list).To(BeEmpty())
+		})
+	})
+
+	Describe("Read", func() {
+		Context("when the snapshot does not exist", func() {
+			It("returns an error", func() {
+				_, err := snapshots.Read("20180301T120000Z")
+				Expect(err).To(MatchError("Snapshot 20180301T120000Z does not exist"))
+			})
+		})
+
+		Context("when the id is a path", func() {
+			It("returns an error", func() {
+				_, err := snapshots.Read("../vars")
+				Expect(err).To(MatchError("Snapshot ../vars does not exist"))
+			})
+		})
+	})
+
+	Describe("Restore", func() {
+		BeforeEach(func() {
+			Expect(snapshots.Take()).To(Succeed())
+
+			fs.WriteFile("/state/bbl-state.json", []byte("some-bad-state"), 0644)
+			fs.WriteFile("/state/vars/jumpbox-state.json", []byte("some-jumpbox-state"), storage.StateMode)
+			fs.WriteFile("/state/vars/terraform.tfstate", []byte("some-new-tfstate"), storage.StateMode)
+		})
+
+		It("restores the state files from the snapshot", func() {
+			err := snapshots.Restore("20180301T120000Z", false)
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/bbl-state.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-state"))
+
+			_, err = fs.Stat("/state/vars/jumpbox-state.json")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			_, err = fs.Stat("/state/vars/user-ops-file.yml")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("leaves the terraform state alone", func() {
+			err := snapshots.Restore("20180301T120000Z", false)
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/vars/terraform.tfstate")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-new-tfstate"))
+		})
+
+		Context("when the terraform state is included", func() {
+			It("rolls back the terraform state too", func() {
+				err := snapshots.Restore("20180301T120000Z", true)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = fs.Stat("/state/vars/terraform.tfstate")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when a terraform plan was saved after the snapshot", func() {
+			BeforeEach(func() {
+				fs.WriteFile("/state/vars/reviewed.tfplan", []byte("some-new-plan"), storage.StateMode)
+				fs.WriteFile("/state/vars/reviewed.tfplan.checksum", []byte("some-new-checksum"), storage.StateMode)
+			})
+
+			It("leaves the plan alone with the terraform state", func() {
+				err := snapshots.Restore("20180301T120000Z", false)
+				Expect(err).NotTo(HaveOccurred())
+
+				contents, err := fs.ReadFile("/state/vars/reviewed.tfplan")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-new-plan"))
+			})
+
+			It("removes the plan when the terraform state is rolled back", func() {
+				err := snapshots.Restore("20180301T120000Z", true)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = fs.Stat("/state/vars/reviewed.tfplan")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+
+				_, err = fs.Stat("/state/vars/reviewed.tfplan.checksum")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the snapshot holds a terraform plan", func() {
+			It("restores the plan with the terraform state", func() {
+				fs.WriteFile("/state/vars/reviewed.tfplan", []byte("some-plan"), storage.StateMode)
+				now = now.Add(time.Minute)
+				Expect(snapshots.Take()).To(Succeed())
+				fs.Remove("/state/vars/reviewed.tfplan")
+
+				err := snapshots.Restore("20180301T120100Z", true)
+				Expect(err).NotTo(HaveOccurred())
+
+				contents, err := fs.ReadFile("/state/vars/reviewed.tfplan")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-plan"))
+			})
+		})
+
+		Context("when the snapshot does not exist", func() {
+			It("returns an error", func() {
+				err := snapshots.Restore("some-id", false)
+				Expect(err).To(MatchError("Snapshot some-id does not exist"))
+			})
+		})
+	})
+})
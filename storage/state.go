@@ -17,4 +17,39 @@ type State struct {
 	TFState        string    `json:"tfState"`
 	LB             LB        `json:"lb"`
 	LatestTFOutput string    `json:"latestTFOutput"`
+
+	// TerraformBackend is the backend that bbl configures terraform with.
+	TerraformBackend TerraformBackend `json:"terraformBackend,omitempty"`
+
+	// Patches are the plan patches that bbl plan --patch applied.
+	Patches []Patch `json:"patches,omitempty"`
+
+	// Overrides record the base scripts that the override scripts in the
+	// state dir were written against.
+	Overrides []Override `json:"overrides,omitempty"`
+
+	// DirectorFeatures are the bosh-deployment customizations that
+	// bbl plan --director-feature turned on for the director.
+	DirectorFeatures []string `json:"directorFeatures,omitempty"`
+
+	// JumpboxSizing and DirectorSizing are the VM types and disk sizes that
+	// bbl plan was given for the jumpbox and the director.
+	JumpboxSizing  Sizing `json:"jumpboxSizing,omitempty"`
+	DirectorSizing Sizing `json:"directorSizing,omitempty"`
+
+	// JumpboxDeploymentDir and BOSHDeploymentDir are the jumpbox-deployment
+	// and bosh-deployment checkouts that bbl plan was given to use instead of
+	// the ones built into bbl, with the git revision each was at.
+	JumpboxDeploymentDir      string `json:"jumpboxDeploymentDir,omitempty"`
+	JumpboxDeploymentRevision string `json:"jumpboxDeploymentRevision,omitempty"`
+	BOSHDeploymentDir         string `json:"boshDeploymentDir,omitempty"`
+	BOSHDeploymentRevision    string `json:"boshDeploymentRevision,omitempty"`
+
+	// OfflineAssetsDir has the release and stemcell tarballs that bbl plan
+	// --offline-assets points create-env at instead of bosh.io.
+	OfflineAssetsDir string `json:"offlineAssetsDir,omitempty"`
+
+	// Checkpoints maps each completed phase of bbl up to the fingerprint
+	// of the inputs it ran with.
+	Checkpoints map[string]string `json:"checkpoints,omitempty"`
 }
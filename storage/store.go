@@ -17,7 +17,7 @@ var (
 )
 
 const (
-	STATE_SCHEMA = 14
+	STATE_SCHEMA = 15
 	STATE_FILE   = "bbl-state.json"
 )
 
@@ -25,6 +25,8 @@ type Store struct {
 	dir              string
 	fs               fs
 	garbageCollector garbageCollector
+	backend          StateBackend
+	snapshotter      snapshotter
 	stateSchema      int
 }
 
@@ -41,11 +43,17 @@ type garbageCollector interface {
 	Remove(d string) error
 }
 
-func NewStore(dir string, fs fs, garbageCollector garbageCollector) Store {
+type snapshotter interface {
+	Take() error
+}
+
+func NewStore(dir string, fs fs, garbageCollector garbageCollector, backend StateBackend, snapshotter snapshotter) Store {
 	return Store{
 		dir:              dir,
 		fs:               fs,
 		garbageCollector: garbageCollector,
+		backend:          backend,
+		snapshotter:      snapshotter,
 		stateSchema:      STATE_SCHEMA,
 	}
 }
@@ -61,7 +69,7 @@ func (s Store) Set(state State) error {
 		if err != nil {
 			return fmt.Errorf("Garbage collector clean up: %s", err)
 		}
-		return nil
+		return s.push()
 	}
 
 	state.Version = s.stateSchema
@@ -79,12 +87,27 @@ func (s Store) Set(state State) error {
 		return err
 	}
 
+	// The snapshot holds the state from before this change, so that it
+	// can be rolled back.
+	err = s.snapshotter.Take()
+	if err != nil {
+		return fmt.Errorf("Snapshot state: %s", err)
+	}
+
 	stateFile := filepath.Join(s.dir, STATE_FILE)
 	err = s.fs.WriteFile(stateFile, jsonData, os.FileMode(0644))
 	if err != nil {
 		return err
 	}
 
+	return s.push()
+}
+
+func (s Store) push() error {
+	err := s.backend.Push(s.dir)
+	if err != nil {
+		return fmt.Errorf("Push state: %s", err)
+	}
 	return nil
 }
 
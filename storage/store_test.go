@@ -19,6 +19,8 @@ var _ = Describe("Store", func() {
 	var (
 		fileIO           *fakes.FileIO
 		garbageCollector *fakes.GarbageCollector
+		backend          *fakes.StateBackend
+		snapshotter      *fakes.Snapshotter
 		store            storage.Store
 		tempDir          string
 	)
@@ -29,8 +31,10 @@ var _ = Describe("Store", func() {
 
 		fileIO = &fakes.FileIO{}
 		garbageCollector = &fakes.GarbageCollector{}
+		backend = &fakes.StateBackend{}
+		snapshotter = &fakes.Snapshotter{}
 
-		store = storage.NewStore(tempDir, fileIO, garbageCollector)
+		store = storage.NewStore(tempDir, fileIO, garbageCollector, backend, snapshotter)
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -133,7 +137,7 @@ var _ = Describe("Store", func() {
 				Expect(fileIO.WriteFileCall.Receives[0].Filename).To(Equal(filepath.Join(tempDir, "bbl-state.json")))
 				Expect(fileIO.WriteFileCall.Receives[0].Mode).To(Equal(os.FileMode(0644)))
 				Expect(fileIO.WriteFileCall.Receives[0].Contents).To(MatchJSON(`{
-				"version": 14,
+				"version": 15,
 				"bblVersion": "5.3.0",
 				"iaas": "aws",
 				"id": "01020304-0506-0708-0910-111213141516",
@@ -182,11 +186,53 @@ var _ = Describe("Store", func() {
 					}
 				},
 				"tfState": "some-tf-state",
-				"latestTFOutput": ""
+				"latestTFOutput": "",
+				"terraformBackend": {},
+				"jumpboxSizing": {},
+				"directorSizing": {}
 		    	}`))
 			})
 		})
 
+		It("pushes the state dir to the state backend", func() {
+			err := store.Set(storage.State{EnvID: "some-env-id"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(backend.PushCall.CallCount).To(Equal(1))
+			Expect(backend.PushCall.Receives.Dir).To(Equal(tempDir))
+		})
+
+		It("snapshots the state", func() {
+			err := store.Set(storage.State{EnvID: "some-env-id"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(snapshotter.TakeCall.CallCount).To(Equal(1))
+		})
+
+		Context("when the state cannot be snapshotted", func() {
+			BeforeEach(func() {
+				snapshotter.TakeCall.Returns.Error = errors.New("lime")
+			})
+
+			It("returns an error without writing the state", func() {
+				err := store.Set(storage.State{EnvID: "some-env-id"})
+				Expect(err).To(MatchError("Snapshot state: lime"))
+
+				Expect(fileIO.WriteFileCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the state backend fails to push", func() {
+			BeforeEach(func() {
+				backend.PushCall.Returns.Error = errors.New("kiwi")
+			})
+
+			It("returns an error", func() {
+				err := store.Set(storage.State{EnvID: "some-env-id"})
+				Expect(err).To(MatchError("Push state: kiwi"))
+			})
+		})
+
 		Context("when the state is empty", func() {
 			It("calls the garbage collector", func() {
 				err := store.Set(storage.State{})
@@ -194,6 +240,9 @@ var _ = Describe("Store", func() {
 
 				Expect(garbageCollector.RemoveCall.CallCount).To(Equal(1))
 				Expect(garbageCollector.RemoveCall.Receives.Directory).To(Equal(tempDir))
+
+				Expect(backend.PushCall.CallCount).To(Equal(1))
+				Expect(snapshotter.TakeCall.CallCount).To(Equal(0))
 			})
 
 			Context("when the garbage collector fails to clean up", func() {
@@ -241,7 +290,7 @@ var _ = Describe("Store", func() {
 				})
 
 				It("returns an error", func() {
-					store = storage.NewStore("non-valid-dir", fileIO, garbageCollector)
+					store = storage.NewStore("non-valid-dir", fileIO, garbageCollector, backend, snapshotter)
 					err := store.Set(storage.State{})
 					Expect(err).To(MatchError(ContainSubstring("no such file or directory")))
 				})
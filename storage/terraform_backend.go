@@ -0,0 +1,12 @@
+package storage
+
+// TerraformBackend is where terraform keeps its state. Without a type, or
+// with the local type, it is kept in vars/terraform.tfstate.
+type TerraformBackend struct {
+	Type   string            `json:"type,omitempty"`
+	Config map[string]string `json:"config,omitempty"`
+}
+
+func (b TerraformBackend) IsRemote() bool {
+	return b.Type != "" && b.Type != "local"
+}
@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// legacySyntax matches the terraform 0.11 idioms that the HCL2 templates no
+// longer accept, or that terraform 0.12 and later deprecate.
+var legacySyntax = []struct {
+	pattern     *regexp.Regexp
+	description string
+}{
+	{regexp.MustCompile(`^\s*type\s*=\s*"(string|list|map)"`), "quoted type constraint"},
+	{regexp.MustCompile(`^\s*(depends_on|ignore_changes)\s*=\s*\[\s*"`), "quoted reference"},
+	{regexp.MustCompile(`=\s*"\$\{[^{}"]*\}"\s*,?\s*$`), "interpolation-only string"},
+	{regexp.MustCompile(`\[\s*"\$\{[^"]*\*[^"]*\}"\s*\]`), "list wrapped in brackets"},
+	{regexp.MustCompile(`^\s*tags\s*\{`), "tags block instead of a map"},
+}
+
+// LegacyTerraformOverrides returns a warning for each override file in the
+// terraform dir that still uses terraform 0.11 syntax, and for a bbl.tfvars
+// that could not be converted to bbl.tfvars.json.
+func (m Migrator) LegacyTerraformOverrides(state State) ([]string, error) {
+	if reflect.DeepEqual(state, State{}) {
+		return nil, nil
+	}
+
+	terraformDir, err := m.store.GetTerraformDir()
+	if err != nil {
+		return nil, fmt.Errorf("getting terraform dir: %s", err)
+	}
+
+	varsDir, err := m.store.GetVarsDir()
+	if err != nil {
+		return nil, fmt.Errorf("getting vars dir: %s", err)
+	}
+
+	warnings, err := m.legacyTerraformVars(varsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := m.fs.ReadDir(terraformDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return warnings, nil
+		}
+		return nil, fmt.Errorf("reading terraform dir: %s", err)
+	}
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), "override.tf") {
+			continue
+		}
+
+		path := filepath.Join(terraformDir, file.Name())
+		contents, err := m.fs.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %s", path, err)
+		}
+
+		found := legacySyntaxIn(string(contents))
+		if len(found) > 0 {
+			warnings = append(warnings, fmt.Sprintf("%s uses terraform 0.11 syntax: %s", path, strings.Join(found, ", ")))
+		}
+	}
+
+	return warnings, nil
+}
+
+// legacyTerraformVars warns about a bbl.tfvars that is still in the vars
+// dir. terraform reads it before bbl.tfvars.json, so the values that bbl
+// provides win over the ones in it.
+func (m Migrator) legacyTerraformVars(varsDir string) ([]string, error) {
+	path := filepath.Join(varsDir, "bbl.tfvars")
+	if _, err := m.fs.Stat(path); err != nil {
+		return nil, nil
+	}
+
+	contents, err := m.fs.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %s", path, err)
+	}
+
+	problem := fmt.Sprintf("%s is no longer written by bbl", path)
+	if _, err := parseTFVars(string(contents)); err != nil {
+		problem = fmt.Sprintf("%s could not be converted to bbl.tfvars.json (%s)", path, err)
+	}
+
+	return []string{fmt.Sprintf("%s. terraform still reads it, but bbl.tfvars.json overrides the variables that bbl provides, so move the variables you added to another vars/*.tfvars file and remove it.", problem)}, nil
+}
+
+func legacySyntaxIn(contents string) []string {
+	lines := map[string][]string{}
+	for i, line := range strings.Split(contents, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "//") {
+			continue
+		}
+
+		for _, syntax := range legacySyntax {
+			if syntax.pattern.MatchString(line) {
+				lines[syntax.description] = append(lines[syntax.description], fmt.Sprintf("%d", i+1))
+			}
+		}
+	}
+
+	var found []string
+	for description, numbers := range lines {
+		noun := "line"
+		if len(numbers) > 1 {
+			noun = "lines"
+		}
+		found = append(found, fmt.Sprintf("%s on %s %s", description, noun, strings.Join(numbers, ", ")))
+	}
+	sort.Strings(found)
+
+	return found
+}
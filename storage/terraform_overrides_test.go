@@ -0,0 +1,161 @@
+package storage_test
+
+import (
+	"errors"
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/fakes"
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LegacyTerraformOverrides", func() {
+	var (
+		migrator storage.Migrator
+		store    *fakes.StateStore
+		fileIO   *fakes.FileIO
+		state    storage.State
+		files    map[string]string
+	)
+
+	BeforeEach(func() {
+		store = &fakes.StateStore{}
+		fileIO = &fakes.FileIO{}
+		migrator = storage.NewMigrator(store, fileIO)
+
+		state = storage.State{IAAS: "aws", EnvID: "some-env"}
+		store.GetTerraformDirCall.Returns.Directory = "/state/terraform"
+		store.GetVarsDirCall.Returns.Directory = "/state/vars"
+
+		files = map[string]string{
+			"/state/terraform/bbl-template.tf": `variable "env_id" {
+  type = "string"
+}`,
+			"/state/terraform/my-override.tf": `variable "extra_zones" {
+  type = "list"
+}
+
+resource "aws_instance" "extra" {
+  # subnet_id = "${aws_subnet.old.id}"
+  subnet_id  = "${aws_subnet.extra.id}"
+  name       = "${var.env_id}-extra"
+  depends_on = ["aws_subnet.extra"]
+
+  tags {
+    Name = "extra"
+  }
+}
+
+resource "aws_instance" "other" {
+  subnet_id = "${aws_subnet.other.id}"
+}`,
+			"/state/terraform/modern_override.tf": `variable "extra_zones" {
+  type = list(string)
+}
+
+resource "aws_instance" "extra" {
+  subnet_id = aws_subnet.extra.id
+  tags = {
+    Name = "${var.env_id}-extra"
+  }
+}`,
+		}
+		fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
+			fakes.FileInfo{FileName: "bbl-template.tf"},
+			fakes.FileInfo{FileName: "my-override.tf"},
+			fakes.FileInfo{FileName: "modern_override.tf"},
+		}
+		fileIO.ReadFileCall.Fake = func(path string) ([]byte, error) {
+			return []byte(files[path]), nil
+		}
+		fileIO.StatCall.Fake = func(path string) (os.FileInfo, error) {
+			if _, ok := files[path]; !ok {
+				return nil, os.ErrNotExist
+			}
+			return fakes.FileInfo{}, nil
+		}
+	})
+
+	It("warns about the override files that use terraform 0.11 syntax", func() {
+		warnings, err := migrator.LegacyTerraformOverrides(state)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fileIO.ReadDirCall.Receives.Dirname).To(Equal("/state/terraform"))
+		Expect(warnings).To(Equal([]string{
+			"/state/terraform/my-override.tf uses terraform 0.11 syntax: interpolation-only string on lines 7, 17, quoted reference on line 9, quoted type constraint on line 2, tags block instead of a map on line 11",
+		}))
+	})
+
+	Context("when the state is empty", func() {
+		It("does not look for override files", func() {
+			warnings, err := migrator.LegacyTerraformOverrides(storage.State{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(warnings).To(BeEmpty())
+			Expect(store.GetTerraformDirCall.CallCount).To(Equal(0))
+		})
+	})
+
+	Context("when bbl.tfvars is still in the vars dir", func() {
+		It("warns about a bbl.tfvars that could not be converted", func() {
+			files["/state/vars/bbl.tfvars"] = "ca_cert = <<EOF\nsome-cert\nEOF\n"
+
+			warnings, err := migrator.LegacyTerraformOverrides(state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(warnings).To(ContainElement("/state/vars/bbl.tfvars could not be converted to bbl.tfvars.json (line 1: unsupported value \"<<EOF\"). terraform still reads it, but bbl.tfvars.json overrides the variables that bbl provides, so move the variables you added to another vars/*.tfvars file and remove it."))
+		})
+
+		It("warns about a bbl.tfvars that bbl no longer writes", func() {
+			files["/state/vars/bbl.tfvars"] = "region = \"us-east-1\"\n"
+
+			warnings, err := migrator.LegacyTerraformOverrides(state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(warnings).To(ContainElement("/state/vars/bbl.tfvars is no longer written by bbl. terraform still reads it, but bbl.tfvars.json overrides the variables that bbl provides, so move the variables you added to another vars/*.tfvars file and remove it."))
+		})
+	})
+
+	Context("when the terraform dir does not exist", func() {
+		It("returns no warnings", func() {
+			fileIO.ReadDirCall.Returns.Error = &os.PathError{Op: "open", Path: "/state/terraform", Err: os.ErrNotExist}
+
+			warnings, err := migrator.LegacyTerraformOverrides(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+	})
+
+	Context("failure cases", func() {
+		It("returns an error when the vars dir cannot be found", func() {
+			store.GetVarsDirCall.Returns.Error = errors.New("lime")
+
+			_, err := migrator.LegacyTerraformOverrides(state)
+			Expect(err).To(MatchError("getting vars dir: lime"))
+		})
+
+		It("returns an error when the terraform dir cannot be found", func() {
+			store.GetTerraformDirCall.Returns.Error = errors.New("lime")
+
+			_, err := migrator.LegacyTerraformOverrides(state)
+			Expect(err).To(MatchError("getting terraform dir: lime"))
+		})
+
+		It("returns an error when the terraform dir cannot be read", func() {
+			fileIO.ReadDirCall.Returns.Error = errors.New("lemon")
+
+			_, err := migrator.LegacyTerraformOverrides(state)
+			Expect(err).To(MatchError("reading terraform dir: lemon"))
+		})
+
+		It("returns an error when an override file cannot be read", func() {
+			fileIO.ReadFileCall.Fake = func(path string) ([]byte, error) {
+				return nil, errors.New("grapefruit")
+			}
+
+			_, err := migrator.LegacyTerraformOverrides(state)
+			Expect(err).To(MatchError("reading /state/terraform/my-override.tf: grapefruit"))
+		})
+	})
+})
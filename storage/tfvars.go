@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var tfvarsUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\"`, `"`,
+	`\n`, "\n",
+	`\r`, "\r",
+	`\t`, "\t",
+	"$${", "${",
+	"%%{", "%{",
+)
+
+// parseTFVars reads the variables that bbl wrote to bbl.tfvars, one
+// name=value per line, where the value is a quoted string, a list of quoted
+// strings or a number or bool.
+func parseTFVars(contents string) (map[string]interface{}, error) {
+	vars := map[string]interface{}{}
+	for i, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d is not a variable definition", i+1)
+		}
+
+		name := strings.TrimSpace(parts[0])
+		value, err := parseTFVarsValue(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+1, err)
+		}
+		vars[name] = value
+	}
+
+	return vars, nil
+}
+
+func parseTFVarsValue(value string) (interface{}, error) {
+	switch {
+	case strings.HasPrefix(value, `"`):
+		s, rest, err := parseTFVarsString(value)
+		if err != nil {
+			return nil, err
+		}
+		if rest != "" {
+			return nil, fmt.Errorf("unexpected %q after string", rest)
+		}
+		return s, nil
+	case strings.HasPrefix(value, "["):
+		return parseTFVarsList(value)
+	case value == "true" || value == "false":
+		return value == "true", nil
+	default:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("unsupported value %q", value)
+		}
+		return json.Number(value), nil
+	}
+}
+
+func parseTFVarsList(value string) ([]string, error) {
+	list := []string{}
+	rest := strings.TrimSpace(strings.TrimPrefix(value, "["))
+	for !strings.HasPrefix(rest, "]") {
+		s, remaining, err := parseTFVarsString(rest)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+
+		rest = strings.TrimSpace(remaining)
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, ","))
+	}
+
+	if strings.TrimSpace(strings.TrimPrefix(rest, "]")) != "" {
+		return nil, fmt.Errorf("unexpected %q after list", rest)
+	}
+
+	return list, nil
+}
+
+// parseTFVarsString reads the quoted string at the start of value and returns
+// it unescaped, along with what follows it.
+func parseTFVarsString(value string) (string, string, error) {
+	if !strings.HasPrefix(value, `"`) {
+		return "", "", fmt.Errorf("expected a quoted string in %q", value)
+	}
+
+	for i := 1; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case '"':
+			return tfvarsUnescaper.Replace(value[1:i]), strings.TrimSpace(value[i+1:]), nil
+		}
+	}
+
+	return "", "", fmt.Errorf("unterminated string in %q", value)
+}
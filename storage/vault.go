@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+)
+
+type vaultFs interface {
+	fileio.FileReader
+	fileio.FileWriter
+	fileio.Stater
+	fileio.DirReader
+}
+
+// Vault converts the sensitive files of a state directory between their
+// encrypted and plaintext forms, so that terraform and bosh can read them
+// while they run.
+type Vault struct {
+	dir    string
+	fs     vaultFs
+	cipher Cipher
+}
+
+func NewVault(dir string, fs vaultFs, cipher Cipher) Vault {
+	return Vault{
+		dir:    dir,
+		fs:     fs,
+		cipher: cipher,
+	}
+}
+
+func (v Vault) IsEnabled() bool {
+	return v.cipher.IsEnabled()
+}
+
+func (v Vault) Seal() error {
+	if !v.IsEnabled() {
+		return nil
+	}
+
+	paths, err := v.paths()
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		err := v.convert(path, IsEncrypted, v.cipher.Encrypt)
+		if err != nil {
+			return fmt.Errorf("Encrypt %s: %s", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (v Vault) Unseal() error {
+	isPlaintext := func(data []byte) bool { return !IsEncrypted(data) }
+
+	paths, err := v.paths()
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		err := v.convert(path, isPlaintext, v.cipher.Decrypt)
+		if err != nil {
+			return fmt.Errorf("Decrypt %s: %s", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (v Vault) convert(path string, skip func([]byte) bool, conversion func([]byte) ([]byte, error)) error {
+	info, err := v.fs.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	contents, err := v.fs.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if skip(contents) {
+		return nil
+	}
+
+	converted, err := conversion(contents)
+	if err != nil {
+		return err
+	}
+
+	return v.fs.WriteFile(path, converted, info.Mode())
+}
+
+func (v Vault) paths() ([]string, error) {
+	paths := []string{}
+	for name := range encryptedFiles {
+		if name == STATE_FILE {
+			paths = append(paths, filepath.Join(v.dir, name))
+		} else {
+			paths = append(paths, filepath.Join(v.dir, "vars", name))
+		}
+	}
+
+	varsDir := filepath.Join(v.dir, "vars")
+	files, err := v.fs.ReadDir(varsDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("Read vars dir: %s", err)
+	}
+	for _, file := range files {
+		if !file.IsDir() && IsTerraformPlan(file.Name()) {
+			paths = append(paths, filepath.Join(varsDir, file.Name()))
+		}
+	}
+
+	sort.Strings(paths)
+	return paths, nil
+}
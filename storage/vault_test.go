@@ -0,0 +1,121 @@
+package storage_test
+
+import (
+	"os"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/spf13/afero"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Vault", func() {
+	var (
+		fs     *afero.Afero
+		cipher storage.Cipher
+		vault  storage.Vault
+	)
+
+	BeforeEach(func() {
+		fs = &afero.Afero{Fs: afero.NewMemMapFs()}
+		cipher = storage.NewCipher([]byte("some-passphrase"))
+		vault = storage.NewVault("/state", fs, cipher)
+
+		err := fs.WriteFile("/state/bbl-state.json", []byte("{}"), storage.StateMode)
+		Expect(err).NotTo(HaveOccurred())
+		err = fs.WriteFile("/state/vars/terraform.tfstate", []byte("some-tf-state"), storage.StateMode)
+		Expect(err).NotTo(HaveOccurred())
+		err = fs.WriteFile("/state/vars/user.tfvars", []byte("some-tf-vars"), storage.StateMode)
+		Expect(err).NotTo(HaveOccurred())
+		err = fs.WriteFile("/state/vars/reviewed.tfplan", []byte("some-tf-plan"), storage.StateMode)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Seal", func() {
+		It("encrypts the sensitive files in the state dir", func() {
+			err := vault.Seal()
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/bbl-state.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storage.IsEncrypted(contents)).To(BeTrue())
+
+			contents, err = fs.ReadFile("/state/vars/terraform.tfstate")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storage.IsEncrypted(contents)).To(BeTrue())
+
+			info, err := fs.Stat("/state/vars/terraform.tfstate")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode()).To(Equal(os.FileMode(storage.StateMode)))
+
+			contents, err = fs.ReadFile("/state/vars/reviewed.tfplan")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(storage.IsEncrypted(contents)).To(BeTrue())
+
+			contents, err = fs.ReadFile("/state/vars/user.tfvars")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-tf-vars"))
+		})
+
+		It("does not encrypt files twice", func() {
+			err := vault.Seal()
+			Expect(err).NotTo(HaveOccurred())
+			err = vault.Seal()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = vault.Unseal()
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/vars/terraform.tfstate")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-tf-state"))
+		})
+
+		Context("when encryption is not enabled", func() {
+			It("leaves the files alone", func() {
+				vault = storage.NewVault("/state", fs, storage.NewCipher(nil))
+
+				err := vault.Seal()
+				Expect(err).NotTo(HaveOccurred())
+
+				contents, err := fs.ReadFile("/state/vars/terraform.tfstate")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-tf-state"))
+			})
+		})
+	})
+
+	Describe("Unseal", func() {
+		BeforeEach(func() {
+			err := vault.Seal()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("decrypts the sensitive files in the state dir", func() {
+			err := vault.Unseal()
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := fs.ReadFile("/state/bbl-state.json")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("{}"))
+
+			contents, err = fs.ReadFile("/state/vars/terraform.tfstate")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-tf-state"))
+
+			contents, err = fs.ReadFile("/state/vars/reviewed.tfplan")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-tf-plan"))
+		})
+
+		Context("when no state key is provided", func() {
+			It("returns an error", func() {
+				vault = storage.NewVault("/state", fs, storage.NewCipher(nil))
+
+				err := vault.Unseal()
+				Expect(err).To(MatchError("Decrypt /state/bbl-state.json: " + storage.ErrMissingStateKey.Error()))
+			})
+		})
+	})
+})
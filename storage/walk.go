@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/cloudfoundry/bosh-bootloader/fileio"
+)
+
+// walkFiles returns the mode of every file below dir, keyed by its slash
+// separated path relative to dir. Files and directories for which skip
+// returns true are left out.
+func walkFiles(fs fileio.DirReader, dir string, skip func(name string, info os.FileInfo) bool) (map[string]os.FileMode, error) {
+	files := map[string]os.FileMode{}
+
+	var walk func(relative string) error
+	walk = func(relative string) error {
+		infos, err := fs.ReadDir(filepath.Join(dir, filepath.FromSlash(relative)))
+		if err != nil {
+			return fmt.Errorf("Read state dir: %s", err)
+		}
+
+		for _, info := range infos {
+			name := path.Join(relative, info.Name())
+			if skip(name, info) {
+				continue
+			}
+
+			if info.IsDir() {
+				if err := walk(name); err != nil {
+					return err
+				}
+				continue
+			}
+
+			files[name] = info.Mode()
+		}
+
+		return nil
+	}
+
+	if err := walk(""); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+)
+
+type OutputContract struct{}
+
+func NewOutputContract() OutputContract {
+	return OutputContract{}
+}
+
+// Outputs lists the outputs that create-env, the cloud config and bbl lbs
+// read from the aws templates.
+func (c OutputContract) Outputs(state storage.State) []terraform.OutputSpec {
+	outputs := []terraform.OutputSpec{
+		{Name: "default_key_name", Type: terraform.StringOutput},
+		{Name: "private_key", Type: terraform.StringOutput, Sensitive: true},
+		{Name: "external_ip", Type: terraform.StringOutput},
+		{Name: "jumpbox_url", Type: terraform.StringOutput},
+		{Name: "internal_security_group", Type: terraform.StringOutput},
+		{Name: "jumpbox__default_security_groups", Type: terraform.ListOutput},
+		{Name: "director__default_security_groups", Type: terraform.ListOutput},
+		{Name: "subnet_id", Type: terraform.StringOutput},
+		{Name: "az", Type: terraform.StringOutput},
+		{Name: "vpc_id", Type: terraform.StringOutput},
+		{Name: "region", Type: terraform.StringOutput},
+		{Name: "internal_az_subnet_id_mapping", Type: terraform.MapOutput},
+		{Name: "internal_az_subnet_cidr_mapping", Type: terraform.MapOutput},
+		{Name: "director_name", Type: terraform.StringOutput},
+		{Name: "internal_cidr", Type: terraform.StringOutput},
+		{Name: "internal_gw", Type: terraform.StringOutput},
+		{Name: "jumpbox__internal_ip", Type: terraform.StringOutput},
+		{Name: "director__internal_ip", Type: terraform.StringOutput},
+		{Name: "iam_instance_profile", Type: terraform.StringOutput},
+	}
+
+	switch state.LB.Type {
+	case "concourse":
+		outputs = append(outputs,
+			terraform.OutputSpec{Name: "concourse_lb_internal_security_group", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "concourse_lb_target_groups", Type: terraform.ListOutput},
+			terraform.OutputSpec{Name: "concourse_lb_name", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "concourse_lb_url", Type: terraform.StringOutput},
+		)
+	case "cf":
+		outputs = append(outputs,
+			terraform.OutputSpec{Name: "cf_router_lb_name", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "cf_router_lb_url", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "cf_router_lb_internal_security_group", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "cf_ssh_lb_name", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "cf_ssh_lb_url", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "cf_ssh_lb_internal_security_group", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "cf_tcp_lb_name", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "cf_tcp_lb_url", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "cf_tcp_lb_internal_security_group", Type: terraform.StringOutput},
+		)
+
+		if state.LB.Domain != "" {
+			outputs = append(outputs, terraform.OutputSpec{Name: "env_dns_zone_name_servers", Type: terraform.ListOutput})
+		}
+	}
+
+	return outputs
+}
@@ -76,7 +76,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesBaseTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5b\x5f\x6f\xe3\xb8\x11\x7f\x3e\x7f\x0a\xc1\xd8\x87\xdd\x36\xf6\x5a\x8e\xff\xe5\x80\x7d\xb8\xf6\x0a\xf4\xfa\x70\x2d\x7a\xfb\x56\x2c\x04\x8a\xa2\x65\x36\x92\x28\x90\x94\xb3\xd9\xc0\xdf\xbd\xe0\x3f\x49\x94\x44\x4b\xca\xc6\xeb\xa4\x77\x0f\x9b\x88\x33\xc3\x99\xdf\x0c\x67\x86\xd2\xe4\x08\x28\x06\x61\x82\xbc\x69\x06\x78\x00\x52\x1c\xa4\x20\x9f\x7a\x4f\x13\xcf\xe3\x8f\x39\xf2\x3e\x79\x53\xf1\x60\x32\xf1\xbc\x08\xed\x41\x91\x70\xef\x93\x5c\xf5\x3c\x90\xcf\x32\x42\xf9\x01\x01\xc6\x67\xbe\xa0\x04\x29\x9e\xf9\x8b\x68\x0f\x77\xdb\xed\xb4\x4d\xb3\x2c\x69\x80\x1f\xc2\xd5\x76\x55\xd2\x30\x52\xf0\xc3\xcc\x17\xbf\x19\x9a\xed\x0a\xfa\xbb\x8d\x1f\xda\x34\xf6\x5e\xb7\x1b\xb0\x5f\x2e\xd6\xeb\x0e\x9a\x6a\x2f\x74\xe7\xef\xfc\x6d\xa4\x68\x20\x98\x41\x94\x71\x0a\x12\xb9\x9b\xa1\x59\x46\xb7\x1b\xb0\xdd\x28\x1a\x54\x74\xd1\xdc\xa1\x10\xf9\xbb\xbd\x5f\xd2\x3c\x20\xa9\x4a\x5d\xe7\x5b\xb0\x5b\xdd\xed\xd7\xd0\xa6\x59\x5a\x34\x4b\xdf\x5f\x2e\x56\x2b\xad\x73\xc1\x66\xda\xa4\x3a\x4d\xb4\x82\x6b\xb4\x87\x4b\x9b\xc6\x96\xb3\x5f\x6e\xc3\x35\xb8\xdb\x96\x34\x31\x39\x96\x3a\x69\x1a\x78\x7b\xb7\xf1\x17\xa0\x92\xd3\xa1\x73\xb8\xdb\xee\xd7\xb7\xd1\xce\xa6\xb1\xf7\xda\x85\x7b\x88\x76\x7b\x29\xe7\x34\x39\x4d\x26\x55\xd4\x00\x08\x11\x63\xc1\x3d\x7a\xb4\x83\x86\x71\x8a\xb3\x78\x6a\x13\x33\x04\x29\xe2\x03\x89\x29\x8a\x31\xc9\x06\x10\x86\x84\x1d\x02\x9c\x85\xa4\xc8\xa2\x00\xe2\x88\x2a\x9e\x2a\x5c\xa7\x8b\xb9\xfc\xff\xe3\xa2\xc1\x09\x8e\x00\x27\x20\xc4\x09\xe6\x8f\xc1\x37\x92\x21\x66\x6f\x97\x60\xc6\x1b\x2c\x28\x3b\x06\x38\x1a\x62\xeb\x81\x50\x1e\x0c\x26\x3f\xe6\xb0\xa6\xbb\x24\x55\xf8\x1b\x6a\xcb\x20\xdf\x58\xe4\x6f\xa4\x1c\x8a\x18\x29\x28\x14\x26\x3d\xb0\x00\xe1\x7c\xea\x4d\xff\x5b\xa4\x79\x48\xbe\xaa\xdf\x14\x20\x39\xca\x22\x16\x90\xcc\xfb\xe4\xfd\x47\x52\xe2\x8c\x23\x9a\x21\x1e\xc4\x80\xa3\x07\xf0\x38\xc7\xf1\xf4\xcb\xc4\xf3\x8e\x39\xf4\x3c\x13\x02\x9c\x16\xc8\xde\x84\x27\x2c\xc8\x29\x3e\x02\x8e\x94\x33\x95\x0f\x8e\xa9\xc6\x0f\x24\x31\xa1\x98\x1f\x52\xa1\xeb\xbf\xff\xf8\x45\x68\x4f\x19\x08\x42\xcc\x99\x90\xb8\x5a\xdc\x6d\xda\x6a\xdf\xa3\xc7\x20\x07\x98\xb6\xc4\x89\x85\x0c\xa4\x48\x01\xf2\xee\xe9\x08\xe8\x5c\x01\x7b\x0a\x4a\xca\x89\xe7\xe5\x45\x98\x60\x28\xe4\x28\xba\x86\x9a\x73\x43\x3b\xaf\x08\x03\x92\xa3\x8c\xb1\xc3\xa9\x03\x46\x86\x60\x41\x45\x64\xc4\x94\x14\x02\x51\x91\x21\x9b\x0f\x85\x7e\x5a\x37\x73\x62\x2c\x05\x67\x19\xe0\x33\xc3\x34\x53\x4c\xd2\x17\x0c\x52\x9c\x73\x2c\x9d\x31\xfd\xfd\x97\xcf\x53\x05\x7b\x80\xa3\x9a\xa0\x84\x40\x90\xcc\xd5\xe3\x93\x4c\xc2\x1c\xc4\x4c\xe7\xdf\xdf\xc5\xb6\x03\xf7\x3b\x09\xde\x04\xef\x11\x7c\x84\x09\xd2\x02\x70\x9c\x11\x8a\x02\x78\x00\x59\x8c\x98\x0c\x0a\x61\x8a\x8c\x80\x53\x1f\x1e\x01\x2d\x12\xa4\x41\xe1\xa4\x8a\x24\xf5\x58\x6c\xd0\xa0\xc7\x91\x52\xb6\x2d\x6a\xde\x06\x76\x5e\xda\xab\x4f\x82\x86\x04\xc5\x14\x31\xe9\xec\x3d\x25\x69\x90\x13\xca\xe5\xc2\x42\x90\x12\xf3\xbb\x79\x92\x53\xc2\x09\x24\x89\x66\x9e\xc9\xe4\x2d\x4e\x59\x10\x26\x04\xde\x2b\x93\xab\xe4\xf0\x65\x8c\xcd\x18\xa6\xf9\x85\x8d\xc5\x59\x69\x6d\xc3\x12\xb1\x79\x1b\x84\x99\xdf\x42\x41\x3e\x7a\x21\x8b\x39\xbc\xa8\xc1\xd6\x7f\x6e\xeb\x9b\x64\x1c\xb6\x90\x68\x90\x34\x63\xa3\xb1\xbc\x59\xaf\x6f\xd7\xc2\x20\x09\x42\xd3\xfe\x33\x76\xa9\x90\x07\x49\xa7\x71\x23\x70\x2d\xa2\xd7\x88\x6b\x11\xbd\x0d\x5c\x71\xc6\x38\xc8\xa0\x06\x53\x61\x68\x92\x3e\xce\x9b\x56\xbd\x7b\x12\x87\xe1\x40\x18\x7f\x2f\x77\x2e\xc2\x0c\x71\x55\x18\xf4\xcf\xd5\x61\xb9\xf1\xb6\x1f\x4e\x02\x03\xb3\x45\x60\xc3\x2a\x82\x6f\x39\x4f\x51\x84\x8b\x54\x90\x29\x01\x65\x02\xb7\x76\x75\x6c\x26\x4d\x2a\x21\x8a\x10\xe3\x01\x3c\x20\x78\x6f\x38\xf7\x20\x61\x48\x14\xd4\x14\x3b\xbc\x29\x6a\x04\xb9\x2f\xf2\xf7\xa2\x06\xd4\x5a\xf8\x1b\x4f\x3c\x50\x3d\x94\xb2\x42\x54\x91\x96\x13\x54\x42\x18\x13\x5e\x5f\xba\xaa\x50\x67\x19\x52\x1d\xe5\xdf\xb2\xe3\x6f\xbf\xb6\xd6\xa7\xdd\x25\x46\x75\x2e\x62\xe7\xe7\x74\x2d\xc6\x4f\x75\xd0\xcd\x33\x61\x8e\x81\xbb\xb3\xbb\xc9\x29\x39\xe2\x08\x51\xa9\x88\x6e\x63\xca\xde\xb6\xd2\xbf\x7a\xa6\x3c\x57\x76\xb4\x15\x49\xf5\x4c\x92\x28\x1f\x54\xfe\xaa\xfc\xd2\x15\xce\xba\xc9\x6b\x37\x1f\xae\x85\xa7\xaa\x6f\xe8\x6a\x19\xfa\xbb\x1a\xc7\x71\x1b\xd0\xda\x18\xce\xfe\xfe\xe6\x37\x4d\xf9\x52\x4d\xce\x99\x9d\x2f\xd7\xe9\x38\x80\x92\xcb\xa2\x38\x8e\xcd\xdf\x67\xf3\x5c\x57\x0e\xef\x4b\xde\xe7\xaa\xa1\x2b\x5d\xd7\xf2\x34\x4a\xf6\xcd\xfd\xda\xad\xff\x33\xe1\x11\xd5\xe4\x15\xc0\xe3\x2c\x6a\x57\x86\x47\xf6\x73\xaf\x00\x9f\xae\xbe\xd2\x2c\xb6\xba\x4b\x6b\xa1\xde\x63\x9a\x85\x67\x75\x9a\x67\x71\x02\x49\x42\x1e\xca\xfc\xff\x23\x10\x43\xe7\x01\x53\x57\x8a\x31\xf1\xb4\xf8\x61\x60\x31\x76\x70\x21\x54\x19\xf0\x32\x40\x0d\x8c\xb0\x8a\xec\xf3\x5f\xff\xd5\xd3\x5d\x2e\x97\xe7\xdb\x4b\xb9\x3e\xba\xb7\xd4\x2f\x45\x06\xf5\xe8\xe6\x3d\xc4\xe8\xba\x28\xb8\xfa\x6b\xe2\x5f\xfe\xf9\xc7\xdf\xbd\x5f\x31\x45\x90\x13\xfa\x52\x85\xd1\xb1\xf5\xa8\xa2\x78\x23\x9a\x8d\x52\xd5\x71\x35\xb2\x03\xb0\xb2\x3e\x9e\x0b\x48\x97\xbf\x3a\xe4\x5d\xaa\x3e\x3a\x02\x4e\x2f\x74\x1f\x59\x05\x7e\xeb\x05\xe4\x69\xf8\x09\x3e\x0b\x98\x5c\x04\x31\xca\x9c\xa9\xae\xe7\x20\x8f\x82\x6f\xf4\x21\xee\xbf\x7a\x6f\x76\x9b\x5d\xcf\x2d\x51\x51\x5c\xf4\x20\xf7\x62\x5d\x00\xf0\x46\x01\xde\xad\x56\xb7\xe7\x01\xd6\x14\xd7\x05\x18\x52\x14\x1d\x8a\xf0\xad\x82\xbc\x5b\xad\x7a\x40\x56\x14\xd7\x05\x59\x64\x8c\x48\xd7\x93\x00\xe4\xf8\x8d\xa2\xbd\x5c\xaf\xd7\xeb\x9e\xda\xaf\x49\xae\x8e\xf7\x1b\x85\xf8\x75\xbc\xb9\x1b\x9b\xa4\xdd\x57\xc8\xab\xc2\xfd\x56\x5e\x94\x8e\x84\xfb\xfb\xae\x5a\x63\xfb\xb6\xd7\x79\xcd\xaa\xbe\xa2\x0e\xe8\xfa\x35\x65\x7f\xe3\xff\x0f\x2d\xf2\x85\x5a\x7e\xf7\xbe\x3f\xac\xeb\x37\x9f\x9a\x9f\xd1\xe0\x5b\x19\xf9\xff\xa5\xa9\x37\x78\xd0\xf1\x6f\xbd\x2e\x8c\xc7\xed\xed\xee\xce\x81\x88\x5e\xba\x34\x26\x67\xaf\x33\x57\x42\xc5\x79\x4d\x29\x97\x2e\x8d\x8a\xe9\xdb\x5e\x19\x30\xee\x5e\xac\x5a\xbb\x34\x34\xba\x34\x5c\x00\x98\xd7\x59\x74\xac\xca\xdc\x2e\xf1\xdf\xd9\x7a\x5e\xfe\xd5\xde\xf5\xda\x4f\x67\xd3\xf1\x02\x88\x3f\xbf\xfb\xbc\x3c\xe2\xd7\xeb\x40\x47\x20\x2e\x3f\x78\x97\x0d\xa7\xfe\xed\xc9\xee\x81\xba\x5a\xa0\xfa\x89\xaa\xbe\xe0\x2b\x01\xf2\xa3\xb7\x99\x9c\xbb\xf1\x76\x37\xde\xe2\xc3\xa8\x17\xa5\x4a\x8d\xee\x56\x87\x92\x82\xa3\x80\x83\xb0\x8a\x0d\xeb\xd1\xd8\x0f\xaf\x92\xd9\x29\x29\x42\x8c\xe3\x0c\x88\xde\x2b\xb0\x0d\xae\x0d\x2f\x7a\x9e\xfe\xe2\xdd\x1c\x32\xa8\x7d\xee\x6e\x7d\x1a\x37\x81\x56\xdb\xb2\xce\x5e\xb2\xd6\xd6\xe7\x4d\x1d\x1d\x4e\xad\x8b\x04\x8c\x11\x88\xa5\x01\x53\x6f\xaa\x56\x6a\xbe\x36\x09\xdc\x9e\x91\x18\x30\x1b\xd1\x50\xfb\x7b\xd4\x2d\x63\xb0\x3a\x92\x75\xdd\x20\x29\x32\xde\x86\x35\x41\x59\xcc\x0f\x32\xd4\xda\xf3\xa2\xd5\x68\x85\xed\x91\xde\x48\xae\xd3\x39\x03\x7a\x75\xa3\x94\x9a\xe3\x2c\x42\x5f\xff\xec\xab\xdd\x5a\x5a\x28\x29\x28\x41\x29\xca\xb8\x43\x51\x4b\xd2\xd0\x43\x52\x7d\x66\x97\xda\xbd\x7b\xaa\xc9\x38\x8d\xb9\x61\x54\x86\x8b\x7b\x46\x4b\x3b\xd7\x6d\xc3\x3e\x82\xa5\xd7\x5e\xe4\x18\xba\xa5\x0d\x3c\x8a\xe5\x54\x50\x87\xe7\x5d\x93\x27\x63\xce\x60\x97\x82\xcf\x3c\x87\x83\xe2\x7d\x68\xb0\x77\x1d\x61\x13\x7b\xb5\xa3\xdc\xdc\x73\xfe\xa7\x39\x8e\x5a\x51\x38\xec\x7c\x8f\x80\xa2\x99\x00\x85\xa7\xe3\x21\x51\x22\x9f\xa8\xe3\x50\xbe\x44\x6d\xdc\xf7\x65\xcd\x68\x8d\x31\x95\xca\x89\x58\x69\x79\xf4\xec\x84\x99\xcd\x1f\x3f\x34\x9c\x51\x8e\xaa\x29\x6d\xad\x8d\x6e\x3c\x9d\x0d\x4c\x9b\x5c\xae\xe2\x7c\x10\xfb\x5a\xb1\x97\xb6\xd6\xf9\x07\xb0\x6f\x3e\x74\xa1\x7f\x9f\xea\x3f\x05\x98\x96\x3f\x09\x40\x51\x26\xdd\x7b\x8f\x1e\x03\x4a\x38\xd0\x2f\x42\xcc\xf4\x04\x29\x78\x5e\xf0\x6a\xc2\xc9\x8c\x7b\x6b\xaf\x81\xa4\x40\x15\x9e\x66\x48\xbc\x1a\xe6\x36\xe4\x4a\x1f\x23\xcc\x1a\x4f\xaf\xe4\x94\xd8\xba\x67\xc3\xab\x87\x41\x8e\x52\x3d\xe5\x95\x31\xcc\xf1\x11\x75\x68\x8d\xbe\x96\xb8\x75\x2a\x8c\x70\x79\x19\x91\x3f\xeb\xd9\x73\x9c\xdb\xfa\x1a\x92\x82\x26\x23\xc5\xfc\xbc\x5c\x5a\x92\xaa\x4f\x00\x51\x54\xdd\x9c\x4a\x71\x07\xce\x73\xf6\xf3\xc7\x8f\xfd\x62\xc5\xfd\xce\x92\x6c\xcd\xe5\x75\xe8\xa7\xd7\x5d\x26\x9e\x1d\x34\x6b\x88\x1b\xf9\xa6\xd3\x6c\xe1\xfc\x5e\x7f\x5e\xfc\xb9\x36\xb6\xe9\xa0\xf1\xd2\x9b\x1f\x1d\x9a\x12\x1d\x33\x7f\x0d\xc7\x39\xa6\x34\xeb\xc2\xbf\x74\x86\xc1\x77\x89\x77\x21\x63\x6d\x55\x56\x84\x6e\x34\x1c\xad\x5d\x4d\x00\xf8\x36\x94\xb3\x55\x94\x6c\x41\x2a\xa1\xb7\x84\xb5\xb3\xbd\x61\xa8\xff\x91\x51\x8d\xa1\x39\xa3\x69\xc8\x75\x56\x0b\x00\x6d\xf3\xd4\xf2\xdf\xdc\xfc\x0b\x68\xe6\x38\x03\xe0\x5b\x50\xc2\x16\xa4\x20\xcf\x71\x16\xb7\x44\x4e\x7e\xf2\xbc\x6f\x38\x4f\x41\xfe\xde\x86\xa4\xa3\xb8\x76\x20\x73\xe3\xf5\x72\x09\x3c\x3e\x4c\x7e\xea\x55\x52\xd6\xac\xeb\xa9\x59\x2f\x99\x2d\x75\xad\x72\xed\xf0\xbd\x45\xe3\xb0\xb6\xfa\xf3\xab\x16\xbb\x45\xe3\x60\x8f\x1f\xfa\x98\xe3\x07\x47\x02\xa8\xd5\x5e\x87\x8c\x8e\x2a\xef\x00\x61\x80\xb0\xae\x9a\x2f\xa5\xfd\x2f\x00\x00\xff\xff\xef\xa5\x0b\x1c\x0d\x3a\x00\x00")
+var _templatesBaseTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5b\x5b\x6f\xe3\xba\x11\x7e\xf7\xaf\x20\x84\x7d\xd8\xb4\xb1\xd7\xf2\x3d\x07\xf0\xc3\x69\x4f\x81\x9e\x3e\x9c\x16\x3d\xfb\xb6\x08\x04\x8a\xa2\x65\x36\xb2\x28\x90\x94\xb3\xd9\x20\xff\xbd\xa0\x44\x4a\xa4\xee\xda\xdc\x1c\xfb\x61\x37\xe2\xcc\xc7\x99\x8f\xc3\x99\x91\x44\x9f\x21\x23\xd0\x8f\x30\x70\x62\x28\x3c\x78\x22\xde\x09\x26\x0e\x78\x9c\x00\x20\x1e\x12\x0c\xf6\xe0\x04\x93\xcf\x5c\x30\x12\x87\x57\x93\x09\x00\x01\x3e\xc0\x34\x12\x60\x9f\xc9\x00\x00\x93\x69\x4c\x99\x38\x62\xc8\xc5\xd4\x05\x7b\xe0\xc0\x13\x99\xba\xf3\xe0\x80\x76\xdb\xad\x53\x97\x59\x14\x32\xd0\xf5\xd1\x6a\xbb\x2a\x64\x38\x4d\xc5\x71\xea\xca\xbf\xb4\xcc\x76\x85\xdc\xdd\xc6\xf5\x6d\x19\x7b\xae\xe5\x06\x1e\x16\xf3\xf5\xba\x41\xa6\x9c\x0b\xdf\xb8\x3b\x77\x1b\xe4\x32\x08\x4e\x11\x8e\x05\x83\x51\x36\x9b\x96\x59\x04\xcb\x0d\xdc\x6e\x72\x19\x9c\x36\xc9\xdc\x60\x1f\xbb\xbb\x83\x5b\xc8\xdc\xe3\xcc\x14\xd3\xe6\x25\xdc\xad\x6e\x0e\x6b\x64\xcb\x2c\x2c\x99\x85\xeb\x2e\xe6\xab\x95\xb2\x39\xe5\x53\x0c\x6b\x38\xc1\x0a\xad\xf1\x01\x2d\x6c\x19\x1b\xe7\xb0\xd8\xfa\x6b\x78\xa3\x78\x4e\xf9\x34\xa4\xe7\xc2\x26\x25\x83\x96\x37\x1b\x77\x0e\x4b\x9c\x06\x9b\xfd\xdd\xf6\xb0\x5e\x06\x3b\x5b\xc6\x9e\x6b\xe7\x1f\x10\xde\x1d\x32\x9c\xa7\xc9\xd3\x64\x52\xc6\x0e\x44\x08\x73\xee\xdd\xe1\x07\x2b\x74\xf2\xb0\xb1\x45\x39\x46\x0c\x8b\x41\xa2\x0c\x87\x84\xc6\xbd\x62\x3e\xe5\x47\x8f\xc4\x3e\x4d\xe3\xc0\x43\x24\x60\xb9\x46\x19\xa8\xce\x7c\x96\x7d\xbf\xcc\x1d\x5b\x13\x9e\x21\x89\xa0\x4f\x22\x22\x1e\xbc\x1f\x34\xc6\xdc\x9a\x2c\x22\x5c\x14\xa1\x6f\x29\xe2\xf8\xec\x91\xa0\xd7\x32\x7e\xa4\x4c\x78\x03\x85\xcf\x09\x32\xac\xcf\x04\x01\x28\x65\x2d\x87\x5c\xed\x91\xbb\xc9\x5c\x62\x98\xd3\x94\x21\x0c\x1c\x78\xcf\x3d\x4c\x12\x07\x38\xff\x4b\x4f\x89\x4f\xbf\xe7\x7f\xc9\xb9\x03\x9c\xe0\x38\xe0\x1e\x8d\xc1\x1e\x7c\x93\x82\x24\x16\x98\xc5\x58\x78\x21\x14\xf8\x1e\x3e\xcc\x48\x78\x3b\x01\xe0\x9c\x20\xa0\x3e\x7b\x20\x58\x8a\xed\x29\x44\xc4\xbd\x84\x91\x33\x14\x38\x5f\xc6\x7c\x05\xce\x27\xc5\x1e\x8c\x42\xca\x88\x38\x9e\x64\xe0\xfc\xf7\xcf\x5f\x65\xbc\x30\x0e\x3d\x9f\x08\x2e\x11\x57\xf3\x9b\x4d\xdd\xe8\x3b\xfc\xe0\x25\x90\xb0\x1a\x9c\x1c\x88\xe1\x09\x67\x64\x38\x9f\x1e\xcf\x90\xcd\x72\x4a\x9f\xbc\x42\x72\x02\x40\x92\xfa\x11\x41\xd2\x22\xb0\x07\x15\x1b\x67\x5a\x70\x56\x4a\x79\x34\xc1\x31\xe7\xc7\xba\x29\x1c\xa3\x94\xc9\x90\x08\x19\x4d\x25\x95\x32\x35\x56\x2f\x4a\x46\x95\x59\x00\x34\xd8\x36\x8d\xa1\x98\x6a\xa5\x69\x8e\x94\x2d\x02\x47\x8c\x24\x82\x64\xab\xe0\xfc\xf1\xeb\x57\x49\x8f\x5c\x7b\x12\x68\xca\x23\x8a\x60\x34\xcb\xaf\xc9\x94\x2b\x60\xc8\x8b\x7c\xfb\x87\x9c\x73\xe0\x64\x4f\x52\x3b\x22\x07\x8c\x1e\x50\x84\x15\x00\x09\x63\xca\xb0\x87\x8e\x30\x0e\xb1\xc4\xfd\x26\xdd\xb8\xd5\x9b\xba\x8b\x0a\x8f\xa5\x11\x56\x7c\x08\x5a\x86\x4f\x7e\x59\xc2\x57\xe4\x49\x00\xf6\xa0\x8e\x33\xab\x13\x3a\x53\xae\x3e\x24\x26\xa3\x38\x64\x98\x73\xc9\xd0\x81\xd1\x93\x97\x50\x26\xb2\x81\xb9\x64\x85\xea\xbf\xf5\x95\x84\x51\x41\x11\x8d\x94\xf2\x34\xcb\xd2\x72\x4b\x79\x7e\x44\xd1\x5d\xe6\xab\x91\x0b\x6e\xc7\xb8\x4b\xd0\x29\x79\x3d\x3f\x49\x5c\x38\x5a\x71\x42\xce\x5b\xf7\x7f\xea\xd6\x08\x98\xba\x2f\xe7\xac\x40\xaf\xe5\xab\xf5\x69\x77\xdc\xfa\xec\x81\x23\x50\x8d\x04\xeb\x5b\x8f\x08\xeb\xb3\x07\x9b\xf5\x7a\xb9\x96\xf1\x99\xa5\x49\x6f\xa0\x4b\x79\x80\xc3\xa8\x76\x3d\x18\xc3\x66\x1a\x5c\x18\x9b\x69\x70\xf1\x6c\x92\x98\x0b\x18\x23\x45\x61\xce\x9c\xce\xe7\x24\xa9\x98\x23\xb7\xf8\x91\x72\xf1\x39\x9b\x34\xf5\x63\x2c\xf2\x84\xaf\xfe\x5f\xee\x8a\x6b\xb0\xbd\x9a\x00\xa0\xd1\x3d\x9b\x4c\x19\x68\x8b\xd9\x09\x07\x24\x3d\x49\x82\x72\xf5\x22\x31\xeb\xef\x1e\xb4\xcc\x43\x82\x92\x93\x00\x73\xe1\xa1\x23\x46\x77\x5a\xe9\x00\x23\x8e\x65\x79\x3c\x11\x8d\x64\x7e\x64\xda\xa7\x77\x69\xf2\x59\xd6\x10\xa3\x17\xbf\x06\xf2\x42\xde\x0a\x5d\xa9\x52\x61\x13\xe8\x91\x80\xeb\x8a\x3e\x24\x8a\x6e\x9b\x6b\x4a\x63\x51\x91\x34\x00\xf0\x8f\xf8\xfc\xfb\x6f\x60\x0f\xca\xc1\xe6\x6a\x91\xb5\x1a\x59\x75\x18\xdf\x74\xe8\x35\x29\x08\xd6\x17\xa4\x13\x32\x46\x5a\xfa\x92\x84\xd1\x33\x09\x30\xcb\x2c\x50\x0d\x48\xd1\x8d\x2a\x9b\xcb\xf6\x54\x2e\x50\xd1\x80\xaa\xd1\xf2\x82\x6c\x54\x32\xa2\xd5\x1c\x25\xf3\x75\x5f\x55\x37\x56\xa1\xd7\x01\x4e\xdb\xc0\x63\x59\xe7\x2b\x25\xbe\x86\x5d\xc3\x6c\xd9\x3a\x03\xba\x10\xad\xd9\xdf\x8a\xfc\xae\x24\x5f\xa4\x1f\xe9\x98\xf6\xb5\x9a\x92\x16\x8e\xb2\x61\x4f\x16\x90\x31\xe9\xb7\x05\x2c\x8f\xc3\x7a\x0a\xee\xcb\xbd\x5d\x25\xac\x2d\xdb\x1a\x69\x16\x47\x07\x7d\xb5\x1a\xfe\xcf\x66\x26\x0d\xde\x9b\x99\x34\xb8\x4c\x66\xb2\xd6\xeb\x7d\xa9\x69\xea\xfe\xf4\x60\xad\x07\xb4\x06\xca\x9a\xc7\xd5\xc8\x4f\xf6\x83\x9d\x14\xc1\x28\xa2\xf7\x45\x46\x7f\xe5\x38\xc2\xdd\x5c\x4d\xdd\x36\xa6\xda\xa2\x68\xfe\x66\x3c\x71\x7e\x6c\x23\xa7\x98\xf5\xf9\x1c\x0d\x8c\x2b\xf5\xdd\x03\xe7\xeb\xdf\xff\xd3\xcc\x99\xfa\xec\xc1\x62\xd1\xc8\x9d\x3d\x3e\xae\x0d\x54\xcf\x25\x06\x34\xd1\xfa\x51\xc0\xe8\xa2\x27\x9b\xbf\xfe\x82\xf7\xb7\x7f\xff\xf9\x4f\xf0\x1b\x61\x18\x09\xca\x5e\xa4\xea\xb5\xcc\x3b\xa2\xe2\x5d\x9b\x46\x8e\x2a\x7f\x0d\x4c\x15\xa5\xaf\x2b\xfe\x1a\xd7\xa8\x01\xec\x19\x59\xac\xa3\xf4\xb5\xc4\x97\x1a\x68\xdc\x9c\xb2\x29\xab\x3d\xf2\xbb\x7d\x11\x9a\x32\x58\x18\xe2\x58\xfc\xcc\x6e\x1d\x41\xda\x40\xee\x06\x50\xa8\xbe\x7b\xb0\xd9\x6d\x76\xdd\x7b\x55\x49\xbc\xd6\x6e\xed\x65\x38\x85\xf0\xe3\xd1\xba\x5b\xad\x96\xdd\xb4\x2a\x89\x77\xa3\x15\x31\x1c\x1c\x53\xff\x03\x52\xbb\x5b\xad\x7a\xa8\xcd\x25\xde\x8d\x5a\x99\x13\x02\x55\x20\x3c\x98\x90\x8f\xc7\xf1\x62\xbd\x5e\xaf\xbb\x49\xd6\x22\xef\xc9\xf2\xc7\x23\xb6\xb9\xa7\xac\xdf\xa0\x8c\x22\xb5\xa3\xdf\x7b\x2e\xc9\x1d\xb7\x79\x85\xd1\x6f\x4c\xf2\x07\x78\x0c\x39\x92\xe4\x67\xdc\x0e\x8d\x20\xfa\x62\x6f\x85\xca\x97\x8e\x03\x7a\x74\x25\xd9\xdf\xa6\xff\x4b\x41\xbe\x44\x83\xde\x3e\xe9\x9b\xf4\xe8\x6a\xfa\xd1\xed\xb8\xd2\xeb\x08\x88\xce\x2d\x77\x61\x2d\xb8\x66\x81\x05\xc9\xe5\xb0\xb0\x5c\xee\x6e\x5a\x78\x50\x43\xaf\xc8\x44\xe7\x2d\xc7\xdb\x73\xd1\x7a\x2b\x51\x0c\xbd\x22\x17\xba\xdf\xba\x1c\x3a\xda\x7b\xa8\x72\xec\x15\x09\x51\xb9\xfe\x65\xe9\xb8\xcc\x02\xa2\x5d\x57\xa4\x55\xeb\xf4\x73\xba\xc5\x8e\xaa\xdf\x44\xd1\xc0\xc0\x19\x10\x3f\x3d\xcc\x3d\xb3\x99\x69\x69\x1b\x5e\x80\xe7\x34\xb8\x48\x9e\xd3\xe0\xb2\x79\xce\x5e\x19\x6b\x6a\xf5\x5f\xc6\xab\xbf\x86\xd6\xc5\xdc\x38\xea\x6d\x76\xae\x98\xbd\x0a\xd6\xc7\xc2\xae\xc1\xee\x1a\xcc\xaf\x46\x3d\x89\xcc\x50\x8a\xe3\x7a\xb6\xa5\x8c\xa6\x02\x7b\x02\xfa\x65\x24\x58\x97\x46\xbc\xae\xcc\xf4\x5a\x41\xe4\x9b\x70\x12\x43\xd9\x2c\x79\x96\xa3\x46\x66\x98\x00\xa0\xde\x06\x1b\xf1\xa5\x16\xac\xe5\x9d\xb1\xa4\x1f\x00\x63\x36\x53\x33\x5f\x43\x63\x70\x56\xb5\xad\x71\xf5\x8c\x71\x0f\x72\x4e\x11\xc9\xcc\x76\x80\x93\x8f\x18\x8b\xaa\x13\xb2\x7d\x44\xa0\xfb\x68\x80\x09\x5f\x04\xda\x68\x23\x75\x50\x19\x6f\x1c\x4c\x8b\x10\x4d\x63\x3b\xf0\xf7\x20\xc2\x71\x28\x8e\x59\x38\xd5\x8f\x3c\xea\x43\x05\x06\x7d\x3d\x31\x5a\x88\xb4\x86\xea\xea\x3a\xb7\x63\x46\xe2\x00\x7f\xff\xab\x2b\xe7\xa8\xcd\x0c\xf6\x00\x47\xf8\x84\x63\xd1\x62\x99\x05\x32\x34\xf2\x35\x2b\x2a\xfa\x3f\x3d\x1a\x18\x4f\x23\x3a\xfd\xd2\xdf\xeb\xba\xe9\x2d\x5d\xbf\xb1\x74\xe6\xfa\x3c\x77\x5f\xb5\x03\x0d\xdc\x5b\xfa\x28\x45\x6d\x8d\x9b\xcf\x59\x18\xd3\x74\x6f\xaa\x26\xc3\x7e\x6a\x63\x15\x40\x5d\xa1\x3c\x20\x8e\x6b\xdb\x51\x07\x98\xb1\x2d\xab\x73\xcd\xfe\x32\x23\x41\x25\xd4\x06\xec\xd5\x02\xa6\xcf\xf7\x6a\xee\x92\x91\x11\xf6\xc4\x41\x16\x17\x3c\x93\x29\x9e\x3f\x56\xee\xa6\x65\xae\x98\x5a\xa1\x2f\x83\xbb\xb0\x4a\x46\x43\x29\xdd\x92\x95\xca\x90\x31\x55\xc3\x7b\x00\xec\x3d\x9e\x1d\xae\xca\x6c\x2a\x97\x5c\x5e\xbf\x06\xd9\xce\xd6\x9d\x6a\x31\x46\x92\x7e\xcd\xf5\x95\xe9\x9c\xa9\xda\xa7\xb9\xb9\xaa\x93\x7c\x77\x52\xa7\xcf\x9d\xe2\x7f\x92\x3c\x1c\xcb\xb5\x92\x23\x1e\xa3\x02\xaa\x47\x0a\xfa\x84\x00\x4d\x45\x92\x8a\xf2\xc4\x8e\x3e\x69\xac\x16\x07\x46\x29\x56\xdc\xe9\xc3\xc9\xe5\x39\x62\x2d\x6b\xe2\x18\x07\x8e\x4d\x08\x75\x2c\xa1\xf5\x44\x72\x79\xd1\x4b\xf0\x49\x46\x31\x8e\x39\x11\xe4\x8c\x1b\x6c\xc5\xdf\x0b\xa2\xea\x66\x62\x52\xf4\xfe\xf2\xd8\xb7\x3e\xec\x4c\x12\x13\x41\x0b\xa4\x2c\xb2\x11\x9c\x4f\x8f\x9d\x20\x4f\xbf\x2c\x16\x8e\x89\x54\xac\x1e\x0c\x82\xf2\x1e\xa5\x80\x3b\x0a\x91\xf0\x5f\xbe\x7c\xe9\x87\x95\x37\x58\x16\xb2\x75\x84\x4c\x03\x6a\x14\x35\x58\x22\x98\x9a\x45\xac\xd8\xcd\x5a\x1d\xa9\xda\xcc\x35\xeb\xa9\x1d\xad\xd1\x1b\xda\xc0\x5e\xe4\xf6\xd6\x51\xa3\x6a\x5a\x46\x02\x2b\xb5\x16\xb0\x96\x03\x6a\x95\x45\xfa\xd6\x89\x7b\xdb\xb8\xda\x3f\x8f\xdc\x4c\x85\x35\x4b\x91\xc1\x6d\xb4\x96\x04\x66\xbb\x0e\x7f\x0c\x52\xaa\x95\x0e\x13\x23\x4f\xc9\x36\x4e\x35\x3b\x6b\x59\xf3\xf7\x29\x5a\xd6\x3e\x32\xa8\x25\x55\x52\xf2\x20\xab\x88\x1b\xb9\x6b\xa6\xff\x85\x2c\x6e\x8c\x68\xf8\x43\x79\xe0\x91\x40\xfe\x4a\x2b\x21\x71\x68\xa3\xfd\x20\x89\xfc\xb5\x96\xe1\x77\x43\xb1\xab\xb9\x7f\x0d\xba\x15\x48\x70\xd5\x63\x8f\xcc\xcb\x6f\x69\x51\x59\xb7\xae\x1a\x23\xb4\x9e\xc4\xf3\x25\xb4\x04\x1a\x7d\x2a\x7f\x80\x63\x6b\x5a\x02\x8d\x9a\xe1\x7d\xa7\x5e\x78\xdf\xb8\x49\x49\xdc\x92\xce\x73\x75\x2d\x67\x88\x35\x3a\xdc\x87\x53\x08\xda\x40\xff\x1f\x00\x16\xe0\x7c\x98\xfd\x37\x00\x00")
 
 func templatesBaseTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -91,12 +91,12 @@ func templatesBaseTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/base.tf", size: 14861, mode: os.FileMode(480), modTime: time.Unix(1520537908, 0)}
+	info := bindataFileInfo{name: "templates/base.tf", size: 14333, mode: os.FileMode(480), modTime: time.Unix(1792204182, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesCf_dnsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\xc1\x6a\xe3\x30\x10\x86\xef\x7e\x8a\x41\xec\x69\x21\x26\x10\xf6\x98\x43\x58\xf6\xb8\x79\x81\x65\x11\xb2\x34\xb5\x55\x64\x49\x68\x24\xa7\x69\xf0\xbb\x17\x59\x2e\x4d\x4a\x5b\x1c\x9a\xdc\x6c\x31\xf3\xff\xff\x37\x83\x34\x88\xa0\x45\x63\x10\x18\x1d\x29\x62\xcf\x95\xeb\x85\xb6\x0c\x4e\x15\x40\x3c\x7a\x84\x2d\x30\x8a\x41\xdb\x96\x55\x63\x55\x05\x24\x97\x82\x44\x60\xe2\x40\x3c\xb8\x14\xf1\xd7\x86\x3f\x3b\x8b\x0c\x18\xda\x81\x2b\x4b\xf3\x6f\x56\xb0\xa2\x9f\x14\x7e\x9c\x06\x11\xea\x0b\x8b\x91\x55\xd9\x42\xb4\x34\x55\x02\xec\x2f\x6a\xb3\x96\x56\xe3\xaa\x73\x14\x51\xad\x26\xc9\x0a\x60\xcc\x21\x5c\x8a\x3e\xc5\x4b\x3f\x9e\xad\x38\x61\x18\x30\x50\x31\x1f\x84\x49\xb3\xe2\xfb\xb0\xf5\x79\x6b\x7d\xde\x3a\x7e\x81\x19\x50\xba\xa0\x18\xb0\x83\x36\x4a\x8a\xa0\xb2\x44\xf1\x9a\x22\x68\xb5\xc4\x4d\xab\x91\xbd\x8e\x06\x20\x77\xfc\xac\x3f\x9e\xcf\xbc\x81\x52\xf4\x7b\xbf\xfb\xfb\x67\x3a\x8b\x06\xca\xd9\x66\xbd\xce\x33\x2c\xb1\x08\xb6\xf0\x6f\x36\x47\xd3\xd4\xf2\xa1\x64\x08\xdc\x34\x75\x36\xcf\x86\x23\xfb\xbf\x00\x8f\xa8\xbb\x01\x15\x51\x77\x27\x2e\xa2\xee\x7a\xa8\xc6\xdd\x84\x2a\xcb\x2c\xc1\xda\x2d\x45\xd2\xbe\x7e\x4c\xbd\x6f\xdc\xd3\xf4\xed\x53\x63\xb4\xe4\xda\x2f\xa3\x8a\xd2\xdf\x00\x2a\x4a\x7f\xa7\x55\x45\xe9\xaf\x5f\x95\x26\x57\xa0\xa4\x4b\x36\xbe\xbd\x09\x9a\x9c\x11\x51\x3b\xcb\x09\xdb\x1e\x6d\xa4\xf2\x88\x7c\xf3\xf2\x69\x72\x2b\xc2\xf6\x1e\x13\xd0\xe4\x3e\xbd\x85\x2f\x01\x00\x00\xff\xff\x9c\x64\x07\x0b\x7b\x05\x00\x00")
+var _templatesCf_dnsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\x41\x6b\x1b\x31\x10\x85\xef\xfb\x2b\x06\x91\x53\xc0\xc2\x10\x7a\xf4\x21\x94\x1e\x9b\x3f\x10\x82\xd0\x4a\x53\x7b\x8a\x56\x23\x34\xd2\xa6\xa9\xf1\x7f\x2f\xb2\x12\x1a\xb7\x94\x6e\xd8\xe4\xb8\x62\xe6\xbd\xf7\x3d\xb1\x9a\x6d\x26\x3b\x06\x04\x25\x4f\x52\x70\x32\x9e\x27\x4b\x51\xc1\x71\x00\x28\x4f\x09\x61\x07\x52\x32\xc5\xfd\x70\x1a\x86\x8c\xc2\x35\x3b\x04\x65\x1f\xc5\x64\xae\x05\x3f\xdd\x98\x9f\x1c\x51\x81\xc2\x38\x1b\x1f\xe5\xf9\xb3\xed\x47\x3b\xb5\xfd\xd9\x66\x7d\xa1\x3e\x34\x6d\xbb\x17\xd8\x9d\x6d\x00\xee\xfa\xa0\xba\x3a\xb6\xd9\x26\x44\xfe\xb4\x39\xb0\x14\xf4\x9b\xb3\xde\x00\x70\x6a\x09\xb8\x96\x54\xcb\xa5\x99\x69\x3e\x46\x30\xcf\x98\xa5\x27\x9f\x6d\xa8\x4d\xf1\xcf\x98\xfa\xf5\x9e\x7e\xbd\xf7\x6f\xbc\x8c\x8e\xb3\x57\xa0\x1e\x29\x78\x67\xb3\x6f\x94\xdd\xa6\xa9\x18\xf2\xff\x35\x22\xff\xd2\x06\x00\xec\x40\x5d\xeb\xab\xe3\x5f\xad\x9c\xd4\x4b\xe5\x7d\xe8\xf3\xdd\xed\xd7\x2f\xe7\xb3\x12\xa0\x9f\xdd\x6c\xb7\xad\xbb\x9e\xa8\xd5\x77\xdf\x82\x62\x18\xb5\xfb\xd6\xed\xb3\x09\xa3\x6e\xbe\x8d\xed\x61\x01\x94\xc8\x61\x1d\x8b\xc8\xe1\x23\x68\x44\x0e\x6f\x45\x19\x79\x2d\xcb\xc8\xcb\x60\x6e\x17\x81\x50\xd2\xdf\xeb\x94\x46\xfe\x61\x90\x92\x4e\x75\x0c\xe4\x0c\xa5\x25\x2c\xc5\xa5\x75\x28\xc5\xa5\x8f\xb8\x96\xe2\xd2\x5b\xaf\x85\x84\x3b\x8a\xe3\x1a\xcb\xf3\x7b\x40\xc2\xc1\x16\xe2\x68\x04\xf7\x13\xc6\x22\xc3\x0a\xd8\x6b\x4d\xc2\x1b\xc1\xfd\xbb\x23\x93\xf0\xef\x1f\xeb\x7e\xfb\x70\x41\xfe\x6b\x00\x36\xf3\xbe\xeb\x3d\x05\x00\x00")
 
 func templatesCf_dnsTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -111,12 +111,12 @@ func templatesCf_dnsTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/cf_dns.tf", size: 1403, mode: os.FileMode(480), modTime: time.Unix(1520375736, 0)}
+	info := bindataFileInfo{name: "templates/cf_dns.tf", size: 1341, mode: os.FileMode(480), modTime: time.Unix(1792204179, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesCf_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\xdc\x5b\x8f\x9b\x46\x14\x07\xf0\x77\x7f\x0a\x64\xf5\xa9\xd2\xba\x1e\xee\x54\xda\xa7\x95\xaa\xf6\xa5\x8a\x9a\xbc\x55\x15\xc2\x78\xd6\x46\x61\xc1\x9a\x19\x6f\x95\x46\xfe\xee\x15\x37\x5f\x62\x0c\xf6\xdf\xff\x24\x9b\xcd\x53\x80\x33\x73\x18\x0e\x3f\x8e\xad\x65\x95\xd4\xe5\x56\xa5\xd2\x9a\x26\xff\xea\x58\xcb\x74\xab\x32\xf3\x29\x5e\xa9\x72\xbb\x99\x5a\xd3\xf4\x39\xd6\x7a\x1d\xe7\x8b\xb3\x5d\x9f\x27\x96\x55\x24\x2f\xd2\x6a\x7f\x1e\xad\xe9\x4f\x9f\x5f\x13\x35\x93\xc5\x6b\x9c\x2d\x77\x0f\xe9\xf3\x83\xd6\xeb\x87\x7c\xf1\xd0\x85\x3e\x34\xa1\x13\xcb\x5a\x4a\x9d\xaa\x6c\x63\xb2\xb2\xa8\x02\x9f\x7e\xb3\xde\xbf\xff\xbd\xda\xf1\xba\x49\xe3\x6c\x79\x34\x62\x5e\xa6\x49\x3e\x6b\x36\xef\xa6\x93\x89\x65\x65\xc5\x4a\x49\xad\xeb\x04\x2c\x2b\xcd\x96\x2a\x5e\xe4\x65\xfa\x51\x5b\x8f\xd6\xdf\xd3\xf9\xac\xfe\xf7\xcb\x7c\xfa\x4f\xbd\x7f\xa3\x4a\x53\xa6\x65\xde\x0e\x68\xd2\x7a\x7e\xcb\x7a\x56\xe5\x4b\xbc\x29\x95\xa9\xb7\xdb\xb6\x6d\xd7\x9b\x4d\xd9\x6d\x3c\xda\xbc\xab\xa6\x95\xc7\xb3\x9e\x46\xcf\x7b\x42\xe7\x7d\xb3\x3f\x88\xe9\x15\x49\xd7\xd3\x99\x64\xd5\x4d\xf6\x67\xb5\xca\x37\x2d\x6f\x3d\x42\x9e\x3d\xcb\xf4\x53\x9a\xcb\x76\x98\x6c\x55\x94\x4a\xc6\xe9\x3a\x29\x56\xb2\x99\xb7\xba\x7e\xed\x94\xbb\xc9\xa4\xdc\x9a\xcd\xd6\x8c\x5d\xf3\xd7\x24\xdf\xb6\xe9\x9c\x57\xcc\xec\x52\xec\xac\xbe\x7a\xbb\xc9\xe4\xea\x7a\xcb\x0a\x23\x55\x91\xe4\xf7\x14\x5e\x37\xc6\xb5\x15\x68\xfd\xd1\x06\x40\xa5\x78\x9a\x68\xb3\xc2\xb7\x2f\xd2\x79\xd9\x0e\x95\xae\x75\xb9\x7c\x7f\xa4\x12\x1e\xb8\x50\xac\x5a\x1e\xac\xa7\x6b\x8b\xfa\xc2\x20\x17\xaa\x5b\xe6\x8b\xe3\x92\x3e\x2f\xdd\xd3\x9f\xfd\xfa\xe8\x75\xa9\x4c\x7c\xb6\x4a\xd5\x6a\xa4\xaa\xd4\x3a\xfe\xaf\x2c\x64\x9c\x97\xc9\x32\x5e\x24\x79\x52\xa4\x59\xb1\xb2\x1e\x2d\xa3\xb6\xb2\x5a\xac\xb5\x4c\x72\xb3\x8e\xd3\xb5\x4c\x3f\xb6\xeb\xd5\x6c\xfa\x14\x9b\xb5\x92\x7a\x5d\xe6\xcb\x7a\x3a\xaf\xde\xb7\x2d\xce\xf7\x3e\x5a\x4d\x3d\xd5\xe7\xfb\x9a\xe4\xa7\x69\xfa\x4d\xb1\x24\x6a\x25\xcd\xd9\x29\x7c\x78\x7a\xf7\x6b\x55\x74\x4d\x99\x98\xec\x45\x96\x5b\xf3\xc5\x41\xf6\xe1\xba\x6a\x23\x0b\xa9\xba\xcb\x5a\x68\x93\x14\xa9\xec\x31\xf8\x78\x67\x57\x91\xc7\x37\x45\xbe\x38\xad\xfc\x93\xd0\x6a\xe7\xe9\x0d\x75\x08\xad\xf3\xe0\xdd\xba\x7a\xbb\x28\xa4\xd1\x47\x59\xec\x47\xaa\xf7\xcc\xaa\xd0\xe6\x98\xd9\xcf\x6d\x54\x6f\xbd\xd6\xf5\xdc\x57\x9c\x32\x5f\x1c\xd2\x98\x55\x87\x35\xb5\x77\x3e\xc4\x56\xe5\x57\x8c\xb0\x2c\x74\x7c\x18\x65\xdc\x67\x55\x6e\x8d\x54\x68\x4b\xd0\x44\x5f\xdb\x15\xfc\x55\x1f\xfd\x1d\x1b\x83\xb0\x0f\xc6\x7a\xe3\xee\x6b\x4d\xe9\xba\x4e\xcf\x9c\xcd\xd6\xaf\x38\xe9\x85\x59\x0f\xd3\xbe\xc1\xa7\xc7\x50\x31\xdd\xfd\xdc\x18\xae\xf3\xd1\x27\xc6\xa5\xf0\x1b\x3a\xa1\xc3\x10\x77\x35\x43\x87\x55\xba\xa9\x1f\x6a\xee\xbd\x6f\xd7\x12\x0d\x2e\x18\xd2\x15\xf5\xdc\xbb\x5f\xde\xbf\x6f\xba\xa6\xbf\x66\x53\x74\x65\x69\xdd\x50\xe5\x60\x6b\xb4\x1f\x00\xef\x8e\xf6\x2b\xf6\x66\x1a\x24\x61\x8f\x75\x48\xe1\x9c\xd5\x1f\xb5\x55\xde\xdb\x1d\xad\x8d\x19\x68\x8f\xda\xc8\xde\xe6\xa8\x8b\xbc\x2e\x8b\xa1\x34\xc6\xf2\x38\x7a\xe2\x9d\x67\xd2\x05\xeb\x26\x5a\xeb\x3c\x4e\xa5\x32\xd9\x73\x96\x26\x46\x56\x16\xed\x6b\x33\x4b\x5e\x62\x2d\xd5\xab\x54\xc7\x87\x54\xed\x56\xf5\xdf\x59\xa2\x8a\x1d\xef\x84\x06\xda\xce\xe3\x87\x69\xff\x09\x69\x9d\x73\x4f\x87\xaa\xec\xfd\x0d\xec\x61\x8a\xb1\x1e\x76\x7f\x64\x7f\x1b\x7b\x18\x68\xa4\x93\x3d\x8c\x73\x6b\x33\x6b\xd2\x0d\xda\xc9\x9a\x74\x73\x6d\x1b\xfb\xe1\xe9\xdd\x77\xec\x61\xc5\xdc\x76\x7b\x1e\x66\x42\xd8\x6f\xb9\xb7\xbb\xb8\xbc\x77\x3f\xfb\x06\xae\xf9\xe8\xf3\xae\x37\xf6\x86\x96\xae\x8d\xbf\xab\x9f\x6b\x57\xe6\xa6\x66\xee\xc3\xd3\xbb\x6f\xd7\xc9\x5d\x5e\x24\xa4\x8d\xeb\x2d\xdf\xf3\x12\x7e\x2b\xe9\xfe\x98\x5d\xe7\x78\x4d\xb1\x6e\xbb\xfb\xfa\xcd\xe1\x41\xc6\x9a\xcd\x26\x1a\xef\x34\x9b\x55\xa2\xb7\x99\xfe\x40\x9b\xe9\x0c\xb4\x99\xde\x7d\x5d\xa6\x73\x43\x97\xb9\xbf\x09\x6f\xff\x16\x6e\x1f\x3a\xfa\x2d\xdc\x75\x79\x78\x78\x1e\x1e\x33\x0f\x1f\xcf\xc3\x67\xe6\x11\xe0\x79\x04\xcc\x3c\x42\x3c\x8f\x90\x99\x47\x84\xe7\x11\x11\xf3\x70\x06\x3e\x97\x8d\xe4\xe1\x0c\x7c\x30\xbb\x3d\x0f\x81\xe7\x21\x98\x79\xa0\xdf\xe2\xef\x43\x49\x79\x38\x78\x1e\x97\x3e\xd5\x41\x79\xe0\x9e\x3a\x4c\x4f\x1d\xdc\x53\x87\xe9\xa9\x83\x7b\xea\x30\x3d\x75\x70\x4f\x1d\xa6\xa7\x0e\xee\xa9\xc3\xf4\xd4\xc1\x3d\x75\x98\x9e\xba\xb8\xa7\x2e\xd3\x53\x17\xf7\xd4\x65\x7a\xea\xe2\x9e\xba\x4c\x4f\x5d\xdc\xd3\x8b\xdf\x92\x41\x79\xe0\x9e\xba\x4c\x4f\x5d\xdc\x53\x97\xe9\xa9\x8b\x7b\xea\x32\x3d\x75\x71\x4f\x5d\xa6\xa7\x2e\xee\xa9\xcb\xf4\xd4\xc5\x3d\x75\x99\x9e\x7a\xb8\xa7\x1e\xd3\x53\x0f\xf7\xd4\x63\x7a\xea\xe1\x9e\x7a\x4c\x4f\x3d\xdc\x53\x8f\xe9\xa9\x87\x7b\xea\x31\x3d\xf5\x70\x4f\x3d\xa6\xa7\x1e\xee\xa9\xc7\xf4\xd4\xc3\x3d\xf5\x98\x9e\x7a\xb8\xa7\x1e\xd3\x53\x0f\xf7\xd4\x63\x7a\xea\xe3\x9e\xfa\x4c\x4f\x7d\xdc\x53\x9f\xe9\xa9\x8f\x7b\xea\x33\x3d\xf5\x71\x4f\x7d\xa6\xa7\x3e\xee\xa9\xcf\xf4\xd4\xc7\x3d\xf5\x99\x9e\xfa\xb8\xa7\x3e\xd3\x53\x1f\xf7\xd4\x67\x7a\xea\xe3\x9e\xfa\x4c\x4f\x7d\xdc\x53\x9f\xe9\x69\x80\x7b\x1a\x30\x3d\x0d\x70\x4f\x03\xa6\xa7\x01\xee\x69\xc0\xf4\x34\xc0\x3d\x0d\x98\x9e\x06\xb8\xa7\x01\xd3\xd3\x00\xf7\x34\x60\x7a\x1a\xe0\x9e\x06\x4c\x4f\x03\xdc\xd3\x80\xe9\x69\x80\x7b\x1a\x30\x3d\x0d\x70\x4f\x03\xa6\xa7\x43\xbf\x27\x38\x92\xc7\xd0\x2f\x0a\xde\x9e\x07\xee\x69\xc8\xf4\x34\xc4\x3d\x0d\x99\x9e\x86\xb8\xa7\x21\xd3\xd3\x10\xf7\x34\x64\x7a\x1a\xe2\x9e\x86\x4c\x4f\x43\xdc\xd3\x90\xe9\x69\x88\x7b\x1a\x32\x3d\x0d\x71\x4f\x43\xa6\xa7\x21\xee\x69\xc8\xf4\x34\xc2\x3d\x8d\x98\x9e\x46\xb8\xa7\x11\xd3\xd3\x08\xf7\x34\x62\x7a\x1a\xe1\x9e\x46\x4c\x4f\x23\xdc\xd3\x88\xe9\x69\x84\x7b\x1a\x31\x3d\x8d\x70\x4f\x23\xa6\xa7\x11\xee\x69\xc4\xf4\x34\xc2\x3d\x8d\x98\x9e\x46\xb8\xa7\x11\xd1\x53\x31\x87\x3d\xed\x42\x49\x79\xc0\x9e\x76\xa1\xa4\x3c\x60\x4f\xbb\x50\x52\x1e\xb0\xa7\x5d\x28\x29\x0f\xd8\xd3\x2e\x94\x94\x07\xec\x69\x17\x4a\xca\x03\xf6\xb4\x0b\x25\xe5\x01\x7b\xda\x85\x92\xf2\x80\x3d\xed\x42\x49\x79\xc0\x9e\x76\xa1\x9c\x3c\x04\xee\xa9\x60\x7a\x2a\x70\x4f\x05\xd3\x53\x81\x7b\x2a\x98\x9e\x0a\xdc\x53\xc1\xf4\x54\xe0\x9e\x0a\xa6\xa7\x02\xf7\x54\x30\x3d\x15\xb8\xa7\x82\xe9\xa9\xc0\x3d\x15\x4c\x4f\x05\xee\xa9\x60\x7a\x2a\x70\x4f\x05\xd3\x53\x1b\xf7\xd4\x66\x7a\x6a\xe3\x9e\xda\x4c\x4f\xe1\xbf\xca\xb3\x0f\x25\xe5\x81\x7b\x6a\x5f\xe9\x29\xef\x65\xc2\xfb\x5f\xae\x6e\xc7\x1f\x7b\xb3\xba\x39\xac\xff\xb5\xea\x76\x88\x91\x77\xaa\xdb\x11\x4e\x5e\xa8\xfe\x3f\x00\x00\xff\xff\x59\xb1\x38\x54\x2d\x50\x00\x00")
+var _templatesCf_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x9b\xcd\x8e\xdb\x36\x17\x86\xf7\xbe\x0a\xc2\xf8\x56\x1f\x30\xae\xa8\x5f\xaa\x80\x57\x01\x8a\x76\x53\x04\x4d\x76\x41\x21\xc8\x32\x67\x2c\x44\x23\x19\x24\x3d\x45\x1a\xcc\xbd\x17\x92\x4c\xff\x8c\x2c\x8e\x7c\xfc\x0e\x32\x71\xbb\x48\x24\x1f\xf2\x11\xf5\xf2\xe1\x41\x00\x2b\xa9\x9b\x9d\x2a\x24\x9b\xe7\xff\xe8\x4c\xcb\x62\xa7\x4a\xf3\x2d\x7b\x50\xcd\x6e\x3b\x67\xf3\xe2\x3e\xd3\x7a\x93\x55\xab\xc1\xad\xef\x33\xc6\xea\xfc\x51\xb2\xfd\x67\xc9\xe6\xff\xfb\xfe\x94\xab\x85\xac\x9f\xb2\x72\xfd\x7c\x57\xdc\xdf\x69\xbd\xb9\xab\x56\x77\xb6\xf4\xae\x2f\x9d\x31\xb6\x96\xba\x50\xe5\xd6\x94\x4d\xcd\x96\x6c\xfe\xe1\x37\xf6\xe9\xd3\xef\xf3\x19\x63\x4f\xdb\x22\x2b\xd7\x76\xc4\xaa\x29\xf2\x6a\xd1\x5f\x9b\xcd\x18\x2b\xeb\x07\x25\xb5\xee\xe6\x66\xac\x28\xd7\x2a\x5b\x55\x4d\xf1\x55\xb3\x25\xfb\x32\xf7\x16\xdd\x7f\xbf\x78\xf3\xbf\xbb\xfb\x5b\xd5\x98\xa6\x68\xaa\x3d\x9d\x29\xba\xa9\x19\xbb\x57\xcd\x63\xb6\x6d\x94\xe9\xae\xfb\xbe\xef\x77\x97\x4d\x63\x2f\x9e\x5c\x7e\x6e\xa7\x95\xa7\xb3\x9e\x57\x7b\x17\x4a\xbd\x4b\xb3\xdf\xf1\xf9\x04\xe8\x6e\x3a\x93\x3f\xb4\xf7\xfa\xe9\xfe\x6c\x97\xf8\xaa\xb5\xed\xc6\xa8\xca\x7b\x59\x7c\x2b\x2a\xb9\x1f\xa6\x7c\xa8\x1b\x25\xb3\x62\x93\xd7\x0f\xb2\x1d\xfd\x4b\xfb\xee\xfa\x29\x9f\x67\xb3\x66\x67\xb6\x3b\xf3\xda\xeb\x7e\xca\xab\x5d\x0b\x33\x4c\xca\x62\xac\x70\x51\xae\x67\xcf\xb3\xd9\xe4\x94\x95\xb5\x91\xaa\xce\xab\x5b\xe2\x66\xc7\x98\x9a\x3b\xf6\xc7\xbe\xe0\xda\x00\x9e\x33\x76\xab\x7a\xdd\xd2\x0c\x73\xea\xca\x2a\x1b\xcf\xeb\xcf\x95\x59\xc7\x0b\xc2\x84\xd7\x4e\x40\x4f\xf1\xc8\x08\x17\xe3\x2c\xab\xd5\x69\x86\x87\x59\x3d\xff\x1c\x16\x46\x6f\x1a\x65\xb2\xc1\xf2\xb4\x6b\x5e\xa8\x46\xeb\xec\xdf\xa6\x96\x59\xd5\xe4\xeb\x6c\x95\x57\x79\x5d\x94\xf5\x03\x5b\x32\xa3\x76\xb2\x5d\xa5\x8d\xcc\x2b\xb3\xc9\x8a\x8d\x2c\xbe\xee\x17\xaa\xbf\xf4\x2d\x33\x1b\x25\xf5\xa6\xa9\x5a\x91\x2e\x59\xd4\xdd\xdb\xd5\xc3\xbb\x4b\xd6\xab\xaf\x7b\xda\xa7\xfc\x90\xc0\xf6\xff\x25\x8b\xbb\x7b\x26\x57\x0f\xd2\x0c\x1e\xe1\xf3\x87\x8f\xbf\xb6\x79\x6b\x69\x19\x33\xe5\xa3\x6c\x76\xe7\xdf\xea\x07\xdf\xbf\x50\x6d\x64\x2d\x95\x7d\x9f\xb5\x36\x79\x5d\xc8\xd3\x00\x1e\x62\x7d\xbc\x69\xc3\x78\xba\x1f\xaa\xd5\xb1\x88\xbd\x2c\xad\x56\xc7\xa2\x97\x5b\xa9\xe3\x80\x6c\x58\xbd\x5b\xd5\xd2\xe8\xfd\x0c\xcc\x26\xa9\xbb\xba\x68\x77\x79\xf7\x27\xbd\xf8\xff\x3e\x2c\xc3\x78\xb6\xd9\x18\x66\x51\x56\xab\xe3\xd4\x8b\xf6\x3b\x97\xab\x77\xaa\x7a\xad\x78\x5d\xeb\xcc\x0e\xf0\xba\x7a\x55\xb3\x33\x52\x0d\x9f\x77\x9a\x74\xfb\xea\xa9\xc7\xfc\x5f\xdd\xb7\x7f\xcc\x49\x2f\x2e\x89\xaf\xbb\xf8\xfc\x56\x53\x86\x61\x70\x61\xce\xfe\xea\x1b\x4e\x3a\x32\x6b\x18\xbc\xeb\xd3\xc1\x95\xa4\x1b\xcf\x05\x77\xc4\x4f\x37\xd2\xf9\xfd\x85\xa3\xf6\xe2\x59\xe0\xde\x5f\xce\x73\x69\xfa\x46\xb3\xc3\x5c\xb1\xe3\xde\xbe\xc7\x71\x2c\xd3\x30\xbc\xae\x00\x9f\xec\xcb\xf3\x1c\xbe\xdc\xb0\xef\x3c\xc4\x8e\xb7\x04\x4b\xb3\x9d\xe3\xa6\x58\x8f\x0c\xe2\xee\x75\x0e\xe5\xc3\xf0\x9e\x7f\xc6\xdb\x9d\xc3\x52\xbd\x9b\x8e\x87\xfb\xaf\xb5\x3c\xc2\x43\x35\x3c\xc2\x7b\x71\xcb\x06\x73\xc9\xe6\x1b\x63\x1c\xfd\x8e\xf0\xc6\xbb\x1d\x5b\x39\x8d\xc2\x85\xf1\x1a\xc7\xc9\xe9\x36\x24\xb1\xc5\xba\xaf\xd6\xba\xca\x0a\xa9\x4c\x79\x5f\x16\xb9\x91\xad\x7c\x7a\xdb\x96\xf9\x63\xa6\xa5\x7a\x92\xea\xf4\x7e\xdb\x45\xb5\x7f\x5d\xe4\xaa\x86\x3d\x8b\x29\xdc\x8f\xe2\x7c\x16\xad\x2b\xd8\x93\xa0\x8c\x7a\x43\x1f\x7a\x1c\xd9\xd9\x8a\x1e\xbe\x76\xa9\x1b\x3d\x8e\xe1\x6a\x48\x8f\x43\x5c\xd7\x93\x9a\x62\x3b\x7c\xf0\x69\xe7\xa4\x29\xb6\x53\xbb\xd1\xcf\x1f\x3e\xfe\x98\x56\x94\x7b\x7e\x78\xe1\x88\xe2\xdc\x7f\xdf\x2d\xda\xe8\xda\xde\x78\xa2\x39\x5e\xf7\x69\xa8\xce\xef\x2f\xc6\x0a\x2f\x9e\x5c\x8e\x94\x39\x4f\xd1\x89\x71\xb3\x63\x4c\xcd\xdd\xdb\x37\x64\x63\x4b\x43\xe9\xc6\x2e\xe6\x75\x98\xd9\x77\x40\x2a\xbc\x11\x4e\xe1\xfd\x0c\x3b\xcb\x11\x23\xcc\x16\xb3\x13\xd0\xf7\xda\xc8\x08\xee\x76\xb1\x0f\xe3\x70\x47\x9d\x7f\xc6\x7b\xc5\x7e\x97\xc1\x1b\xc5\xd8\xd1\x28\x06\x8e\x46\x31\xba\xad\x4f\x0c\x26\x77\x35\x27\x5b\x6f\xd8\xd6\xb8\xbb\x9a\x93\xd2\x61\x53\x73\x2c\xbd\x82\x23\xa2\x73\x44\x48\x8e\x98\xce\x11\x23\x39\x12\x3a\x47\x82\xe4\x10\x74\x0e\x81\xe4\x48\xe9\x1c\x29\x90\x23\xf0\xc8\x1c\x81\x87\xe4\xe0\x74\x0e\x8e\xe4\xa0\xfe\xc3\xfa\xa1\x14\xc4\x11\xbc\xb8\x79\x05\x47\x80\xe4\xa0\xfb\x34\x40\xfa\x34\xa0\xfb\x34\x88\x90\x1c\x74\x9f\x06\x31\x92\x83\xee\xd3\x20\x41\x72\xd0\x7d\x1a\x08\x24\x07\xdd\xa7\x41\x0a\xe4\x08\xe9\x3e\x0d\x3d\x24\x07\xdd\xa7\x21\x47\x72\xd0\x7d\x1a\xfa\x48\x0e\xba\x4f\xc3\x00\xc9\x41\xf7\x69\x18\x22\x39\xe8\x3e\x0d\x23\x24\x07\xdd\xa7\x61\x8c\xe4\xa0\xfb\x34\x4c\x90\x1c\x74\x9f\x86\x02\xc9\x41\xf7\x69\x98\x02\x39\x22\xba\x4f\x23\x0f\xc9\x41\xf7\x69\xc4\x91\x1c\x74\x9f\x46\x3e\x92\x83\xee\xd3\x28\x40\x72\xd0\x7d\x1a\x85\x48\x0e\xba\x4f\xa3\x08\xc9\x41\xf7\x69\x14\x23\x39\xe8\x3e\x8d\x12\x24\x07\xdd\xa7\x91\x40\x72\xd0\x7d\x1a\xa5\x40\x8e\x98\xee\xd3\xd8\x43\x72\xd0\x7d\x1a\x73\x24\x07\xdd\xa7\xb1\x8f\xe4\xa0\xfb\x34\x0e\x90\x1c\x74\x9f\xc6\x21\x92\x83\xee\xd3\x38\x42\x72\xd0\x7d\x1a\xc7\x48\x0e\xba\x4f\xe3\x04\xc9\x41\xf7\x69\x2c\x90\x1c\x74\x9f\xc6\x29\x90\x23\xa1\xfb\x34\xf1\x90\x1c\x74\x9f\x26\x1c\xc9\x41\xf7\x69\xe2\x23\x39\xe8\x3e\x4d\x02\x24\x07\xdd\xa7\x49\x88\xe4\xa0\xfb\x34\x89\x90\x1c\x74\x9f\x26\x31\x92\x83\xee\xd3\x24\x41\x72\xd0\x7d\x9a\x08\x24\x07\xdd\xa7\x49\x0a\xe4\x10\x1e\x99\x43\x78\x48\x0e\xba\x4f\x05\x47\x72\xd0\x7d\x2a\x7c\x24\x07\xdd\xa7\x22\x40\x72\xd0\x7d\x2a\x42\x24\x07\xdd\xa7\x22\x42\x72\xd0\x7d\x2a\x62\x24\x07\xdd\xa7\x22\x41\x72\xd0\x7d\x2a\x04\x92\x83\xee\x53\x91\x02\x39\x52\xba\x4f\x53\x0f\xc9\x41\xf7\x69\xca\x91\x1c\x74\x9f\xa6\x3e\x92\x83\xee\xd3\x34\x40\x72\xd0\x7d\x9a\x86\x48\x0e\xba\x4f\xd3\x08\xc9\x41\xf7\x69\x1a\x23\x39\xe8\x3e\x4d\x13\x24\x07\xdd\xa7\xa9\x40\x72\xd0\x7d\x9a\xa6\x38\x0e\xee\x91\x7d\x6a\x4b\x41\x1c\x64\x9f\xda\x52\x10\x07\xd9\xa7\xb6\x14\xc4\x41\xf6\xa9\x2d\x05\x71\x90\x7d\x6a\x4b\x41\x1c\x64\x9f\xda\x52\x10\x07\xd9\xa7\xb6\x14\xc4\x41\xf6\xa9\x2d\x05\x71\x90\x7d\x6a\x4b\x41\x1c\x64\x9f\xda\x52\x0c\x07\xa7\xfb\x94\x7b\x48\x0e\xba\x4f\x39\x47\x72\xd0\x7d\xca\x7d\x24\x07\xdd\xa7\x3c\x40\x72\xd0\x7d\xca\x43\x24\x07\xdd\xa7\x3c\x42\x72\xd0\x7d\xca\x63\x24\x07\xdd\xa7\x3c\x41\x72\xd0\x7d\xca\x05\x92\x83\xee\x53\x9e\x02\x39\x7c\xba\x4f\x7d\x0f\xc9\x41\xf7\xa9\xcf\x91\x1c\x74\x9f\xfa\x3e\x92\x83\xee\x53\x3f\x98\xc6\x01\xf9\x09\xe1\x0d\x3f\x94\xde\x0f\xeb\xfc\x95\x74\xff\x9d\x4b\x3f\x91\xde\x57\xbb\x7e\x1f\xbd\x2f\x5e\xd7\x3a\xab\xf3\x47\x39\x7b\x9e\xfd\x37\x00\x51\x67\xfe\x54\x91\x4f\x00\x00")
 
 func templatesCf_lbTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -131,12 +131,12 @@ func templatesCf_lbTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/cf_lb.tf", size: 20525, mode: os.FileMode(480), modTime: time.Unix(1520538614, 0)}
+	info := bindataFileInfo{name: "templates/cf_lb.tf", size: 20369, mode: os.FileMode(480), modTime: time.Unix(1792204179, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesConcourse_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x97\xbd\x6e\xdb\x30\x10\xc7\x77\x3d\x05\x21\x74\x2a\x6a\x55\xb1\x3d\x78\xc9\x94\xa9\x4b\xd1\xa1\x5b\x60\x10\x14\x75\xb6\x84\x30\xa4\x70\x24\x1d\x18\x81\xdf\xbd\x20\x29\xc9\xfa\xb0\x13\x39\x0e\x8c\x26\x59\x0c\x1e\xef\x78\xf7\xfb\x1f\x79\x10\x82\x56\x16\x39\x90\x98\xbd\x68\xaa\x81\x5b\x2c\xcd\x9e\x6e\x51\xd9\x2a\x26\x31\x57\x92\x2b\x8b\x1a\xa8\xc8\x68\x29\x0d\xa0\x64\x62\xb4\xed\x35\x22\x44\xb2\x67\x20\xf5\xdf\x3d\x89\xbf\xbd\xee\x18\x26\x20\x77\xb4\xcc\x0f\xb3\x36\xcc\x4c\x64\xb3\x26\xcc\xac\x09\x33\x0b\x61\x22\x42\x72\xd0\x1c\xcb\xca\x94\x4a\xba\x20\x0f\x8d\x1b\xf9\x55\xfb\xb8\x4d\xbb\x8a\xd3\x32\xef\x9c\x24\x14\x67\x22\x09\xcb\x87\x38\x8a\x08\x31\x6c\xab\x7d\x56\x84\xfc\x76\x79\x7d\x38\xa1\x83\x8b\x26\xca\x0d\xf0\x3d\x17\x50\x87\x2c\xb7\x52\x21\x50\x5e\x30\xb9\x05\x4d\xee\xc9\x63\xec\xaa\x8f\xd7\xde\xe1\x10\x45\x6f\x41\xa5\x68\x05\x9c\x25\xbb\x4a\x03\x4d\xb3\xaf\xba\x34\x4b\xb9\x45\xd0\xda\x65\x54\xa1\x32\x8a\x2b\x51\x5b\x0c\xf7\x79\x6e\x50\x3d\xd3\x4a\xa1\xf1\xab\xab\xd4\x85\x50\xcd\x42\xbb\xc4\xcb\x1c\x69\x26\x14\x7f\x0a\x59\xa7\x89\xff\xff\x99\xc6\x6b\x57\xe7\x20\xd1\x32\x0f\xdc\xc6\x35\x24\x53\xda\x22\xf1\x62\x5c\x45\x63\x3e\x9f\xcf\x3f\x83\x87\x8b\x33\x22\x52\x2f\x7e\x35\x26\xcb\xe5\xe2\x33\x90\x2c\x97\x8b\x11\x91\xb0\xf6\xd5\x80\x40\xa8\xfb\x14\x13\x38\x87\x64\x76\x37\x26\x32\xbe\x33\xff\xcb\x95\x11\xd9\xa0\xf8\xf1\x8b\x3b\x7c\x78\x75\xa1\xd0\xd0\x53\xaf\x9d\x2b\x5c\x28\x96\xd3\x8c\x09\x26\x39\x20\xf5\xd0\xee\x49\x2c\xc1\xbc\x28\x7c\x72\x1b\xb4\xcd\x24\x18\xdd\x0f\xfd\xd8\x14\xe6\x8d\x89\xc8\xea\x5f\x3a\xf9\xee\x13\x5f\x9f\xca\x9c\x8a\x52\x1b\x90\x80\x43\xfd\x9a\x97\xae\x9f\x0b\x43\x79\x24\x28\xb2\x1e\xb5\x84\xa1\x3c\x0c\xc5\x6c\xeb\xfe\xfb\xf0\xc7\xdb\x1a\xf9\x3a\xb6\x55\x1a\xf9\xd9\xb2\x61\x56\x18\xca\xb8\x1f\x2f\xe1\x29\xef\x36\x4c\x13\x69\xa3\xf0\x85\x61\x1e\x87\x0d\x0c\xb7\x60\x6a\x79\x07\xd9\xd1\xae\x31\x19\x54\xd7\x66\x7b\x62\x22\x0c\x5c\xcf\xa1\x69\x05\x7e\x4f\xd6\x55\xda\x2b\xbd\x7e\xed\x5b\x4c\x47\x3a\xed\xe8\x3c\x33\x37\x0b\x60\xc2\x14\x94\x17\xc0\x9f\x6a\x42\x61\x69\x4f\x4d\x81\xa0\x0b\x25\x82\xf7\x5d\xea\x8d\x56\x8e\xcd\xad\xd1\x37\xf9\x8e\x89\x3e\xde\x45\x30\x8e\x35\xec\xaa\x78\x9a\xd9\xb9\x56\x3a\x8e\x89\x1b\x34\x93\x1f\x1b\xb7\x6e\x27\x77\xe8\x15\x0d\x75\x04\x34\xb9\xa5\xbc\x4b\xbf\xa9\xea\x81\x79\x69\x5b\x5d\xa2\x64\x3b\xdc\x6e\x20\xa4\x9b\x76\xb7\xd6\x71\xb9\x5c\x5c\x21\x63\x4b\x67\xb2\x8a\xce\xa3\x2f\x62\x98\xf1\x1f\xd0\x50\x59\x53\x59\x73\xc9\x17\xc1\x8e\x09\x0b\xd7\xcd\x43\x57\xe8\x1b\xc7\x77\x61\xe9\xfe\xa1\x8f\x13\x5f\xe9\x70\xc2\x8f\xc9\xea\x5d\xb2\xdf\xdf\xda\xe0\xb0\x3e\x5b\x83\xff\x72\x38\xc5\x6b\xd8\xe7\xef\xb0\xb0\x28\x26\x85\xc9\xa5\xa6\x6d\xa8\x7f\x01\x00\x00\xff\xff\xaa\x1a\xd6\xc4\xfe\x0d\x00\x00")
+var _templatesConcourse_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x55\x31\x6f\xeb\x38\x0c\xde\xfd\x2b\x04\xe3\xa6\xc3\xc5\xe7\x26\x19\xb2\x64\xea\x74\xcb\xe1\x0d\x6f\x2b\x0a\x41\x96\x99\x58\xa8\x2a\x19\x94\x9c\x22\x28\xf2\xdf\x1f\x28\xcb\x6e\x6c\x27\x4d\xda\xd7\x16\xaf\xf1\x12\x50\xe2\x47\xf2\xfb\x28\x12\xc1\xd9\x06\x25\xb0\x54\x3c\x39\xee\x40\x36\xa8\xfc\x9e\x6f\xd1\x36\x75\xca\x52\x69\x8d\xb4\x0d\x3a\xe0\xba\xe0\xca\x78\x40\x23\xf4\xe4\xda\x73\xc2\x98\x11\x8f\xc0\xe2\x6f\xcd\xd2\xbf\x9e\x77\x02\x33\x30\x3b\xae\xca\xc3\xac\x87\x99\xe9\x62\xd6\xc1\xcc\x3a\x98\x59\x0b\x93\x30\x56\x82\x93\xa8\x6a\xaf\xac\x61\x6b\x96\xde\x76\x6e\xec\xbf\xe8\x93\x26\x8c\xed\x6a\xc9\x55\xd9\x45\xd2\x56\x0a\x9d\xb5\xb6\x24\x61\xcc\x8b\xad\x63\xeb\x90\x12\x63\xff\x53\x52\xef\xce\xe6\x40\x78\x5a\x6d\x40\xee\xa5\x86\x08\xa9\xb6\xc6\x22\x70\x59\x09\xb3\x05\x8a\x74\x47\x95\xdf\x87\xeb\x87\x24\x79\x8d\x4f\x8e\x8d\x86\xb3\xa4\xae\xf2\x34\x84\xf0\xfb\xfa\x98\x48\x65\xb6\x08\xce\x51\xe1\x35\x5a\x6f\xa5\xd5\xf1\xc4\xcb\x90\xe5\x06\xed\x23\xaf\x2d\xfa\x60\x5d\xe5\x04\x61\x3b\x43\x6f\x92\xaa\x44\x5e\x68\x2b\x1f\x42\xce\x69\x9e\x85\xef\xdf\x3c\xbd\xa7\x2a\x47\x89\xaa\x92\xad\xd9\xb4\x80\xec\x74\xe6\xa3\x4b\xaa\xfc\x3d\x22\xe6\xf3\xf9\xfc\x23\xa8\x20\x9c\x09\x19\xd1\xf8\x8d\xe8\x58\x2e\x17\x1f\xc1\xc6\x72\xb9\x98\x90\xd1\xda\xbe\x11\x17\xd0\xbe\x85\x53\x74\xc0\x39\x36\x66\x37\x53\x32\xa6\x8f\xe4\x0f\x78\x23\xba\x18\xd5\x3d\x1d\xac\xe3\xf9\xea\x2a\x8b\x9e\x9f\x9a\x6b\x54\xb3\xb6\xa2\xe4\x85\xd0\xc2\x48\x40\x1e\xda\x67\xcd\x52\x03\xfe\xc9\xe2\x03\x5d\x70\x4d\x61\xc0\xbb\x0e\x96\xbe\x58\x52\x38\xc8\x74\x11\xff\xb9\xec\xef\x33\x29\x73\xad\x9c\x07\x03\x38\xd6\xac\x1b\x67\xc3\x24\x04\x9a\x18\x42\x17\x03\xa6\x32\x81\x66\xa4\x5d\x5f\xeb\xcf\xdb\x1f\x94\x6d\xaf\x56\xff\x85\xd9\x16\xd6\xc6\x46\x34\xda\x73\x21\xc3\xe6\xa0\xb0\xc3\xfe\xe8\x90\x36\x16\x9f\x04\x96\x84\x46\x9b\x02\xb7\xe0\xa3\x9a\xc7\x89\xf1\xe3\x93\xa1\x9e\xab\x3c\x26\x7a\x62\xd8\x8f\x1c\xcf\x11\xd2\xeb\x79\x49\xc5\x55\x3e\xa8\x3a\x0e\xf2\x9e\xa1\x17\x62\xfa\x85\x38\xdd\x86\x15\x08\xed\x2b\x2e\x2b\x90\x0f\x71\x81\xb5\xa6\x3d\xf7\x15\x82\xab\xac\xa6\x4d\xba\x66\x37\xf4\x00\x18\x6b\xcc\xf4\xb8\x3f\x0c\xcf\x70\x27\x8e\xc4\x21\xcf\x45\xeb\x39\x55\xee\x58\xbb\xc3\x9b\x7a\xe7\x65\x03\x7c\x6e\xf7\x50\x9c\xaf\xed\x1f\x8a\xf8\xee\x0e\x7a\xa1\xe5\xea\x1e\x0a\x2e\xc3\x2e\x8a\x1b\xb0\xe7\xea\x72\x1f\xbd\x45\xba\x7e\x5b\x7d\xae\x72\xb4\xb9\xbe\x54\xb8\xe5\x72\xf1\x6e\xdd\x7a\x4e\xae\x96\x8d\x3c\x86\xaa\x51\xc1\x6f\x15\xcd\x36\xbe\x6e\x3c\x4b\xaf\xd9\x48\x6d\x5f\xed\x84\x6e\x20\xd2\x31\xda\x58\xd7\x80\x64\x54\xe0\xd9\xc8\xc7\x14\xb9\x61\xbc\xbb\x8b\xfc\xaf\xf2\x00\xfe\xcf\x75\x4a\x5d\x79\x95\x9e\x42\x80\xbd\x3f\x9b\x34\x9d\x4e\xb9\x19\x37\xf1\xab\x75\x37\xa8\x2f\x23\x94\xc6\x71\x23\x1e\x21\x39\x24\xbf\x06\x00\x6d\x0c\x1f\xfa\x93\x0d\x00\x00")
 
 func templatesConcourse_lbTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -151,12 +151,12 @@ func templatesConcourse_lbTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/concourse_lb.tf", size: 3582, mode: os.FileMode(480), modTime: time.Unix(1520375763, 0)}
+	info := bindataFileInfo{name: "templates/concourse_lb.tf", size: 3475, mode: os.FileMode(480), modTime: time.Unix(1792204179, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesIamTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x51\x6f\xe3\x36\x0c\x7e\xae\x7f\x05\x61\xec\x61\x2b\x9a\xac\xed\xcb\x80\xe0\x8a\x43\xd1\x66\xc5\xb6\x1b\x56\x24\xc5\x3d\xac\x28\x0c\x46\xa6\x1d\x6d\xb2\xe4\x49\x72\xba\xac\xc8\x7f\x1f\x24\xd9\x4e\xd2\xd8\x4e\xbb\xe1\xee\xa5\x40\xfd\x7d\x24\x3f\x92\x0e\x49\xaf\x50\x73\x5c\x08\x82\x78\xa1\xcc\x32\xe1\x58\x24\x5c\x1a\x8b\x92\x51\x52\x6a\x95\x71\x41\x31\xbc\x44\x00\x29\x65\x58\x09\x0b\x57\x10\xc7\xd1\x26\x8a\x84\x62\x28\x8c\x87\x38\x16\xf7\x81\x7a\xaf\xd5\x8a\xa7\x94\x3a\xd6\x37\x2f\x2b\xd4\xe3\x5e\xaf\x70\xe5\x3c\xc1\x47\x38\x87\x09\x5c\xc0\xc6\x3b\x4d\xd1\x22\xc4\xf8\x6c\x7a\x84\x78\x91\x41\x8f\xc4\x82\xde\x10\x66\x13\x47\x11\x00\x53\x95\xb4\x81\xed\x75\x8f\x0f\x25\x07\x01\x9a\x8c\xaa\x34\xa3\xad\x08\xad\x06\x03\x93\x5c\x25\x3c\xdd\x24\x5e\x80\xe7\x46\x00\x25\xda\xa5\xa3\x7c\xff\x3a\xf8\x05\x8c\x60\x40\x40\x04\x20\x78\x46\x6c\xcd\x04\xf9\x58\x00\x4c\x13\x5a\x4a\x16\x94\x29\x4d\x49\x4a\xc6\x6a\xb5\x86\x2b\xb0\xba\xa2\x08\x60\xe3\x6c\xd0\x98\xaa\x20\x1f\x3d\x29\x95\xe0\xcc\x11\x3e\x7c\x98\xfe\xf6\x63\xe4\x9c\xc4\x9f\x49\x1b\xae\x64\x3c\x81\xf8\xf2\xfc\xe2\x72\x74\x71\x3e\xba\xf8\x21\x3e\x73\xd0\xdc\xa2\xa5\x82\xa4\x8d\x27\xf0\xe8\x03\x86\xb0\x00\xf1\x35\xb3\xb5\x91\xb1\x66\x72\xed\x63\xcc\x5c\x82\x67\x0d\xe3\x5e\x73\xc9\x78\x89\x22\x9e\xb4\x66\xce\x27\xe9\x15\x67\xe4\x2c\x89\x5d\x8e\xb1\xc0\x7f\x94\xc4\x67\x33\x66\xaa\x88\x6b\xda\xa6\x75\x32\xcd\x32\x62\x2e\x7c\x7c\x2d\x84\x7a\xde\x7a\x9f\xf3\xd4\x3d\x0d\x16\x9b\x08\xe0\x29\xda\x44\x2e\xa7\xce\x36\x85\xbc\xdf\xda\xa8\x9a\xfd\xff\x5a\xf5\x05\x4a\xfd\xb8\xad\x22\xb1\x4b\x57\x74\xc5\x38\x5a\xba\x4e\x53\x4d\xc6\xb4\xc5\x69\x70\x6b\x91\x2d\x3f\x2b\x51\x15\xf4\x1a\xbb\x51\xe5\xfa\xa7\x02\xf3\x43\xc0\xbf\x51\xdd\x46\xb7\x24\xc8\xd2\x5c\x62\x69\x96\xca\x76\xa3\x7d\x96\x86\x69\xbe\x68\x94\xd2\x81\xd6\x96\xb0\x42\x2e\x70\xc1\x05\xb7\xeb\xdf\x95\xec\x27\x7a\xf1\xfd\x68\xfd\x3b\xef\x25\xcc\x28\xe7\x4a\xf6\xc2\x73\x62\x95\xe6\x76\x7d\xa7\x55\x55\xf6\xb3\xea\x4a\xf4\x13\xaa\x85\xa4\x7e\x38\xd4\xaa\x03\x1e\xe8\x9b\x6f\x4f\x5f\x0b\x02\xfa\x80\xf9\x81\xcf\x5f\x55\xca\xb3\x75\x53\x96\x6b\x6b\x35\x5f\x54\xf6\xc0\xfd\xac\x92\xbd\xa5\x7b\x20\x5d\x70\x89\xb6\xbf\xb8\xae\xa8\xc6\x92\xee\x7c\xb1\x6e\x49\x0f\xc1\x37\xce\xa3\x98\x97\xca\x36\xee\x67\xf4\x57\x45\x66\xa0\xb8\x6f\xe0\xd6\xcf\x77\xa9\x07\x9c\x50\xb4\x99\xea\x28\x47\xfb\xb6\x38\xf0\xc1\x2d\xc2\x8e\x08\xa5\x40\x56\x9b\x47\x27\x00\x4f\x67\xee\x6f\xc7\xe0\x72\x4f\x67\xf5\x64\x72\xcf\x4f\xeb\xd9\x75\x16\x9d\xbc\x78\x70\xe7\x77\x7e\xe2\xfd\x73\x2c\x26\xf7\x68\x8c\x9f\xab\xef\xf5\x7d\x32\xe0\x98\x04\x1a\xcb\x99\x50\x98\x2e\x50\xa0\x64\x5c\xe6\x93\xd3\xff\x14\xa2\x29\xc6\x76\xc2\xc3\xd0\xdc\xae\xe1\x8e\x91\xd6\x62\x7f\x16\x66\x32\xa3\xa9\x64\x7a\x5d\xda\xd3\x57\x96\x2d\xe3\x8e\x24\x69\xb4\x74\x8b\x16\x7f\xa1\x75\x2f\x2f\x74\xf7\x4e\xa3\xb4\x7d\x94\xa6\xcb\xde\xcd\x1e\xe5\xe9\x95\xec\x9d\xfc\x3b\x84\xbf\x36\x6e\xff\x3b\xba\x9e\x76\x76\x73\x82\x7e\x6a\xfb\x4d\xb0\xbb\xae\x1c\xa5\x76\x77\xe4\xba\xa8\xdd\x68\x19\x88\xfb\x2b\xd0\x9f\x42\x63\xd4\xf2\xe0\xf2\x39\xb2\xd1\x3a\x75\xbf\xe3\x04\xab\xb5\x8e\x3c\xde\xe4\xb3\x27\xd0\x3d\x09\xf2\x9c\xe5\x7b\xf5\x75\x1c\x47\x3c\x97\xee\x2a\x62\x4b\x94\x39\x19\xb8\x82\xc7\xd8\x79\x8e\x9f\xfc\x65\x74\x90\x50\x26\xd4\x73\x22\x54\xee\x92\x58\x88\x90\x83\x50\x79\x92\xbb\x1d\x90\x6c\xb3\x71\x5c\x26\x54\x95\x3e\xa3\x65\xcb\xa4\xa5\x8c\x17\x0b\xd1\x48\xf7\x57\x6f\x68\xab\x6b\x04\x74\x64\xda\x84\x33\x75\x37\x00\x56\x25\x4b\x78\xda\xbe\x3f\x3b\xf7\x68\x40\x3c\xc9\x6a\xcc\x32\xce\x12\xbb\x2e\x29\x90\x66\xd3\x9f\xa7\x37\x0f\x1d\x1d\xea\x12\xb9\x9b\x9c\xd3\x9a\x94\x9a\x32\xfe\xf7\xb6\x4f\x66\xa9\xb4\x4d\x9a\x6e\x09\x95\x8f\x82\xdd\xe0\xf9\xdb\xe6\x32\xd4\x79\x47\x72\x0e\xcd\x28\xbc\xaa\x5f\xec\x34\x6d\x4e\xc3\xe3\x47\xe4\xf1\x13\x75\x55\xb2\xad\xf0\x63\xc7\x6a\xef\x4d\xfc\xb6\x23\x75\xa7\x0c\xef\xaf\xe9\xf6\x66\xed\xf9\x65\x6d\xdf\x37\xfe\x55\x2e\x54\x17\xaa\x9e\xbe\x9f\x54\xee\x0f\xa9\xdd\xdd\xb9\x0f\xcf\xad\x26\x2c\x0e\xf0\xfb\xca\x7e\x52\xf9\x74\x45\x72\x7f\xb5\x7b\xb0\x19\xdb\x8d\xf7\x41\x46\x08\x60\x9a\x9e\x3d\x1d\x7f\x37\xba\x56\xf5\x7e\x07\x55\x65\xcb\xca\xfa\x35\xdd\xf3\x55\xbc\x42\x51\xd1\xf0\x87\x25\x7c\x84\x3f\x14\x97\xdf\xc6\xf1\x19\xb8\xef\xdb\x71\xdf\x6c\x0d\xa3\xf1\xd4\x4f\x98\xef\x60\xb2\xb5\x7a\x93\x81\x9f\xe0\xff\x06\x00\x00\xff\xff\x6f\x9b\x07\x6e\xce\x0f\x00\x00")
+var _templatesIamTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\xdf\x6f\xe3\x36\x0c\x7e\xae\xff\x0a\x42\xd8\xc3\x56\x24\x59\xd3\x97\x01\xc1\x05\x87\xa2\xcd\x8a\x6d\x37\xac\x48\x8a\x7b\x58\x51\x18\x8c\x4c\x3b\xda\x64\xc9\x93\xe4\x74\x59\x91\xff\x7d\x90\x6c\xe7\xa7\x9d\xa6\x37\xdc\x90\xa2\x40\xc4\x8f\x1f\x3f\x92\x36\xc5\x2c\xd1\x08\x9c\x4b\x02\x36\xd7\x76\x11\x0b\xcc\x63\xa1\xac\x43\xc5\x29\x2e\x8c\x4e\x85\x24\x06\xaf\x11\x40\x42\x29\x96\xd2\xc1\x18\x18\x8b\xd6\x51\x24\x35\x47\x69\x83\x49\x60\xfe\x50\x41\x1f\x8c\x5e\x8a\x84\x12\x18\xc3\x12\xcd\xa0\x93\x12\xc6\x9e\x06\x3e\xc2\x15\x8c\x60\xe8\xe9\x12\x74\x08\x0c\x5f\x6c\x87\x84\x20\xaf\x52\xa2\x30\xa7\xb7\x02\x44\x11\x00\xd7\xa5\xf2\x7a\x83\xd2\xc1\xb1\x48\x1f\xd6\x90\xd5\xa5\xe1\xb4\x0d\x6d\x74\x7b\x38\xf6\xcd\xab\x4f\x89\xd4\x32\x16\xc9\x3a\xf6\x72\x2a\x6c\x04\x50\xa0\x5b\xf8\xc2\x7c\xcf\x76\xe3\x0e\xa1\xdf\x1d\x3b\x02\x90\x22\x25\xbe\xe2\x92\x42\x18\x00\x6e\x08\x1d\xc5\x73\x4a\xb5\xa1\x38\x21\xeb\x8c\x5e\xc1\x18\x9c\x29\x29\x02\x58\x7b\x6e\xb4\xb6\xcc\x29\x04\x8e\x0b\x2d\x05\xf7\x80\x0f\x1f\x26\xbf\xfd\x18\x79\x12\xf6\x99\x8c\x15\x5a\xb1\x11\xb0\xeb\xab\xe1\x75\x7f\x78\xd5\x1f\xfe\xc0\x7a\xde\x34\x73\xe8\x28\x27\xe5\xd8\x08\x9e\x42\x40\xef\xe1\x3f\xec\x86\xbb\xda\xc9\x3a\x3b\xba\x09\x31\xa6\x3e\xb7\x5e\x83\x78\x30\x42\x71\x51\xa0\x64\xa3\x5a\xad\xff\x63\x33\x32\x4b\xc1\xc9\x87\x23\x7e\x3d\xc0\x1c\xff\xd1\x0a\x5f\xec\x80\xeb\x9c\xd5\xb0\xf5\x86\x64\x92\xa6\xc4\x7d\x78\x76\x23\xa5\x7e\xd9\xb2\xcf\x44\xe2\x4f\x2b\x8f\x75\x04\xf0\x1c\xad\x23\x9f\x53\x6b\x87\xaa\xbc\xcf\xed\x51\x8d\xfe\xe2\x2e\x7d\x85\x2a\x3f\xd5\x27\x10\xaa\xe6\xeb\xad\xb9\x40\x47\x37\x49\x62\xc8\x5a\xd6\x3b\xb0\x3b\x87\x7c\xf1\x59\xcb\x32\xa7\x43\xdb\xad\x2e\x56\x3f\xe5\x98\x1d\x1b\xc2\xc3\xd4\xee\x74\x47\x92\x1c\xcd\x14\x16\x76\xa1\x5d\xbb\xb5\xcb\xd3\x72\x23\xe6\x8d\x52\xb2\x9d\x80\x25\x0a\x89\x73\x21\x85\x5b\xfd\xae\x55\x37\x30\x88\xef\xb6\xd6\xaf\x75\x27\x60\x4a\x99\xd0\xaa\xd3\x3c\x23\x5e\x1a\xe1\x56\xf7\x46\x97\x45\x37\xaa\xae\x44\x37\xa0\x9c\x2b\xea\x36\x57\xb5\x6a\x31\x9f\xe8\x5b\x68\x4f\x57\x0b\x2a\xeb\x23\x66\x47\x9c\xbf\xea\x44\xa4\xab\xa6\x2c\x37\xce\x19\x31\x2f\xdd\x11\xfd\xb4\x54\x9d\xa5\x7b\x24\x93\x0b\x85\xae\xbb\xb8\xbe\xa8\xd6\x91\x69\x7d\xb0\xee\xc8\x9c\x32\xdf\xfa\x98\x72\x56\x68\xd7\xd0\x4f\xe9\xaf\x92\x6c\x77\xf5\xce\xc1\xd6\xe7\xbb\xd0\x23\x4c\x55\xb4\xa9\x6e\x29\x47\x13\x2a\x18\x1f\xfd\x75\xd7\x12\xa1\x90\xc8\x6b\xf7\xe8\x02\xe0\xb9\xe7\xff\xb7\xcc\x2c\x7f\x3a\xad\x87\x92\x3f\xbf\xac\xc7\x56\x2f\xba\x78\x8d\x2e\xf6\xdf\xf3\x0b\x6f\x61\x02\xf3\xd1\x03\x5a\x1b\x46\xea\x7b\xb9\x2f\x4e\x10\x93\x44\xeb\x04\x97\x1a\x93\x39\x4a\x54\x5c\xa8\x6c\x74\xf9\x45\x21\x9a\x62\x6c\x87\xfb\xc9\x91\x5d\x9b\x77\x14\x6d\x0e\xeb\x0f\xfb\x33\xb7\xa3\x29\x4d\x14\x37\xab\xc2\x5d\xb2\x5e\x3b\xe2\x9e\x14\x19\x74\x74\x87\x0e\x7f\xa1\x55\x27\xae\xea\xee\xbd\x41\xe5\xba\x20\x4d\x97\x03\xcd\x1e\xe4\x79\xdf\x63\x37\xff\x16\xe1\x87\xce\x9b\x6f\x6f\xde\x4c\x3b\xd7\x72\x8c\x61\x6a\x87\x9b\x60\xf7\xa6\xf2\x90\x9a\xee\x8d\x9d\xa2\xa6\x31\x0a\xc6\xb0\x7f\xf5\x85\xb5\xe7\xe9\xea\x79\x80\x46\x9d\x7d\x95\xb5\x0a\x3e\x67\xcf\xda\x17\xd9\xf7\xb1\x59\x93\xc8\x56\x99\xff\xba\xd1\xe5\x37\xb4\xff\xb2\x09\x89\x4c\xf9\x15\x88\x2f\x50\x65\x64\x61\x0c\x4f\x9e\xf1\x39\x2c\x41\x47\x79\xa4\x52\xbf\xc4\x52\x67\x5e\xfb\x5c\x56\x55\x96\x3a\x8b\x33\x3f\xf3\xe3\x3a\x09\x2f\x93\x4b\x5d\x26\x2f\xe8\xf8\x22\xde\xd8\x07\xf3\xb9\x1c\x78\x4c\xb5\xc6\x56\x8b\x15\x1a\x05\x70\x98\x5b\x13\xc6\x86\xaa\x03\x2c\x0b\x1e\x8b\xa4\x6e\x25\x6c\xd6\xcc\xea\x38\x02\x70\x06\xd3\x54\xf0\xd8\xad\x0a\x0a\x6c\x6c\x3a\xf9\x79\x72\xfb\xc8\x8e\x1f\x9d\x36\x61\xbb\xd9\x78\x7d\x71\x61\x28\x15\x7f\x6f\xfb\x61\x17\xda\xb8\xb8\xe9\x8a\xd4\x59\x3f\x24\xdc\x42\xdf\xa4\xc0\x80\x6d\x92\x38\xd5\x61\x0f\xea\x4b\x9d\xd9\x7e\xf0\xfa\x7a\x6b\x67\xb3\xf6\xf5\xa2\x37\xa6\xcd\x19\xeb\xe7\xb2\xe0\x5b\xe1\x6f\x2d\xa2\x9b\xb1\x75\xb8\xef\x46\xef\x7d\xcd\xdf\x5f\xd3\xed\x3e\xda\xf6\x06\x6d\xc8\x06\xe2\x7f\xd8\x3e\xbd\xea\x7a\xd9\xf8\xa4\xb3\xb0\x24\xb1\x5e\x97\x79\xe6\x0c\x61\x7e\x64\x7f\x28\xdd\x27\x9d\x4d\x96\xa4\xf6\xaf\xed\x60\x6c\x46\x72\xc3\x7e\x12\x51\x05\xb0\x2c\x3a\x18\xda\xdd\x8f\xc5\xc1\x3d\xd6\xd2\x3c\x5d\xba\xa2\x74\xc0\xda\x87\x9d\x2f\xce\x12\x65\x49\x27\x7e\x25\xfa\x9f\xaa\x43\xf8\x08\x7f\x68\xa1\xbe\x65\xac\x07\xfe\x97\xea\xa0\x6b\x80\x86\x11\x38\xb8\x0c\x23\xe5\x3b\x18\x6d\xbd\xce\x72\x88\xd6\xd1\xbf\x03\x00\x18\xfa\xc4\x3b\x90\x0f\x00\x00")
 
 func templatesIamTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -171,12 +171,12 @@ func templatesIamTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/iam.tf", size: 4046, mode: os.FileMode(480), modTime: time.Unix(1520537908, 0)}
+	info := bindataFileInfo{name: "templates/iam.tf", size: 3984, mode: os.FileMode(480), modTime: time.Unix(1792204182, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesIso_segmentsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\xdd\x6f\xdb\x36\x10\x7f\xcf\x5f\x71\x10\xfa\x50\xb7\x8a\x20\x7f\x75\x4a\x81\x6c\x18\xda\xc7\xa2\x2b\xd0\x6e\x2f\x45\x41\x50\x14\x2d\x13\xa5\x49\x81\xa4\xbc\x25\x41\xfe\xf7\x81\xa4\xa2\xe8\xcb\xf2\x47\xdc\x2d\xc3\xfc\x60\xd8\x24\x8f\xf7\xbb\xbb\x1f\xef\x8e\xdc\x62\xc5\x70\xca\x29\x04\x4c\x4b\x8e\x0d\x93\x02\x69\x9a\x6f\xa8\x30\x3a\x80\xbb\x0b\x00\x73\x53\x50\xa8\x3e\xd7\x10\x68\xa3\x98\xc8\x83\x0b\x80\x8c\xae\x70\xc9\xcd\xc3\x44\xec\xc7\x34\x51\xac\xb0\xdb\xd8\xb1\xdf\xdc\x2f\xcc\xf9\x0d\x10\x45\xb1\xa1\x80\x81\x4b\x9c\x41\x8a\x39\x16\x84\x2a\xc0\x22\x83\xf7\x1f\x3f\x03\x15\x46\x31\xaa\x61\x25\x15\x60\xd0\x4c\xe4\x9c\x42\x0d\x09\x2a\x48\x11\xfc\x81\x39\xcb\x60\x8b\x79\x49\x35\x60\x45\x21\x06\xa9\x60\x1a\x05\x17\xf7\x17\x17\x2d\x63\x90\x91\x28\x95\x7a\x8d\x0a\xa9\xba\xb6\x5c\x43\xc0\x99\x36\x4d\x2b\xae\xe1\xeb\x6c\x16\xc2\x9b\xe4\x4d\x12\xc2\x6c\xb9\x5c\x86\xb0\x98\xd9\x91\xd9\x72\xb6\x8c\xbf\x0d\x6e\xaf\xd7\x58\xd1\x0c\x19\x52\x1c\xae\xe4\x2a\xbe\x8a\x43\xb8\x8a\xaf\xa6\x21\x24\x71\x32\x0b\x21\x99\xc7\xb1\xfb\xb6\x23\x49\x72\x15\x42\xb2\x58\xcc\x43\x98\xc7\x76\x7c\xe1\x7e\x27\x71\x12\x87\x30\x5f\x2c\x7f\xb2\xb2\xb3\xb9\xfb\x9e\x79\x88\xa3\xd8\xca\xec\x08\x6c\x15\x86\x79\x6c\x51\xbd\x89\xbd\xd5\x5c\x12\xcc\xb5\x93\xb6\x5b\xe3\x5b\x44\x64\x29\xec\xfa\xe0\xc5\xdd\x16\xab\xa8\x4f\x1c\xf8\x19\x62\xf8\x05\x38\x15\xb9\x59\xbf\xb4\x6b\xf0\x16\x33\x8e\x53\xc6\x99\xb9\x41\xb7\x52\x50\x3d\x81\xb7\x10\xdf\xbb\xb0\x29\xaa\x65\xa9\x08\x85\x00\xff\xa9\x91\x2e\x53\x41\x4d\xe0\x0d\xf1\x7f\x2a\xf0\x5e\x6f\xf3\xe3\x30\x38\x80\x51\x13\xdb\xbd\xb5\x6b\x5b\x10\xc4\xb2\x1d\xab\xfd\xa4\x5b\x47\x58\xa6\x50\xca\x25\xf9\xde\x5a\x67\x87\xbd\x76\x67\x80\x15\xb0\x43\x21\x2c\x42\x0f\x24\x62\x22\xa3\x7f\xc1\xeb\x7d\x66\xbe\x86\xe9\xc4\x29\xea\x4d\x7a\x45\x94\x53\xeb\xb4\x1d\xf2\x2d\x65\x76\x1f\x1b\x44\x9c\xfb\x78\x00\x7c\xc4\x1b\xfa\x18\x09\x2a\xb6\xd6\xaa\x4b\xa6\xe5\xa5\xc7\xfe\xe2\xae\x21\xee\x50\xdc\xf7\x3d\xae\x64\x69\x28\x32\x96\x3e\x08\x6b\x2d\x09\x73\xe1\x0c\x20\xf0\x33\xfb\x02\x31\x16\x05\x2f\x57\x07\xa2\x65\xf1\x63\xb4\xa3\x86\x8a\xe8\x55\xc4\xb2\x9e\xd9\x00\x4d\x94\x2c\xf3\x3b\x75\xd0\x47\x4c\x18\xaa\x04\xe6\xed\xc1\x6c\x88\x66\x94\xa7\x15\xc7\xdc\x5a\x85\xec\xff\x47\xe3\x46\xd8\xed\x83\x20\xac\xe7\x07\x3f\xb5\xa8\x5e\x4b\x65\x50\x33\x28\x5e\xd5\x25\x4f\x1d\xf1\x94\xd4\xda\x45\x19\xd9\x9c\x88\x7c\x4e\x64\x22\x87\x6b\x30\xaa\xa4\x56\xcb\x9a\x62\x6e\xd6\x88\xac\x29\xf9\x5e\x85\xdc\x0f\xdd\x20\xb3\x56\x54\xaf\x25\xcf\x9c\xca\xa5\x9b\x2b\x45\x7f\xf6\x1a\x66\x6e\xce\xf9\x66\x8b\x79\x1b\xea\xd4\x4f\x1a\xac\x72\x6a\x7a\x76\x7c\x79\xf7\xe9\x6d\xe2\x12\x3b\x80\x61\x1b\x2a\xcb\xee\xf9\x9b\x39\x4a\x5d\x00\xd8\x74\x42\x05\x55\x15\x4a\x26\xb4\xb1\x19\xde\x25\x9f\x6a\x6d\x12\x77\xa6\x94\x34\x92\x48\x6e\x35\xad\x8d\x29\xbc\x1e\x9e\x3e\xca\x40\x5b\xd2\x4e\x3d\xc8\xd4\x18\x1f\x24\x0f\x43\x31\x06\x63\x1f\x0e\xb8\x86\xc5\x62\xbe\x03\xc9\x83\xb0\xf6\xd2\x5a\x73\x44\xa8\x32\x6c\xc5\x08\x36\x6d\xc6\x32\xbc\x41\x9a\xaa\x2d\x55\xcd\x25\x11\x4f\xdd\xdf\x08\x2b\x71\x7f\x3e\x83\x0c\x19\xb7\x67\xd4\x20\xad\xf9\x79\xcd\xd1\x94\x94\xca\x26\xb7\x5c\xc9\xb2\xd0\xb6\xe8\x54\xbb\xb4\x67\x22\xb2\x7a\x3c\x97\xdd\x39\x7b\xa0\xbf\xd5\xb9\x45\x37\xcc\xa9\x37\xf3\x59\xc5\x8a\x36\x92\x8a\x95\xea\x97\x9b\xd6\xde\x0f\x65\xa7\x33\x78\x7c\x5e\x18\xce\xc9\x79\xa3\x30\x0d\x55\xa3\x7e\x07\xf5\x49\xb1\xad\xed\x9b\x7a\xad\xd0\x11\x95\xa0\x32\xe6\xd2\x1b\x33\x5c\x03\x86\xdd\xe0\x7b\x88\x1f\xe5\x0d\xb7\xfb\x29\x4e\xf9\xec\x24\xfb\x3e\xd1\x47\x38\xa5\x52\x7e\xbc\x6f\x90\x2a\x39\x0d\x86\xfa\xe5\xba\xe3\xf4\x2b\x0e\x72\x13\xbc\x6a\xf6\x0f\xbd\xb6\x75\x32\x68\xff\x97\x77\x9f\xc0\x28\xbc\x5a\x31\x02\x2b\x25\x37\xe0\xc9\x05\x46\x82\x15\x0d\xfa\x27\xad\xd1\x09\xd5\xe7\xb6\x73\xaa\x9c\xd2\x81\x93\xd6\xb9\x02\x74\x4b\x04\x13\xb9\xa2\xda\x65\xbd\x6e\x02\x69\x2e\xab\xd2\x90\x91\xbd\x24\xd4\x40\xd5\x6c\x85\x7a\xae\x18\x68\x09\xac\xed\x83\xfb\x9d\xb4\x9b\x0f\x79\x37\xda\xcd\x4c\xd7\xf5\x4e\x2f\x53\xec\xe8\x35\x8e\x21\x50\xe3\x4e\xf1\x54\x1a\x75\xaf\x27\x47\x93\xa9\x73\x4a\x4f\x61\xd5\xce\x34\xf2\x0c\xb8\xd5\xf5\xcf\x39\x18\x76\xc0\x9e\xcf\x8a\x67\xf6\x7e\x78\x26\x9e\xd5\x57\xcd\x61\x9e\xfd\xfe\xfe\xbf\xce\xb3\x32\x7b\x12\xcf\x6a\xff\x9c\x91\x67\x63\x7b\x3e\x0f\x9e\xb9\x94\x8b\x39\x47\x55\xec\x8f\x61\xdb\x20\x8f\x7e\xfd\xf0\x61\x6f\xf1\xcb\x68\x41\x45\xa6\x91\x14\x3d\x77\x7e\x1d\xb0\x60\xa8\xf6\xf9\x0e\xf3\x79\x15\xd1\xcb\xe9\x1e\xae\xc4\xe3\xf4\x8c\xff\x05\x56\x54\x44\xcd\x18\xcd\x25\x4a\x53\xc7\x09\x1f\x69\x9a\x21\x42\x39\xd7\x4f\x66\x44\xaf\x82\x79\x9d\xe0\x74\x42\x9a\xea\x3a\xc7\xe4\x27\xb1\x63\xe0\x46\x70\x12\x39\x76\x79\xf2\x9c\x45\x70\x84\x1c\xd3\x24\x9e\x8e\xf3\xa3\x5a\x71\x1a\x45\x76\x27\xdf\x03\x99\x22\xb0\xf9\x01\xe4\xe8\xa5\x0b\x81\x4d\xb3\xec\x9c\x58\x6f\x2c\xd8\xff\xcd\x39\x97\xa5\x29\x4a\x03\x01\x59\xa1\xd6\x83\x19\xb2\xd7\x3b\x1f\x1c\xf7\x22\xdf\xae\x56\x44\x0a\x82\xfd\x2b\x1f\xe5\x69\xd4\x92\x8c\x5e\x45\x56\x36\x74\xef\x1b\x2f\x83\x60\x32\x09\x21\x9e\xb4\xb5\xf5\x01\x21\x96\x1d\xa2\x6d\xbf\x61\xfe\x89\x71\x8f\x6e\x7c\x8b\xea\xd7\x4b\xb4\xc1\x45\xc1\x44\xde\x53\xef\xae\x99\xb7\xac\xd8\xe0\xe2\x65\xfb\xf1\xa1\xfd\xa4\xd9\x7b\xd9\xbd\x0f\x42\x18\x13\xb0\xbe\x9f\xd8\xfb\xe8\x08\x2e\xf7\x74\xfd\x8f\x23\x7b\x7c\x30\xdf\x85\x70\x30\x17\x3c\x21\x78\x83\xa9\x65\x57\x0c\xff\x0e\x00\x00\xff\xff\xe3\x14\x91\x63\xd2\x1a\x00\x00")
+var _templatesIso_segmentsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\xdf\x6f\xdb\x36\x10\x7e\xf7\x5f\x71\x10\xfa\xd0\xb4\x8a\x20\xff\xea\x94\x02\xda\x30\xb4\x8f\x45\x57\xa0\xdd\x5e\x82\x80\xa0\x48\x5a\x26\x4a\x93\x02\x49\x79\x4b\x8a\xfc\xef\x03\x49\xc5\x96\x25\xd9\x8e\x53\x77\xcb\x30\x05\x08\x6c\x92\x77\xf7\xdd\xf1\xe3\x77\xb4\xd6\x58\x73\x5c\x08\x06\x11\x37\x4a\x60\xcb\x95\x44\x86\x95\x2b\x26\xad\x89\xe0\xdb\x08\xc0\xde\x56\x0c\x9a\x27\x07\x63\x35\x97\xe5\x08\x80\xb2\x05\xae\x85\x6d\x86\xa3\x34\xf2\x63\x86\x68\x5e\x39\x27\x6e\xec\x37\xff\x09\x0b\x71\x0b\x44\x33\x6c\x19\x60\x10\x0a\x53\x28\xb0\xc0\x92\x30\x0d\x58\x52\x78\xff\xf1\x33\x30\x69\x35\x67\x06\x16\x4a\x03\x06\xc3\x65\x29\x18\x6c\x00\x41\x03\x28\x81\x3f\xb0\xe0\x14\xd6\x58\xd4\xcc\x00\xd6\x0c\x52\x50\x1a\xc6\x49\x34\xba\x1f\x8d\x76\x52\x41\x56\xa1\x42\x99\x25\xaa\x94\xee\x66\x92\x83\xe0\xc6\xbe\x94\xf5\xaa\x60\xfa\xa2\x95\x4b\x0e\xd7\x93\x49\x0c\x6f\xb2\x37\x59\x0c\x93\xf9\x7c\x1e\xc3\x6c\xe2\x46\x26\xf3\xc9\x3c\xbd\x19\x0c\x62\x96\x58\x33\x8a\x2c\xa9\x4e\x0d\x75\x95\x5e\xa5\x31\x5c\xa5\x57\xe3\x18\xb2\x34\x9b\xc4\x90\x4d\xd3\xd4\xff\x77\x23\x59\x76\x15\x43\x36\x9b\x4d\x63\x98\xa6\x6e\x7c\xe6\x3f\x67\x69\x96\xc6\x30\x9d\xcd\x7f\x72\xb6\x93\xa9\xff\x3f\x09\x40\x0f\x22\xac\xe9\xc9\x08\x1b\x24\xd3\xd4\x61\x7b\x93\x86\x0a\x08\x45\xb0\x30\xde\x07\x37\x0a\xe1\x3b\x44\x54\x2d\xdd\xfa\x35\xd6\x49\x9f\x45\xf0\x33\xa4\xf0\x0b\x08\x26\x4b\xbb\x7c\xe9\xd6\xe0\x35\xe6\x02\x17\x5c\x70\x7b\x8b\xee\x94\x64\xe6\x02\xde\x42\xea\x9c\x6b\x66\x54\xad\x09\x83\x08\xff\x69\x90\xa9\x0b\xc9\x6c\x14\x6a\x1d\xbe\x34\xe8\x43\xc8\xf6\x93\x83\x07\x96\xb4\x31\x8d\x00\xd6\x15\x41\x9c\x0e\x2d\x0c\x33\xce\x17\xa7\x1a\x15\x42\x91\xaf\xdb\x25\x84\x53\x1d\x02\x7a\xc4\x6e\xad\x1b\x8a\x61\x16\x83\x77\x9d\x70\x49\xd9\x5f\xf0\xfa\x58\x5e\xaf\x61\xec\x8a\xda\x9b\x82\x1c\x98\x60\xee\x98\xed\x31\xdd\x89\x73\x31\x72\x3b\x86\x4b\x03\xb9\x4f\x1f\xe0\x23\x5e\x31\x77\xc6\x5e\x7c\x73\xe6\x4c\xae\x11\xa7\xf7\x97\xdc\xa8\xcb\x00\xfb\xc5\xb7\x96\xf9\xbd\x3b\x9c\xf7\xfd\xfa\x6a\x55\x5b\x86\xac\xe3\x33\xc2\xc6\x28\xc2\xfd\xd6\x45\x10\x85\x99\x63\x65\xdf\x53\xf3\x60\xb2\x29\xfb\x36\xd3\xed\x9e\x26\x2d\xd7\xc9\xab\x84\xd3\x4e\xba\x00\x6d\x6c\x9c\x42\x0e\x1d\xc0\x09\x97\x96\x69\x89\xc5\xee\x20\xed\x67\xc9\x44\xd1\x50\xc8\xaf\xd4\x48\x14\xed\x6c\xf6\xf1\xd6\x95\x5c\xba\x2a\x0f\x3e\x9b\xd2\x9b\xa5\xd2\x16\xb5\x37\x20\x44\xb9\x14\x85\xab\x3a\xd1\xca\x18\xbf\xe3\xc8\x49\x1f\x0a\xd2\xc7\x65\x09\x39\x58\x5d\x33\x17\x65\xc9\xb0\xb0\x4b\x44\x96\x8c\x7c\x6d\xb6\x37\x0c\xdd\x22\xbb\xd4\xcc\x2c\x95\x70\xa5\xcc\x61\xee\xe7\x6a\xd9\x9f\xcd\x61\xe2\xe7\x7c\x51\xd6\x58\x3c\xc0\x74\x7f\x39\x8c\xc3\xa4\xc5\xba\x64\xbb\xa7\xc6\x51\xe8\xcb\xbb\x4f\x6f\x33\xaf\xdf\x00\x96\xaf\x98\xaa\x77\xd7\x04\xdf\xf7\x0e\xa9\x13\x33\x26\x99\x6e\x50\x72\x69\xac\x13\x72\xaf\x2b\xcd\xda\x2c\xed\x4c\x69\x65\x15\x51\xc2\x45\x5a\x5a\x5b\x85\x38\xa2\xd8\xda\xc0\xae\xa5\x28\xb6\x36\x0f\x53\x1b\xcb\xc7\xa1\x38\x04\xe3\x18\x0e\xc8\x61\x36\x9b\xee\x41\xf2\x60\x6c\x82\xb5\x31\x02\x11\xa6\x2d\x5f\x70\x82\x6d\x8b\xa7\x1c\xaf\x90\x61\x7a\xcd\x74\x7b\x3e\x11\x85\xff\x9a\x60\x2d\xcf\x96\x8b\x25\x87\x53\x39\x98\x8b\x31\xe2\x6c\x99\x18\x46\x6a\xed\xe4\xab\xd4\xaa\xae\x9c\x52\x5d\xbb\xd3\xb7\x3b\x9c\x90\xc5\xf6\x14\x76\xe7\x38\xbd\xd9\xa8\x87\x79\x40\xd9\xe0\x68\x64\x43\x14\x3b\xaa\xd1\x3f\xec\xbb\x2e\x1f\x5a\x47\x67\xf0\xa4\xc3\x3f\x2c\xb2\x65\xb4\x6d\x2e\x9d\x8e\xd2\xbf\x08\x7d\xd2\x7c\xed\xae\x3f\xbd\x1b\x4d\x74\x8a\xae\x37\x49\x5c\x86\x24\x86\x15\xbd\x9b\x69\x48\x3f\x5c\x51\x7e\x40\x15\xbc\xe3\x13\x8b\xf1\xd9\x1b\xf5\x6b\x61\x4e\x2a\x46\x13\xf9\xf4\x9a\x20\x5d\x0b\x16\x0d\x5d\x77\x37\x57\xc6\xb0\xe2\x78\x79\xe0\x55\xbb\xfb\xf7\x2e\x9d\x17\x03\xc9\x7f\x79\xf7\x09\xac\xc6\x8b\x05\x27\xb0\xd0\x6a\xe5\xca\x70\x69\x4a\xb0\x0a\x5c\xe8\xa8\x7f\x90\x5a\xb7\x97\x1c\xfa\xe9\x24\xce\xac\x3b\xc6\x69\xeb\x82\xd7\xfb\xcb\x21\xe2\xb2\xd4\xcc\x78\x15\xeb\xaa\xc2\xe6\xd9\x6a\x8b\x55\x3d\x65\xe9\x76\xf6\xc1\x02\xf4\x7a\xba\xcb\x78\xd0\xd5\xa9\x8e\xc2\xf6\x76\xd2\xde\x8a\x56\xb7\x1c\x3d\x09\xb8\x4e\x6f\x1e\xa1\x1e\x87\xa9\xd2\x9c\x2a\x77\xf1\xff\x2e\xc2\xb4\xfc\x3c\x85\x36\x9d\x73\x78\x32\x7f\xf6\x0a\x44\x53\xa3\x7f\x8f\x48\xdd\xc2\x7c\x27\x9d\x8e\xba\x7b\x46\xa4\xaa\xe9\x79\x48\x55\xd3\x03\xa4\xfa\xfd\xfd\x7f\x9b\x54\x35\x7d\x3a\xa9\x6a\xba\x8f\x05\x4f\x22\x55\x4d\x9f\x33\xa9\x7c\x8b\xc0\x42\xa0\x66\xb7\x1f\x4d\xad\x01\xd2\xfc\xfa\xe1\xc3\xd1\x06\x46\x59\xc5\x24\x35\x48\xc9\x87\xda\x35\xcf\xf0\x95\x70\xa0\x83\xdd\x3c\xab\x36\x78\x39\x8e\x0e\x13\x23\x3d\x4c\xc3\xf4\x1f\xe7\x41\x43\x4b\xca\x59\xa9\x50\x51\x78\x16\x04\x6e\x30\x8a\x08\x13\xc2\x7c\x0f\x07\x7a\xdd\x28\x84\x03\x1f\x0e\x8a\xc2\x6c\x24\xa4\x7c\x0a\x1f\xfa\xc9\x9f\x4e\x87\xbd\x05\x3c\x67\x3b\x3b\xc0\x88\x71\x96\x8e\x0f\x93\xa2\x59\xf1\x04\x5e\x1c\xd0\xd5\x47\xd2\x43\x62\x7b\x5e\x46\xf4\x54\x41\x62\xdb\x6e\x25\x4f\xe9\x21\x0e\xe4\xff\xe2\x40\xab\xda\x56\xb5\x85\x88\x2c\xd0\xce\x4b\x2a\xe4\x7e\x7a\x85\x1b\x80\x7f\xe3\xdd\xea\x40\x44\x49\x82\xc3\xfb\x34\x26\x8a\x64\xc7\x2c\x79\x95\x38\xc3\x18\xae\xa3\xe8\xe6\x22\x86\xf4\xa2\x1d\xa4\x0f\x04\x71\x7a\x34\xc8\xd1\x6c\x9a\x77\x78\x7b\x43\xe2\xbb\xe6\x77\x3b\xe2\x14\xad\x70\x55\x71\x59\xee\x46\xbd\xe3\xd5\x0a\x57\xfb\x5f\x11\xf6\xde\x90\xc6\xb0\x77\x2d\xa7\x07\x00\xb8\xf7\xb8\x3f\x1e\xc2\xf6\xa5\x72\x0f\x4a\x23\xce\xe7\xd9\x85\x21\x5f\x03\x9b\xf1\xf7\x00\xdd\xc4\x10\x92\xe7\x19\x00\x00")
 
 func templatesIso_segmentsTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -191,12 +191,12 @@ func templatesIso_segmentsTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/iso_segments.tf", size: 6866, mode: os.FileMode(480), modTime: time.Unix(1520375763, 0)}
+	info := bindataFileInfo{name: "templates/iso_segments.tf", size: 6631, mode: os.FileMode(480), modTime: time.Unix(1792204182, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesLb_subnetTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x4d\x6e\xfb\x20\x10\xc5\xf7\x3e\xc5\x08\x65\xf1\xff\x48\x68\xd4\x55\x37\xb9\x42\x2f\x50\x45\x08\xe3\xa9\x83\x4a\x20\x32\x63\xa7\xa9\xe5\xbb\x57\x80\x15\xdb\xb5\xd3\xa6\xc9\xc6\x02\xe6\xf7\xde\x63\x86\x0a\xbd\xab\x2b\x85\xc0\xe4\xd9\x0b\x5f\xe7\x16\x89\x01\x33\x79\xff\xed\x19\xb4\x19\x80\x72\xb5\x25\x18\xff\x76\xc0\x56\xad\x41\x5b\xd2\xe1\x4f\x23\x2b\x2e\x1b\xa9\x8d\xcc\xb5\xd1\x74\x11\x1f\xce\xa2\xff\xdb\xb1\x0c\xa0\x39\x29\xa1\x8b\x79\xa5\x53\xd2\xf0\xb4\x19\xcf\x29\x5d\x54\x22\x37\x4e\xbd\x4d\xce\x85\xe5\xe4\x24\xaa\x84\x82\xb0\xb4\x86\xa7\x75\x32\xc5\xb5\x2d\xf0\xfd\xff\x63\x52\x9b\xb9\x48\x14\x34\x78\x44\x4b\x37\x8c\x4e\x48\x81\x93\x01\x90\x2c\x7d\x4c\x0e\xf0\x2c\x8f\x3d\x26\x94\xa3\x6d\x82\xe5\x8d\xc9\x37\xc9\xd7\xaa\x1d\x55\x47\x13\x5d\x00\x18\xfd\x8a\xea\xa2\x0c\xf6\x14\x5d\x5a\x57\xa1\x50\x07\x69\x4b\xf4\xb0\x83\x17\x36\x44\x66\x6b\x60\x33\x5f\x6c\x1f\x59\x5d\x96\x4d\x9b\x54\xb9\x9a\x50\x90\xcc\x0d\xa6\x4e\x4d\x16\xda\xe1\xce\x97\x2e\x7a\x99\x76\x83\x53\xa0\x27\x6d\x25\x69\x67\xc5\xa8\x3f\x3b\x60\x5b\x1e\xff\x0f\xdb\x90\xb7\x94\x84\x67\x79\xf9\xd2\xe6\x24\x1f\x24\xb4\x25\xac\x2c\x92\xe8\x0f\x72\x5d\xf2\xbe\xeb\x23\xc9\x71\xf9\xb5\x74\xb4\xcf\xa7\x0e\xf9\x37\x71\x7a\xa0\xf4\xde\x29\x1d\xed\x33\x60\x69\xe7\x87\xc1\xbe\x77\xaa\x13\xe3\x6a\x79\x32\x64\xc3\x43\xe2\x83\x1a\xff\xc7\x75\x31\x1b\xb4\xd9\x05\xfc\x26\xb8\xab\xe9\x54\xd3\xe8\xad\x0a\x5d\xf4\xa9\x1a\x69\x6a\x8c\x33\x96\x68\xcb\x76\x3a\xb6\x5f\xe6\xcc\x53\xdf\x8f\x9d\xd5\xde\x54\x89\x4f\xfb\x7e\xf0\x30\x80\x89\xf8\x19\x00\x00\xff\xff\x0b\x56\xd0\x1c\xba\x04\x00\x00")
+var _templatesLb_subnetTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\x4d\x8e\xdc\x20\x10\x85\xf7\x9c\xa2\x84\x66\x91\x49\x7a\xc8\x28\xab\x6c\x7c\x85\x5c\x20\x8a\x10\x86\x8a\xa7\x14\x06\x5a\x80\xdd\xe9\x58\xbe\x7b\x84\xb1\xe2\xdf\x56\xdc\xbd\xb1\x80\xfa\xea\x3d\xea\x11\x30\xfa\x36\x68\x04\xae\x6e\x51\xc6\xb6\x76\x98\x38\x70\x5b\x4f\xdf\x91\x43\xcf\x00\xb4\x6f\x5d\x82\xe5\xaf\x02\x8b\xae\x49\x6f\x1f\x3a\x15\x84\xea\x14\x59\x55\x93\xa5\x74\x97\x7f\xbc\xc3\xf8\xcc\x00\xba\xab\x96\x64\x36\x45\x5e\x2b\x2b\xca\x4e\xe6\x92\x09\xb2\xb6\x5e\xff\x9a\x8f\x68\x32\xa1\x34\x1f\xd9\xf9\x6c\x5e\xba\xc0\xd7\x4b\xd1\x21\xc8\x19\xfc\xfd\xe9\x4b\xee\xb1\xeb\x0c\x15\xa0\xc5\x77\x74\xe9\x81\xb2\x15\xe4\x99\x31\x80\xa4\x9a\x08\xd5\xe8\x13\xe0\x9b\x7a\x47\xa8\x80\x3f\xf5\xb9\x1c\x5d\x27\xc9\x0c\x2f\xb6\x7e\x29\x92\x9e\xfa\x45\xf5\xc0\x19\xc0\x90\x11\x96\x7e\xa2\xbe\x6b\x8b\x13\x85\x1a\xe7\x03\x4a\xfd\xa6\x5c\x83\x19\xfe\x7d\x76\x7a\xd9\x8b\xfe\x31\x72\x06\xc6\xd6\xe3\x08\xbe\x4d\x28\x93\xaa\x2d\x96\x99\xac\x16\xfa\xf9\x8a\x37\xf7\x7a\x0c\x7a\x80\x30\x18\x13\x39\x95\xc8\x3b\xb9\x18\x47\x05\xfc\x55\x8c\xff\xcf\xaf\xd9\x66\xa3\x12\xde\xd4\x7d\x33\x50\xa8\x20\x0b\x25\x97\x30\x38\x4c\x72\x3a\x25\xa8\x11\xe3\x7c\x17\xdd\x96\x95\xa5\x6a\xb1\x29\xd6\xca\xc4\x43\x13\x13\x4b\xc5\xe8\x35\x8d\xa2\x39\xf0\x02\xfa\x4f\x66\x4f\x04\xb6\x8c\xf8\x9f\xd0\x39\x49\xf3\xdb\x10\x73\x17\xf1\x51\x90\xd9\xa4\x69\xe7\xf8\xa4\x53\xdf\xa6\x6b\x9b\x16\xef\x4e\x92\x99\x6c\x74\xca\xb6\x38\xdd\xd8\xb1\x86\x63\xc0\xde\xe2\x39\xde\xae\xee\x18\x9f\xa3\x72\x92\x38\xa7\x8a\x0d\xec\xef\x00\xfe\x60\x22\x75\x71\x04\x00\x00")
 
 func templatesLb_subnetTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -211,12 +211,12 @@ func templatesLb_subnetTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/lb_subnet.tf", size: 1210, mode: os.FileMode(480), modTime: time.Unix(1520375763, 0)}
+	info := bindataFileInfo{name: "templates/lb_subnet.tf", size: 1137, mode: os.FileMode(480), modTime: time.Unix(1792204179, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesSsl_certificateTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x8f\x41\x6e\xc3\x20\x14\x44\xf7\x9c\x62\x84\xba\xee\x0d\x72\x16\x84\xf1\xb8\xf9\x2a\x31\xd1\x87\xd0\xa2\x88\xbb\x57\xc6\x1b\xa7\x92\x37\x61\x83\x04\xf3\x46\x6f\xaa\x57\xf1\x53\x24\x6c\xce\xd1\x05\x6a\x91\x45\x82\x2f\xb4\x78\x1a\xa0\xb4\x3b\x71\x81\xcd\x45\x65\xfd\xb2\xa6\x1b\x73\x4a\xb8\x70\xf5\xb2\xbe\xc1\xdd\x55\xea\x76\x7f\xb3\x9d\xd2\xca\x9c\x1e\x1a\x08\xeb\x7f\xb2\x13\x7f\x73\x99\x5a\xa9\xaf\xca\x36\x4e\xe3\x61\xaf\x59\xfd\x6d\x2b\xe7\x22\xbf\x5b\xdb\xc7\xb3\x7a\xfd\xcc\xd7\xa4\xc5\x71\xad\x4e\xe6\x6e\x8d\x01\x8e\x2a\x53\x9a\x1b\x0e\xe1\x57\xd3\x6e\xff\xc5\xc7\xe2\xd3\xf8\xfe\x3d\xa0\xc3\x44\xec\xe7\x14\x3a\x44\x77\xbf\x28\x0b\x43\x0b\x91\x63\x14\x10\x94\x43\x95\x4b\x52\xba\x99\xb9\x68\x6a\xb8\xa0\xe8\x83\x06\xe8\xa6\x9b\xbf\x00\x00\x00\xff\xff\x4f\x95\x65\x5c\xd6\x01\x00\x00")
+var _templatesSsl_certificateTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8f\x41\x6e\xec\x20\x10\x44\xf7\x7d\x8a\x12\x07\xf8\x37\xf0\x59\x10\xc6\xe5\x3f\xad\x30\xc6\x6a\x18\x12\x14\xf9\xee\x91\x6d\x45\x72\xa2\x99\x45\x58\xd2\xaf\x4a\xf5\x5a\x30\x0d\x63\x22\x5c\x29\xc9\x47\x5a\xd5\x59\x63\xa8\x74\xf8\x14\xa0\xf6\x95\x18\x50\xaa\xe9\xf2\x5f\x36\x91\x97\xbc\x8f\xb7\xa0\xcb\x9f\x53\xab\x69\xdb\xd3\x6f\xec\x2f\xb2\xc6\x92\x1f\x16\x09\x17\xde\x8b\xd7\x70\xf7\x85\xd6\x68\xd7\x1a\x07\x97\xc6\xe3\xe3\x2c\x59\xc2\x9d\x7e\x35\xce\xfa\x81\x01\x2d\xd8\xbf\x72\xcb\x56\x3d\x97\xe6\x75\x12\x01\xae\x1b\xc6\x3c\x75\x7c\x73\x3f\xf7\xfd\x22\x0f\xc7\xe7\xe4\xe9\x2f\xc0\xc5\x08\xe7\x7b\xce\x5f\xb8\x7d\x50\xd2\x99\xb1\xc7\xc4\x43\x00\x88\xc6\xfd\x3a\x72\xce\x46\x3f\xb1\x54\xcb\x1d\x03\xaa\x3d\x28\xc0\x26\x9b\x7c\x0d\x00\x63\x9b\x0e\xe4\xbc\x01\x00\x00")
 
 func templatesSsl_certificateTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -231,12 +231,12 @@ func templatesSsl_certificateTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/ssl_certificate.tf", size: 470, mode: os.FileMode(480), modTime: time.Unix(1520375736, 0)}
+	info := bindataFileInfo{name: "templates/ssl_certificate.tf", size: 444, mode: os.FileMode(480), modTime: time.Unix(1792204179, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesVpcTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xd1\x6a\xe3\x30\x10\x45\xdf\xf5\x15\x17\xb1\x0f\xc9\xb2\x6b\xb2\xaf\x81\x6c\xff\xa0\xfd\x04\xa1\x48\x53\x67\x5a\x65\x64\x24\xd9\x6d\x08\xfe\xf7\x22\xd9\x69\x21\xf4\xa1\x03\x36\x66\xe6\x0e\xf7\x8c\xef\x64\x13\xdb\x63\x20\x68\x7a\xe7\x5c\x58\x7a\x33\x0d\xce\xb0\xd7\xb8\x2a\xa0\x5c\x06\xc2\x5a\x07\xe8\x5c\x12\x4b\xaf\x15\xe0\xe9\xd9\x8e\xa1\xdc\x06\x4b\x2b\xbb\xc4\x43\xe1\x28\xb5\xf5\xd4\xbe\x6c\x08\x17\x8c\x99\x60\x05\x37\x07\x4c\x83\xd3\x6a\x56\x2a\x44\x67\x43\x6e\x46\xd5\xd4\xc5\x51\x4a\x5d\xfd\x75\x0d\x24\x7d\x39\x6d\x26\x9b\xba\x3b\xae\x2d\xfe\x63\x87\x07\xec\xb0\xc7\xbf\x59\xaf\xab\xec\x57\x90\x9f\xac\x7e\x33\xc2\x1e\x2f\x91\x65\xa3\xa1\xff\xc0\xbe\xe5\xda\xee\xea\xf3\xbb\x63\xbf\x9d\x1b\x6d\xa2\x1c\xc7\xe4\x08\x7a\x15\x68\xe8\xf6\xae\xfc\x0b\xfb\x5d\x2d\x3c\xf5\xc8\xee\xf3\xbe\x86\xec\xd8\x27\x73\x0c\xd1\xbd\xde\xab\x2b\x5b\xd3\xb2\x4f\x4d\xca\x92\x8b\x15\x47\xa6\x90\x58\x71\x97\x9b\x74\x0d\xa0\x4a\x48\x6a\x82\xc6\x4b\x36\xa7\x98\x8b\xd8\x33\x65\x1c\x50\xd2\x48\xaa\x66\x68\xfb\xe5\x1f\x03\x8f\xf6\x4c\x5f\x3e\x24\x93\x61\x3f\xff\x6d\x71\x00\xb3\x9a\xd5\x47\x00\x00\x00\xff\xff\xe1\xdc\x0f\xba\x0f\x02\x00\x00")
+var _templatesVpcTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xd1\x6a\xeb\x30\x0c\x86\xef\xfd\x14\x3f\xe6\x5c\xb4\x87\x73\x42\x77\x5b\xc8\xf6\x06\xdb\x23\x04\xd7\xd6\x52\x6d\xae\x12\x6c\x27\x5b\x29\x79\xf7\x21\x37\xdd\x45\x19\xcc\x90\x90\xfc\xfa\x65\x7d\x92\x66\x97\xd8\x1d\x22\xc1\xd2\x27\xe7\xc2\xd2\x77\xf3\xe8\x3b\x0e\x16\x17\x03\x94\xf3\x48\x58\x4f\x8b\x5c\x12\x4b\x6f\x80\x40\xaf\x6e\x8a\x65\x95\xad\xad\x52\xf6\x89\xc7\xc2\x83\xa8\xf4\x52\xbf\x5c\x8c\x67\x4c\x99\xe0\x04\xb7\xfb\x31\x8f\xde\x9a\xc5\x98\x38\x78\x17\x73\x2d\xa3\x25\xfd\x30\x49\x41\x8b\x48\xd2\x97\xe3\x66\x76\xa9\xb9\x43\xda\xe2\x11\x3b\x3c\x61\x87\x3d\x1e\xd6\x2c\x0e\x57\x86\xdf\xb3\x7e\x08\x61\x8f\xb7\x81\x65\x63\x61\xff\xc1\x7d\x64\x95\x1b\x7d\xfe\x36\x1c\xb6\xca\x98\x28\x0f\x53\xf2\x04\xbb\x86\x2d\x6c\x7d\x2b\xf5\x95\xf8\xee\xb4\xa8\x7d\x35\xdf\x2d\xa9\x91\x43\xea\x0e\x71\xf0\xef\x37\x57\x35\x2a\x51\xb5\x71\x48\x06\x60\xc9\xc5\x89\xa7\xae\x90\x38\xf1\xe7\xd5\x65\xd7\x61\xeb\x90\x49\x74\x57\x5d\x90\xdc\x1d\x87\x5c\xc4\x9d\x28\xa3\x45\x49\x13\x19\xdd\x96\xeb\xf5\x57\xd9\x80\x67\x77\x22\x4d\xff\x73\xd1\x3a\x24\x73\xc7\x61\xf9\x3f\x8f\xde\x1a\x60\x31\x8b\xf9\x1a\x00\x38\xa8\x05\x16\xfb\x01\x00\x00")
 
 func templatesVpcTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -251,7 +251,7 @@ func templatesVpcTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/vpc.tf", size: 527, mode: os.FileMode(480), modTime: time.Unix(1520375763, 0)}
+	info := bindataFileInfo{name: "templates/vpc.tf", size: 507, mode: os.FileMode(480), modTime: time.Unix(1792204179, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
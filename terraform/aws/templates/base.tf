@@ -1,5 +1,5 @@
 variable "nat_ami_map" {
-  type = "map"
+  type = map(string)
 
   default = {
     ap-northeast-1 = "ami-10dfc877"
@@ -20,15 +20,15 @@ variable "nat_ami_map" {
 }
 
 variable "access_key" {
-  type = "string"
+  type = string
 }
 
 variable "secret_key" {
-  type = "string"
+  type = string
 }
 
 variable "region" {
-  type = "string"
+  type = string
 }
 
 variable "bosh_inbound_cidr" {
@@ -36,24 +36,24 @@ variable "bosh_inbound_cidr" {
 }
 
 variable "availability_zones" {
-  type = "list"
+  type = list(string)
 }
 
 variable "env_id" {
-  type = "string"
+  type = string
 }
 
 variable "short_env_id" {
-  type = "string"
+  type = string
 }
 
 variable "vpc_cidr" {
-  type    = "string"
+  type    = string
   default = "10.0.0.0/16"
 }
 
 resource "aws_eip" "jumpbox_eip" {
-  depends_on = ["aws_internet_gateway.ig"]
+  depends_on = [aws_internet_gateway.ig]
   vpc        = true
 }
 
@@ -64,25 +64,25 @@ resource "tls_private_key" "bosh_vms" {
 
 resource "aws_key_pair" "bosh_vms" {
   key_name   = "${var.env_id}_bosh_vms"
-  public_key = "${tls_private_key.bosh_vms.public_key_openssh}"
+  public_key = tls_private_key.bosh_vms.public_key_openssh
 }
 
 resource "aws_security_group" "nat_security_group" {
   name        = "${var.env_id}-nat-security-group"
   description = "NAT"
-  vpc_id      = "${local.vpc_id}"
+  vpc_id      = local.vpc_id
 
-  tags {
+  tags = {
     Name = "${var.env_id}-nat-security-group"
   }
 
   lifecycle {
-    ignore_changes = ["name"]
+    ignore_changes = [name]
   }
 }
 
 resource "aws_security_group_rule" "nat_to_internet_rule" {
-  security_group_id = "${aws_security_group.nat_security_group.id}"
+  security_group_id = aws_security_group.nat_security_group.id
 
   type        = "egress"
   from_port   = 0
@@ -92,7 +92,7 @@ resource "aws_security_group_rule" "nat_to_internet_rule" {
 }
 
 resource "aws_security_group_rule" "nat_icmp_rule" {
-  security_group_id = "${aws_security_group.nat_security_group.id}"
+  security_group_id = aws_security_group.nat_security_group.id
 
   type        = "ingress"
   protocol    = "icmp"
@@ -102,71 +102,71 @@ resource "aws_security_group_rule" "nat_icmp_rule" {
 }
 
 resource "aws_security_group_rule" "nat_tcp_rule" {
-  security_group_id = "${aws_security_group.nat_security_group.id}"
+  security_group_id = aws_security_group.nat_security_group.id
 
   type                     = "ingress"
   protocol                 = "tcp"
   from_port                = 0
   to_port                  = 65535
-  source_security_group_id = "${aws_security_group.internal_security_group.id}"
+  source_security_group_id = aws_security_group.internal_security_group.id
 }
 
 resource "aws_security_group_rule" "nat_udp_rule" {
-  security_group_id = "${aws_security_group.nat_security_group.id}"
+  security_group_id = aws_security_group.nat_security_group.id
 
   type                     = "ingress"
   protocol                 = "udp"
   from_port                = 0
   to_port                  = 65535
-  source_security_group_id = "${aws_security_group.internal_security_group.id}"
+  source_security_group_id = aws_security_group.internal_security_group.id
 }
 
 resource "aws_instance" "nat" {
-  private_ip             = "${cidrhost(aws_subnet.bosh_subnet.cidr_block, 7)}"
+  private_ip             = cidrhost(aws_subnet.bosh_subnet.cidr_block, 7)
   instance_type          = "t2.medium"
-  subnet_id              = "${aws_subnet.bosh_subnet.id}"
+  subnet_id              = aws_subnet.bosh_subnet.id
   source_dest_check      = false
-  ami                    = "${lookup(var.nat_ami_map, var.region)}"
-  vpc_security_group_ids = ["${aws_security_group.nat_security_group.id}"]
+  ami                    = lookup(var.nat_ami_map, var.region)
+  vpc_security_group_ids = [aws_security_group.nat_security_group.id]
 
-  tags {
+  tags = {
     Name  = "${var.env_id}-nat"
-    EnvID = "${var.env_id}"
+    EnvID = var.env_id
   }
 }
 
 resource "aws_eip" "nat_eip" {
-  depends_on = ["aws_internet_gateway.ig"]
-  instance   = "${aws_instance.nat.id}"
+  depends_on = [aws_internet_gateway.ig]
+  instance   = aws_instance.nat.id
   vpc        = true
 }
 
 provider "aws" {
-  access_key = "${var.access_key}"
-  secret_key = "${var.secret_key}"
-  region     = "${var.region}"
+  access_key = var.access_key
+  secret_key = var.secret_key
+  region     = var.region
 }
 
 resource "aws_default_security_group" "default_security_group" {
-  vpc_id = "${local.vpc_id}"
+  vpc_id = local.vpc_id
 }
 
 resource "aws_security_group" "internal_security_group" {
   name        = "${var.env_id}-internal-security-group"
   description = "Internal"
-  vpc_id      = "${local.vpc_id}"
+  vpc_id      = local.vpc_id
 
-  tags {
+  tags = {
     Name = "${var.env_id}-internal-security-group"
   }
 
   lifecycle {
-    ignore_changes = ["name"]
+    ignore_changes = [name]
   }
 }
 
 resource "aws_security_group_rule" "internal_security_group_rule_tcp" {
-  security_group_id = "${aws_security_group.internal_security_group.id}"
+  security_group_id = aws_security_group.internal_security_group.id
   type              = "ingress"
   protocol          = "tcp"
   from_port         = 0
@@ -175,7 +175,7 @@ resource "aws_security_group_rule" "internal_security_group_rule_tcp" {
 }
 
 resource "aws_security_group_rule" "internal_security_group_rule_udp" {
-  security_group_id = "${aws_security_group.internal_security_group.id}"
+  security_group_id = aws_security_group.internal_security_group.id
   type              = "ingress"
   protocol          = "udp"
   from_port         = 0
@@ -184,7 +184,7 @@ resource "aws_security_group_rule" "internal_security_group_rule_udp" {
 }
 
 resource "aws_security_group_rule" "internal_security_group_rule_icmp" {
-  security_group_id = "${aws_security_group.internal_security_group.id}"
+  security_group_id = aws_security_group.internal_security_group.id
   type              = "ingress"
   protocol          = "icmp"
   from_port         = -1
@@ -193,7 +193,7 @@ resource "aws_security_group_rule" "internal_security_group_rule_icmp" {
 }
 
 resource "aws_security_group_rule" "internal_security_group_rule_allow_internet" {
-  security_group_id = "${aws_security_group.internal_security_group.id}"
+  security_group_id = aws_security_group.internal_security_group.id
   type              = "egress"
   protocol          = "-1"
   from_port         = 0
@@ -202,93 +202,93 @@ resource "aws_security_group_rule" "internal_security_group_rule_allow_internet"
 }
 
 resource "aws_security_group_rule" "internal_security_group_rule_ssh" {
-  security_group_id        = "${aws_security_group.internal_security_group.id}"
+  security_group_id        = aws_security_group.internal_security_group.id
   type                     = "ingress"
   protocol                 = "TCP"
   from_port                = 22
   to_port                  = 22
-  source_security_group_id = "${aws_security_group.jumpbox.id}"
+  source_security_group_id = aws_security_group.jumpbox.id
 }
 
 resource "aws_security_group" "bosh_security_group" {
   name        = "${var.env_id}-bosh-security-group"
   description = "BOSH Director"
-  vpc_id      = "${local.vpc_id}"
+  vpc_id      = local.vpc_id
 
-  tags {
+  tags = {
     Name = "${var.env_id}-bosh-security-group"
   }
 
   lifecycle {
-    ignore_changes = ["name", "description"]
+    ignore_changes = [name, description]
   }
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_tcp_ssh" {
-  security_group_id = "${aws_security_group.bosh_security_group.id}"
+  security_group_id = aws_security_group.bosh_security_group.id
   type              = "ingress"
   protocol          = "tcp"
   from_port         = 22
   to_port           = 22
-  cidr_blocks       = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks       = [var.bosh_inbound_cidr]
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_tcp_bosh_agent" {
-  security_group_id        = "${aws_security_group.bosh_security_group.id}"
+  security_group_id        = aws_security_group.bosh_security_group.id
   type                     = "ingress"
   protocol                 = "tcp"
   from_port                = 6868
   to_port                  = 6868
-  source_security_group_id = "${aws_security_group.jumpbox.id}"
+  source_security_group_id = aws_security_group.jumpbox.id
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_uaa" {
-  security_group_id        = "${aws_security_group.bosh_security_group.id}"
+  security_group_id        = aws_security_group.bosh_security_group.id
   type                     = "ingress"
   protocol                 = "tcp"
   from_port                = 8443
   to_port                  = 8443
-  source_security_group_id = "${aws_security_group.jumpbox.id}"
+  source_security_group_id = aws_security_group.jumpbox.id
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_credhub" {
-  security_group_id        = "${aws_security_group.bosh_security_group.id}"
+  security_group_id        = aws_security_group.bosh_security_group.id
   type                     = "ingress"
   protocol                 = "tcp"
   from_port                = 8844
   to_port                  = 8844
-  source_security_group_id = "${aws_security_group.jumpbox.id}"
+  source_security_group_id = aws_security_group.jumpbox.id
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_tcp_director_api" {
-  security_group_id        = "${aws_security_group.bosh_security_group.id}"
+  security_group_id        = aws_security_group.bosh_security_group.id
   type                     = "ingress"
   protocol                 = "tcp"
   from_port                = 25555
   to_port                  = 25555
-  source_security_group_id = "${aws_security_group.jumpbox.id}"
+  source_security_group_id = aws_security_group.jumpbox.id
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_tcp" {
-  security_group_id        = "${aws_security_group.bosh_security_group.id}"
+  security_group_id        = aws_security_group.bosh_security_group.id
   type                     = "ingress"
   protocol                 = "tcp"
   from_port                = 0
   to_port                  = 65535
-  source_security_group_id = "${aws_security_group.internal_security_group.id}"
+  source_security_group_id = aws_security_group.internal_security_group.id
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_udp" {
-  security_group_id        = "${aws_security_group.bosh_security_group.id}"
+  security_group_id        = aws_security_group.bosh_security_group.id
   type                     = "ingress"
   protocol                 = "udp"
   from_port                = 0
   to_port                  = 65535
-  source_security_group_id = "${aws_security_group.internal_security_group.id}"
+  source_security_group_id = aws_security_group.internal_security_group.id
 }
 
 resource "aws_security_group_rule" "bosh_security_group_rule_allow_internet" {
-  security_group_id = "${aws_security_group.bosh_security_group.id}"
+  security_group_id = aws_security_group.bosh_security_group.id
   type              = "egress"
   protocol          = "-1"
   from_port         = 0
@@ -299,55 +299,55 @@ resource "aws_security_group_rule" "bosh_security_group_rule_allow_internet" {
 resource "aws_security_group" "jumpbox" {
   name        = "${var.env_id}-jumpbox-security-group"
   description = "Jumpbox"
-  vpc_id      = "${local.vpc_id}"
+  vpc_id      = local.vpc_id
 
-  tags {
+  tags = {
     Name = "${var.env_id}-jumpbox-security-group"
   }
 
   lifecycle {
-    ignore_changes = ["name", "description"]
+    ignore_changes = [name, description]
   }
 }
 
 resource "aws_security_group_rule" "jumpbox_ssh" {
-  security_group_id = "${aws_security_group.jumpbox.id}"
+  security_group_id = aws_security_group.jumpbox.id
   type              = "ingress"
   protocol          = "tcp"
   from_port         = 22
   to_port           = 22
-  cidr_blocks       = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks       = [var.bosh_inbound_cidr]
 }
 
 resource "aws_security_group_rule" "jumpbox_rdp" {
-  security_group_id = "${aws_security_group.jumpbox.id}"
+  security_group_id = aws_security_group.jumpbox.id
   type              = "ingress"
   protocol          = "tcp"
   from_port         = 3389
   to_port           = 3389
-  cidr_blocks       = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks       = [var.bosh_inbound_cidr]
 }
 
 resource "aws_security_group_rule" "jumpbox_agent" {
-  security_group_id = "${aws_security_group.jumpbox.id}"
+  security_group_id = aws_security_group.jumpbox.id
   type              = "ingress"
   protocol          = "tcp"
   from_port         = 6868
   to_port           = 6868
-  cidr_blocks       = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks       = [var.bosh_inbound_cidr]
 }
 
 resource "aws_security_group_rule" "jumpbox_director" {
-  security_group_id = "${aws_security_group.jumpbox.id}"
+  security_group_id = aws_security_group.jumpbox.id
   type              = "ingress"
   protocol          = "tcp"
   from_port         = 25555
   to_port           = 25555
-  cidr_blocks       = ["${var.bosh_inbound_cidr}"]
+  cidr_blocks       = [var.bosh_inbound_cidr]
 }
 
 resource "aws_security_group_rule" "jumpbox_egress" {
-  security_group_id = "${aws_security_group.jumpbox.id}"
+  security_group_id = aws_security_group.jumpbox.id
   type              = "egress"
   protocol          = "-1"
   from_port         = 0
@@ -356,88 +356,88 @@ resource "aws_security_group_rule" "jumpbox_egress" {
 }
 
 resource "aws_security_group_rule" "bosh_internal_security_rule_tcp" {
-  security_group_id        = "${aws_security_group.internal_security_group.id}"
+  security_group_id        = aws_security_group.internal_security_group.id
   type                     = "ingress"
   protocol                 = "tcp"
   from_port                = 0
   to_port                  = 65535
-  source_security_group_id = "${aws_security_group.bosh_security_group.id}"
+  source_security_group_id = aws_security_group.bosh_security_group.id
 }
 
 resource "aws_security_group_rule" "bosh_internal_security_rule_udp" {
-  security_group_id        = "${aws_security_group.internal_security_group.id}"
+  security_group_id        = aws_security_group.internal_security_group.id
   type                     = "ingress"
   protocol                 = "udp"
   from_port                = 0
   to_port                  = 65535
-  source_security_group_id = "${aws_security_group.bosh_security_group.id}"
+  source_security_group_id = aws_security_group.bosh_security_group.id
 }
 
 resource "aws_subnet" "bosh_subnet" {
-  vpc_id     = "${local.vpc_id}"
-  cidr_block = "${cidrsubnet(var.vpc_cidr, 8, 0)}"
+  vpc_id     = local.vpc_id
+  cidr_block = cidrsubnet(var.vpc_cidr, 8, 0)
 
-  tags {
+  tags = {
     Name = "${var.env_id}-bosh-subnet"
   }
 }
 
 resource "aws_route_table" "bosh_route_table" {
-  vpc_id = "${local.vpc_id}"
+  vpc_id = local.vpc_id
 }
 
 resource "aws_route" "bosh_route_table" {
   destination_cidr_block = "0.0.0.0/0"
-  gateway_id             = "${aws_internet_gateway.ig.id}"
-  route_table_id         = "${aws_route_table.bosh_route_table.id}"
+  gateway_id             = aws_internet_gateway.ig.id
+  route_table_id         = aws_route_table.bosh_route_table.id
 }
 
 resource "aws_route_table_association" "route_bosh_subnets" {
-  subnet_id      = "${aws_subnet.bosh_subnet.id}"
-  route_table_id = "${aws_route_table.bosh_route_table.id}"
+  subnet_id      = aws_subnet.bosh_subnet.id
+  route_table_id = aws_route_table.bosh_route_table.id
 }
 
 resource "aws_subnet" "internal_subnets" {
-  count             = "${length(var.availability_zones)}"
-  vpc_id            = "${local.vpc_id}"
-  cidr_block        = "${cidrsubnet(var.vpc_cidr, 4, count.index+1)}"
-  availability_zone = "${element(var.availability_zones, count.index)}"
+  count             = length(var.availability_zones)
+  vpc_id            = local.vpc_id
+  cidr_block        = cidrsubnet(var.vpc_cidr, 4, count.index+1)
+  availability_zone = element(var.availability_zones, count.index)
 
-  tags {
+  tags = {
     Name = "${var.env_id}-internal-subnet${count.index}"
   }
 
   lifecycle {
-    ignore_changes = ["cidr_block", "availability_zone"]
+    ignore_changes = [cidr_block, availability_zone]
   }
 }
 
 resource "aws_route_table" "internal_route_table" {
-  vpc_id = "${local.vpc_id}"
+  vpc_id = local.vpc_id
 }
 
 resource "aws_route" "internal_route_table" {
   destination_cidr_block = "0.0.0.0/0"
-  instance_id            = "${aws_instance.nat.id}"
-  route_table_id         = "${aws_route_table.internal_route_table.id}"
+  instance_id            = aws_instance.nat.id
+  route_table_id         = aws_route_table.internal_route_table.id
 }
 
 resource "aws_route_table_association" "route_internal_subnets" {
-  count          = "${length(var.availability_zones)}"
-  subnet_id      = "${element(aws_subnet.internal_subnets.*.id, count.index)}"
-  route_table_id = "${aws_route_table.internal_route_table.id}"
+  count          = length(var.availability_zones)
+  subnet_id      = element(aws_subnet.internal_subnets.*.id, count.index)
+  route_table_id = aws_route_table.internal_route_table.id
 }
 
 resource "aws_internet_gateway" "ig" {
-  vpc_id = "${local.vpc_id}"
+  vpc_id = local.vpc_id
 }
 
 locals {
   director_name        = "bosh-${var.env_id}"
-  internal_cidr        = "${aws_subnet.bosh_subnet.cidr_block}"
-  internal_gw          = "${cidrhost(local.internal_cidr, 1)}"
-  jumpbox_internal_ip  = "${cidrhost(local.internal_cidr, 5)}"
-  director_internal_ip = "${cidrhost(local.internal_cidr, 6)}"
+  internal_cidr        = aws_subnet.bosh_subnet.cidr_block
+  internal_gw          = cidrhost(local.internal_cidr, 1)
+  jumpbox_internal_ip  = cidrhost(local.internal_cidr, 5)
+  director_internal_ip = cidrhost(local.internal_cidr, 6)
 }
 
 resource "aws_kms_key" "kms_key" {
@@ -445,16 +445,16 @@ resource "aws_kms_key" "kms_key" {
 }
 
 output "default_key_name" {
-  value = "${aws_key_pair.bosh_vms.key_name}"
+  value = aws_key_pair.bosh_vms.key_name
 }
 
 output "private_key" {
-  value     = "${tls_private_key.bosh_vms.private_key_pem}"
+  value     = tls_private_key.bosh_vms.private_key_pem
   sensitive = true
 }
 
 output "external_ip" {
-  value = "${aws_eip.jumpbox_eip.public_ip}"
+  value = aws_eip.jumpbox_eip.public_ip
 }
 
 output "jumpbox_url" {
@@ -466,77 +466,73 @@ output "director_address" {
 }
 
 output "nat_eip" {
-  value = "${aws_eip.nat_eip.public_ip}"
+  value = aws_eip.nat_eip.public_ip
 }
 
 output "internal_security_group" {
-  value = "${aws_security_group.internal_security_group.id}"
+  value = aws_security_group.internal_security_group.id
 }
 
 output "bosh_security_group" {
-  value = "${aws_security_group.bosh_security_group.id}"
+  value = aws_security_group.bosh_security_group.id
 }
 
 output "jumpbox_security_group" {
-  value = "${aws_security_group.jumpbox.id}"
+  value = aws_security_group.jumpbox.id
 }
 
 output "jumpbox__default_security_groups" {
-  value = ["${aws_security_group.jumpbox.id}"]
+  value = [aws_security_group.jumpbox.id]
 }
 
 output "director__default_security_groups" {
-  value = ["${aws_security_group.bosh_security_group.id}"]
+  value = [aws_security_group.bosh_security_group.id]
 }
 
 output "subnet_id" {
-  value = "${aws_subnet.bosh_subnet.id}"
+  value = aws_subnet.bosh_subnet.id
 }
 
 output "az" {
-  value = "${aws_subnet.bosh_subnet.availability_zone}"
+  value = aws_subnet.bosh_subnet.availability_zone
 }
 
 output "vpc_id" {
-  value = "${local.vpc_id}"
+  value = local.vpc_id
 }
 
 output "region" {
-  value = "${var.region}"
+  value = var.region
 }
 
 output "kms_key_arn" {
-  value = "${aws_kms_key.kms_key.arn}"
+  value = aws_kms_key.kms_key.arn
 }
 
 output "internal_az_subnet_id_mapping" {
-  value = "${
-	  zipmap("${aws_subnet.internal_subnets.*.availability_zone}", "${aws_subnet.internal_subnets.*.id}")
-	}"
+  value = zipmap(aws_subnet.internal_subnets.*.availability_zone, aws_subnet.internal_subnets.*.id)
 }
 
 output "internal_az_subnet_cidr_mapping" {
-  value = "${
-	  zipmap("${aws_subnet.internal_subnets.*.availability_zone}", "${aws_subnet.internal_subnets.*.cidr_block}")
-	}"
+  value = zipmap(aws_subnet.internal_subnets.*.availability_zone, aws_subnet.internal_subnets.*.cidr_block)
 }
 
 output "director_name" {
-  value = "${local.director_name}"
+  value = local.director_name
 }
 
 output "internal_cidr" {
-  value = "${local.internal_cidr}"
+  value = local.internal_cidr
 }
 
 output "internal_gw" {
-  value = "${local.internal_gw}"
+  value = local.internal_gw
 }
 
 output "jumpbox__internal_ip" {
-  value = "${local.jumpbox_internal_ip}"
+  value = local.jumpbox_internal_ip
 }
 
 output "director__internal_ip" {
-  value = "${local.director_internal_ip}"
+  value = local.director_internal_ip
 }
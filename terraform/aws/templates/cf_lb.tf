@@ -1,7 +1,7 @@
 resource "aws_security_group" "cf_ssh_lb_security_group" {
   name        = "${var.env_id}-cf-ssh-lb-security-group"
   description = "CF SSH"
-  vpc_id      = "${local.vpc_id}"
+  vpc_id      = local.vpc_id
 
   ingress {
     cidr_blocks = ["0.0.0.0/0"]
@@ -17,26 +17,26 @@ resource "aws_security_group" "cf_ssh_lb_security_group" {
     cidr_blocks = ["0.0.0.0/0"]
   }
 
-  tags {
+  tags = {
     Name = "${var.env_id}-cf-ssh-lb-security-group"
   }
 
   lifecycle {
-    ignore_changes = ["name"]
+    ignore_changes = [name]
   }
 }
 
 output "cf_ssh_lb_security_group" {
-  value = "${aws_security_group.cf_ssh_lb_security_group.id}"
+  value = aws_security_group.cf_ssh_lb_security_group.id
 }
 
 resource "aws_security_group" "cf_ssh_lb_internal_security_group" {
   name        = "${var.env_id}-cf-ssh-lb-internal-security-group"
   description = "CF SSH Internal"
-  vpc_id      = "${local.vpc_id}"
+  vpc_id      = local.vpc_id
 
   ingress {
-    security_groups = ["${aws_security_group.cf_ssh_lb_security_group.id}"]
+    security_groups = [aws_security_group.cf_ssh_lb_security_group.id]
     protocol        = "tcp"
     from_port       = 2222
     to_port         = 2222
@@ -49,17 +49,17 @@ resource "aws_security_group" "cf_ssh_lb_internal_security_group" {
     cidr_blocks = ["0.0.0.0/0"]
   }
 
-  tags {
+  tags = {
     Name = "${var.env_id}-cf-ssh-lb-internal-security-group"
   }
 
   lifecycle {
-    ignore_changes = ["name"]
+    ignore_changes = [name]
   }
 }
 
 output "cf_ssh_lb_internal_security_group" {
-  value = "${aws_security_group.cf_ssh_lb_internal_security_group.id}"
+  value = aws_security_group.cf_ssh_lb_internal_security_group.id
 }
 
 resource "aws_elb" "cf_ssh_lb" {
@@ -81,22 +81,22 @@ resource "aws_elb" "cf_ssh_lb" {
     lb_protocol       = "tcp"
   }
 
-  security_groups = ["${aws_security_group.cf_ssh_lb_security_group.id}"]
-  subnets         = ["${aws_subnet.lb_subnets.*.id}"]
+  security_groups = [aws_security_group.cf_ssh_lb_security_group.id]
+  subnets         = aws_subnet.lb_subnets.*.id
 }
 
 output "cf_ssh_lb_name" {
-  value = "${aws_elb.cf_ssh_lb.name}"
+  value = aws_elb.cf_ssh_lb.name
 }
 
 output "cf_ssh_lb_url" {
-  value = "${aws_elb.cf_ssh_lb.dns_name}"
+  value = aws_elb.cf_ssh_lb.dns_name
 }
 
 resource "aws_security_group" "cf_router_lb_security_group" {
   name        = "${var.env_id}-cf-router-lb-security-group"
   description = "CF Router"
-  vpc_id      = "${local.vpc_id}"
+  vpc_id      = local.vpc_id
 
   ingress {
     cidr_blocks = ["0.0.0.0/0"]
@@ -126,26 +126,26 @@ resource "aws_security_group" "cf_router_lb_security_group" {
     cidr_blocks = ["0.0.0.0/0"]
   }
 
-  tags {
+  tags = {
     Name = "${var.env_id}-cf-router-lb-security-group"
   }
 
   lifecycle {
-    ignore_changes = ["name"]
+    ignore_changes = [name]
   }
 }
 
 output "cf_router_lb_security_group" {
-  value = "${aws_security_group.cf_router_lb_security_group.id}"
+  value = aws_security_group.cf_router_lb_security_group.id
 }
 
 resource "aws_security_group" "cf_router_lb_internal_security_group" {
   name        = "${var.env_id}-cf-router-lb-internal-security-group"
   description = "CF Router Internal"
-  vpc_id      = "${local.vpc_id}"
+  vpc_id      = local.vpc_id
 
   ingress {
-    security_groups = ["${aws_security_group.cf_router_lb_security_group.id}"]
+    security_groups = [aws_security_group.cf_router_lb_security_group.id]
     protocol        = "tcp"
     from_port       = 80
     to_port         = 80
@@ -158,17 +158,17 @@ resource "aws_security_group" "cf_router_lb_internal_security_group" {
     cidr_blocks = ["0.0.0.0/0"]
   }
 
-  tags {
+  tags = {
     Name = "${var.env_id}-cf-router-lb-internal-security-group"
   }
 
   lifecycle {
-    ignore_changes = ["name"]
+    ignore_changes = [name]
   }
 }
 
 output "cf_router_lb_internal_security_group" {
-  value = "${aws_security_group.cf_router_lb_internal_security_group.id}"
+  value = aws_security_group.cf_router_lb_internal_security_group.id
 }
 
 resource "aws_elb" "cf_router_lb" {
@@ -195,7 +195,7 @@ resource "aws_elb" "cf_router_lb" {
     instance_protocol  = "http"
     lb_port            = 443
     lb_protocol        = "https"
-    ssl_certificate_id = "${aws_iam_server_certificate.lb_cert.arn}"
+    ssl_certificate_id = aws_iam_server_certificate.lb_cert.arn
   }
 
   listener {
@@ -203,25 +203,25 @@ resource "aws_elb" "cf_router_lb" {
     instance_protocol  = "tcp"
     lb_port            = 4443
     lb_protocol        = "ssl"
-    ssl_certificate_id = "${aws_iam_server_certificate.lb_cert.arn}"
+    ssl_certificate_id = aws_iam_server_certificate.lb_cert.arn
   }
 
-  security_groups = ["${aws_security_group.cf_router_lb_security_group.id}"]
-  subnets         = ["${aws_subnet.lb_subnets.*.id}"]
+  security_groups = [aws_security_group.cf_router_lb_security_group.id]
+  subnets         = aws_subnet.lb_subnets.*.id
 }
 
 output "cf_router_lb_name" {
-  value = "${aws_elb.cf_router_lb.name}"
+  value = aws_elb.cf_router_lb.name
 }
 
 output "cf_router_lb_url" {
-  value = "${aws_elb.cf_router_lb.dns_name}"
+  value = aws_elb.cf_router_lb.dns_name
 }
 
 resource "aws_security_group" "cf_tcp_lb_security_group" {
   name        = "${var.env_id}-cf-tcp-lb-security-group"
   description = "CF TCP"
-  vpc_id      = "${local.vpc_id}"
+  vpc_id      = local.vpc_id
 
   ingress {
     cidr_blocks = ["0.0.0.0/0"]
@@ -237,33 +237,33 @@ resource "aws_security_group" "cf_tcp_lb_security_group" {
     cidr_blocks = ["0.0.0.0/0"]
   }
 
-  tags {
+  tags = {
     Name = "${var.env_id}-cf-tcp-lb-security-group"
   }
 
   lifecycle {
-    ignore_changes = ["name"]
+    ignore_changes = [name]
   }
 }
 
 output "cf_tcp_lb_security_group" {
-  value = "${aws_security_group.cf_tcp_lb_security_group.id}"
+  value = aws_security_group.cf_tcp_lb_security_group.id
 }
 
 resource "aws_security_group" "cf_tcp_lb_internal_security_group" {
   name        = "${var.env_id}-cf-tcp-lb-internal-security-group"
   description = "CF TCP Internal"
-  vpc_id      = "${local.vpc_id}"
+  vpc_id      = local.vpc_id
 
   ingress {
-    security_groups = ["${aws_security_group.cf_tcp_lb_security_group.id}"]
+    security_groups = [aws_security_group.cf_tcp_lb_security_group.id]
     protocol        = "tcp"
     from_port       = 1024
     to_port         = 1123
   }
 
   ingress {
-    security_groups = ["${aws_security_group.cf_tcp_lb_security_group.id}"]
+    security_groups = [aws_security_group.cf_tcp_lb_security_group.id]
     protocol        = "tcp"
     from_port       = 80
     to_port         = 80
@@ -276,17 +276,17 @@ resource "aws_security_group" "cf_tcp_lb_internal_security_group" {
     cidr_blocks = ["0.0.0.0/0"]
   }
 
-  tags {
+  tags = {
     Name = "${var.env_id}-cf-tcp-lb-internal-security-group"
   }
 
   lifecycle {
-    ignore_changes = ["name"]
+    ignore_changes = [name]
   }
 }
 
 output "cf_tcp_lb_internal_security_group" {
-  value = "${aws_security_group.cf_tcp_lb_internal_security_group.id}"
+  value = aws_security_group.cf_tcp_lb_internal_security_group.id
 }
 
 resource "aws_elb" "cf_tcp_lb" {
@@ -1001,14 +1001,14 @@ resource "aws_elb" "cf_tcp_lb" {
     lb_protocol       = "tcp"
   }
 
-  security_groups = ["${aws_security_group.cf_tcp_lb_security_group.id}"]
-  subnets         = ["${aws_subnet.lb_subnets.*.id}"]
+  security_groups = [aws_security_group.cf_tcp_lb_security_group.id]
+  subnets         = aws_subnet.lb_subnets.*.id
 }
 
 output "cf_tcp_lb_name" {
-  value = "${aws_elb.cf_tcp_lb.name}"
+  value = aws_elb.cf_tcp_lb.name
 }
 
 output "cf_tcp_lb_url" {
-  value = "${aws_elb.cf_tcp_lb.dns_name}"
+  value = aws_elb.cf_tcp_lb.dns_name
 }
@@ -1,14 +1,14 @@
 resource "aws_security_group" "concourse_lb_internal_security_group" {
   name        = "${var.env_id}-concourse-lb-internal-security-group"
   description = "Concourse Internal"
-  vpc_id      = "${local.vpc_id}"
+  vpc_id      = local.vpc_id
 
-  tags {
+  tags = {
     Name = "${var.env_id}-concourse-lb-internal-security-group"
   }
 
   lifecycle {
-    ignore_changes = ["name"]
+    ignore_changes = [name]
   }
 }
 
@@ -19,7 +19,7 @@ resource "aws_security_group_rule" "concourse_lb_internal_80" {
   to_port     = 80
   cidr_blocks = ["0.0.0.0/0"]
 
-  security_group_id = "${aws_security_group.concourse_lb_internal_security_group.id}"
+  security_group_id = aws_security_group.concourse_lb_internal_security_group.id
 }
 
 resource "aws_security_group_rule" "concourse_lb_internal_2222" {
@@ -29,7 +29,7 @@ resource "aws_security_group_rule" "concourse_lb_internal_2222" {
   to_port     = 2222
   cidr_blocks = ["0.0.0.0/0"]
 
-  security_group_id = "${aws_security_group.concourse_lb_internal_security_group.id}"
+  security_group_id = aws_security_group.concourse_lb_internal_security_group.id
 }
 
 resource "aws_security_group_rule" "concourse_lb_internal_443" {
@@ -39,7 +39,7 @@ resource "aws_security_group_rule" "concourse_lb_internal_443" {
   to_port     = 443
   cidr_blocks = ["0.0.0.0/0"]
 
-  security_group_id = "${aws_security_group.concourse_lb_internal_security_group.id}"
+  security_group_id = aws_security_group.concourse_lb_internal_security_group.id
 }
 
 resource "aws_security_group_rule" "concourse_lb_internal_egress" {
@@ -49,23 +49,23 @@ resource "aws_security_group_rule" "concourse_lb_internal_egress" {
   to_port     = 0
   cidr_blocks = ["0.0.0.0/0"]
 
-  security_group_id = "${aws_security_group.concourse_lb_internal_security_group.id}"
+  security_group_id = aws_security_group.concourse_lb_internal_security_group.id
 }
 
 resource "aws_lb" "concourse_lb" {
   name               = "${var.short_env_id}-concourse-lb"
   load_balancer_type = "network"
-  subnets            = ["${aws_subnet.lb_subnets.*.id}"]
+  subnets            = aws_subnet.lb_subnets.*.id
 }
 
 resource "aws_lb_listener" "concourse_lb_80" {
-  load_balancer_arn = "${aws_lb.concourse_lb.arn}"
+  load_balancer_arn = aws_lb.concourse_lb.arn
   protocol          = "TCP"
   port              = 80
 
   default_action {
     type             = "forward"
-    target_group_arn = "${aws_lb_target_group.concourse_lb_80.arn}"
+    target_group_arn = aws_lb_target_group.concourse_lb_80.arn
   }
 }
 
@@ -73,7 +73,7 @@ resource "aws_lb_target_group" "concourse_lb_80" {
   name     = "${var.short_env_id}-concourse80"
   port     = 80
   protocol = "TCP"
-  vpc_id   = "${local.vpc_id}"
+  vpc_id   = local.vpc_id
 
   health_check {
     healthy_threshold   = 10
@@ -84,13 +84,13 @@ resource "aws_lb_target_group" "concourse_lb_80" {
 }
 
 resource "aws_lb_listener" "concourse_lb_2222" {
-  load_balancer_arn = "${aws_lb.concourse_lb.arn}"
+  load_balancer_arn = aws_lb.concourse_lb.arn
   protocol          = "TCP"
   port              = 2222
 
   default_action {
     type             = "forward"
-    target_group_arn = "${aws_lb_target_group.concourse_lb_2222.arn}"
+    target_group_arn = aws_lb_target_group.concourse_lb_2222.arn
   }
 }
 
@@ -98,17 +98,17 @@ resource "aws_lb_target_group" "concourse_lb_2222" {
   name     = "${var.short_env_id}-concourse2222"
   port     = 2222
   protocol = "TCP"
-  vpc_id   = "${local.vpc_id}"
+  vpc_id   = local.vpc_id
 }
 
 resource "aws_lb_listener" "concourse_lb_443" {
-  load_balancer_arn = "${aws_lb.concourse_lb.arn}"
+  load_balancer_arn = aws_lb.concourse_lb.arn
   protocol          = "TCP"
   port              = 443
 
   default_action {
     type             = "forward"
-    target_group_arn = "${aws_lb_target_group.concourse_lb_443.arn}"
+    target_group_arn = aws_lb_target_group.concourse_lb_443.arn
   }
 }
 
@@ -116,21 +116,21 @@ resource "aws_lb_target_group" "concourse_lb_443" {
   name     = "${var.short_env_id}-concourse443"
   port     = 443
   protocol = "TCP"
-  vpc_id   = "${local.vpc_id}"
+  vpc_id   = local.vpc_id
 }
 
 output "concourse_lb_internal_security_group" {
-  value = "${aws_security_group.concourse_lb_internal_security_group.name}"
+  value = aws_security_group.concourse_lb_internal_security_group.name
 }
 
 output "concourse_lb_target_groups" {
-  value = ["${aws_lb_target_group.concourse_lb_80.name}", "${aws_lb_target_group.concourse_lb_443.name}", "${aws_lb_target_group.concourse_lb_2222.name}"]
+  value = [aws_lb_target_group.concourse_lb_80.name, aws_lb_target_group.concourse_lb_443.name, aws_lb_target_group.concourse_lb_2222.name]
 }
 
 output "concourse_lb_name" {
-  value = "${aws_lb.concourse_lb.name}"
+  value = aws_lb.concourse_lb.name
 }
 
 output "concourse_lb_url" {
-  value = "${aws_lb.concourse_lb.dns_name}"
+  value = aws_lb.concourse_lb.dns_name
 }
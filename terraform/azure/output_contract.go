@@ -0,0 +1,48 @@
+package azure
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+)
+
+type OutputContract struct{}
+
+func NewOutputContract() OutputContract {
+	return OutputContract{}
+}
+
+// Outputs lists the outputs that create-env, the cloud config and bbl lbs
+// read from the azure templates.
+func (c OutputContract) Outputs(state storage.State) []terraform.OutputSpec {
+	outputs := []terraform.OutputSpec{
+		{Name: "vnet_name", Type: terraform.StringOutput},
+		{Name: "subnet_name", Type: terraform.StringOutput},
+		{Name: "resource_group_name", Type: terraform.StringOutput},
+		{Name: "storage_account_name", Type: terraform.StringOutput},
+		{Name: "default_security_group", Type: terraform.StringOutput},
+		{Name: "external_ip", Type: terraform.StringOutput},
+		{Name: "private_key", Type: terraform.StringOutput, Sensitive: true},
+		{Name: "public_key", Type: terraform.StringOutput},
+		{Name: "jumpbox_url", Type: terraform.StringOutput},
+		{Name: "director_name", Type: terraform.StringOutput},
+		{Name: "internal_cidr", Type: terraform.StringOutput},
+		{Name: "internal_gw", Type: terraform.StringOutput},
+		{Name: "jumpbox__internal_ip", Type: terraform.StringOutput},
+		{Name: "director__internal_ip", Type: terraform.StringOutput},
+	}
+
+	switch state.LB.Type {
+	case "concourse":
+		outputs = append(outputs,
+			terraform.OutputSpec{Name: "concourse_lb_name", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "concourse_lb_ip", Type: terraform.StringOutput},
+		)
+	case "cf":
+		outputs = append(outputs,
+			terraform.OutputSpec{Name: "cf_app_gateway_name", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "cf_security_group", Type: terraform.StringOutput},
+		)
+	}
+
+	return outputs
+}
@@ -0,0 +1,29 @@
+package azure_test
+
+import (
+	"fmt"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform/azure"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputContract", func() {
+	DescribeTable("declares only outputs that the template has", func(state storage.State) {
+		template := azure.NewTemplateGenerator().Generate(state)
+
+		outputs := azure.NewOutputContract().Outputs(state)
+		Expect(outputs).NotTo(BeEmpty())
+		for _, output := range outputs {
+			Expect(template).To(ContainSubstring(fmt.Sprintf("output %q", output.Name)))
+		}
+	},
+		Entry("without an lb", storage.State{}),
+		Entry("with a concourse lb", storage.State{LB: storage.LB{Type: "concourse"}}),
+		Entry("with a cf lb", storage.State{LB: storage.LB{Type: "cf"}}),
+		Entry("with a cf lb and a domain", storage.State{LB: storage.LB{Type: "cf", Domain: "some-domain"}}),
+	)
+})
@@ -77,7 +77,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesCf_dnsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x92\xc1\x6a\xf3\x30\x10\x84\xef\x7a\x8a\x45\xe4\xf4\x43\x44\xe0\x3f\xf7\x49\x42\x59\x36\xd2\x26\x15\xd8\x92\x90\x64\x97\xc4\xe8\xdd\x8b\x54\xdc\x24\x36\x4d\x0b\x39\xd5\x57\xcf\xee\xcc\x7c\x5a\x43\x99\x40\xd2\x65\x88\x1c\x7b\x0c\xc3\xa1\xb3\x1a\x6d\x90\x20\xf5\x71\xdb\x1d\x24\x4c\x02\xc0\x51\xcf\xb0\xf8\x5e\x40\x6e\xa6\x91\xa2\x62\x37\xa2\x35\x65\xdb\xf4\x5b\x1b\xa4\x00\x88\x9c\xfc\x10\x35\xe3\x29\xfa\x21\x60\x9b\x6f\x03\xb3\xd1\xbd\x40\x1d\x7c\x7a\x53\x55\x55\xea\xb4\xe1\xc0\xce\x24\xf4\xee\xd6\x6e\xff\x95\x92\x42\xe8\xac\xa6\x6c\xbd\xc3\x13\x65\x7e\xa7\xb3\xd2\x47\xf9\x2a\x8a\x10\xf3\xe2\x6b\x27\xe3\x12\x5e\xbc\xe3\x56\xe9\x17\x7d\xd2\x39\x65\xee\xd1\xf8\x9e\xac\x2b\xcf\xb6\x11\x00\x99\x4e\xa9\xd9\x02\xb0\x1b\x6d\xf4\xae\x67\x97\x57\x00\xab\x53\xf9\xbe\x02\x61\x64\xed\xa3\xf9\xb9\xc6\xbf\xba\xa9\x36\xc6\x85\xe0\x2e\xf3\x8c\x45\xe9\xe3\x95\xfc\x73\xef\x96\x73\xb7\x8c\x53\xa7\xff\xef\x76\x9f\xbb\x6b\xfc\xb4\xf8\xbb\x97\x9b\xa9\xde\xa0\x5a\x9d\xa0\x6a\x07\xa5\x6c\x40\x32\x26\x72\x4a\xe5\xd1\x0b\xdf\xe0\xa9\xa1\x1e\x02\x6a\x82\x3f\xc6\x68\x8d\xa7\xed\x5d\xd2\xf9\x08\x00\x00\xff\xff\x54\x9f\xf2\xd7\xcf\x03\x00\x00")
+var _templatesCf_dnsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x92\x41\x6b\x03\x21\x10\x85\xef\xfe\x8a\x87\xf4\x54\x88\x04\x7a\xde\x5f\x12\x82\x4c\x74\x92\x0a\xbb\x2a\xea\xa6\x24\x61\xff\x7b\x71\x53\x5b\xba\xa1\x69\xc9\xa9\x5e\xe7\x8d\xef\xbd\x8f\xb1\x54\x08\x92\xce\x63\xe2\x34\xe8\x38\xee\x7a\x67\xb4\x8b\x12\xd2\xec\x57\xfd\x4e\xe2\x22\x00\x4f\x03\x63\xf1\x3a\xc8\xa7\xcb\x91\x92\x62\x7f\xd4\xce\x4e\xab\x59\xbf\x72\x51\x0a\x20\x71\x0e\x63\x32\xac\x0f\x29\x8c\x51\xcf\xfb\x1d\x9a\xcb\xf7\xa9\xda\x85\xfc\xaa\xaa\x44\x00\x96\x23\x7b\x9b\x75\xf0\xcd\x07\xe8\xb0\x69\x9b\x14\x63\xef\x0c\x15\x17\xbc\x3e\x50\xe1\x37\x3a\x29\xb3\xdf\x8a\x49\x88\xf6\xe9\x57\x19\xeb\xb3\x3e\x07\xcf\x73\x97\x7b\x45\x6a\x8b\x7c\xca\x85\x07\x6d\xc3\x40\xce\x3f\xde\x40\x00\x85\x0e\x19\xdd\x6c\x07\xb0\x3f\xba\x14\xfc\xc0\xbe\x7c\x18\x5d\x71\x09\x60\xfa\x39\x35\xe9\xc4\x26\x24\xfb\x6b\x72\xf9\x5c\x69\xd7\x92\x7a\x21\xe8\xb0\xc4\xa0\xcc\xbe\x51\x7e\xb0\x1c\x50\x4a\xdf\x0c\x3e\x5f\x07\xf9\xb2\x5e\xd7\x1c\xd7\xd0\x79\x31\xdd\xd4\x13\x53\x37\x17\xa6\xe6\x7b\x51\x2e\x6a\xb2\x36\x71\xce\xdb\x3f\xf1\xa8\xa8\xef\x12\x99\x05\xff\x1f\xca\x2d\x8f\x1a\x7c\x81\xe3\x7d\x00\x67\x3a\xd9\x36\x9d\x03\x00\x00")
 
 func templatesCf_dnsTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -92,12 +92,12 @@ func templatesCf_dnsTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/cf_dns.tf", size: 975, mode: os.FileMode(480), modTime: time.Unix(1521650026, 0)}
+	info := bindataFileInfo{name: "templates/cf_dns.tf", size: 925, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesCf_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xcd\x6e\xe3\x36\x10\xbe\xeb\x29\x08\xa1\x87\xb6\x58\x39\x4e\x62\x14\xb9\xe8\xd0\xa2\x87\xf6\xbc\xbd\x13\x94\x34\xb2\x89\xd0\x24\xcb\x1f\x67\xdd\x85\xdf\xbd\x20\x29\xc9\x16\x4d\xd9\x72\x8a\xdd\xcd\xa2\xc9\x2d\x9e\x1f\x0e\xbf\xf9\x66\x38\xa3\x1d\x51\x94\x54\x0c\x50\xae\xf7\xda\xc0\x16\x37\x62\x4b\x28\xcf\xd1\xe7\x43\x96\x1d\x85\xb2\xfd\x84\x6b\x50\x06\x57\x44\xc3\x2f\xab\x94\x58\x12\xad\x5f\x84\x6a\x82\x4c\x81\x16\x56\xd5\x80\x72\xf2\x8f\x55\xa0\xb6\x58\xdb\x8a\x83\xc9\x51\x5e\xb7\x85\x76\x07\x64\x08\x71\xb2\x05\x14\xff\x95\x28\xff\xe1\xf3\x8e\xa8\x05\xf0\x1d\xa6\xcd\xa1\x08\x06\x19\x42\xa4\x69\x14\x68\x8d\xa5\x82\x96\x7e\x3a\x55\xaf\x69\xa3\xc2\x01\x3f\x3a\x4b\x0e\xe6\x45\xa8\x67\xec\x7e\xfe\x80\x9e\x3e\xa0\xfb\x9f\x0e\xce\x41\x1f\x15\x5e\x2b\x61\x25\x0e\xc7\x7b\x07\x7d\x94\x63\x8d\x45\x25\xf4\x66\xe1\xd4\xbc\xf9\x8e\x2a\x63\x09\xc3\xbd\x7b\x6f\x3f\x32\x8f\x34\x46\xf6\x49\x54\x7a\x57\x1a\x6a\xab\xa8\xd9\x87\x73\x3d\x4a\xd3\x10\x25\x10\x72\xe1\x31\x51\x13\x43\x05\x4f\xaa\x2a\x58\x53\xc1\x27\x51\x98\x0b\x42\x86\x90\x21\x6b\xed\x43\x43\x08\xf8\x8e\x2a\xc1\xb7\xc0\xcd\x59\x50\xee\xa4\xc3\xcc\x4b\x2b\xcb\x20\x30\x63\x63\x8c\xbc\xc0\x8d\x0b\x14\xf1\x96\x19\x42\x52\x51\xe1\x9c\xa6\xed\x1e\x96\xf7\x19\x42\x0d\x55\x50\xc7\x50\x1d\x7d\xff\xc9\x2b\x61\x79\xe3\x29\x57\xd7\xa0\xf5\x64\x14\xbf\x32\x26\x5e\xc2\xa9\xc2\x88\x5a\xb0\x09\xbd\xbf\x6a\x1f\x5b\x07\xab\x14\xca\x60\x45\xf8\x1a\xc6\x5a\x3f\x3b\x9d\x06\xb4\xa1\xdc\x27\xf2\x4c\xb1\x44\xf9\xd3\xf2\xc4\xd1\x54\x41\x9c\x39\x8a\x15\x7b\x9d\x64\x41\x9c\x82\x3c\xab\x2e\xd2\x24\x4e\x10\x2b\xad\xb8\xa8\xdb\xdb\x6a\x64\x4c\x17\xfd\x7a\xbe\xe8\x39\x84\x79\xf8\xbe\x09\xb3\x5a\x3d\xbe\x33\xe6\xc8\x18\x26\xd6\xaf\xe3\x8b\x33\x9c\xc1\x96\xc7\xef\x9d\x2d\xff\x43\xba\x48\x5b\x31\x5a\x63\x7a\xed\xdd\xbd\xcc\x8f\xaa\xa0\x72\xea\x19\x3e\xb7\xbc\xf2\x1e\xbf\x02\xa4\xe1\x16\x43\x32\x08\x1b\x62\x29\x51\xde\xec\x39\xd9\xd2\x7a\x02\x03\x22\x25\xa3\x41\x19\xaf\x89\x81\x17\xb2\xbf\x75\x0a\x21\x52\x16\xbd\xe9\x7f\x1c\x33\xe6\x0e\x33\x8e\xa9\xcf\xb6\x9b\x46\x86\x20\x4b\x94\x7f\x34\x84\x37\x44\x35\xf8\xe3\x96\x30\x96\x7b\xb9\xa1\xa0\x62\x79\x90\xd4\x44\x92\xda\x15\x75\x89\x1e\xfc\xd8\x12\x4a\xae\x82\xd8\xf3\x38\x98\x0d\x10\x66\x36\x85\xd7\x0c\x8e\x52\x75\x5a\xa2\xfc\x8f\x6e\x36\x41\x48\x12\xb3\x49\x78\xba\x0b\xd2\x8d\xd0\x26\x21\x25\x92\x2e\xc2\xc5\x47\x43\xfa\x21\x18\x51\x6e\x40\xed\x48\x74\xe6\xe3\xb2\xbb\xf3\x16\x84\x35\x28\x29\xb4\x3c\xdc\x60\x8f\xcd\x46\x81\xde\x08\xd6\x38\x61\x8f\x40\x97\x4b\xc7\xa8\x5a\xf0\x96\xae\xad\x0a\x29\x89\x41\x49\x95\x43\x67\x5c\x50\x59\x8c\x8c\x43\xcc\x61\x56\xc7\xb4\x99\x31\x3e\xd3\xe6\x70\x17\xf4\xf5\xdd\x51\x35\xfc\xb2\xf0\xbb\xc1\x91\x35\x3e\xee\x56\x09\x6e\x80\x37\xbe\xbf\x9d\x06\x5b\xa2\xbc\x97\x39\xd1\x30\x01\x20\xe4\x35\x4b\xb4\x5a\x3d\xbe\xc6\xc9\xc8\xc7\xd3\xf2\x56\x17\x4c\xac\xe3\x30\x12\x71\x5c\xcd\xc2\xa5\xd2\xac\xdb\xa2\x77\x34\x91\x91\xf3\xee\x11\x27\x67\xd0\x70\xed\x74\x18\xf0\x33\x84\x2a\x52\x3f\xbb\x08\x87\x37\x40\x08\x16\x5d\xf7\x2c\x9a\xce\xa6\xe8\x6c\x0a\x67\x73\xe6\xd0\x61\x8b\x35\x18\x43\xf9\xb0\x72\xa4\xfb\xf2\xcc\x25\xac\xa8\xa0\xd8\x18\x6d\xba\xaa\x17\xe2\x99\x82\x5f\x69\x1b\x4c\xda\x96\xf2\xd0\x02\xf2\xdf\xa9\x76\x7b\x6d\x73\x92\x94\xc4\x89\x4f\xcb\xc9\x92\x8f\x8a\x5e\xc1\xdf\x16\xb4\xc1\xe3\x62\x2c\xd1\xfd\xe0\xa1\x02\x1c\x5f\x2c\xd1\x5f\x3c\x38\x5a\x33\xbf\x8a\xd3\xd6\xb5\xeb\xb3\x0e\x55\xa2\x5c\x6b\x56\x38\x8d\x70\x78\x43\x0c\x19\x93\x22\x5a\xe6\x0f\x7d\x67\x0a\xfb\xfb\x58\xaf\xff\xf5\x98\x6d\x9f\x14\x46\xb5\x01\x0e\xea\x62\x52\x6e\xce\x8e\x73\x5d\x30\x6d\x3a\x4a\x4e\x52\x1f\x4f\xd2\xea\x0a\xc9\x47\x15\x79\x86\xf8\x85\xd2\xbe\x30\x7e\x8d\x93\xfd\x35\x20\xd2\x6f\x0c\x23\x7d\x0b\x48\x9d\x72\xc4\xe2\xf8\x9c\x88\xc5\x5f\x1a\x55\xd7\x81\xdf\x10\xa8\x27\x0f\xc2\x17\xc6\xb4\xef\x4c\x4a\x58\xd7\x64\xfd\x9e\x74\x1d\xda\x9b\xea\xd9\xaf\x5e\xa1\x0d\x5a\x06\xd8\xec\x65\xba\x7f\xff\x46\xb4\x1b\x4e\xdd\x7f\xa3\x4c\x5f\xd8\x19\xe6\xb7\x92\xd4\x13\x75\xfa\xf9\x6f\xe6\xeb\x34\xf1\x34\xdd\xf4\x21\xf0\xf4\x0d\xfa\x4a\x39\xd0\xdf\x30\x09\xfa\x3d\x0b\x7d\x83\xf9\x36\x49\x88\x7a\xdb\x9b\xcd\xc1\x21\xcb\x84\x35\xd2\x1a\xb7\x75\xba\x75\xb4\x5f\x43\xbd\xcf\xb0\x86\xee\x08\xb3\x91\xfb\xc4\xde\x3a\x5e\xf7\x4f\x9c\xc6\x1f\xd9\x27\x5c\xce\xf8\x88\xf0\x6f\x00\x00\x00\xff\xff\x51\x97\x99\x8a\x37\x19\x00\x00")
+var _templatesCf_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xcd\x6e\xe3\x36\x10\xbe\xeb\x29\x06\xc4\x1e\xda\x62\xe5\x4d\x36\x41\x91\x0b\x0f\x2d\x7a\x68\xcf\xdb\x3b\x41\x49\xb4\x4d\x84\x26\x59\x92\x72\xd6\x0d\xfc\xee\x05\xff\x14\x49\x96\x6c\x2b\xc0\xee\x66\xb1\xa5\x4f\xd1\xfc\x70\x66\xbe\x8f\x43\x4e\xf6\xd4\x70\x5a\x09\x06\xc8\x1e\xac\x63\x3b\xd2\xa8\x1d\xe5\x12\xc1\xf3\xb1\x28\x5e\x84\x7a\xfd\x99\xd4\xcc\x38\x52\x51\xcb\x7e\xbd\x9f\x12\x6b\x6a\xed\x93\x32\x4d\x94\x19\x66\x55\x6b\x6a\x06\x88\xfe\xdb\x1a\x66\x76\xc4\xb6\x95\x64\x0e\x01\xaa\xd7\xa5\xf5\x1b\x14\x00\x92\xee\x18\x8c\x17\x06\xf4\xee\x79\x4f\xcd\x8a\xc9\x3d\xe1\xcd\xb1\x8c\x06\x05\x00\x6d\x1a\xc3\xac\x25\xda\xb0\x35\xff\xdc\xa9\xd7\xbc\x31\xd1\xfb\x4f\xde\x4c\x32\xf7\xa4\xcc\x23\xf1\x9f\xdf\xc3\xc3\x7b\xb8\xfd\xb9\x00\xc8\x01\x91\x8d\x51\xad\x26\x71\x67\x0c\x39\xba\xa1\x78\x55\x29\xbb\x5d\x79\x9d\x02\x60\xcf\x8d\x6b\xa9\x20\xd9\xaf\xff\xdc\xb3\x1c\x89\x7b\xa6\x93\x65\xc8\x5e\x2c\xab\x5b\xc3\xdd\x21\x6e\x18\xca\x32\x5f\x93\x89\x92\xa0\x02\x40\xa8\x9a\x3a\xae\x64\x56\xf3\x3f\x0c\xbe\x06\x86\x6d\xb8\x92\x33\x69\x5f\x91\x75\x01\xe0\xe8\xc6\x02\x0e\x21\x01\x30\xb9\xe7\x46\xc9\x1d\x93\x2e\xed\x10\xc1\x29\x00\x8e\x57\xe6\x69\x5a\xc1\x42\x9a\xe5\xd6\x39\x7d\x06\xff\xf9\x9c\xa3\x65\x01\xa0\x0d\x57\xbe\x78\x9d\x72\xef\x87\xe1\xe3\xcd\x6d\x01\xd0\x70\xc3\xea\x71\x75\xd2\xc2\x80\xfe\x92\x95\x6a\x65\xe3\xcb\x48\xeb\x9a\x59\x9b\x65\xc3\x85\x01\xfd\x26\x84\x7a\xf2\x7a\xda\x28\xa7\x6a\x25\xb2\xac\xbf\x30\xa0\xbf\xeb\x10\x5b\xaa\xa8\x56\xc6\x11\x43\xe5\xa6\x9f\x20\x06\xf4\x8b\xd7\x69\x98\x75\x5c\x06\xec\x4e\x14\x31\xa0\x87\x9b\x9e\xa3\x19\xd2\x9f\x3a\x1a\x2b\x66\x9d\x49\xe6\x77\x7e\x2e\x52\x01\x60\x9a\xb2\x63\x2e\x4d\x6b\xad\xea\xf5\x92\xc3\x30\x24\x89\x7d\x3d\x4b\xec\x35\x34\xf9\xf8\x7d\xd3\xe4\xfe\xfe\xee\x7f\x9e\x08\xb5\x79\x1d\x4b\xbc\xe1\x15\x1c\xb9\xfb\xde\x39\xf2\xc3\x90\x44\xb7\x95\xe0\x35\xe1\x97\x2e\xd3\xf3\xac\xa8\x4a\xae\xe7\xee\xd6\x25\x97\xec\xb2\xaa\x74\xb1\x77\x85\xa7\xa2\x8b\x00\x03\x6a\x0e\x92\xee\x78\x8d\xa6\x33\xa7\x5a\x0b\x1e\x95\xc9\x86\x3a\xf6\x44\x0f\x4b\x1f\x14\x54\xeb\x32\x9b\xce\x64\x74\x55\x22\x17\x9f\x24\x9e\xd6\x8f\x6d\x7a\x56\x74\xa1\x61\x40\x9f\x1c\x95\x0d\x35\x0d\xf9\xb4\xa3\x42\x78\x08\x00\x1c\x67\x66\x2c\x8f\x92\x9a\x6a\x5a\xfb\x63\x8b\xc1\x77\xf1\x63\x11\x0f\x55\xc5\xc6\x9e\x7b\x0b\x03\xda\x32\x2a\xdc\xb6\x0c\x9a\xd1\xd1\xd4\x49\xc4\x80\xfe\x4c\x2f\x0d\x00\x4d\xdd\x36\x0b\xf2\xc2\x80\x3e\x44\xf3\xad\xb2\x2e\x7f\xcd\x0b\x03\xa2\x9a\xaf\x62\x81\x07\xcf\xea\x63\x34\xe2\xd2\x31\xb3\xa7\xa3\x3d\xef\x6e\x52\xce\x3b\xa6\x5a\x07\x93\xc2\x56\xc6\x0c\x0e\xc4\x6d\x0d\xb3\x5b\x25\x1a\x6f\x99\x2b\x90\x10\xf4\x3c\xaa\x95\x5c\xf3\x4d\x6b\x02\x2b\x4e\x8a\x32\x45\xfd\x64\x5c\x72\x5d\x0e\x8c\x63\xcc\xf1\x81\x4d\xb8\xdf\x0f\xbd\x7b\x3e\xfb\xfa\xe5\xcd\xf1\x43\xd4\xb7\x1f\x5e\x54\xe3\x97\x55\x78\xcd\x07\xde\x87\x6a\x04\xe4\xd6\x46\x49\xc7\x64\x13\x3a\x58\x3f\x58\x0c\x28\xcb\xbc\xa8\xbb\xd9\x01\xfc\x9f\x80\xe1\xfe\xfe\xee\x35\x4e\x06\x3e\x1e\x6e\x96\xba\x10\x6a\x33\x0e\x63\x22\x8e\x8b\x28\x9c\x3b\x90\xf5\xba\xcc\x8e\x66\x10\x39\xed\x19\x01\x9c\x5c\xee\x4e\xec\x9f\x60\xe9\xa5\x5e\x00\x54\xb4\x7e\xf4\x19\x66\x1b\xad\x94\x18\x65\x7a\x12\x48\xb2\x29\x93\x4d\xe9\x6d\xd0\xd8\xa1\xc7\x86\x58\xe6\x1c\x97\x1b\x7b\x2e\xd5\x2b\x08\x14\xd8\x51\x56\xac\xdc\x3a\xeb\xd2\x81\x57\xea\x91\xb3\x30\x7f\x36\x84\xae\xd7\x5c\xc6\xd3\x8f\xfe\xe0\xd6\x0f\xa1\xa9\x2f\x04\xe0\xf2\x46\xdd\x4a\x18\xcf\xdd\xbb\x83\xf3\x6e\xd8\x3f\x2d\xb3\x8e\x0c\xcf\x21\x86\xdb\xce\x43\xc5\x46\x2d\x7e\xb2\xb5\x84\xe2\x58\x2b\xc2\xdc\xcc\xd7\xbe\x3f\x9f\x34\x27\x0c\xc8\x5a\x51\x7a\x8d\x18\x7e\x43\x1d\x4d\x12\x8f\xc1\x68\xec\x4e\xfd\x28\xce\xd9\x3d\x95\xfc\x29\xef\x1a\xa0\x10\xdc\x3a\x26\x99\x39\x0b\xc5\x62\x4c\xbc\xeb\x52\x58\x97\x38\x38\xcb\xf5\x7c\x65\x2c\x66\x75\xe7\xd1\x23\x79\x52\xe7\x33\x67\x79\x12\xd9\x29\x88\xbf\x46\x89\xec\x1b\xab\x91\x5d\x52\xa4\xa4\x3c\xe2\xee\x78\x9f\x11\x77\xbf\x74\x55\x7d\xcb\x7d\x43\x45\xed\xdd\x00\x5f\xb8\xa6\xb9\x1f\x19\xd5\xfa\xd6\x1a\x46\x9f\xcb\xa5\x5d\x42\xd6\xd2\xbb\x4c\xcd\xaf\x15\x8c\xb8\x83\x9e\x70\x8b\x01\xfd\x4e\xad\x7f\x83\x02\x8c\x90\x1e\xa6\xb1\x68\xeb\x17\x44\xa7\x2e\xa6\xe4\x78\x0a\xcd\xb9\x3b\x69\xe6\x42\xea\xd1\x62\xd9\xcd\xf3\x95\x30\xb0\xdf\x10\x84\xfe\xb9\xfa\x71\x51\xf0\x27\xfa\x1b\x81\x30\xea\x6d\x6f\x16\x83\x63\x51\xa8\xd6\xe9\xd6\xf9\xe1\x92\x50\xad\xf3\xb4\x19\x7c\xc6\x89\x7b\x4f\x45\xdb\x9f\x15\x27\x66\xd3\xfe\x18\xdf\xf3\x37\x9c\xf5\xa7\xbd\x5d\xfc\xbf\xc0\x7f\x03\x00\x03\x0b\x55\xf4\xd0\x18\x00\x00")
 
 func templatesCf_lbTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -112,12 +112,12 @@ func templatesCf_lbTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/cf_lb.tf", size: 6455, mode: os.FileMode(480), modTime: time.Unix(1521650026, 0)}
+	info := bindataFileInfo{name: "templates/cf_lb.tf", size: 6352, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesConcourse_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xcd\x8e\xd3\x3c\x14\xdd\xe7\x29\x2c\xeb\x5b\x7d\x52\xa2\x8a\xe9\xa2\x2c\xba\x40\xac\xd8\x21\xc1\x3e\x72\x1c\x4f\xc7\xaa\xeb\x6b\x5d\xdb\x1d\x60\xd4\x77\x47\x4e\x9a\x4c\x7e\x9c\x34\xed\x20\x41\x05\x5d\x9f\x7b\xec\x7b\x7e\x9a\x04\x85\x05\x8f\x5c\x10\xca\x7e\x78\x14\x78\xc8\x8d\x2f\x94\xe4\xb9\x34\x94\x50\x0e\x9a\x83\x47\x2b\x28\x79\x49\x08\xd1\xec\x20\xc8\xd4\x6f\x4b\xe8\x7f\x2f\x47\x86\x99\xd0\xc7\x5c\x96\xa7\xb4\x1d\x4e\x55\x41\x13\x42\x14\x70\xe6\x24\xe8\xf9\x69\x14\x3b\x09\xfa\x14\x06\x9a\xbb\xe5\x3b\x04\x6f\xf2\xfe\xe9\xd5\x40\x73\xe7\x3e\x32\x2b\xc0\x3e\x65\x01\x5e\xd1\xb4\x0b\xe5\xac\x2c\x51\x58\x9b\x33\xd5\xde\x65\x4b\xa8\x75\xcc\x49\x1e\x90\x76\xef\x27\xd7\x0b\xc8\x2f\x8e\xe9\x92\x61\x49\x93\x84\x10\xc7\x76\xb6\x52\x85\x10\xa1\x8f\x12\x41\x1f\x84\x76\x23\x19\x02\xef\x29\x39\x25\xc9\x58\x69\x55\x2c\x93\xf8\xa2\xb2\x31\xa1\x96\xeb\x13\xf3\x25\x66\x47\x4c\x9d\xa1\x28\x8f\x08\xda\x09\x5d\x06\xb5\x39\xe8\x47\xb9\xf3\x58\x93\xd7\x4a\x45\x13\x34\xb3\x5f\xc3\x97\x4a\x93\xf6\xf8\x68\x45\x37\xb6\x56\x96\xfd\xcd\x5b\x44\xd6\x92\x66\x97\x5c\xc9\xd1\x2b\xd1\xb5\x26\x7d\x72\xce\xd8\x9b\x0c\xaa\x27\x7f\x81\x47\xac\x2c\x98\x62\x9a\x0b\x0c\x3b\x8e\x1a\xa0\x8a\xe1\x82\x73\x6e\x74\x8e\xbf\x41\x77\x83\xe0\x80\x83\x9a\x2e\xca\xd7\x8f\x9f\x69\xf7\x7c\x03\xe8\x62\xc0\xf5\xfa\x21\x21\xa4\x60\x7c\x3f\x8d\x3a\xc3\x3a\xb8\xc6\x6b\x03\xa0\x46\x86\xab\x22\x8f\xe1\xc6\xf6\x1b\x84\x42\x34\x5a\x0e\xcd\xec\xb0\x55\xb8\x6c\xe0\x68\x4d\x32\x95\x9f\x6a\xe4\xee\x03\x14\x37\xfa\xd5\xdd\x98\x5d\xb5\x57\x57\xf5\xea\x66\x55\xfe\xb5\x6a\xa2\x55\x9b\xd5\x92\x52\x6d\x56\x7f\x56\xa7\x6e\xa9\xd4\x7d\x65\xe7\xb6\x42\x6d\x56\x71\x51\xb4\x70\xcf\x80\xfb\xdc\x0a\xee\x51\xba\xef\xd7\xb6\xeb\x0a\xa5\x0c\x4a\x08\x47\xc4\xc7\xdf\xad\xde\x27\x84\x94\x12\x05\x9f\x78\xbd\xdb\x12\xfa\x49\x17\xe0\x75\x19\xd8\x18\xe7\xc2\xda\xc9\xcb\x7c\x50\x0a\x9e\x2f\x15\x22\x88\xc6\xab\xbb\x9d\x2d\x0a\xda\xe5\xc8\xf4\x4e\xf4\x51\xff\x07\x4c\x29\xac\x93\xba\xee\xe8\x10\xb8\x25\x74\xb3\xea\x10\xb5\x01\x47\xf1\x28\xbf\xcd\x10\x0d\x81\x0d\x66\xee\xad\x75\x79\xbc\x46\xee\x4e\x85\x34\x0e\xec\xb1\xbd\x21\x3e\x33\xcf\xac\x85\xf9\xb1\x4b\x02\xb4\xb9\xef\x00\xad\xd7\x0f\x7f\x61\x82\x26\x9e\x0a\x6f\xfe\xa6\x39\x93\xa6\x15\xd9\xef\xf8\x9f\x3e\x25\x09\x78\x67\xbc\xeb\x6c\x12\xb6\x0d\x9c\xf5\x46\x47\xa6\xbc\x98\xa1\x79\x95\x2d\x4a\x24\xcd\x24\x4d\xf4\x7b\xa5\xfd\xbc\xa9\x38\x7f\x06\x00\x00\xff\xff\xcb\x18\x25\x73\xb3\x0f\x00\x00")
+var _templatesConcourse_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xc1\x8e\xdb\x20\x10\xbd\xf3\x15\x23\xd4\x53\xa5\x44\x51\x37\x87\xf4\xc0\xa1\xea\xa9\xb7\x4a\xed\xdd\xc2\x98\xcd\xa2\x65\x01\x0d\x90\x6d\xbb\xca\xbf\x57\xc4\xc1\xb1\x13\xec\x4d\xb2\xaa\xd4\xa8\x9d\xab\x67\x1e\x33\xef\xbd\x31\x36\x4a\x6f\x23\x0a\x09\x94\xff\x8a\x28\xf1\xa9\x72\xb1\xd6\x4a\x54\xca\x51\xa0\xc2\x1a\x61\x23\x7a\x49\xe1\x85\x00\x18\xfe\x24\x61\x2c\x18\xd0\x77\x2f\x1b\x8e\x73\x69\x36\x95\x6a\xb6\xb3\xae\x78\xa6\x6b\x4a\x00\xb4\x15\x3c\x28\x6b\x72\xc1\x20\x18\xa4\x52\x94\x6b\x65\x0d\x01\xc8\x6d\x55\x6b\xb4\xd1\x55\xc3\x83\x19\xe4\x5e\x87\x69\xf3\xda\xfa\x87\x79\xca\x25\x00\xdd\x18\x15\x6f\x1a\x94\xde\x57\x5c\x77\x1d\x30\xa0\x3e\xf0\xa0\x44\xea\xcb\x3f\xc6\x8c\x7c\x1a\x0c\xe8\xb7\xc0\x4d\xc3\xb1\xa1\x84\x00\x04\xbe\xf6\xc0\x76\x6c\x00\x48\xb3\x51\x68\xcd\x93\x34\x61\x3f\x40\x3b\x3b\x01\xd8\x92\x2d\x21\xa7\xe4\xea\xfa\x3c\x56\x5f\x25\x73\x38\x79\x4b\xd0\x59\xbc\x94\x54\x38\x22\xbf\x44\xc8\x31\x0f\xf7\x68\x4d\x90\xa6\x49\x04\x0b\x6b\xee\xd5\x3a\x62\xab\x6e\x1a\x69\xc4\x2a\x13\x53\x65\xbc\x99\x72\xb3\x01\x5e\x52\xa8\xa4\xa6\x6a\x7a\xf3\x76\x8f\xe7\x1d\xe2\x7c\x5a\x86\x0a\xa3\x96\x7d\x2d\x66\x0f\x21\x38\x7f\x95\x22\x6d\xe5\xdb\x44\xe1\x4d\xcd\x35\x37\x42\x62\xa5\x9a\xfd\x81\x87\x6e\x87\x63\x4d\xd1\x9f\x0f\xbd\x8e\x68\x87\x36\x58\x61\x75\x1e\xfa\x28\x18\xd0\xef\x9f\xbf\xd2\xfe\xf9\xce\x62\xc8\x8f\x0f\xc1\x60\xb9\xbc\x23\x00\x35\x17\x8f\xe3\x59\xfb\xb4\x5e\x5e\x16\xd7\x59\xab\x87\x0a\xeb\xba\x2a\x25\x1d\xeb\xed\xd0\xd6\x32\x33\xd8\x8b\x01\xd0\x2e\xe9\x50\xd9\x2a\x9f\xea\xc7\xbc\xb2\x2b\xb8\x51\xb3\x94\x45\x3d\x28\x59\x92\xa6\xd5\xe5\xa2\xcd\xb9\x9a\x8b\xff\x7b\x33\xd8\x9b\xd5\xe2\x9c\xb5\x59\x2d\xfe\x8e\xad\xb9\x7c\x69\x6e\xc1\x27\xd7\xad\xcc\x6a\x51\xa6\xc2\xc8\xf0\x6c\xf1\xb1\xf2\x52\x44\x54\xe1\xe7\xa5\xfb\x73\x01\x3f\x0e\x95\x4d\x47\xe4\x92\x7e\x30\xf8\xb0\xf8\x48\x00\x1a\x85\x52\x8c\x7c\x86\x31\xa0\x5f\x4c\x6d\xa3\x69\xd2\x98\x5c\x08\xe9\x7d\x7e\x36\x0c\x06\xf4\x93\xd6\xf6\xf9\x35\xf3\x27\xd2\xc4\xae\xb7\xbd\x36\x89\xbb\x0a\xb9\x59\xf7\xe7\x64\x40\xdf\xa7\x9c\x46\xfa\xa0\xcc\x6e\xab\x4e\x12\x19\xd0\xd5\xa2\x07\xd4\xf9\x19\xe5\xbd\xfa\x31\x01\x74\x9c\x98\x73\xa6\x3e\x31\xcf\x32\xd5\x89\xb0\x45\x57\x96\xb3\x7a\x40\x6f\x30\xcd\xc4\x0d\x74\xa6\x6b\xfc\x39\xb6\x59\xdd\xb6\x6d\x96\xcb\xbb\x7f\xc6\x37\x23\x2f\xfc\xbe\x73\xc6\x3d\x33\xe1\x95\x3d\xe8\x2c\x5d\x31\x63\x24\xfc\x81\x37\xf1\x96\x10\x1b\x83\x8b\xa1\xd7\x7f\xba\x5b\x12\x58\x3b\xc7\x86\xeb\x28\xc7\x10\x32\x4f\x45\x0c\xe5\xca\x08\xc5\x7f\x89\xee\xbf\x83\x6c\xc9\xef\x01\x00\xa0\xe7\x8f\xad\x33\x0f\x00\x00")
 
 func templatesConcourse_lbTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -132,12 +132,12 @@ func templatesConcourse_lbTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/concourse_lb.tf", size: 4019, mode: os.FileMode(480), modTime: time.Unix(1524614590, 0)}
+	info := bindataFileInfo{name: "templates/concourse_lb.tf", size: 3891, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesNetworkTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xcf\x4a\xc4\x30\x10\xc6\xef\x79\x8a\x61\xf0\xa0\xb0\x5b\x3c\x7a\xf1\x49\x44\x42\x36\x19\xd7\xe0\x36\x29\x93\x3f\x8a\x25\xef\x2e\xa9\xad\xd8\xd8\xe2\xe6\xfc\x9b\x99\xef\xf7\x85\x29\xf8\xc4\x9a\x00\xd5\x67\x62\xe2\x5e\x66\xcb\x31\xa9\x8b\x74\x14\xdf\x3d\xbf\x21\xe0\xc9\x87\x57\x84\x51\x00\x38\xd5\x13\x34\xef\x11\xf0\x66\xcc\x8a\x3b\x72\x59\x5a\x53\x8e\x15\x3f\x66\x87\x02\x40\x19\xc3\x14\x82\x0c\x83\xd2\xf4\xc3\x3f\xcd\x03\xf3\x05\xa9\xad\xe1\x82\xcf\x02\xe0\xe2\xb5\x8a\xd6\xbb\xcd\xfd\x4c\x67\xeb\x5d\xa9\x7b\x97\xd4\xf2\xcc\x3e\x0d\x72\x8a\x35\x71\x8b\xc4\x1a\xe8\x6a\xa4\xae\x52\x05\x45\x11\xe2\xaf\x74\x48\x27\x47\xf1\x5f\xd7\x1d\xd9\xb0\x92\x1d\x98\x5e\xec\xc7\xef\x81\x2a\xf8\x7d\xe1\xb6\xf5\x3e\xc0\xc3\x01\xee\xef\x76\xad\xae\xd6\x02\x68\x3e\x6e\xa3\x95\x86\x68\x6a\xf9\x0a\x00\x00\xff\xff\xdb\x9a\xf5\x1a\x0b\x02\x00\x00")
+var _templatesNetworkTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xcd\x4a\xc4\x30\x14\x85\xf7\x79\x8a\x43\x70\xa1\x30\x53\x5c\xba\xe9\x93\x88\x84\x4c\x72\x1d\x83\xd3\xa4\xdc\xfc\x28\x96\xbe\xbb\x44\xad\x90\x48\x71\xb2\xbd\xe7\x1e\xbe\xef\x86\x29\x86\xcc\x86\x20\xf5\x47\x66\xe2\x49\x15\xc7\x29\xeb\x8b\xf2\x94\xde\x02\xbf\x4a\xc8\x53\x88\x2f\x12\x8b\x00\xbc\x9e\x08\xdd\x1b\x21\x6f\x96\xa2\x79\x20\x5f\x94\xb3\xeb\xb1\xc6\x8f\xc5\x4b\x01\x68\x6b\x99\x62\x54\x71\xd6\x66\x5b\x1c\xf1\x58\xd3\x3f\xf5\xca\x38\xcb\x4f\x02\xb8\x04\xa3\x93\x0b\xbe\xad\xae\x49\xa6\xb3\x0b\x5e\x00\x1b\xab\x3a\x73\xc8\xb3\xfa\x82\x19\xb1\x71\xb7\xd3\xa1\x52\x0c\x35\x22\x56\x21\xfe\x5a\xc6\x7c\xf2\x94\xfe\x95\xdb\xb1\x8b\x8d\xdd\xcc\xf4\xec\xde\x7f\x17\xaa\xd1\x77\xfd\x6d\x2f\x7a\xc0\xc3\x01\xf7\x77\x3b\x2e\xd7\xc8\x00\xdd\xff\xf4\x67\xe8\xc6\xcd\x1d\x3e\x07\x00\xcf\x65\xf8\x89\xed\x01\x00\x00")
 
 func templatesNetworkTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -152,12 +152,12 @@ func templatesNetworkTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/network.tf", size: 523, mode: os.FileMode(480), modTime: time.Unix(1519854618, 0)}
+	info := bindataFileInfo{name: "templates/network.tf", size: 493, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesNetwork_security_groupTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\xcf\x8e\x9b\x30\x10\x87\xef\x7e\x8a\x91\xd5\xd3\x4a\x1b\x6d\xd9\xb0\xe2\x92\x43\x8f\xbd\xf7\x8e\x1c\x7b\x4a\xac\x12\x0f\x1a\x9b\xa4\x6d\xc4\xbb\x57\x86\x92\x86\x84\x44\x14\x55\xaa\xb2\x0a\xe7\x6f\x7e\xf3\xc7\x9f\x60\xf4\x54\xb3\x46\x90\xea\x67\xcd\xc8\xdb\xdc\x61\xd8\x13\x7f\xcb\x3d\xea\x9a\x6d\xf8\x91\x17\x4c\x75\x25\x41\xae\xc9\x6f\x24\x1c\x04\x80\x53\x5b\x84\xb3\x6f\x05\xf2\xc3\x61\xa7\x78\x81\x6e\x97\x5b\xd3\x3c\xb7\xb8\x00\x28\x49\xab\x60\xc9\x8d\xc2\x8c\x85\x25\xd7\x44\xae\x9f\xa4\xeb\x97\xb7\x3d\x5a\xae\x1f\x6c\x08\x2c\x62\xfe\x22\x52\x8d\x14\x02\x20\xa8\xc2\xb7\xc3\x01\xa0\xdb\x59\x26\xb7\x45\x17\x2e\xc6\x8a\x9d\x1a\xd1\x08\x31\x61\x71\xae\x4b\x94\x20\xfd\xad\xb5\xaf\xae\xef\xbb\xed\x2b\xb6\x14\xc3\xc6\x6b\x92\x97\x17\x01\x60\x2c\xa3\x3e\x3f\xd1\x9f\xdc\xcf\x6e\x4d\xb5\x33\x31\x4d\x69\x8d\xde\x5f\x9d\xe0\x53\x59\xd2\xbe\xeb\x4a\x81\x34\x95\x57\xb8\x2f\xba\x8a\xd4\xef\x73\x56\xc4\x21\x67\xe5\x0a\x1c\x52\x4f\x91\x31\xe8\x83\x75\xed\x03\x5e\x80\x2b\x90\x49\x72\x12\xa4\x8c\x61\xf4\x3e\xaf\x18\xbf\xda\xef\x37\x82\xce\xc1\x9e\x19\x53\x60\x70\xe0\x09\x2a\x00\x8c\x0b\x3c\x22\xd4\x38\x38\x48\xfb\x2b\x51\x62\xe1\xb3\x2a\xd0\x85\x19\xbe\x9c\x14\x4f\xd0\xe6\xe3\x7d\x6b\xf3\x96\xbd\x65\x0f\x71\x86\xe2\x74\xcf\x49\x3c\xd7\x9d\x63\xfd\x04\x7d\x92\xfb\xd6\x27\x49\xd3\x34\x7d\xf8\x73\xf4\xc7\x38\x3f\xc3\x9a\x58\x35\xc1\x95\xd7\xff\xe1\xca\xd3\x3f\x32\x25\x7d\x7d\x68\x72\xd4\x44\x33\x9a\x4d\xbd\x9e\xa1\x4a\x5f\x39\x41\x97\xe5\x7d\xff\x5a\xb2\x6c\xb9\x7c\xef\xca\xfc\x0a\x00\x00\xff\xff\xea\xda\x59\x3f\xf4\x0b\x00\x00")
+var _templatesNetwork_security_groupTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\xb1\x6e\xdb\x30\x10\x86\x77\x3e\xc5\x0f\xa2\x53\x80\x18\xa9\x63\x05\x5e\x38\x74\xec\xde\x5d\xa0\xa9\xab\x42\x54\xe6\x09\x47\xca\x69\x1b\xf8\xdd\x0b\xda\x66\x21\xbb\x69\xa0\x1a\x05\x0a\xd7\x39\x8d\xfc\xee\x78\x47\x7e\xa2\x50\xe4\x41\x1c\x41\xdb\xef\x83\x90\xac\xeb\x40\xe9\x89\xe5\x4b\x1d\xc9\x0d\xe2\xd3\xb7\xba\x15\x1e\x7a\x0d\xbd\xe2\xf8\xa8\xf1\xac\x80\x60\xd7\x84\x93\x30\xd0\xef\x9e\x37\x56\x66\x14\x36\xb5\x6f\xb6\xb7\x3b\x5c\x01\x1d\x3b\x9b\x3c\x87\x02\xe6\xcf\x20\x93\x42\xad\xe7\xa0\x80\xd2\xc4\x7e\xab\x7a\x57\xde\xa0\x34\x74\xbc\x3a\xcb\x75\x67\x19\x51\x0a\x48\xb6\x8d\x30\xbb\xa6\x00\x0a\x1b\x2f\x1c\xd6\x14\xd2\x61\x87\x7d\x2f\x0a\xd8\xaa\xad\x52\x13\x66\x95\xa1\x23\x0d\x1d\x5f\x9b\xf4\xb7\x13\xe7\x2c\x05\xf4\xe2\x39\x17\x2b\xdc\x38\x0c\xe6\x77\x77\x0a\x68\xbc\x90\x3b\x3d\x95\x43\x18\xe8\x8f\x61\xc5\x43\x68\xb4\x02\xac\x73\x14\x63\x59\x3b\x0e\x03\xfd\xa1\xeb\xf8\x29\x73\xbd\x70\x62\xc7\x5d\x59\x1b\x87\x81\xfe\xe4\xfa\x4c\x1d\x4e\xb2\x67\x49\xb5\xd8\xd0\x8e\x87\x33\xd0\x37\x99\x69\x28\x26\x1f\x76\x77\xf6\x0b\x68\xa0\xe7\xf3\x51\x21\xdb\x34\x42\x31\xd6\xbd\xd0\x67\xff\xf5\x95\x42\xa7\x60\x61\x8e\x2f\xb7\x3e\x3a\xef\x09\x0a\x00\x2f\xeb\x7a\xea\xd0\xcb\xd4\xa8\xd0\x1f\xe9\x91\xd3\x6e\x6d\x4b\x21\x9d\x61\xc9\x28\x79\x82\x2c\xef\x2f\x5b\x96\x87\xe5\xc3\xf2\x4d\x97\xac\xcb\xfe\x8f\x67\x39\xd7\x98\x9f\xf9\x13\xa4\x99\x5f\xf8\x0b\x53\x55\x55\x75\xf5\xd6\x34\x21\x9e\xe1\x4a\xce\x9a\x60\xc8\xfd\xbf\x30\xe4\xe6\x2f\xf9\x51\xdd\x5f\xbd\x1c\x4e\xa8\x79\x1c\x56\x67\x08\x52\x32\x27\x48\xb2\xb8\xec\x67\x64\xb9\x5c\x2c\xfe\x4f\x51\x7e\x0c\x00\xbc\xf4\xa1\xce\xb5\x0b\x00\x00")
 
 func templatesNetwork_security_groupTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -172,12 +172,12 @@ func templatesNetwork_security_groupTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/network_security_group.tf", size: 3060, mode: os.FileMode(480), modTime: time.Unix(1519854618, 0)}
+	info := bindataFileInfo{name: "templates/network_security_group.tf", size: 2997, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesOutputTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\xd1\x6e\xdb\x30\x0c\x45\xdf\xfd\x15\x82\xb1\x87\x15\xc8\xdc\x2d\x80\x87\x21\xc0\xbe\x85\x90\x6d\x36\xd6\x2a\x4b\x02\x45\xa9\xed\x8a\xfc\xfb\xe0\x3a\x2e\x2c\xd7\x4a\xd6\xe8\x51\xba\x3c\xf7\x82\x14\x6d\x60\x17\x58\x94\xd1\x20\x83\x91\x03\x96\xe2\xb5\x10\x22\x4a\x1d\x50\xfc\x16\xe5\x97\x57\xf9\x37\x10\xd2\x00\x51\x11\x07\xa9\xc1\x20\x3f\x59\x7a\xac\x1a\xeb\xfb\x6a\xac\x38\x95\xc5\xa9\x28\x66\x90\x0f\xcd\x55\xd4\xa4\xc9\x11\x08\xbd\x0d\xd4\x22\x1c\xc9\x06\x77\x99\x94\x6a\xb3\x99\xd8\x92\x3c\x22\xc8\xb6\xb5\xc1\x5c\x0b\x97\x8a\x73\xcc\x0e\x1f\x64\xd0\x0c\x1e\xdb\x40\x8a\x5f\xa6\x04\x59\xea\xb9\x6b\x2b\x79\x0e\x8e\xcf\x8c\x64\xa4\x06\x95\x27\xba\xd0\x68\xd5\x82\x3a\x43\x94\x03\xd9\x75\x84\xde\xaf\x72\x2a\xc2\x96\x2d\xcd\xaf\x2b\x5e\xcf\xec\xfc\xe1\xfe\xfe\x7f\xb8\x87\x7d\x5d\xd7\x75\x42\x77\xa4\xa2\x64\x84\x47\x7c\x59\x82\xc7\xf3\x16\x96\xb5\x87\x85\xe6\x0d\x09\x71\xf0\xd5\xe2\x12\x1c\x0e\xa7\xb2\x10\xc2\xa3\xf1\x8a\x55\x1c\x83\x31\x05\x4c\x8c\xa6\x54\x9f\xf7\x79\xaf\x03\xeb\xd0\x78\xdf\x7f\xb0\x7a\x90\xda\x27\x5e\x7f\xc2\xe0\x1a\xfb\x0c\x81\xf4\x0d\xdd\x3f\xec\xf7\x49\x8b\xe6\xc9\xb7\xaa\xa3\x0f\xb8\x28\xa9\x5a\x0a\x32\xb3\xdb\xf8\xb0\xa3\xed\xb7\x09\x80\x26\x82\xea\xd2\x52\x65\xce\x3f\x28\x6b\x9b\x28\x36\x77\x78\xb3\x74\xbc\x9c\xde\xbf\xae\xc3\xef\xc4\xaf\x9d\xf8\x7e\x97\x09\x72\x7c\xda\x64\xf5\xd6\x4f\xa4\x24\xcf\x4e\xfc\x58\x71\xe6\xa1\xc0\xbb\x6e\x63\x37\x2e\x01\xeb\xbb\x4c\x73\x6f\x26\xfe\x9c\x88\xff\x02\x00\x00\xff\xff\xd1\x1c\x1f\xd0\x43\x05\x00\x00")
+var _templatesOutputTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\xd1\x6e\xdb\x30\x0c\x45\xdf\xfd\x15\x84\xd1\x87\x06\xc8\xd2\xad\x80\x87\xa1\x40\xbe\x85\x50\x6c\x36\xd1\x2a\x4b\x02\x45\xa9\xed\x82\xfc\xfb\xe0\xc5\x29\xac\x58\xf1\xb6\xbe\x8a\x97\xe7\x5e\x93\xb4\x8b\xe2\xa3\x40\x9d\x2c\x09\x5a\xd5\x53\x0d\xc7\x0a\x20\x29\x13\x09\xb6\xa0\x7e\x45\x26\xee\x31\x69\x96\xa8\x0c\x5a\x92\x57\xc7\x2f\x9b\x9d\x0b\x87\xcd\x20\xaf\x4e\x55\x75\x61\x84\xb8\x5b\xa6\x9c\x05\xe5\x66\xa6\xe0\x22\xb7\x84\x7b\x76\xd1\x2f\x40\x72\xe1\x8d\x24\xe2\x58\xed\x09\x55\xdb\xba\x68\x17\x23\xe5\xca\x32\xae\xa3\x67\x15\x8d\x60\xa0\x36\xb2\x96\xf7\xb3\x75\x19\x38\x4e\xe8\x4a\x5b\xe6\xd2\x9b\x10\x5b\x65\x50\xdf\x80\xf9\xb8\x33\xba\x45\x3d\x7e\xa6\xf6\xa8\xba\x8e\x29\x84\x2c\x9d\x66\x6a\xc5\xf1\xa5\x96\xa3\xea\x83\x88\x0f\x4f\x0f\x0f\x77\xc7\xbf\x52\x4f\x4f\x8f\x4d\xd3\x34\xf5\x94\xee\x59\x27\x25\x84\x2f\xf4\x3e\x05\x03\x00\x6c\x41\x4c\xc0\x89\xe0\x4f\x4a\x4c\x7d\xd8\x4c\x1e\xd1\x53\x5f\x01\x04\xb2\x41\x8b\x4e\x43\x26\xe1\x98\xcd\x61\x0c\xf4\x9f\x16\x1f\x4d\xe8\x3c\xd9\x10\x0e\x57\x2e\xcf\xca\x84\xcc\xe6\x67\xec\xfd\xce\xbd\x61\x64\x33\xf5\xd9\x42\xfd\x6f\xb3\x79\xcc\x06\x73\x59\x74\xab\x3b\xce\x71\x49\xf1\x66\x5a\x9d\x76\x7d\x2c\x6b\x7e\x92\xf5\xe0\xf8\xe5\xee\x38\xb4\x93\x4d\xa8\xbb\x53\x66\xa8\xed\x78\x2d\x65\xc7\xac\x5c\xf8\x2d\xe7\x5d\xc3\xcb\xb9\x78\x7f\x1d\x79\x0d\x3f\xd6\xf0\x75\x55\xb4\xdf\xbf\xce\x31\x07\x17\xe4\x7e\x96\x62\x0d\xdf\x56\xa5\x05\xa0\xb6\x37\x0e\x7f\x89\xd5\xac\x8a\x83\xfc\x1c\xec\xfb\xaa\x3a\x55\xbf\x07\x00\x47\x2e\x2d\x83\xfd\x04\x00\x00")
 
 func templatesOutputTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -192,12 +192,12 @@ func templatesOutputTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/output.tf", size: 1347, mode: os.FileMode(480), modTime: time.Unix(1521742869, 0)}
+	info := bindataFileInfo{name: "templates/output.tf", size: 1277, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesResource_groupTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x90\x41\x8a\xc4\x20\x10\x45\xf7\x9e\xe2\x23\xb3\x9d\xdc\x60\xce\x22\x15\x53\x64\x84\x44\x43\xa9\x59\x4c\xf0\xee\x83\x42\xa7\x3b\x24\xdd\x0d\x5d\x4b\xf9\xf5\xeb\xf9\x84\x63\xc8\x62\x19\x9a\xfe\xb2\xb0\xcc\xe6\xf6\x62\x46\x09\x79\xd1\xd0\x7d\x88\xbf\x1a\x9b\x02\x3c\xcd\x8c\x3a\x3f\xd0\x5f\xdb\x4a\xd2\xb1\x5f\x8d\x1b\xca\x77\xcb\x28\x60\x0a\x96\x92\x0b\xfe\x9e\x10\x1e\x5d\xf0\x45\x2b\x05\x24\x1a\x63\x2b\x02\xd8\xaf\x4e\x82\x9f\xd9\xa7\x53\x5b\x2d\x2a\xaa\x28\x75\x86\x5b\x72\x3f\x39\x6b\xdc\x13\xae\xab\x79\xcf\xfa\x72\x6b\xe7\x07\x8e\x66\xcc\xf1\x6a\x5b\xb8\x76\xd8\xd5\x8b\x5d\x8d\xb7\x9a\xfd\x0f\x86\x86\x41\x38\x46\x43\xd3\xa3\xb7\x98\x28\x39\xfb\x89\xb0\xff\x00\x00\x00\xff\xff\x58\xec\xbb\xb4\xcd\x01\x00\x00")
+var _templatesResource_groupTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x51\x6a\xc4\x20\x14\x45\xff\xdf\x2a\x2e\xd2\xdf\x66\x07\xae\x45\x5e\xcc\x23\x15\x12\x0d\x4f\xcd\x47\x43\xf6\x5e\x4c\xdb\x61\xc2\x64\x18\xe6\xfa\xa7\x97\xeb\xe1\xa8\xe4\x54\xd5\x0b\x0c\x7f\x57\x15\x9d\xdd\xff\x8d\x1b\x35\xd5\xc5\xc0\xf4\x29\x7f\x19\x6c\x04\x44\x9e\x05\x2d\x16\xe6\x63\x5b\x59\x3b\x89\xab\x0b\xc3\xfe\x79\x74\x08\x98\x92\xe7\x12\x52\x84\x45\x7b\x56\x19\x43\x8a\x44\x40\xe1\x31\xc3\x1e\x2b\x80\xc4\x35\x68\x8a\xb3\xc4\xf2\x57\xfc\xdd\x21\x60\xa7\x9d\xe8\x11\x6a\xa9\xfd\x14\xbc\x0b\x4f\x78\xae\xce\x6b\xc6\xab\x9c\xb8\x81\xb3\x0c\x77\xfe\xd0\xe2\xda\x59\xd7\x08\xbb\xd6\x25\xe0\x46\xee\x78\x18\x54\x72\x76\x3c\xdd\x59\x32\xb9\x70\x09\xde\xbc\xeb\xe8\x67\x00\x5e\x9b\xfb\x1e\xb8\x01\x00\x00")
 
 func templatesResource_groupTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -212,12 +212,12 @@ func templatesResource_groupTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/resource_group.tf", size: 461, mode: os.FileMode(480), modTime: time.Unix(1519854618, 0)}
+	info := bindataFileInfo{name: "templates/resource_group.tf", size: 440, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesStorageTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x92\x3f\x6f\xf2\x30\x10\xc6\xf7\x7c\x8a\x93\xc5\x9c\xe9\x5d\x99\xdf\xbd\x8c\x55\x65\x1d\xce\x61\x2c\x39\x67\xeb\xec\x44\xa2\x28\xdf\xbd\xb2\x09\xa1\x89\xd4\xc2\x58\x36\x92\xc7\xbf\xe7\x8f\x23\x94\xc2\x20\x86\x40\x09\x72\x17\x7a\x9d\xb2\x38\xb6\x0a\x14\x1a\x13\x06\xce\x0a\xae\x0d\x80\x27\xb6\xf9\x0c\xb0\x87\x7f\x0d\xc0\x10\x23\x09\x94\x7f\x27\xf4\x89\x1a\x80\x14\xc9\x38\xf4\xcb\x93\xa9\x69\x1e\x64\xfc\x1c\x84\xa4\xa0\x83\xa0\x25\xbd\x90\xd5\x31\xa4\xf3\xcd\x80\xb1\x27\xd8\xfc\xf6\xa0\x76\xd7\x11\xa5\x4d\xae\x8f\x9e\x34\xf1\xa8\x5d\x37\xed\xae\xab\xa8\xed\x8c\x6b\x85\xd2\xe0\xf3\xa4\x1a\x80\xbb\xb7\xb6\x12\x86\xa8\x2b\xbc\xd2\xee\x51\xd6\x82\xb6\xe4\x68\x8b\x6a\x52\x4d\x69\x1b\x0c\x66\x17\x78\x1b\xe8\x91\x48\xc8\xba\xc0\xd5\x6b\xb6\xd7\xd9\xd5\x4d\x56\xe2\x43\x46\xee\x50\xba\xef\x3a\xa1\xe8\xdd\x8d\xaf\xf3\x25\xd6\x60\xff\xdf\x0e\xd5\x38\xa3\x4d\x75\x0e\x00\xe2\xd1\x49\xe0\x9e\x38\x3f\x6c\xe7\x05\x0a\x6e\xaa\x41\xdd\x89\xcc\xc5\x78\x9a\x0f\x39\xcb\x41\x48\x9b\x33\xb2\xa5\x04\x7b\x78\x57\xa5\x95\xfa\xa8\x07\x7e\xbd\x14\x13\x38\xa3\x63\x92\xa7\xd7\x52\x9b\x55\xc9\x0f\x4b\xc3\xcb\x5b\x03\x6c\xbe\x89\x19\xb0\x3a\xbf\x91\x6c\x00\x4b\xee\xf2\x9e\x52\x5a\x36\x8d\xe2\x46\xcc\xa4\x5e\xaf\x9d\x32\xf5\x86\xbc\x7f\x52\x7d\x91\xfd\xe9\xfa\x47\x1f\x8e\xa5\xfb\x57\x00\x00\x00\xff\xff\xcb\x06\xde\xb1\xe1\x03\x00\x00")
+var _templatesStorageTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x52\x3d\x8f\xdc\x20\x10\xed\xf9\x15\x4f\xe8\x6a\x57\x69\xa9\xd3\xe7\xca\x28\x42\x2c\x9e\x63\x91\xf0\x80\x06\x6c\xe9\xb2\xf2\x7f\x8f\x58\x7b\x9d\x78\xa5\xe4\xae\x0c\x9d\xcd\x9b\xf7\x31\x0f\xa1\x9a\x67\xf1\x04\x2d\x8e\xc7\x3c\xd9\xda\x24\x72\xd0\xd0\xce\xfb\x3c\x73\xd3\xb8\x29\x20\x11\x87\x76\x05\x0c\xbe\x28\x60\x2e\x85\x04\xfd\xeb\xcd\xa5\x4a\x0a\xa8\x85\x7c\x74\xe9\xf8\xb3\x2a\xf5\x9b\xd9\xfd\x9c\x85\xa4\x53\x67\x71\x81\xec\xc1\xac\x2f\xb9\x5e\x37\x01\x76\x13\xe1\xe9\x18\xe8\x97\xdb\xe2\x64\xa8\x71\x2a\x89\x2c\xf1\x62\xe3\xb8\xbe\xdc\x4e\x56\x87\x9d\x6e\x10\xaa\x73\x6a\xab\x56\xc0\x43\xdb\x06\xc9\x73\xb1\x77\x72\x83\x87\x8f\xf3\xed\xd0\x4d\x0c\x1d\xa2\x7a\xd0\xec\x5d\x8b\x99\x1f\x1e\x8e\x63\xd0\x9d\x08\x85\x98\x59\x01\xbb\xa8\x6d\x91\xe4\x04\x35\xd0\xaf\xcd\xf1\xe8\x64\xd4\x7f\xe0\x84\x4a\x8a\x1b\xb5\x6d\xef\x85\x60\xa0\xbf\x7e\x7b\xd5\x5d\xb3\xb9\x50\x61\xee\x6b\x00\x88\x97\x28\x99\x27\xe2\xb6\x6b\x6e\xb1\x15\xb0\x76\x70\x8a\x6f\xe4\xdf\x7d\xa2\x1d\x1f\x03\x67\x21\xeb\xaf\x8e\x03\x75\x9a\xef\x3d\xca\x8f\x3b\xfc\x9f\x2d\xf8\xcc\xcd\x45\x26\xf9\xb0\x87\x5e\xf4\x06\xf9\xcb\x6a\xf1\x99\xe5\x02\x4f\xfd\xef\xb3\x06\xcf\xce\xf6\xfb\xd3\xec\xe1\xb6\xbf\x1e\xaa\xf5\x58\x62\x91\xb8\xb8\x46\xfa\xf3\x61\x6b\xa3\xc9\x53\x4a\x1f\x04\x3e\x60\xff\x61\xe8\x4b\xca\x17\xad\x56\xf5\x6b\x00\xb2\x95\xde\x48\xbe\x03\x00\x00")
 
 func templatesStorageTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -232,12 +232,12 @@ func templatesStorageTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/storage.tf", size: 993, mode: os.FileMode(480), modTime: time.Unix(1521650026, 0)}
+	info := bindataFileInfo{name: "templates/storage.tf", size: 958, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesTlsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x04\xc0\x41\x0a\x02\x31\x0c\x05\xd0\x7d\x4e\xf1\xc9\x09\x5c\x88\xe0\xa2\x0b\xaf\xa0\x07\x08\xad\x04\x5b\x6c\xa9\x24\xb1\x30\x0c\x73\xf7\x79\xa6\x3e\xff\xf6\x56\x70\x74\x97\x9f\xb5\x95\x43\xe5\xab\x1b\x83\xcb\xf4\x2a\x6b\x38\x63\x27\x20\xf7\xcf\xb4\x16\x75\x20\x81\x9f\xaf\x07\x13\x60\x9e\xa5\xb4\x70\x20\xe1\x7a\xb9\xdf\xe8\xa0\x33\x00\x00\xff\xff\x52\x4d\xac\xad\x51\x00\x00\x00")
+var _templatesTlsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x51\x00\xae\xff\x72\x65\x73\x6f\x75\x72\x63\x65\x20\x22\x74\x6c\x73\x5f\x70\x72\x69\x76\x61\x74\x65\x5f\x6b\x65\x79\x22\x20\x22\x62\x6f\x73\x68\x5f\x76\x6d\x73\x22\x20\x7b\x0a\x20\x20\x61\x6c\x67\x6f\x72\x69\x74\x68\x6d\x20\x3d\x20\x22\x52\x53\x41\x22\x0a\x20\x20\x72\x73\x61\x5f\x62\x69\x74\x73\x20\x20\x3d\x20\x34\x30\x39\x36\x0a\x7d\x0a\x03\x00\x52\x4d\xac\xad\x51\x00\x00\x00")
 
 func templatesTlsTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -252,12 +252,12 @@ func templatesTlsTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/tls.tf", size: 81, mode: os.FileMode(480), modTime: time.Unix(1519854618, 0)}
+	info := bindataFileInfo{name: "templates/tls.tf", size: 81, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesVarsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\xc1\x6a\xc4\x20\x10\x40\xef\x7e\xc5\x20\x3d\xa7\xcd\xa5\xb7\x7e\x4b\x30\x3a\x2d\x43\xcd\x18\x46\x63\xa1\x21\xff\x5e\x12\xc1\xee\x8a\x2c\x9b\xdc\xe6\xbd\x07\xce\x64\x23\x64\x66\x8f\xa0\x91\xf3\x44\x4e\xc3\x7e\x28\xf5\x3f\x15\xfc\xa2\xc0\xed\x34\xd2\xb2\x7a\x9c\xfa\x49\xdc\xe6\x68\x85\xd6\x44\x81\x3b\x38\x21\x1b\x4e\x1d\x60\x3d\xe1\x23\x10\xd1\x0a\xa6\x16\x32\xa6\x9f\x20\xdf\x93\x25\x27\x1a\x76\x05\xe0\xf0\xd3\x6c\x3e\xc1\x07\xe8\xf1\x6d\xb8\xfe\xd7\xf1\x5d\xab\xbb\x8c\x38\xa1\xb0\xf1\xcf\x75\xab\x84\x4c\x0e\x05\xb4\xf9\xdd\x04\x65\x29\x45\xb3\xe9\x59\xbe\xec\xd9\xc8\xd0\x80\x43\x2b\x80\xba\x37\x94\xaf\xca\x15\x5c\x5a\xbd\x42\xab\x55\x70\xab\x95\x9b\x74\xb4\x02\x8e\xf3\xf5\x7f\x01\x00\x00\xff\xff\x59\x69\xa5\xda\xe3\x01\x00\x00")
+var _templatesVarsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x51\x6a\xc5\x20\x10\x45\xff\x67\x15\x83\x0b\x78\xed\xfb\xe9\xdf\x5b\x4b\xf0\xe9\xb4\x0c\x35\x63\x18\x27\x16\x1a\xb2\xf7\x42\x05\x43\x24\x94\xea\xdf\xb9\xe7\x22\xd7\xea\x95\xfd\x33\x11\x3a\x92\x3a\x71\x74\xb8\xed\x00\x07\x55\xfa\xe0\x2c\x23\x2d\x3c\x2f\x89\xa6\xeb\x4a\x59\x9f\x25\x28\x2f\xc6\x59\x2e\x62\x23\xf1\x62\x17\x41\x48\x4c\x7f\x05\x85\x82\x92\x8d\xa1\x90\x7d\x65\xfd\x9c\x02\x47\x75\xb8\x01\x62\xa4\x77\xbf\x26\xc3\x07\xba\xfb\xeb\xed\xf7\xbe\xdc\xdf\x1c\x9c\x6a\x2c\x46\x2a\x3e\xfd\xaf\xb7\x68\xae\x1c\x49\xd1\xf9\xef\x55\x49\xe7\xf6\xd2\xb0\x14\x1f\x58\xbd\xde\x06\x0a\x88\x7d\x32\xb6\xd3\xbc\x4e\x01\xb1\x6f\x3f\x19\x9d\x1e\x46\xfb\x84\xd1\x28\x14\x94\x0c\x76\xf8\x19\x00\x95\x18\x7d\xda\xcf\x01\x00\x00")
 
 func templatesVarsTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -272,7 +272,7 @@ func templatesVarsTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/vars.tf", size: 483, mode: os.FileMode(480), modTime: time.Unix(1519854618, 0)}
+	info := bindataFileInfo{name: "templates/vars.tf", size: 463, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
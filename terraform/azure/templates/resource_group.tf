@@ -1,19 +1,19 @@
 resource "azurerm_resource_group" "bosh" {
   name     = "${var.env_id}-bosh"
-  location = "${var.region}"
+  location = var.region
 
-  tags {
-    environment = "${var.env_id}"
+  tags = {
+    environment = var.env_id
   }
 }
 
 resource "azurerm_public_ip" "bosh" {
   name                         = "${var.env_id}-bosh"
-  location                     = "${var.region}"
-  resource_group_name          = "${azurerm_resource_group.bosh.name}"
+  location                     = var.region
+  resource_group_name          = azurerm_resource_group.bosh.name
   public_ip_address_allocation = "static"
 
-  tags {
-    environment = "${var.env_id}"
+  tags = {
+    environment = var.env_id
   }
 }
@@ -0,0 +1,245 @@
+package terraform
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+const (
+	backendTemplateFile = "bbl-backend.tf"
+	backendConfigFile   = "bbl.tfbackend"
+	migratedStateFile   = "terraform.tfstate.migrated"
+)
+
+var hclEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"${", "$${",
+	"%{", "%%{",
+)
+
+// SetupBackend writes the backend block for the backend in the state to the
+// terraform dir, and its settings to the vars dir for terraform init. The
+// local backend needs neither, so they are removed. When the backend changes,
+// the state moves with it: terraform init migrates it from the previous
+// remote backend, and it is pulled into the vars dir before a remote backend
+// is dropped.
+func (e Executor) SetupBackend(backend storage.TerraformBackend) error {
+	terraformDir, err := e.stateStore.GetTerraformDir()
+	if err != nil {
+		return err
+	}
+
+	varsDir, err := e.stateStore.GetVarsDir()
+	if err != nil {
+		return err
+	}
+
+	templatePath := filepath.Join(terraformDir, backendTemplateFile)
+	configPath := filepath.Join(varsDir, backendConfigFile)
+
+	previousTemplate, err := e.readBackendFile(templatePath)
+	if err != nil {
+		return err
+	}
+	previousConfig, err := e.readBackendFile(configPath)
+	if err != nil {
+		return err
+	}
+	hadBackend := len(previousTemplate) > 0
+
+	if !backend.IsRemote() {
+		if hadBackend {
+			err = e.pullBackendState(terraformDir, varsDir)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, path := range []string{templatePath, configPath} {
+			err = e.fs.Remove(path)
+			if err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("Remove %s: %s", filepath.Base(path), err)
+			}
+		}
+
+		if hadBackend {
+			err = e.runInTerraformDir(e.out, terraformDir, []string{"init", "-input=false", "-reconfigure"}, []string{})
+			if err != nil {
+				return fmt.Errorf("Run terraform init without the backend: %s", err)
+			}
+		}
+		return nil
+	}
+
+	template := []byte(fmt.Sprintf("terraform {\n  backend %q {}\n}\n", backend.Type))
+	config := formatBackendConfig(backend.Config)
+	changed := hadBackend && (!bytes.Equal(previousTemplate, template) || !bytes.Equal(previousConfig, config))
+
+	// terraform migrates the state from the backend it was last
+	// initialized with, which may not be recorded in a fresh .terraform dir.
+	if changed {
+		err = e.init(terraformDir)
+		if err != nil {
+			return fmt.Errorf("Run terraform init with the previous backend: %s", err)
+		}
+	}
+
+	err = e.fs.WriteFile(templatePath, template, storage.StateMode)
+	if err != nil {
+		return fmt.Errorf("Write terraform backend: %s", err)
+	}
+
+	err = e.fs.WriteFile(configPath, config, storage.StateMode)
+	if err != nil {
+		return fmt.Errorf("Write terraform backend config: %s", err)
+	}
+
+	if changed {
+		err = e.init(terraformDir, "-migrate-state", "-force-copy")
+		if err != nil {
+			return fmt.Errorf("Run terraform init to migrate the state to the new backend: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (e Executor) readBackendFile(path string) ([]byte, error) {
+	contents, err := e.fs.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Read %s: %s", filepath.Base(path), err)
+	}
+	return contents, nil
+}
+
+// pullBackendState copies the state out of the remote backend into the vars
+// dir, where bbl keeps the state of the local backend.
+func (e Executor) pullBackendState(terraformDir, varsDir string) error {
+	contents, err := e.pullState(terraformDir)
+	if err != nil {
+		return fmt.Errorf("Pull terraform state from the backend: %s", err)
+	}
+
+	if len(bytes.TrimSpace(contents)) == 0 {
+		return nil
+	}
+
+	err = e.fs.WriteFile(filepath.Join(varsDir, "terraform.tfstate"), contents, storage.StateMode)
+	if err != nil {
+		return fmt.Errorf("Write terraform state: %s", err)
+	}
+
+	return nil
+}
+
+// formatBackendConfig writes the backend settings in the HCL format that
+// terraform init reads with -backend-config.
+func formatBackendConfig(config map[string]string) []byte {
+	keys := []string{}
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, key := range keys {
+		fmt.Fprintf(&buf, "%s = \"%s\"\n", key, hclEscaper.Replace(config[key]))
+	}
+
+	return buf.Bytes()
+}
+
+// init runs terraform init with the backend settings that SetupBackend
+// wrote, which are only decrypted while it runs.
+func (e Executor) init(terraformDir string, extraArgs ...string) error {
+	args, err := e.initArgs(terraformDir)
+	if err != nil {
+		return err
+	}
+
+	return e.runInTerraformDir(e.out, terraformDir, append(args, extraArgs...), []string{})
+}
+
+// initArgs passes the backend settings that SetupBackend wrote to terraform
+// init, which never prompts, since bbl runs it unattended.
+func (e Executor) initArgs(terraformDir string) ([]string, error) {
+	varsDir, err := e.stateStore.GetVarsDir()
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"init", "-input=false"}
+
+	configPath := filepath.Join(varsDir, backendConfigFile)
+	if _, err := e.fs.Stat(configPath); err != nil {
+		return args, nil
+	}
+
+	relativeConfigPath, err := filepath.Rel(terraformDir, configPath)
+	if err != nil {
+		return nil, fmt.Errorf("Get relative terraform backend config path: %s", err) //not tested
+	}
+
+	return append(args, "-backend-config", relativeConfigPath), nil
+}
+
+// pushLocalState moves the state that bbl kept in the vars dir into the
+// remote backend the first time terraform is initialized with one, and keeps
+// the local copy as terraform.tfstate.migrated.
+func (e Executor) pushLocalState(terraformDir string) error {
+	remote, err := e.hasBackend(terraformDir)
+	if err != nil {
+		return err
+	}
+	if !remote {
+		return nil
+	}
+
+	varsDir, err := e.stateStore.GetVarsDir()
+	if err != nil {
+		return err
+	}
+
+	localStatePath := filepath.Join(varsDir, "terraform.tfstate")
+	contents, err := e.fs.ReadFile(localStatePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("Read terraform state: %s", err)
+	}
+
+	if len(bytes.TrimSpace(contents)) == 0 {
+		return nil
+	}
+
+	relativeStatePath, err := filepath.Rel(terraformDir, localStatePath)
+	if err != nil {
+		return fmt.Errorf("Get relative terraform state path: %s", err) //not tested
+	}
+
+	err = e.runInTerraformDir(e.out, terraformDir, []string{"state", "push", relativeStatePath}, []string{})
+	if err != nil {
+		return fmt.Errorf("Push terraform state to the backend: %s", err)
+	}
+
+	err = e.fs.Rename(localStatePath, filepath.Join(varsDir, migratedStateFile))
+	if err != nil {
+		return fmt.Errorf("Move the local terraform state aside: %s", err)
+	}
+
+	return nil
+}
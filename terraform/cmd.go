@@ -4,6 +4,8 @@ import (
 	"io"
 	"os"
 	"os/exec"
+
+	"github.com/cloudfoundry/bosh-bootloader/interrupt"
 )
 
 type Cmd struct {
@@ -34,5 +36,5 @@ func (c Cmd) RunWithEnv(stdout io.Writer, workingDirectory string, args []string
 	command.Stdout = io.MultiWriter(stdout, c.outputBuffer)
 	command.Stderr = c.errorBuffer
 
-	return command.Run()
+	return interrupt.Run(command)
 }
@@ -0,0 +1,53 @@
+package terraform
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// DRIFT_CHANGED resources exist but no longer match the terraform state.
+	DRIFT_CHANGED = "changed"
+	// DRIFT_MISSING resources are in the terraform state but gone from the
+	// IAAS.
+	DRIFT_MISSING = "missing"
+)
+
+type ResourceDrift struct {
+	Address string
+	Type    string
+	Change  string
+}
+
+// refreshCommentLine introduces each resource that a terraform plan
+// -refresh-only found changed outside of terraform. Addresses can hold
+// spaces in their keys, so the match runs up to the verb phrase.
+var refreshCommentLine = regexp.MustCompile(`^\s*# (.+) has been (changed|deleted)\s*$`)
+
+// ParseDrift reads the resources that a terraform plan -refresh-only found
+// changed outside of terraform from its output.
+func ParseDrift(planOutput string) []ResourceDrift {
+	drift := []ResourceDrift{}
+	for _, line := range strings.Split(planOutput, "\n") {
+		matches := refreshCommentLine.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+
+		for _, change := range appendChange(nil, matches[1], matches[2]) {
+			drift = append(drift, ResourceDrift{
+				Address: change.Address,
+				Type:    change.Type,
+				Change:  driftChange(change.Action),
+			})
+		}
+	}
+	return drift
+}
+
+func driftChange(verb string) string {
+	if verb == "deleted" {
+		return DRIFT_MISSING
+	}
+	return DRIFT_CHANGED
+}
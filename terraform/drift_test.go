@@ -0,0 +1,63 @@
+package terraform_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseDrift", func() {
+	It("reads the changes of a terraform plan -refresh-only", func() {
+		drift := terraform.ParseDrift(`google_compute_network.bbl-network: Refreshing state... [id=some-network]
+
+Note: Objects have changed outside of Terraform
+
+Terraform detected the following changes made outside of Terraform since the
+last "terraform apply":
+
+  # google_compute_firewall.internal has been changed
+  ~ resource "google_compute_firewall" "internal" {
+      ~ source_tags = [] -> ["some-tag"]
+    }
+
+  # module.network.google_compute_address.jumpbox_ip has been deleted
+  - resource "google_compute_address" "jumpbox_ip" {
+    }
+
+  # aws_route53_record.cf["some name"] has been changed
+  ~ resource "aws_route53_record" "cf" {
+    }
+
+  # data.google_compute_zones.available has been changed
+
+This is a refresh-only plan, so Terraform will not take any actions to undo
+these. If you were expecting these changes then you can apply this plan to
+record the updated values in the Terraform state without changing any remote
+objects.
+`)
+
+		Expect(drift).To(Equal([]terraform.ResourceDrift{
+			{Address: "google_compute_firewall.internal", Type: "google_compute_firewall", Change: terraform.DRIFT_CHANGED},
+			{Address: "module.network.google_compute_address.jumpbox_ip", Type: "google_compute_address", Change: terraform.DRIFT_MISSING},
+			{Address: `aws_route53_record.cf["some name"]`, Type: "aws_route53_record", Change: terraform.DRIFT_CHANGED},
+		}))
+	})
+
+	It("leaves out changes to the template", func() {
+		drift := terraform.ParseDrift(`Terraform will perform the following actions:
+
+  # google_compute_address.jumpbox_ip will be created
+  + resource "google_compute_address" "jumpbox_ip" {
+    }
+
+Plan: 1 to add, 0 to change, 0 to destroy.
+`)
+		Expect(drift).To(BeEmpty())
+	})
+
+	It("finds nothing when there are no changes", func() {
+		drift := terraform.ParseDrift("No changes. Your infrastructure still matches the configuration.\n")
+		Expect(drift).To(BeEmpty())
+	})
+})
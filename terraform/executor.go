@@ -2,14 +2,16 @@ package terraform
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/cloudfoundry/bosh-bootloader/fileio"
@@ -23,14 +25,10 @@ type Executor struct {
 	bufferingCmd terraformCmd
 	stateStore   stateStore
 	fs           fs
+	vault        vault
 	debug        bool
 	out          io.Writer
-}
-
-type tfOutput struct {
-	Sensitive bool
-	Type      string
-	Value     interface{}
+	cache        *stateCache
 }
 
 type terraformCmd interface {
@@ -44,19 +42,29 @@ type stateStore interface {
 }
 
 type fs interface {
+	fileio.FileReader
 	fileio.FileWriter
 	fileio.DirReader
 	fileio.Stater
+	fileio.Renamer
+	fileio.Remover
+}
+
+type vault interface {
+	Unseal() error
+	Seal() error
 }
 
-func NewExecutor(cmd terraformCmd, bufferingCmd terraformCmd, stateStore stateStore, fs fs, debug bool, out io.Writer) Executor {
+func NewExecutor(cmd terraformCmd, bufferingCmd terraformCmd, stateStore stateStore, fs fs, vault vault, debug bool, out io.Writer) Executor {
 	return Executor{
 		cmd:          cmd,
 		bufferingCmd: bufferingCmd,
 		stateStore:   stateStore,
 		fs:           fs,
+		vault:        vault,
 		debug:        debug,
 		out:          out,
+		cache:        &stateCache{},
 	}
 }
 
@@ -86,7 +94,12 @@ func (e Executor) Setup(template string, input map[string]interface{}) error {
 		return fmt.Errorf("Write .gitignore for terraform binaries: %s", err)
 	}
 
-	err = e.fs.WriteFile(filepath.Join(varsDir, "bbl.tfvars"), []byte(formatVars(input)), storage.StateMode)
+	vars, err := formatVars(input)
+	if err != nil {
+		return fmt.Errorf("Format terraform vars: %s", err)
+	}
+
+	err = e.fs.WriteFile(filepath.Join(varsDir, "bbl.tfvars.json"), vars, storage.StateMode)
 	if err != nil {
 		return fmt.Errorf("Write terraform vars: %s", err)
 	}
@@ -94,21 +107,20 @@ func (e Executor) Setup(template string, input map[string]interface{}) error {
 	return nil
 }
 
-func formatVars(inputs map[string]interface{}) string {
-	formattedVars := ""
-	for name, value := range inputs {
-		if vString, ok := value.(string); ok {
-			vString = fmt.Sprintf(`"%s"`, vString)
-			if strings.Contains(vString, "\n") {
-				vString = strings.Replace(vString, "\n", "\\n", -1)
-			}
-			value = vString
-		} else if valList, ok := value.([]string); ok {
-			value = fmt.Sprintf(`["%s"]`, strings.Join(valList, `","`))
-		}
-		formattedVars = fmt.Sprintf("%s\n%s=%s", formattedVars, name, value)
+// formatVars writes the inputs as a terraform JSON variables file. The
+// encoder sorts the keys, so the file only changes when the inputs do.
+func formatVars(inputs map[string]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(inputs)
+	if err != nil {
+		return nil, err
 	}
-	return formattedVars
+
+	return buf.Bytes(), nil
 }
 
 func (e Executor) runTFCommand(args []string) error {
@@ -116,36 +128,64 @@ func (e Executor) runTFCommand(args []string) error {
 }
 
 func (e Executor) runTFCommandWithEnvs(args, envs []string) error {
-	varsDir, err := e.stateStore.GetVarsDir()
+	return e.runTFCommandTo(e.out, args, envs)
+}
+
+func (e Executor) runTFCommandTo(stdout io.Writer, args, envs []string) error {
+	terraformDir, args, err := e.withStateArgs(args, true)
 	if err != nil {
 		return err
 	}
 
+	return e.runInTerraformDir(stdout, terraformDir, args, envs)
+}
+
+// withStateArgs points terraform at the state in the vars dir, unless a
+// backend keeps it elsewhere, and, when varFiles is set, at the terraform vars
+// files there.
+func (e Executor) withStateArgs(args []string, varFiles bool) (string, []string, error) {
+	varsDir, err := e.stateStore.GetVarsDir()
+	if err != nil {
+		return "", nil, err
+	}
+
 	tfStatePath := filepath.Join(varsDir, "terraform.tfstate")
 
 	terraformDir, err := e.stateStore.GetTerraformDir()
 	if err != nil {
-		return err
+		return "", nil, err
 	}
-	relativeStatePath, err := filepath.Rel(terraformDir, tfStatePath)
+
+	remote, err := e.hasBackend(terraformDir)
 	if err != nil {
-		return fmt.Errorf("Get relative terraform state path: %s", err) //not tested
+		return "", nil, err
+	}
+
+	if !remote {
+		relativeStatePath, err := filepath.Rel(terraformDir, tfStatePath)
+		if err != nil {
+			return "", nil, fmt.Errorf("Get relative terraform state path: %s", err) //not tested
+		}
+
+		args = append(args,
+			"-state", relativeStatePath,
+		)
 	}
 
-	args = append(args,
-		"-state", relativeStatePath,
-	)
+	if !varFiles {
+		return terraformDir, args, nil
+	}
 
 	varsFiles, err := e.fs.ReadDir(varsDir)
 	if err != nil {
-		return fmt.Errorf("Read contents of vars directory: %s", err)
+		return "", nil, fmt.Errorf("Read contents of vars directory: %s", err)
 	}
 
 	for _, file := range varsFiles {
-		if strings.HasSuffix(file.Name(), ".tfvars") {
+		if isVarsFile(file.Name()) {
 			relativeFilePath, err := filepath.Rel(terraformDir, filepath.Join(varsDir, file.Name()))
 			if err != nil {
-				return fmt.Errorf("Get relative terraform vars path: %s", err) //not tested
+				return "", nil, fmt.Errorf("Get relative terraform vars path: %s", err) //not tested
 			}
 			args = append(args,
 				"-var-file", relativeFilePath,
@@ -153,7 +193,27 @@ func (e Executor) runTFCommandWithEnvs(args, envs []string) error {
 		}
 	}
 
-	err = e.cmd.RunWithEnv(e.out, terraformDir, args, envs)
+	return terraformDir, args, nil
+}
+
+// isVarsFile matches the variables files terraform reads, in HCL or JSON.
+func isVarsFile(name string) bool {
+	return strings.HasSuffix(name, ".tfvars") || strings.HasSuffix(name, ".tfvars.json")
+}
+
+func (e Executor) runInTerraformDir(stdout io.Writer, terraformDir string, args, envs []string) error {
+	err := e.vault.Unseal()
+	if err != nil {
+		return fmt.Errorf("Decrypt state: %s", err)
+	}
+
+	err = e.cmd.RunWithEnv(stdout, terraformDir, args, envs)
+	if e.cache != nil {
+		e.cache.state = nil
+	}
+
+	sealErr := e.vault.Seal()
+
 	if err != nil {
 		if e.debug {
 			return err
@@ -161,6 +221,10 @@ func (e Executor) runTFCommandWithEnvs(args, envs []string) error {
 		return fmt.Errorf(redactedError)
 	}
 
+	if sealErr != nil {
+		return fmt.Errorf("Encrypt state: %s", sealErr)
+	}
+
 	return nil
 }
 
@@ -170,12 +234,12 @@ func (e Executor) Init() error {
 		return err
 	}
 
-	err = e.cmd.Run(e.out, terraformDir, []string{"init"})
+	err = e.init(terraformDir)
 	if err != nil {
 		return fmt.Errorf("Run terraform init: %s", err)
 	}
 
-	return nil
+	return e.pushLocalState(terraformDir)
 }
 
 func (e Executor) Apply(credentials map[string]string) error {
@@ -187,41 +251,145 @@ func (e Executor) Apply(credentials map[string]string) error {
 	return e.runTFCommand(args)
 }
 
-func (e Executor) Validate(credentials map[string]string) error {
-	args := []string{"validate"}
+// Plan refreshes the resources in the terraform state and returns the
+// changes terraform would make to bring them back in line with the
+// template, without making them. When planFile is set, terraform also saves
+// the plan there, and the checksum of the template and variables it was
+// planned with goes next to it, so that ApplyPlan can tell if they change.
+func (e Executor) Plan(credentials map[string]string, planFile string) (string, error) {
+	args := []string{"plan", "-input=false", "-no-color"}
 	for key, value := range credentials {
 		arg := fmt.Sprintf("%s=%s", key, value)
 		args = append(args, "-var", arg)
 	}
+	if planFile != "" {
+		args = append(args, "-out", planFile)
+	}
 
-	varsDir, err := e.stateStore.GetVarsDir()
+	buffer := bytes.NewBuffer([]byte{})
+	err := e.runTFCommandTo(io.MultiWriter(buffer, e.out), args, []string{})
+	if err != nil {
+		return "", err
+	}
+
+	if planFile != "" {
+		checksum, err := e.inputsChecksum()
+		if err != nil {
+			return "", err
+		}
+
+		err = e.fs.WriteFile(planChecksumFile(planFile), []byte(checksum), storage.StateMode)
+		if err != nil {
+			return "", fmt.Errorf("Write terraform plan checksum: %s", err)
+		}
+	}
+
+	return buffer.String(), nil
+}
+
+// RefreshPlan returns the changes made to the resources in the terraform
+// state outside of terraform, without changing the state or the resources.
+func (e Executor) RefreshPlan(credentials map[string]string) (string, error) {
+	args := []string{"plan", "-refresh-only", "-input=false", "-no-color"}
+	for key, value := range credentials {
+		arg := fmt.Sprintf("%s=%s", key, value)
+		args = append(args, "-var", arg)
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	err := e.runTFCommandTo(io.MultiWriter(buffer, e.out), args, []string{})
+	if err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+// ApplyPlan applies a plan that Plan saved, and refuses to if the template
+// or variables have changed since it was planned.
+func (e Executor) ApplyPlan(planFile string) error {
+	planned, err := e.fs.ReadFile(planChecksumFile(planFile))
+	if err != nil {
+		return fmt.Errorf("Read terraform plan checksum, %s was not planned by bbl: %s", planFile, err)
+	}
+
+	checksum, err := e.inputsChecksum()
 	if err != nil {
 		return err
 	}
 
-	terraformDir, err := e.stateStore.GetTerraformDir()
+	if strings.TrimSpace(string(planned)) != checksum {
+		return fmt.Errorf("The terraform template or variables have changed since %s was planned.", planFile)
+	}
+
+	terraformDir, args, err := e.withStateArgs([]string{"apply", "-input=false"}, false)
 	if err != nil {
 		return err
 	}
+	args = append(args, planFile)
 
-	varsFiles, err := e.fs.ReadDir(varsDir)
+	return e.runInTerraformDir(e.out, terraformDir, args, []string{})
+}
+
+func planChecksumFile(planFile string) string {
+	return fmt.Sprintf("%s.checksum", planFile)
+}
+
+// inputsChecksum hashes the terraform files in the terraform dir and the
+// terraform vars files in the vars dir, which together decide the plan.
+func (e Executor) inputsChecksum() (string, error) {
+	terraformDir, err := e.stateStore.GetTerraformDir()
 	if err != nil {
-		return fmt.Errorf("Read contents of vars directory: %s", err)
+		return "", err
 	}
 
-	for _, file := range varsFiles {
-		if strings.HasSuffix(file.Name(), ".tfvars") {
-			relativeFilePath, err := filepath.Rel(terraformDir, filepath.Join(varsDir, file.Name()))
+	varsDir, err := e.stateStore.GetVarsDir()
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	for _, input := range []struct{ dir, suffix string }{
+		{terraformDir, ".tf"},
+		{varsDir, ".tfvars"},
+		{varsDir, ".tfvars.json"},
+	} {
+		files, err := e.fs.ReadDir(input.dir)
+		if err != nil {
+			return "", fmt.Errorf("Read contents of %s: %s", input.dir, err)
+		}
+
+		names := []string{}
+		for _, file := range files {
+			if !file.IsDir() && strings.HasSuffix(file.Name(), input.suffix) {
+				names = append(names, file.Name())
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			contents, err := e.fs.ReadFile(filepath.Join(input.dir, name))
 			if err != nil {
-				return fmt.Errorf("Get relative terraform vars path: %s", err) //not tested
+				return "", fmt.Errorf("Read %s: %s", name, err)
 			}
-			args = append(args,
-				"-var-file", relativeFilePath,
-			)
+
+			fmt.Fprintf(hash, "file %s %d\n", name, len(contents))
+			hash.Write(contents)
 		}
 	}
 
-	err = e.cmd.RunWithEnv(e.out, terraformDir, args, []string{})
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Validate checks the syntax of the terraform templates. Since terraform 0.12
+// it no longer reads variables to do so.
+func (e Executor) Validate() error {
+	terraformDir, err := e.stateStore.GetTerraformDir()
+	if err != nil {
+		return err
+	}
+
+	err = e.cmd.RunWithEnv(e.out, terraformDir, []string{"validate"}, []string{})
 	if err != nil {
 		if e.debug {
 			return err
@@ -233,7 +401,7 @@ func (e Executor) Validate(credentials map[string]string) error {
 }
 
 func (e Executor) Destroy(credentials map[string]string) error {
-	args := []string{"destroy", "-force"}
+	args := []string{"destroy", "-auto-approve"}
 	for key, value := range credentials {
 		arg := fmt.Sprintf("%s=%s", key, value)
 		args = append(args, "-var", arg)
@@ -258,108 +426,161 @@ func (e Executor) Version() (string, error) {
 	return version, nil
 }
 
+// Output returns a single output of the terraform state.
 func (e Executor) Output(outputName string) (string, error) {
-	terraformDir, err := e.stateStore.GetTerraformDir()
+	outputs, err := e.Outputs()
 	if err != nil {
 		return "", err
 	}
 
-	varsDir, err := e.stateStore.GetVarsDir()
-	if err != nil {
-		return "", err
+	value, ok := outputs[outputName]
+	if !ok {
+		return "", fmt.Errorf("The terraform state has no output named %s", outputName)
 	}
 
-	err = e.cmd.Run(e.out, terraformDir, []string{"init"})
-	if err != nil {
-		return "", fmt.Errorf("Run terraform init in terraform dir: %s", err)
+	if s, ok := value.(string); ok {
+		return s, nil
 	}
 
-	args := []string{"output", outputName}
-	_, err = e.fs.Stat(filepath.Join(varsDir, "terraform.tfstate"))
-	if err == nil {
-		args = append(args, "-state", filepath.Join(varsDir, "terraform.tfstate"))
-	}
-	buffer := bytes.NewBuffer([]byte{})
-	err = e.bufferingCmd.Run(buffer, terraformDir, args)
+	contents, err := json.Marshal(value)
 	if err != nil {
-		return "", fmt.Errorf("Run terraform output -state: %s", err)
+		return "", fmt.Errorf("Marshal output %s: %s", outputName, err) // not tested
 	}
-
-	return strings.TrimSuffix(buffer.String(), "\n"), nil
+	return string(contents), nil
 }
 
+// Outputs returns the outputs of the terraform state, which is read from
+// vars/terraform.tfstate rather than by running terraform output.
 func (e Executor) Outputs() (map[string]interface{}, error) {
-	terraformDir, err := e.stateStore.GetTerraformDir()
+	state, err := e.readState()
 	if err != nil {
 		return map[string]interface{}{}, err
 	}
 
-	varsDir, err := e.stateStore.GetVarsDir()
+	outputs := map[string]interface{}{}
+	for tfKey, tfValue := range state.outputs() {
+		outputs[tfKey] = tfValue.Value
+	}
+
+	return outputs, nil
+}
+
+// SensitiveOutputs returns the names of the outputs that the template marks
+// as sensitive.
+func (e Executor) SensitiveOutputs() (map[string]bool, error) {
+	state, err := e.readState()
 	if err != nil {
-		return map[string]interface{}{}, err
+		return map[string]bool{}, err
 	}
 
-	err = e.cmd.Run(os.Stderr, terraformDir, []string{"init", varsDir})
+	sensitive := map[string]bool{}
+	for tfKey, tfValue := range state.outputs() {
+		if tfValue.Sensitive {
+			sensitive[tfKey] = true
+		}
+	}
+
+	return sensitive, nil
+}
+
+// IsPaved reports whether terraform has created any resources.
+func (e Executor) IsPaved() (bool, error) {
+	state, err := e.readState()
 	if err != nil {
-		return map[string]interface{}{}, fmt.Errorf("Run terraform init in terraform dir: %s", err)
+		return false, err
 	}
 
-	buffer := bytes.NewBuffer([]byte{})
-	args := []string{"output", "--json"}
-	_, err = e.fs.Stat(filepath.Join(varsDir, "terraform.tfstate"))
-	if err == nil {
-		args = append(args, "-state", filepath.Join(varsDir, "terraform.tfstate"))
+	return state.hasResources(), nil
+}
+
+// readState reads the terraform state once per process. When a backend is
+// configured, the state is pulled from it, which still needs terraform.
+func (e Executor) readState() (tfState, error) {
+	if e.cache != nil && e.cache.state != nil {
+		return *e.cache.state, nil
 	}
-	err = e.bufferingCmd.Run(buffer, terraformDir, args)
+
+	terraformDir, err := e.stateStore.GetTerraformDir()
 	if err != nil {
-		return map[string]interface{}{}, fmt.Errorf("Run terraform output --json in vars dir: %s", err)
+		return tfState{}, err
 	}
 
-	tfOutputs := map[string]tfOutput{}
-	err = json.Unmarshal(buffer.Bytes(), &tfOutputs)
+	varsDir, err := e.stateStore.GetVarsDir()
 	if err != nil {
-		return map[string]interface{}{}, fmt.Errorf("Unmarshal terraform output: %s", err)
+		return tfState{}, err
 	}
 
-	outputs := map[string]interface{}{}
-	for tfKey, tfValue := range tfOutputs {
-		outputs[tfKey] = tfValue.Value
+	remote, err := e.hasBackend(terraformDir)
+	if err != nil {
+		return tfState{}, err
 	}
 
-	return outputs, nil
-}
+	var contents []byte
+	if remote {
+		contents, err = e.pullState(terraformDir)
+		if err != nil {
+			return tfState{}, err
+		}
+	} else {
+		contents, err = e.fs.ReadFile(filepath.Join(varsDir, "terraform.tfstate"))
+		if err != nil && !os.IsNotExist(err) {
+			return tfState{}, fmt.Errorf("Read terraform state: %s", err)
+		}
+	}
 
-func (e Executor) IsPaved() (bool, error) {
-	terraformDir, err := e.stateStore.GetTerraformDir()
-	if err != nil {
-		return false, err
+	state := tfState{}
+	if len(bytes.TrimSpace(contents)) > 0 {
+		state, err = parseState(contents)
+		if err != nil {
+			return tfState{}, err
+		}
 	}
 
-	err = e.cmd.Run(ioutil.Discard, terraformDir, []string{"init"})
-	if err != nil {
-		return false, fmt.Errorf("Run terraform init in terraform dir: %s", err)
+	if e.cache != nil {
+		e.cache.state = &state
 	}
+	return state, nil
+}
 
-	varsDir, err := e.stateStore.GetVarsDir()
+func (e Executor) hasBackend(terraformDir string) (bool, error) {
+	files, err := e.fs.ReadDir(terraformDir)
 	if err != nil {
-		return false, err
+		return false, fmt.Errorf("Read contents of terraform directory: %s", err)
 	}
 
-	buffer := bytes.NewBuffer([]byte{})
-	args := []string{"show"}
-	_, err = e.fs.Stat(filepath.Join(varsDir, "terraform.tfstate"))
-	if err == nil {
-		args = append(args, filepath.Join(varsDir, "terraform.tfstate"))
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".tf") {
+			continue
+		}
+
+		contents, err := e.fs.ReadFile(filepath.Join(terraformDir, file.Name()))
+		if err != nil {
+			return false, fmt.Errorf("Read %s: %s", file.Name(), err)
+		}
+		if backendBlock.Match(contents) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (e Executor) pullState(terraformDir string) ([]byte, error) {
+	args, err := e.initArgs(terraformDir)
+	if err != nil {
+		return nil, err
 	}
 
-	err = e.bufferingCmd.Run(buffer, terraformDir, args)
+	err = e.runInTerraformDir(os.Stderr, terraformDir, args, []string{})
 	if err != nil {
-		return false, fmt.Errorf("Run terraform show: %s", err)
+		return nil, fmt.Errorf("Run terraform init in terraform dir: %s", err)
 	}
 
-	if strings.TrimSpace(string(buffer.Bytes())) == "No state." {
-		return false, nil
+	buffer := bytes.NewBuffer([]byte{})
+	err = e.bufferingCmd.Run(buffer, terraformDir, []string{"state", "pull"})
+	if err != nil {
+		return nil, fmt.Errorf("Run terraform state pull: %s", err)
 	}
 
-	return true, nil
+	return buffer.Bytes(), nil
 }
@@ -23,6 +23,7 @@ var _ = Describe("Executor", func() {
 		cmd          *fakes.TerraformCmd
 		stateStore   *fakes.StateStore
 		fileIO       *fakes.FileIO
+		vault        *fakes.Vault
 		executor     terraform.Executor
 		debugFalse   terraform.Executor
 
@@ -42,9 +43,10 @@ var _ = Describe("Executor", func() {
 		cmd = &fakes.TerraformCmd{}
 		stateStore = &fakes.StateStore{}
 		fileIO = &fakes.FileIO{}
+		vault = &fakes.Vault{}
 
-		executor = terraform.NewExecutor(cmd, bufferingCmd, stateStore, fileIO, true, os.Stdout)
-		debugFalse = terraform.NewExecutor(cmd, bufferingCmd, stateStore, fileIO, false, nil)
+		executor = terraform.NewExecutor(cmd, bufferingCmd, stateStore, fileIO, vault, true, os.Stdout)
+		debugFalse = terraform.NewExecutor(cmd, bufferingCmd, stateStore, fileIO, vault, false, nil)
 
 		var err error
 		terraformDir, err = ioutil.TempDir("", "terraform")
@@ -59,7 +61,7 @@ var _ = Describe("Executor", func() {
 		relativeStatePath, err = filepath.Rel(terraformDir, tfStatePath)
 		Expect(err).NotTo(HaveOccurred())
 
-		tfVarsPath = filepath.Join(varsDir, "bbl.tfvars")
+		tfVarsPath = filepath.Join(varsDir, "bbl.tfvars.json")
 		relativeVarsPath, err = filepath.Rel(terraformDir, tfVarsPath)
 		Expect(err).NotTo(HaveOccurred())
 
@@ -67,16 +69,102 @@ var _ = Describe("Executor", func() {
 	})
 
 	Describe("Init", func() {
+		BeforeEach(func() {
+			fileIO.StatCall.Returns.Error = &os.PathError{Op: "stat", Err: os.ErrNotExist}
+		})
+
 		It("runs terraform init", func() {
 			err := executor.Init()
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(cmd.RunCall.CallCount).To(Equal(1))
-			Expect(cmd.RunCall.Receives.Args).To(Equal([]string{"init"}))
+			Expect(cmd.RunCall.Receives.Args).To(Equal([]string{"init", "-input=false"}))
+			Expect(vault.UnsealCall.CallCount).To(Equal(1))
+			Expect(vault.SealCall.CallCount).To(Equal(1))
 
 			Expect(bufferingCmd.RunCall.CallCount).To(Equal(0))
 		})
 
+		Context("when bbl configured a remote backend", func() {
+			var backendConfigPath string
+
+			BeforeEach(func() {
+				backendConfigPath = filepath.Join(varsDir, "bbl.tfbackend")
+				fileIO.StatCall.Fake = func(name string) (os.FileInfo, error) {
+					if name == backendConfigPath {
+						return fakes.FileInfo{}, nil
+					}
+					return nil, os.ErrNotExist
+				}
+				fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
+					fakes.FileInfo{FileName: "bbl-backend.tf"},
+				}
+				fileIO.ReadFileCall.Fake = func(filename string) ([]byte, error) {
+					if filepath.Base(filename) == "bbl-backend.tf" {
+						return []byte("terraform {\n  backend \"s3\" {}\n}\n"), nil
+					}
+					return nil, os.ErrNotExist
+				}
+			})
+
+			It("passes the backend settings to terraform init", func() {
+				err := executor.Init()
+				Expect(err).NotTo(HaveOccurred())
+
+				relativeConfigPath, err := filepath.Rel(terraformDir, backendConfigPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cmd.RunCall.CallCount).To(Equal(1))
+				Expect(cmd.RunCall.Receives.Args).To(Equal([]string{"init", "-input=false", "-backend-config", relativeConfigPath}))
+				Expect(fileIO.RenameCall.CallCount).To(Equal(0))
+			})
+
+			Context("when the state is still in the vars dir", func() {
+				BeforeEach(func() {
+					fileIO.ReadFileCall.Fake = func(filename string) ([]byte, error) {
+						switch filename {
+						case filepath.Join(terraformDir, "bbl-backend.tf"):
+							return []byte("terraform {\n  backend \"s3\" {}\n}\n"), nil
+						case tfStatePath:
+							return []byte(`{"version": 4}`), nil
+						}
+						return nil, os.ErrNotExist
+					}
+				})
+
+				It("pushes it to the backend and moves it aside", func() {
+					err := executor.Init()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(cmd.RunCall.CallCount).To(Equal(2))
+					Expect(cmd.RunCall.Receives.Args).To(Equal([]string{"state", "push", relativeStatePath}))
+					Expect(vault.UnsealCall.CallCount).To(Equal(2))
+					Expect(vault.SealCall.CallCount).To(Equal(2))
+
+					Expect(fileIO.RenameCall.Receives.Oldpath).To(Equal(tfStatePath))
+					Expect(fileIO.RenameCall.Receives.Newpath).To(Equal(filepath.Join(varsDir, "terraform.tfstate.migrated")))
+				})
+
+				Context("when pushing the state fails", func() {
+					It("returns an error and keeps the state", func() {
+						cmd.RunCall.Returns.Errors = []error{nil, errors.New("lineage mismatch")}
+
+						err := executor.Init()
+						Expect(err).To(MatchError("Push terraform state to the backend: lineage mismatch"))
+						Expect(fileIO.RenameCall.CallCount).To(Equal(0))
+					})
+				})
+
+				Context("when the state cannot be moved aside", func() {
+					It("returns an error", func() {
+						fileIO.RenameCall.Returns.Error = errors.New("durian")
+
+						err := executor.Init()
+						Expect(err).To(MatchError("Move the local terraform state aside: durian"))
+					})
+				})
+			})
+		})
+
 		Context("when getting terraform dir fails", func() {
 			BeforeEach(func() {
 				stateStore.GetTerraformDirCall.Returns.Error = errors.New("canteloupe")
@@ -114,12 +202,52 @@ var _ = Describe("Executor", func() {
 			Expect(string(fileIO.WriteFileCall.Receives[1].Contents)).To(Equal("*\n"))
 
 			Expect(fileIO.WriteFileCall.Receives[2].Filename).To(Equal(tfVarsPath))
-			Expect(string(fileIO.WriteFileCall.Receives[2].Contents)).To(ContainSubstring(`project_id="some-project-id"`))
+			Expect(string(fileIO.WriteFileCall.Receives[2].Contents)).To(MatchJSON(`{"project_id": "some-project-id"}`))
 
 			Expect(cmd.RunCall.CallCount).To(Equal(0))
 			Expect(bufferingCmd.RunCall.CallCount).To(Equal(0))
 		})
 
+		It("writes the terraform variables as JSON", func() {
+			err := executor.Setup("some-template", map[string]interface{}{
+				"ssl_certificate":    "-----BEGIN CERTIFICATE-----\nsome \"quoted\" ${cert} <key>\n",
+				"availability_zones": []string{"z1", "z2"},
+				"isolation_segments": 1,
+				"nat":                true,
+				"subnet_cidrs": map[string]interface{}{
+					"z1": "10.0.16.0/20",
+					"z2": "10.0.32.0/20",
+				},
+				"tags": map[string]string{"team": "infra"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(string(fileIO.WriteFileCall.Receives[2].Contents)).To(Equal(`{
+  "availability_zones": [
+    "z1",
+    "z2"
+  ],
+  "isolation_segments": 1,
+  "nat": true,
+  "ssl_certificate": "-----BEGIN CERTIFICATE-----\nsome \"quoted\" ${cert} <key>\n",
+  "subnet_cidrs": {
+    "z1": "10.0.16.0/20",
+    "z2": "10.0.32.0/20"
+  },
+  "tags": {
+    "team": "infra"
+  }
+}
+`))
+		})
+
+		Context("when the inputs cannot be encoded", func() {
+			It("returns an error", func() {
+				err := executor.Setup("some-template", map[string]interface{}{"bad": func() {}})
+				Expect(err).To(MatchError(ContainSubstring("Format terraform vars: ")))
+			})
+		})
+
 		Context("when an error occurs", func() {
 			Context("when getting terraform dir fails", func() {
 				BeforeEach(func() {
@@ -190,96 +318,297 @@ var _ = Describe("Executor", func() {
 		})
 	})
 
-	Describe("Validate", func() {
-		BeforeEach(func() {
-			fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
-				fakes.FileInfo{
-					FileName: "bbl.tfvars",
+	Describe("SetupBackend", func() {
+		It("writes the backend block and its settings", func() {
+			err := executor.SetupBackend(storage.TerraformBackend{
+				Type: "s3",
+				Config: map[string]string{
+					"region": "us-west-2",
+					"bucket": "some-bucket",
+					"key":    "some \"quoted\" ${key}",
 				},
-			}
-			err := ioutil.WriteFile(tfStatePath, []byte("some-updated-terraform-state"), storage.StateMode)
+			})
 			Expect(err).NotTo(HaveOccurred())
 
-			err = ioutil.WriteFile(tfVarsPath, []byte("some-tfvars"), storage.StateMode)
-			Expect(err).NotTo(HaveOccurred())
-		})
+			Expect(fileIO.WriteFileCall.Receives[0].Filename).To(Equal(filepath.Join(terraformDir, "bbl-backend.tf")))
+			Expect(string(fileIO.WriteFileCall.Receives[0].Contents)).To(Equal("terraform {\n  backend \"s3\" {}\n}\n"))
 
-		AfterEach(func() {
-			os.RemoveAll(varsDir)
+			Expect(fileIO.WriteFileCall.Receives[1].Filename).To(Equal(filepath.Join(varsDir, "bbl.tfbackend")))
+			Expect(string(fileIO.WriteFileCall.Receives[1].Contents)).To(Equal(`bucket = "some-bucket"
+key = "some \"quoted\" $${key}"
+region = "us-west-2"
+`))
+			Expect(fileIO.RemoveCall.CallCount).To(Equal(0))
 		})
 
-		It("runs terraform validate", func() {
-			err := executor.Validate(map[string]string{
-				"some-cert": "some-cert-value",
-			})
-			Expect(err).NotTo(HaveOccurred())
+		Context("when the backend is local", func() {
+			It("removes the backend block and its settings", func() {
+				fileIO.RemoveCall.Returns = []fakes.RemoveReturn{{Error: os.ErrNotExist}}
 
-			By("passing the correct args and dir to run command", func() {
-				Expect(cmd.RunCall.Receives.WorkingDirectory).To(Equal(terraformDir))
-				Expect(cmd.RunCall.Receives.Args).To(ConsistOf([]string{
-					"validate",
-					"-var", "some-cert=some-cert-value",
-					"-var-file", relativeVarsPath,
+				err := executor.SetupBackend(storage.TerraformBackend{Type: "local"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fileIO.WriteFileCall.CallCount).To(Equal(0))
+				Expect(fileIO.RemoveCall.Receives).To(Equal([]fakes.RemoveReceive{
+					{Name: filepath.Join(terraformDir, "bbl-backend.tf")},
+					{Name: filepath.Join(varsDir, "bbl.tfbackend")},
 				}))
-				Expect(bufferingCmd.RunCall.CallCount).To(Equal(0))
+			})
+
+			Context("when the backend block cannot be removed", func() {
+				It("returns an error", func() {
+					fileIO.RemoveCall.Returns = []fakes.RemoveReturn{{Error: errors.New("jackfruit")}}
+
+					err := executor.SetupBackend(storage.TerraformBackend{})
+					Expect(err).To(MatchError("Remove bbl-backend.tf: jackfruit"))
+				})
+			})
+
+			Context("when bbl configured a remote backend before", func() {
+				BeforeEach(func() {
+					fileIO.ReadFileCall.Fake = func(filename string) ([]byte, error) {
+						switch filepath.Base(filename) {
+						case "bbl-backend.tf":
+							return []byte("terraform {\n  backend \"s3\" {}\n}\n"), nil
+						case "bbl.tfbackend":
+							return []byte("bucket = \"some-bucket\"\n"), nil
+						}
+						return nil, os.ErrNotExist
+					}
+					bufferingCmd.RunCall.Stub = func(stdout io.Writer) {
+						fmt.Fprint(stdout, `{"version": 4, "serial": 7, "resources": [{"type": "aws_instance", "name": "nat"}]}`)
+					}
+				})
+
+				It("pulls the state into the vars dir before it drops the backend", func() {
+					err := executor.SetupBackend(storage.TerraformBackend{Type: "local"})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(bufferingCmd.RunCall.Receives.Args).To(Equal([]string{"state", "pull"}))
+					Expect(fileIO.WriteFileCall.Receives).To(HaveLen(1))
+					Expect(fileIO.WriteFileCall.Receives[0].Filename).To(Equal(tfStatePath))
+					Expect(string(fileIO.WriteFileCall.Receives[0].Contents)).To(Equal(`{"version": 4, "serial": 7, "resources": [{"type": "aws_instance", "name": "nat"}]}`))
+
+					Expect(fileIO.RemoveCall.Receives).To(Equal([]fakes.RemoveReceive{
+						{Name: filepath.Join(terraformDir, "bbl-backend.tf")},
+						{Name: filepath.Join(varsDir, "bbl.tfbackend")},
+					}))
+					Expect(cmd.RunCall.CallCount).To(Equal(2))
+					Expect(cmd.RunCall.Receives.Args).To(Equal([]string{"init", "-input=false", "-reconfigure"}))
+				})
+
+				Context("when the state cannot be pulled", func() {
+					It("returns an error and keeps the backend", func() {
+						bufferingCmd.RunCall.Returns.Errors = []error{errors.New("access denied")}
+
+						err := executor.SetupBackend(storage.TerraformBackend{Type: "local"})
+						Expect(err).To(MatchError("Pull terraform state from the backend: Run terraform state pull: access denied"))
+						Expect(fileIO.RemoveCall.CallCount).To(Equal(0))
+					})
+				})
+
+				Context("when the state cannot be written to the vars dir", func() {
+					It("returns an error and keeps the backend", func() {
+						fileIO.WriteFileCall.Returns = []fakes.WriteFileReturn{{Error: errors.New("disk full")}}
+
+						err := executor.SetupBackend(storage.TerraformBackend{Type: "local"})
+						Expect(err).To(MatchError("Write terraform state: disk full"))
+						Expect(fileIO.RemoveCall.CallCount).To(Equal(0))
+					})
+				})
+
+				Context("when terraform init fails without the backend", func() {
+					It("returns an error", func() {
+						cmd.RunCall.Returns.Errors = []error{nil, errors.New("lime")}
+
+						err := executor.SetupBackend(storage.TerraformBackend{Type: "local"})
+						Expect(err).To(MatchError("Run terraform init without the backend: lime"))
+					})
+				})
 			})
 		})
 
-		Context("when other vars files are in the directory", func() {
-			var (
-				relativeUserProvidedVarsPathA string
-				relativeUserProvidedVarsPathC string
-			)
+		Context("when the remote backend changes", func() {
 			BeforeEach(func() {
-				fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
-					fakes.FileInfo{
-						FileName: "bbl.tfvars",
-					},
-					fakes.FileInfo{
-						FileName: "awesome-user-vars.tfvars",
-					},
-					fakes.FileInfo{
-						FileName: "custom-user-vars.tfvars",
-					},
-					fakes.FileInfo{
-						FileName: "definitely-not-a-tf-vars-file",
-					},
+				fileIO.ReadFileCall.Fake = func(filename string) ([]byte, error) {
+					switch filepath.Base(filename) {
+					case "bbl-backend.tf":
+						return []byte("terraform {\n  backend \"s3\" {}\n}\n"), nil
+					case "bbl.tfbackend":
+						return []byte("bucket = \"some-bucket\"\n"), nil
+					}
+					return nil, os.ErrNotExist
 				}
+			})
 
-				relativeUserProvidedVarsPathA = strings.Replace(relativeVarsPath, "bbl", "awesome-user-vars", 1)
-				relativeUserProvidedVarsPathC = strings.Replace(relativeVarsPath, "bbl", "custom-user-vars", 1)
+			It("migrates the state from the previous backend", func() {
+				err := executor.SetupBackend(storage.TerraformBackend{Type: "gcs", Config: map[string]string{"bucket": "other-bucket"}})
+				Expect(err).NotTo(HaveOccurred())
+
+				relativeConfigPath, err := filepath.Rel(terraformDir, filepath.Join(varsDir, "bbl.tfbackend"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cmd.RunCall.CallCount).To(Equal(2))
+				Expect(cmd.RunCall.Receives.Args).To(Equal([]string{"init", "-input=false", "-backend-config", relativeConfigPath, "-migrate-state", "-force-copy"}))
+				Expect(fileIO.WriteFileCall.Receives).To(HaveLen(2))
 			})
 
-			It("passes all user provided tfvars files to the run command in alphabetic order", func() {
-				err := executor.Validate(map[string]string{
-					"some-cert": "some-cert-value",
+			It("does not run terraform when the settings are unchanged", func() {
+				err := executor.SetupBackend(storage.TerraformBackend{Type: "s3", Config: map[string]string{"bucket": "some-bucket"}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cmd.RunCall.CallCount).To(Equal(0))
+			})
+
+			Context("when terraform init fails with the previous backend", func() {
+				It("returns an error and keeps the previous backend", func() {
+					cmd.RunCall.Returns.Errors = []error{errors.New("kumquat")}
+
+					err := executor.SetupBackend(storage.TerraformBackend{Type: "gcs"})
+					Expect(err).To(MatchError("Run terraform init with the previous backend: kumquat"))
+					Expect(fileIO.WriteFileCall.CallCount).To(Equal(0))
 				})
+			})
+
+			Context("when the state cannot be migrated", func() {
+				It("returns an error", func() {
+					cmd.RunCall.Returns.Errors = []error{nil, errors.New("quince")}
+
+					err := executor.SetupBackend(storage.TerraformBackend{Type: "gcs"})
+					Expect(err).To(MatchError("Run terraform init to migrate the state to the new backend: quince"))
+				})
+			})
+		})
+
+		Context("failure cases", func() {
+			It("returns an error when the backend block cannot be written", func() {
+				fileIO.WriteFileCall.Returns = []fakes.WriteFileReturn{{Error: errors.New("fig")}}
+
+				err := executor.SetupBackend(storage.TerraformBackend{Type: "gcs"})
+				Expect(err).To(MatchError("Write terraform backend: fig"))
+			})
+
+			It("returns an error when the backend settings cannot be written", func() {
+				fileIO.WriteFileCall.Returns = []fakes.WriteFileReturn{{}, {Error: errors.New("date")}}
+
+				err := executor.SetupBackend(storage.TerraformBackend{Type: "gcs"})
+				Expect(err).To(MatchError("Write terraform backend config: date"))
+			})
+		})
+	})
+
+	Describe("Plan", func() {
+		BeforeEach(func() {
+			fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
+				fakes.FileInfo{FileName: "bbl.tfvars.json"},
+			}
+			cmd.RunCall.Stub = func(stdout io.Writer) {
+				stdout.Write([]byte("some-plan-output"))
+			}
+		})
+
+		It("runs terraform plan and returns its output", func() {
+			output, err := executor.Plan(map[string]string{
+				"some-cert": "some-cert-value",
+			}, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("some-plan-output"))
+
+			Expect(cmd.RunCall.Receives.WorkingDirectory).To(Equal(terraformDir))
+			Expect(cmd.RunCall.Receives.Args).To(Equal([]string{
+				"plan", "-input=false", "-no-color",
+				"-var", "some-cert=some-cert-value",
+				"-state", relativeStatePath,
+				"-var-file", relativeVarsPath,
+			}))
+			Expect(vault.UnsealCall.CallCount).To(Equal(1))
+			Expect(vault.SealCall.CallCount).To(Equal(1))
+		})
+
+		Context("when a plan file is provided", func() {
+			It("saves the plan to it along with the checksum of its inputs", func() {
+				_, err := executor.Plan(map[string]string{}, "/some/vars/bbl.tfplan")
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(cmd.RunCall.Receives.Args).To(ConsistOf([]string{
-					"validate",
-					"-var", "some-cert=some-cert-value",
-					"-var-file", relativeUserProvidedVarsPathA,
+				Expect(cmd.RunCall.Receives.Args).To(Equal([]string{
+					"plan", "-input=false", "-no-color",
+					"-out", "/some/vars/bbl.tfplan",
+					"-state", relativeStatePath,
 					"-var-file", relativeVarsPath,
-					"-var-file", relativeUserProvidedVarsPathC,
 				}))
-				Expect(bufferingCmd.RunCall.CallCount).To(Equal(0))
+				Expect(fileIO.WriteFileCall.Receives).To(HaveLen(1))
+				Expect(fileIO.WriteFileCall.Receives[0].Filename).To(Equal("/some/vars/bbl.tfplan.checksum"))
+				Expect(fileIO.WriteFileCall.Receives[0].Contents).To(HaveLen(64))
+			})
+		})
 
-				// be sure we don't leak env vars from destroy
-				Expect(cmd.RunCall.Receives.Env).NotTo(ContainElement("TF_WARN_OUTPUT_ERRORS=1"))
+		Context("when terraform plan fails", func() {
+			It("returns a redacted error", func() {
+				cmd.RunCall.Stub = nil
+				cmd.RunCall.Returns.Errors = []error{errors.New("kiwi")}
+
+				_, err := debugFalse.Plan(map[string]string{}, "")
+				Expect(err).To(MatchError("Some output has been redacted, use `bbl latest-error` to see it or run again with --debug for additional debug output"))
 			})
 		})
+	})
 
-		Context("when terraform command run fails", func() {
+	Describe("RefreshPlan", func() {
+		BeforeEach(func() {
+			fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
+				fakes.FileInfo{FileName: "bbl.tfvars.json"},
+			}
+			cmd.RunCall.Stub = func(stdout io.Writer) {
+				stdout.Write([]byte("some-plan-output"))
+			}
+		})
+
+		It("runs terraform plan -refresh-only and returns its output", func() {
+			output, err := executor.RefreshPlan(map[string]string{
+				"some-cert": "some-cert-value",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("some-plan-output"))
+
+			Expect(cmd.RunCall.Receives.WorkingDirectory).To(Equal(terraformDir))
+			Expect(cmd.RunCall.Receives.Args).To(Equal([]string{
+				"plan", "-refresh-only", "-input=false", "-no-color",
+				"-var", "some-cert=some-cert-value",
+				"-state", relativeStatePath,
+				"-var-file", relativeVarsPath,
+			}))
+			Expect(vault.UnsealCall.CallCount).To(Equal(1))
+			Expect(vault.SealCall.CallCount).To(Equal(1))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("runs terraform validate without variables", func() {
+			err := executor.Validate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cmd.RunCall.Receives.WorkingDirectory).To(Equal(terraformDir))
+			Expect(cmd.RunCall.Receives.Args).To(Equal([]string{"validate"}))
+			Expect(bufferingCmd.RunCall.CallCount).To(Equal(0))
+		})
+
+		Context("when getting terraform dir fails", func() {
 			BeforeEach(func() {
-				err := ioutil.WriteFile(tfStatePath, []byte("some-tf-state"), storage.StateMode)
-				Expect(err).NotTo(HaveOccurred())
+				stateStore.GetTerraformDirCall.Returns.Error = errors.New("canteloupe")
+			})
+
+			It("returns an error", func() {
+				err := executor.Validate()
+				Expect(err).To(MatchError("canteloupe"))
+			})
+		})
 
+		Context("when terraform command run fails", func() {
+			BeforeEach(func() {
 				cmd.RunCall.Returns.Errors = []error{errors.New("the-executor-error")}
 			})
 
 			It("returns the error", func() {
-				err := executor.Validate(map[string]string{})
+				err := executor.Validate()
 				Expect(err).To(MatchError("the-executor-error"))
 			})
 
@@ -289,18 +618,82 @@ var _ = Describe("Executor", func() {
 				})
 
 				It("returns a redacted error message", func() {
-					err := debugFalse.Validate(map[string]string{})
+					err := debugFalse.Validate()
 					Expect(err).To(MatchError("Some output has been redacted, use `bbl latest-error` to see it or run again with --debug for additional debug output"))
 				})
 			})
 		})
 	})
 
+	Describe("ApplyPlan", func() {
+		var (
+			planFile string
+			tfvars   string
+		)
+
+		BeforeEach(func() {
+			planFile = filepath.Join(varsDir, "bbl.tfplan")
+			tfvars = "some-tfvars"
+
+			fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
+				fakes.FileInfo{FileName: "bbl.tfvars.json"},
+			}
+			checksums := map[string][]byte{}
+			fileIO.WriteFileCall.Returns = nil
+			fileIO.ReadFileCall.Fake = func(filename string) ([]byte, error) {
+				if checksum, ok := checksums[filename]; ok {
+					return checksum, nil
+				}
+				if strings.HasSuffix(filename, ".checksum") {
+					return nil, errors.New("no such file")
+				}
+				return []byte(tfvars), nil
+			}
+
+			_, err := executor.Plan(map[string]string{}, planFile)
+			Expect(err).NotTo(HaveOccurred())
+			for _, write := range fileIO.WriteFileCall.Receives {
+				checksums[write.Filename] = write.Contents
+			}
+		})
+
+		It("applies the saved plan", func() {
+			err := executor.ApplyPlan(planFile)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cmd.RunCall.Receives.WorkingDirectory).To(Equal(terraformDir))
+			Expect(cmd.RunCall.Receives.Args).To(Equal([]string{
+				"apply", "-input=false",
+				"-state", relativeStatePath,
+				planFile,
+			}))
+			Expect(vault.UnsealCall.CallCount).To(Equal(2))
+			Expect(vault.SealCall.CallCount).To(Equal(2))
+		})
+
+		Context("when the terraform variables changed after the plan was saved", func() {
+			It("refuses to apply it", func() {
+				tfvars = "some-other-tfvars"
+
+				err := executor.ApplyPlan(planFile)
+				Expect(err).To(MatchError(fmt.Sprintf("The terraform template or variables have changed since %s was planned.", planFile)))
+				Expect(cmd.RunCall.CallCount).To(Equal(1))
+			})
+		})
+
+		Context("when bbl did not save the plan", func() {
+			It("refuses to apply it", func() {
+				err := executor.ApplyPlan("/some/other.tfplan")
+				Expect(err).To(MatchError("Read terraform plan checksum, /some/other.tfplan was not planned by bbl: no such file"))
+			})
+		})
+	})
+
 	Describe("Apply", func() {
 		BeforeEach(func() {
 			fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
 				fakes.FileInfo{
-					FileName: "bbl.tfvars",
+					FileName: "bbl.tfvars.json",
 				},
 			}
 			err := ioutil.WriteFile(tfStatePath, []byte("some-updated-terraform-state"), storage.StateMode)
@@ -331,17 +724,69 @@ var _ = Describe("Executor", func() {
 				}))
 				Expect(bufferingCmd.RunCall.CallCount).To(Equal(0))
 			})
+
+			By("decrypting the terraform state for the duration of the run", func() {
+				Expect(vault.UnsealCall.CallCount).To(Equal(1))
+				Expect(vault.SealCall.CallCount).To(Equal(1))
+			})
+		})
+
+		Context("when the state cannot be decrypted", func() {
+			BeforeEach(func() {
+				vault.UnsealCall.Returns.Error = errors.New("mango")
+			})
+
+			It("returns an error without running terraform", func() {
+				err := debugFalse.Apply(map[string]string{})
+				Expect(err).To(MatchError("Decrypt state: mango"))
+				Expect(cmd.RunCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the state cannot be encrypted again", func() {
+			BeforeEach(func() {
+				vault.SealCall.Returns.Error = errors.New("papaya")
+			})
+
+			It("returns an error", func() {
+				err := debugFalse.Apply(map[string]string{})
+				Expect(err).To(MatchError("Encrypt state: papaya"))
+			})
+		})
+
+		Context("when a remote backend is configured", func() {
+			BeforeEach(func() {
+				fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
+					fakes.FileInfo{FileName: "bbl-backend.tf"},
+					fakes.FileInfo{FileName: "bbl.tfvars.json"},
+				}
+				fileIO.ReadFileCall.Fake = func(filename string) ([]byte, error) {
+					return []byte("terraform {\n  backend \"gcs\" {}\n}\n"), nil
+				}
+			})
+
+			It("does not point terraform at the state in the vars dir", func() {
+				err := executor.Apply(map[string]string{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cmd.RunCall.Receives.Args).To(Equal([]string{
+					"apply",
+					"--auto-approve",
+					"-var-file", relativeVarsPath,
+				}))
+			})
 		})
 
 		Context("when other vars files are in the directory", func() {
 			var (
 				relativeUserProvidedVarsPathA string
 				relativeUserProvidedVarsPathC string
+				relativeUserProvidedVarsPathJ string
 			)
 			BeforeEach(func() {
 				fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
 					fakes.FileInfo{
-						FileName: "bbl.tfvars",
+						FileName: "bbl.tfvars.json",
 					},
 					fakes.FileInfo{
 						FileName: "awesome-user-vars.tfvars",
@@ -349,13 +794,17 @@ var _ = Describe("Executor", func() {
 					fakes.FileInfo{
 						FileName: "custom-user-vars.tfvars",
 					},
+					fakes.FileInfo{
+						FileName: "json-user-vars.tfvars.json",
+					},
 					fakes.FileInfo{
 						FileName: "definitely-not-a-tf-vars-file",
 					},
 				}
 
-				relativeUserProvidedVarsPathA = strings.Replace(relativeVarsPath, "bbl", "awesome-user-vars", 1)
-				relativeUserProvidedVarsPathC = strings.Replace(relativeVarsPath, "bbl", "custom-user-vars", 1)
+				relativeUserProvidedVarsPathA = strings.Replace(relativeVarsPath, "bbl.tfvars.json", "awesome-user-vars.tfvars", 1)
+				relativeUserProvidedVarsPathC = strings.Replace(relativeVarsPath, "bbl.tfvars.json", "custom-user-vars.tfvars", 1)
+				relativeUserProvidedVarsPathJ = strings.Replace(relativeVarsPath, "bbl.tfvars.json", "json-user-vars.tfvars.json", 1)
 			})
 
 			It("passes all user provided tfvars files to the run command in alphabetic order", func() {
@@ -372,6 +821,7 @@ var _ = Describe("Executor", func() {
 					"-var-file", relativeUserProvidedVarsPathA,
 					"-var-file", relativeVarsPath,
 					"-var-file", relativeUserProvidedVarsPathC,
+					"-var-file", relativeUserProvidedVarsPathJ,
 				}))
 				Expect(bufferingCmd.RunCall.CallCount).To(Equal(0))
 
@@ -416,7 +866,7 @@ var _ = Describe("Executor", func() {
 
 			fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
 				fakes.FileInfo{
-					FileName: "bbl.tfvars",
+					FileName: "bbl.tfvars.json",
 				},
 			}
 		})
@@ -429,7 +879,7 @@ var _ = Describe("Executor", func() {
 				Expect(cmd.RunCall.Receives.WorkingDirectory).To(Equal(terraformDir))
 				Expect(cmd.RunCall.Receives.Args).To(ConsistOf([]string{
 					"destroy",
-					"-force",
+					"-auto-approve",
 					"-var", "some-cert=some-cert-value",
 					"-state", relativeStatePath,
 					"-var-file", relativeVarsPath,
@@ -533,43 +983,179 @@ var _ = Describe("Executor", func() {
 
 	Describe("Output", func() {
 		BeforeEach(func() {
-			err := ioutil.WriteFile(tfStatePath, []byte("some-tf-state"), storage.StateMode)
-			Expect(err).NotTo(HaveOccurred())
+			fileIO.ReadFileCall.Returns.Contents = []byte(`{
+				"version": 3,
+				"modules": [{
+					"path": ["root"],
+					"outputs": {
+						"external_ip": {"sensitive": false, "type": "string", "value": "some-external-ip"},
+						"network_ports": {"sensitive": false, "type": "list", "value": [22, 6868]}
+					}
+				}]
+			}`)
 		})
 
 		It("returns an output from the terraform state", func() {
-			bufferingCmd.RunCall.Stub = func(stdout io.Writer) {
-				fmt.Fprintf(stdout, "some-external-ip\n")
-			}
 			output, err := executor.Output("external_ip")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(output).To(Equal("some-external-ip"))
 
-			Expect(bufferingCmd.RunCall.Receives.WorkingDirectory).To(Equal(terraformDir))
-			Expect(bufferingCmd.RunCall.Receives.Args).To(Equal([]string{"output", "external_ip", "-state", tfStatePath}))
-			Expect(cmd.RunCall.Receives.Args).To(Equal([]string{"init"}))
+			Expect(fileIO.ReadFileCall.Receives.Filename).To(Equal(tfStatePath))
+			Expect(cmd.RunCall.CallCount).To(Equal(0))
+			Expect(bufferingCmd.RunCall.CallCount).To(Equal(0))
 		})
 
-		Context("when an error occurs", func() {
-			Context("when it fails to get terraform dir", func() {
-				BeforeEach(func() {
-					stateStore.GetTerraformDirCall.Returns.Error = errors.New("failed")
-				})
+		It("prints outputs that are not strings as json", func() {
+			output, err := executor.Output("network_ports")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal("[22,6868]"))
+		})
 
-				It("returns an error", func() {
-					_, err := executor.Output("external_ip")
-					Expect(err).To(MatchError("failed"))
-				})
+		Context("when the output is not in the state", func() {
+			It("returns an error", func() {
+				_, err := executor.Output("director_address")
+				Expect(err).To(MatchError("The terraform state has no output named director_address"))
 			})
+		})
+	})
 
-			Context("when it fails to get vars dir", func() {
-				BeforeEach(func() {
-					stateStore.GetVarsDirCall.Returns.Error = errors.New("failed")
-				})
+	Describe("Outputs", func() {
+		Context("when the state was written by terraform 0.11", func() {
+			BeforeEach(func() {
+				fileIO.ReadFileCall.Returns.Contents = []byte(`{
+					"version": 3,
+					"modules": [{
+						"path": ["root"],
+						"outputs": {
+							"director_address": {"sensitive": false, "type": "string", "value": "some-director-address"},
+							"external_ip": {"sensitive": false, "type": "string", "value": "some-external-ip"}
+						}
+					}, {
+						"path": ["root", "some-module"],
+						"outputs": {
+							"module_output": {"sensitive": false, "type": "string", "value": "some-module-output"}
+						}
+					}]
+				}`)
+			})
 
-				It("returns an error", func() {
-					_, err := executor.Output("external_ip")
-					Expect(err).To(MatchError("failed"))
+			It("returns the outputs of the root module", func() {
+				outputs, err := executor.Outputs()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(outputs).To(Equal(map[string]interface{}{
+					"director_address": "some-director-address",
+					"external_ip":      "some-external-ip",
+				}))
+
+				Expect(fileIO.ReadFileCall.Receives.Filename).To(Equal(tfStatePath))
+				Expect(cmd.RunCall.CallCount).To(Equal(0))
+				Expect(bufferingCmd.RunCall.CallCount).To(Equal(0))
+				Expect(vault.UnsealCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when the state was written by terraform 0.12", func() {
+			BeforeEach(func() {
+				fileIO.ReadFileCall.Returns.Contents = []byte(`{
+					"version": 4,
+					"outputs": {
+						"director_address": {"type": "string", "value": "some-director-address"},
+						"subnet_cidrs": {"type": ["list", "string"], "value": ["10.0.0.0/24"]}
+					}
+				}`)
+			})
+
+			It("returns the top level outputs", func() {
+				outputs, err := executor.Outputs()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(outputs).To(Equal(map[string]interface{}{
+					"director_address": "some-director-address",
+					"subnet_cidrs":     []interface{}{"10.0.0.0/24"},
+				}))
+			})
+		})
+
+		It("reads the state once", func() {
+			fileIO.ReadFileCall.Returns.Contents = []byte(`{"version": 4, "outputs": {"external_ip": {"value": "some-external-ip"}}}`)
+
+			_, err := executor.Outputs()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = executor.Outputs()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fileIO.ReadFileCall.CallCount).To(Equal(1))
+		})
+
+		It("reads the state again after terraform runs", func() {
+			fileIO.ReadFileCall.Returns.Contents = []byte(`{"version": 4, "outputs": {"external_ip": {"value": "some-external-ip"}}}`)
+
+			_, err := executor.Outputs()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = executor.Apply(map[string]string{})
+			Expect(err).NotTo(HaveOccurred())
+
+			fileIO.ReadFileCall.Returns.Contents = []byte(`{"version": 4, "outputs": {"external_ip": {"value": "some-other-ip"}}}`)
+
+			outputs, err := executor.Outputs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outputs).To(Equal(map[string]interface{}{"external_ip": "some-other-ip"}))
+		})
+
+		Context("when there is no terraform state", func() {
+			BeforeEach(func() {
+				fileIO.ReadFileCall.Returns.Error = &os.PathError{Op: "open", Path: tfStatePath, Err: os.ErrNotExist}
+			})
+
+			It("returns no outputs", func() {
+				outputs, err := executor.Outputs()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(outputs).To(BeEmpty())
+			})
+		})
+
+		Context("when a backend is configured", func() {
+			BeforeEach(func() {
+				fileIO.ReadDirCall.Returns.FileInfos = []os.FileInfo{
+					fakes.FileInfo{FileName: "bbl-template.tf"},
+					fakes.FileInfo{FileName: "backend_override.tf"},
+				}
+				fileIO.ReadFileCall.Fake = func(filename string) ([]byte, error) {
+					if filepath.Base(filename) == "backend_override.tf" {
+						return []byte("terraform {\n  backend \"s3\" {}\n}\n"), nil
+					}
+					return []byte(`resource "aws_instance" "nat" {}`), nil
+				}
+				bufferingCmd.RunCall.Stub = func(stdout io.Writer) {
+					fmt.Fprint(stdout, `{"version": 4, "outputs": {"external_ip": {"value": "some-remote-ip"}}}`)
+				}
+				fileIO.StatCall.Returns.Error = os.ErrNotExist
+			})
+
+			It("pulls the state from the backend", func() {
+				outputs, err := executor.Outputs()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(outputs).To(Equal(map[string]interface{}{"external_ip": "some-remote-ip"}))
+
+				Expect(cmd.RunCall.Receives.WorkingDirectory).To(Equal(terraformDir))
+				Expect(cmd.RunCall.Receives.Args).To(Equal([]string{"init", "-input=false"}))
+				Expect(bufferingCmd.RunCall.Receives.WorkingDirectory).To(Equal(terraformDir))
+				Expect(bufferingCmd.RunCall.Receives.Args).To(Equal([]string{"state", "pull"}))
+			})
+
+			Context("when bbl configured the backend", func() {
+				It("passes the backend settings to terraform init", func() {
+					fileIO.StatCall.Returns.Error = nil
+
+					_, err := executor.Outputs()
+					Expect(err).NotTo(HaveOccurred())
+
+					relativeConfigPath, err := filepath.Rel(terraformDir, filepath.Join(varsDir, "bbl.tfbackend"))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(cmd.RunCall.Receives.Args).To(Equal([]string{"init", "-input=false", "-backend-config", relativeConfigPath}))
 				})
 			})
 
@@ -579,60 +1165,35 @@ var _ = Describe("Executor", func() {
 				})
 
 				It("returns an error", func() {
-					_, err := executor.Output("external_ip")
+					_, err := executor.Outputs()
 					Expect(err).To(MatchError("Run terraform init in terraform dir: failed"))
 				})
 			})
 
-			Context("when it fails to call terraform command run", func() {
+			Context("when terraform state pull fails", func() {
 				BeforeEach(func() {
 					bufferingCmd.RunCall.Returns.Errors = []error{errors.New("failed")}
 				})
 
 				It("returns an error", func() {
-					_, err := executor.Output("external_ip")
-					Expect(err).To(MatchError("Run terraform output -state: failed"))
+					_, err := executor.Outputs()
+					Expect(err).To(MatchError("Run terraform state pull: failed"))
 				})
 			})
 		})
-	})
 
-	Describe("Outputs", func() {
-		BeforeEach(func() {
-			bufferingCmd.RunCall.Stub = func(stdout io.Writer) {
-				fmt.Fprintf(stdout, `{
-					"director_address": {
-						"sensitive": false,
-						"type": "string",
-						"value": "some-director-address"
-					},
-					"external_ip": {
-						"sensitive": false,
-						"type": "string",
-						"value": "some-external-ip"
-					}
-				}`)
-			}
-		})
-
-		It("returns all outputs from the terraform state", func() {
-			outputs, err := executor.Outputs()
-			Expect(err).NotTo(HaveOccurred())
-
-			Expect(outputs).To(Equal(map[string]interface{}{
-				"director_address": "some-director-address",
-				"external_ip":      "some-external-ip",
-			}))
+		Context("when an error occurs", func() {
+			Context("when it fails to get terraform dir", func() {
+				BeforeEach(func() {
+					stateStore.GetTerraformDirCall.Returns.Error = errors.New("failed")
+				})
 
-			Expect(bufferingCmd.RunCall.Receives.WorkingDirectory).To(Equal(terraformDir))
-			Expect(bufferingCmd.RunCall.Receives.Args).To(Equal([]string{
-				"output", "--json", "-state", tfStatePath,
-			}))
-			Expect(cmd.RunCall.Receives.WorkingDirectory).To(Equal(terraformDir))
-			Expect(cmd.RunCall.Receives.Args).To(Equal([]string{"init", varsDir}))
-		})
+				It("returns an error", func() {
+					_, err := executor.Outputs()
+					Expect(err).To(MatchError("failed"))
+				})
+			})
 
-		Context("when an error occurs", func() {
 			Context("when it fails to get vars dir", func() {
 				BeforeEach(func() {
 					stateStore.GetVarsDirCall.Returns.Error = errors.New("failed")
@@ -644,70 +1205,85 @@ var _ = Describe("Executor", func() {
 				})
 			})
 
-			Context("when terraform init fails", func() {
+			Context("when it fails to read the terraform dir", func() {
 				BeforeEach(func() {
-					cmd.RunCall.Returns.Errors = []error{errors.New("failed")}
+					fileIO.ReadDirCall.Returns.Error = errors.New("failed")
 				})
 
 				It("returns an error", func() {
 					_, err := executor.Outputs()
-					Expect(err).To(MatchError("Run terraform init in terraform dir: failed"))
+					Expect(err).To(MatchError("Read contents of terraform directory: failed"))
 				})
 			})
 
-			Context("when it fails to call terraform command run", func() {
+			Context("when it fails to read the terraform state", func() {
 				BeforeEach(func() {
-					bufferingCmd.RunCall.Returns.Errors = []error{errors.New("failed")}
+					fileIO.ReadFileCall.Returns.Error = errors.New("failed")
 				})
 
 				It("returns an error", func() {
 					_, err := executor.Outputs()
-					Expect(err).To(MatchError("Run terraform output --json in vars dir: failed"))
+					Expect(err).To(MatchError("Read terraform state: failed"))
 				})
 			})
 
-			Context("when it fails to unmarshal the terraform outputs", func() {
+			Context("when it fails to parse the terraform state", func() {
 				BeforeEach(func() {
-					bufferingCmd.RunCall.Stub = func(stdout io.Writer) {
-						fmt.Fprintf(stdout, "%%%")
-					}
+					fileIO.ReadFileCall.Returns.Contents = []byte("%%%")
 				})
 
 				It("returns an error", func() {
 					_, err := executor.Outputs()
-					Expect(err).To(MatchError("Unmarshal terraform output: invalid character '%' looking for beginning of value"))
+					Expect(err).To(MatchError("Parse terraform state: invalid character '%' looking for beginning of value"))
 				})
 			})
 		})
 	})
 
-	Describe("IsPaved", func() {
-		Context("when the state store fails to get the terraform directory", func() {
-			It("returns an error", func() {
-				stateStore.GetTerraformDirCall.Returns.Error = errors.New("guava")
+	Describe("SensitiveOutputs", func() {
+		It("returns the outputs that are marked sensitive", func() {
+			fileIO.ReadFileCall.Returns.Contents = []byte(`{
+				"version": 4,
+				"outputs": {
+					"external_ip": {"type": "string", "value": "some-external-ip"},
+					"private_key": {"type": "string", "value": "some-private-key", "sensitive": true}
+				}
+			}`)
 
-				_, err := executor.IsPaved()
+			sensitive, err := executor.SensitiveOutputs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sensitive).To(Equal(map[string]bool{"private_key": true}))
+		})
 
-				Expect(err).To(MatchError("guava"))
+		Context("when the state cannot be read", func() {
+			It("returns an error", func() {
+				fileIO.ReadFileCall.Returns.Error = errors.New("papaya")
+
+				_, err := executor.SensitiveOutputs()
+				Expect(err).To(MatchError("Read terraform state: papaya"))
 			})
 		})
+	})
 
-		Context("when the state store fails to get the vars directory", func() {
-			It("returns an error", func() {
-				stateStore.GetVarsDirCall.Returns.Error = errors.New("guava")
+	Describe("IsPaved", func() {
+		Context("when there is no terraform state", func() {
+			BeforeEach(func() {
+				fileIO.ReadFileCall.Returns.Error = &os.PathError{Op: "open", Path: tfStatePath, Err: os.ErrNotExist}
+			})
 
-				_, err := executor.IsPaved()
+			It("returns false", func() {
+				isPaved, err := executor.IsPaved()
 
-				Expect(err).To(MatchError("guava"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(isPaved).To(Equal(false))
 			})
 		})
 
-		Context("when terraform show returns No state", func() {
+		Context("when the terraform state has no resources", func() {
 			BeforeEach(func() {
-				bufferingCmd.RunCall.Stub = func(stdout io.Writer) {
-					fmt.Fprint(stdout, "No state.")
-				}
+				fileIO.ReadFileCall.Returns.Contents = []byte(`{"version": 3, "modules": [{"path": ["root"], "outputs": {}, "resources": {}}]}`)
 			})
+
 			It("returns false", func() {
 				isPaved, err := executor.IsPaved()
 
@@ -716,28 +1292,27 @@ var _ = Describe("Executor", func() {
 			})
 		})
 
-		Context("when terraform show returns outputs", func() {
+		Context("when the terraform state has resources", func() {
 			BeforeEach(func() {
-				bufferingCmd.RunCall.Stub = func(stdout io.Writer) {
-					fmt.Fprint(stdout, "Pretty much anything else.")
-				}
+				fileIO.ReadFileCall.Returns.Contents = []byte(`{"version": 4, "resources": [{"type": "aws_instance", "name": "nat"}]}`)
 			})
+
 			It("returns true", func() {
 				isPaved, err := executor.IsPaved()
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(isPaved).To(Equal(true))
+				Expect(bufferingCmd.RunCall.CallCount).To(Equal(0))
 			})
 		})
 
-		Context("when it fails to call terraform command run", func() {
-			BeforeEach(func() {
-				bufferingCmd.RunCall.Returns.Errors = []error{errors.New("failed")}
-			})
-
+		Context("when the state store fails to get the terraform directory", func() {
 			It("returns an error", func() {
+				stateStore.GetTerraformDirCall.Returns.Error = errors.New("guava")
+
 				_, err := executor.IsPaved()
-				Expect(err).To(MatchError("Run terraform show: failed"))
+
+				Expect(err).To(MatchError("guava"))
 			})
 		})
 	})
@@ -0,0 +1,56 @@
+package gcp
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+)
+
+type OutputContract struct{}
+
+func NewOutputContract() OutputContract {
+	return OutputContract{}
+}
+
+// Outputs lists the outputs that create-env, the cloud config and bbl lbs
+// read from the gcp templates.
+func (c OutputContract) Outputs(state storage.State) []terraform.OutputSpec {
+	outputs := []terraform.OutputSpec{
+		{Name: "network", Type: terraform.StringOutput},
+		{Name: "subnetwork", Type: terraform.StringOutput},
+		{Name: "director_name", Type: terraform.StringOutput},
+		{Name: "internal_cidr", Type: terraform.StringOutput},
+		{Name: "internal_gw", Type: terraform.StringOutput},
+		{Name: "jumpbox__internal_ip", Type: terraform.StringOutput},
+		{Name: "director__internal_ip", Type: terraform.StringOutput},
+		{Name: "jumpbox__tags", Type: terraform.ListOutput},
+		{Name: "director__tags", Type: terraform.ListOutput},
+		{Name: "internal_tag_name", Type: terraform.StringOutput},
+		{Name: "jumpbox_url", Type: terraform.StringOutput},
+		{Name: "external_ip", Type: terraform.StringOutput},
+	}
+
+	switch state.LB.Type {
+	case "concourse":
+		outputs = append(outputs,
+			terraform.OutputSpec{Name: "concourse_target_pool", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "concourse_lb_ip", Type: terraform.StringOutput},
+		)
+	case "cf":
+		outputs = append(outputs,
+			terraform.OutputSpec{Name: "router_backend_service", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "router_lb_ip", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "ssh_proxy_lb_ip", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "tcp_router_lb_ip", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "ws_lb_ip", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "ssh_proxy_target_pool", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "tcp_router_target_pool", Type: terraform.StringOutput},
+			terraform.OutputSpec{Name: "ws_target_pool", Type: terraform.StringOutput},
+		)
+
+		if state.LB.Domain != "" {
+			outputs = append(outputs, terraform.OutputSpec{Name: "system_domain_dns_servers", Type: terraform.ListOutput})
+		}
+	}
+
+	return outputs
+}
@@ -57,14 +57,14 @@ func (t TemplateGenerator) GenerateBackendService(zoneList []string) string {
   timeout_sec = 900
   enable_cdn  = false
 %s
-  health_checks = ["${google_compute_health_check.cf-public-health-check.self_link}"]
+  health_checks = [google_compute_health_check.cf-public-health-check.self_link]
 }
 `
 	var backends string
 	for i := 0; i < len(zoneList); i++ {
 		backends = fmt.Sprintf(`%s
   backend {
-    group = "${google_compute_instance_group.router-lb-%d.self_link}"
+    group = google_compute_instance_group.router-lb-%d.self_link
   }
 `, backends, i)
 	}
@@ -95,7 +95,7 @@ func (t TemplateGenerator) GenerateSubnetCidrs(zoneList []string) string {
 	var cidrs []string
 	for i := 0; i < len(zoneList); i++ {
 		cidrs = append(cidrs, fmt.Sprintf(`output "subnet_cidr_%d" {
-  value = "${cidrsubnet(var.subnet_cidr, 8, %d)}"
+  value = cidrsubnet(var.subnet_cidr, 8, %d)
 }
 `, i+1, (i+1)*16))
 	}
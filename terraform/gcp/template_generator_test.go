@@ -68,31 +68,31 @@ resource "google_compute_instance_group" "router-lb-2" {
   enable_cdn  = false
 
   backend {
-    group = "${google_compute_instance_group.router-lb-0.self_link}"
+    group = google_compute_instance_group.router-lb-0.self_link
   }
 
   backend {
-    group = "${google_compute_instance_group.router-lb-1.self_link}"
+    group = google_compute_instance_group.router-lb-1.self_link
   }
 
   backend {
-    group = "${google_compute_instance_group.router-lb-2.self_link}"
+    group = google_compute_instance_group.router-lb-2.self_link
   }
 
-  health_checks = ["${google_compute_health_check.cf-public-health-check.self_link}"]
+  health_checks = [google_compute_health_check.cf-public-health-check.self_link]
 }
 `
 
 		subnetCIDRs = `output "subnet_cidr_1" {
-  value = "${cidrsubnet(var.subnet_cidr, 8, 16)}"
+  value = cidrsubnet(var.subnet_cidr, 8, 16)
 }
 
 output "subnet_cidr_2" {
-  value = "${cidrsubnet(var.subnet_cidr, 8, 32)}"
+  value = cidrsubnet(var.subnet_cidr, 8, 32)
 }
 
 output "subnet_cidr_3" {
-  value = "${cidrsubnet(var.subnet_cidr, 8, 48)}"
+  value = cidrsubnet(var.subnet_cidr, 8, 48)
 }
 `
 	})
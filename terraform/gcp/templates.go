@@ -73,7 +73,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesBosh_directorTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x96\xdf\x8e\xa2\x30\x14\xc6\xef\x79\x8a\x93\x66\x2f\x66\x12\x64\x15\x45\xd9\x0b\x9f\xc4\x98\xa6\x60\x65\xd8\xa9\x94\x94\xa2\xb3\x99\xf0\xee\x9b\xb6\xfc\x17\x19\x9c\x19\x33\x7a\x01\xd2\xef\x9c\xf3\xf5\xd7\x53\xe9\x99\x88\x98\x04\x8c\x02\xca\xf2\x20\xa1\x12\x87\xf1\x41\x20\x78\xb7\x00\xe4\xbf\x94\x02\x00\x6c\x01\x65\x52\xc4\x49\x84\x2c\x80\x03\x3d\x92\x9c\x49\xf5\x70\x31\x77\xf4\xf7\xf7\x62\x8d\xac\xc2\xb2\x04\xcd\x78\x2e\x42\x0a\x28\xe2\x3c\x62\x14\x87\xfc\x94\xe6\x92\xe2\x84\xca\x0b\x17\xaf\x08\x50\x10\xb0\x59\xfd\x4b\xd5\x48\xc8\x49\xd7\xe8\x7f\xb6\x80\x7e\xbd\x9f\x89\x70\x68\x72\xc6\xf1\xa1\xa8\xa3\x2c\x00\x92\x4b\x8e\x43\x41\x89\xa4\xd8\x98\x56\x23\x19\x6c\xe1\x48\x58\x46\x47\xad\x34\xfa\xd2\x8d\x79\x30\x64\xe6\xca\x42\x29\xb5\x00\xe2\x54\x63\xc2\x82\x24\x11\x6d\x84\x2d\x82\x85\x92\x95\x95\xda\xf9\x86\xc9\x38\x2d\x2e\x4e\x46\xd9\x11\xb3\x38\x79\x2d\xc6\xa9\x1e\x63\x41\x2f\x84\x31\x04\x88\xbe\x49\x2a\x12\xc2\xba\xd3\xb8\x9a\x40\x2d\x6b\x79\x9b\xea\x4a\x25\x2d\x90\x65\x01\x18\x3b\x66\xee\x0a\xfa\x0e\x55\x7d\x30\x47\x7b\x25\x20\x8c\xf1\x8b\x76\x02\x90\x72\x21\x33\x63\x66\x87\x5c\x17\xd9\x80\xd6\xfe\xda\x57\x57\xd7\xf3\x3c\x0f\xed\x8d\x4c\x70\xc9\x43\xce\x94\x1d\x19\xa6\xca\x60\xa1\x52\x49\x22\x22\x2a\xb1\x24\x91\xa9\xd4\x9d\x4f\xc0\xb3\x97\x19\x4f\x69\x82\xf6\x53\x49\x35\x21\xe3\xa8\x1a\xdd\x77\xb0\x9a\xe0\x7f\x3a\x37\x7f\xb5\x5a\xea\xab\xbf\x5a\x7d\x23\xc7\x43\x2c\x68\x28\xb9\xb8\x93\x65\x1d\x36\x81\x67\xad\x7d\x34\xd3\xd6\x5c\xfa\x5c\x3f\x05\x28\x4e\xca\x8d\x33\x99\x4d\x15\x31\x93\x7c\x2a\xa2\xc1\x90\x07\x92\x6a\x4d\x6a\xac\xf9\x56\xae\x69\x3f\xd7\x73\xbd\xb9\xb9\xd9\x6c\x36\x3f\xd1\x6f\x7f\xf3\x53\x1a\xf0\x37\xc5\x47\x3f\x18\xa5\xd9\x13\x3f\x90\x63\x59\x69\xd2\x1e\x5e\x2e\xfd\x3f\x5f\x42\x57\x2f\x9a\x3d\xbe\xc3\xee\x6e\xd4\x89\xcd\xf9\x43\x0d\xd9\x62\x15\x87\xa7\x06\xd6\x94\xad\x7d\x4b\x93\x1f\x3e\xb7\xfd\x19\x0f\x09\xcb\x74\xc2\x6a\x40\xbf\xf2\x0d\x0f\x75\x67\xce\x01\x4f\xbd\x23\x81\x0d\xbe\x0d\xf3\x67\xf3\x56\xe7\xb9\x4c\x73\x09\xa8\x73\x18\x3a\x13\x96\xd3\x7b\xb1\xb6\x92\xb5\x0f\x37\xe3\xf9\x1a\xa5\xd3\x1c\x82\x06\x32\x56\xfd\x84\xd5\x50\x2f\xa9\x6e\xb8\x0e\xa8\x4e\x68\x87\xcd\x95\x1f\x0d\xd1\xe9\x68\x6e\x84\x47\x97\xab\x60\xa5\x7e\xe1\x99\x7c\x1a\xc8\x62\xc3\xa2\x87\xb8\xdc\x9f\x18\xd7\xba\x38\xbd\x33\xa5\xf7\x7c\x03\xcb\x17\x72\xae\x6f\xd9\x54\x4d\xd8\xcd\xb5\xd3\xbd\x7b\xbd\x8a\xd5\x36\x76\xea\x83\x44\xb9\x84\x76\x15\x30\xf4\x3f\xa5\x06\xf7\xc3\xb3\x19\x28\xfd\x51\xd5\x2a\xb8\xac\xbc\x1f\x5c\x43\x49\xa2\xa1\x0e\xba\x9d\xba\x8a\x6c\x5a\xf2\x7f\x00\x00\x00\xff\xff\x08\x23\x4c\x5b\xa8\x0c\x00\x00")
+var _templatesBosh_directorTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x96\xc1\x6e\xa3\x3c\x14\x85\xf7\x7e\x8a\x2b\xeb\x5f\xb4\x12\xe5\x4f\x69\x48\x99\x05\x4f\x52\x45\x96\x21\x0e\x65\xea\x60\x64\x4c\xd2\x51\x95\x77\x1f\xd9\x06\x03\x09\xa1\xa4\x6d\x34\xca\x82\x36\x3e\xf7\xdc\xe3\x8f\xeb\xc0\x9e\xca\x9c\x26\x9c\x01\xae\xea\xa4\x60\x8a\xa4\xf9\x46\x62\xf8\x40\x00\xea\x4f\xc9\x00\x00\x62\xa8\x94\xcc\x8b\x0c\x01\x6c\xd8\x96\xd6\x5c\x41\x0c\xf8\x71\xe1\x9b\xcf\xff\x8f\x2b\x8c\x8e\x08\x49\x56\x89\x5a\xa6\x0c\x70\x26\x44\xc6\x19\x49\xc5\xae\xac\x15\x23\x05\x53\x07\x21\xdf\x30\xe0\x24\xe1\x0f\xee\x3f\xdd\xa1\xa0\x3b\xd3\xe1\xf4\x13\x03\xfe\xef\x63\x4f\xa5\xcf\x8a\x3d\xc9\x37\x47\x57\x85\x00\x68\xad\x04\x49\x25\xa3\x8a\x11\x1b\x59\xaf\x54\x10\xc3\x96\xf2\x8a\x4d\x46\xe9\xf4\x4d\x1a\xfb\xc5\x58\x98\xb3\x08\x8d\x14\x01\xe4\xa5\x81\x44\x24\x2d\x32\x06\x31\xe8\xa0\x3d\x78\x7a\x5f\xb6\x89\xb3\x1a\x27\xe2\xf7\x78\xf8\x15\xe3\x5b\xc2\xf3\xe2\x6d\x72\x03\xdb\x5c\xb2\x03\xe5\x1c\x03\x66\xef\x8a\xc9\x82\xf2\x61\xf8\xb3\xd8\x4e\xd6\x8b\x35\x2b\x90\x76\x44\x08\xc0\x26\xb1\x9b\xd5\x94\x5f\x70\x7b\xe3\x17\x78\xad\x05\x94\x73\x71\x30\x21\x00\x4a\x21\x55\x65\x73\xbc\xe0\x20\xc0\x1e\xe0\x55\xb4\x8a\xf4\x35\x08\xc3\x30\xc4\x6b\x2b\x93\x42\x89\x54\x70\x3d\x47\x2a\x2d\x31\x02\x38\x6a\x2b\x45\x65\xc6\x14\x51\x34\xb3\x9d\x86\x5b\x49\x44\xf5\xfa\x20\x4a\x56\xe0\xf5\x5c\x48\x5d\xc9\x34\xa5\x4e\xf7\x4d\x4c\x33\xa2\xcf\x47\x16\x2d\x97\x4f\xe6\x1a\x2d\x97\x3f\x88\x70\x93\x4b\x96\x2a\x21\xaf\xc4\xe8\xca\x66\xa0\x74\xda\x1b\xe2\x74\x3d\xce\x91\x7e\x89\x4d\x5e\x34\x27\x65\x36\x96\xb6\xe2\x41\x89\xb9\x74\x46\x4b\x6e\x03\xa9\x6d\xf5\xc9\xc8\x2d\x03\x3b\x74\x41\x18\x84\x0b\xfb\xc7\xf3\xf3\xf3\xbf\x98\xb2\xdf\xf5\xae\x4c\xc4\xbb\x46\x63\xbe\x98\x04\x79\x22\xbe\x0d\xc2\xa6\xc9\xac\x43\xfb\xf4\x14\xfd\xfa\x16\x35\x77\xbf\x3c\xf8\x19\x9e\xce\x70\xde\x48\xde\xe8\xa7\x6f\x62\x0c\x7b\x98\xf2\x74\xd7\x71\xba\x24\x52\xe9\xe7\x9a\x7a\x73\x35\x6f\x03\x94\x8b\x94\xf2\xca\x90\x6a\x17\xcc\xd3\x1c\x62\xd0\x17\xfb\x7c\xbf\x3b\x79\xd4\x7b\x10\x79\xb0\xb8\xd7\xf5\xa2\x56\x65\xad\x00\x37\x70\xec\xcf\xc0\x9e\xf2\x9a\x5d\x01\xb3\xe7\xd3\x7f\x55\x99\xb0\xea\x64\x7e\xf7\x3e\x73\x66\xd6\xce\x0e\xd1\x0b\x43\x3f\x6c\x86\x6b\x40\x06\xf7\x4b\x07\x30\x86\xa5\x06\x99\x3f\x10\x8c\x56\x66\x87\x61\x9d\xe6\xf9\x2a\x2a\x75\x37\x62\xe0\xc1\xe3\x00\x67\x73\x02\x09\x71\xaa\xbc\xbc\xc6\x2d\xbc\x1f\xe5\xf0\x55\xbb\xd5\x78\x38\x3d\xfa\x43\x9b\x17\x33\x98\x17\xce\xa6\xef\x5e\x07\xcc\x9d\xf2\x8c\xf6\x64\x3a\x1b\x6f\xac\x17\xd7\xe3\x7b\x18\xe9\x3a\xd9\xb0\xad\x34\x4d\x07\x9e\x6e\x97\x8a\x66\x23\x33\x72\xc9\xb5\x2d\xf3\x0b\xba\x63\xe8\x88\xfe\x0e\x00\x3b\x07\xf0\x83\x4c\x0c\x00\x00")
 
 func templatesBosh_directorTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -88,12 +88,12 @@ func templatesBosh_directorTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/bosh_director.tf", size: 3240, mode: os.FileMode(480), modTime: time.Unix(1521677188, 0)}
+	info := bindataFileInfo{name: "templates/bosh_director.tf", size: 3148, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesCf_dnsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\xcf\x8a\xdb\x30\x10\x87\xef\x7a\x8a\x41\xf4\x54\xb0\x59\xe8\x79\x0f\x85\x9e\x7b\xe9\xb1\x2c\x46\x91\x26\x8e\x8a\xac\x11\x33\xb2\xb3\xe9\xe2\x77\x2f\x72\xe2\x6c\xd2\xad\xe9\xfa\x10\xc8\x61\x73\xc9\x1f\xfd\x34\xf3\xcd\xa7\x60\x34\x18\xf6\x66\x13\x10\xb4\x1c\x24\x63\xd7\x38\xea\x8c\x8f\x1a\x5e\x14\x40\x3e\x24\x84\x47\xd0\x92\xd9\xc7\x56\xab\x51\x29\x46\xa1\x9e\x2d\x82\x6e\x89\xda\x80\x8d\x8b\xd2\x74\x26\x9a\x16\x5d\xf3\x9b\x22\x6a\xd0\x18\x87\xe9\xe7\xe3\xd7\x52\x28\x9a\x0e\xe1\xf4\x7a\x04\xfd\xe9\x65\x30\x5c\x97\x98\x77\x63\x35\xc5\x14\x40\xd9\x32\x07\xcf\xa1\x2b\xaa\xb1\x9e\x72\x28\x96\x7d\xca\x9e\x62\xc9\x7d\xfb\xfe\x03\x4a\x09\xd8\x12\x43\xde\x21\x5c\x55\x07\x8c\x83\x67\x8a\x1d\xc6\x3c\x0d\x40\x7d\x4e\x7d\xfe\x6b\xdc\x09\x57\x90\x07\x64\x39\x12\x0f\x26\xf4\x78\xc4\x58\x18\xb4\xbe\x1c\xb3\x2e\xe0\x73\x85\x71\xd9\x14\xa3\x25\x76\x8d\x60\xd6\xa0\xf7\x3e\x38\x6b\xd8\x55\x2e\xca\x1b\x4f\x8f\xa0\x3f\xd7\xef\x6c\x3e\x9b\x1b\x8f\x7a\x12\x46\x27\xcd\x64\xe7\xe7\xdc\xdc\x52\x97\xfa\x8c\x4d\x1b\x68\x63\x42\x63\x9c\x63\x14\xa9\xed\xb6\x3a\x7d\xd4\x4f\xf3\x81\x9f\xfb\x7f\x2d\xe5\x72\x0e\xaf\x27\xf7\xe5\xe1\x41\x29\x80\x4b\x92\x95\x8e\x46\x5d\x0a\x30\x3b\x93\x8d\x4c\x80\xe7\xcd\xff\x45\xac\x4f\xef\xa3\x7e\x7a\x9f\xe0\x0d\xc9\x6e\x49\x6e\x59\xbb\x81\xdf\x19\xf5\x57\xdf\xa5\x0d\x3d\x57\x3e\xdd\x8f\xd8\xb7\x6c\xab\x8d\xda\x6d\x25\xb2\xab\x12\xd3\xf3\xe1\x5f\x56\xe5\xa6\x52\xaf\xba\xdf\x9d\xd6\x4b\xba\xd5\x62\xb3\x4d\x4b\xff\xd4\x6c\xd3\x6d\x9d\x96\xde\x4c\x7d\x46\xbe\x4b\xa9\xaf\x78\xab\xad\x3a\x4a\x29\x20\x2f\x99\x3d\x2d\xdf\xd6\xee\xfe\x8e\x1e\xad\x57\x58\xab\x6d\x06\x6a\x5b\xc6\xd6\x64\x5a\x34\x7a\x11\xf9\xb0\xba\xf2\x16\xb0\x97\xe5\x8b\xc0\x5e\x3e\x74\xaa\x51\xfd\x09\x00\x00\xff\xff\xab\x21\x00\xec\xae\x0a\x00\x00")
+var _templatesCf_dnsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x95\x41\x8b\x9c\x40\x10\x85\xef\xfe\x8a\xa2\xd9\xd3\x82\xb2\x90\xb3\x87\x40\xce\xb9\xe4\x18\x16\xe9\xb1\x6b\x9c\x0e\x6d\x57\x53\xd5\xea\x4e\x86\xf9\xef\xa1\x1d\x9d\xb8\xd9\x08\x2b\x64\xc8\x40\xe2\x45\xed\x7e\x55\xf5\xde\xa7\x68\xaf\xd9\xea\x9d\x43\x50\x72\x94\x88\x6d\x65\xa8\xd5\xd6\x2b\x38\x65\x00\xf1\x18\x10\x4a\x90\xc8\xd6\x37\xd9\x39\xcb\x18\x85\x3a\xae\x11\x54\x43\xd4\x38\xac\x8c\x97\xaa\xd5\x5e\x37\x68\xaa\xef\xe4\x51\x81\x42\xdf\x8f\xcb\x97\xdb\xd4\xc6\xeb\x16\x61\x3a\x4a\x50\x0f\xa7\x5e\x73\x91\x64\xd6\x9c\xf3\x51\x96\x01\xa4\x92\x59\x78\x15\xbd\xf2\x74\x2e\x46\x1d\x4a\xcd\x36\x44\x4b\x1e\x4a\x50\x9f\x3e\x7f\x81\xd4\x02\xf6\xc4\x10\x0f\x08\xaf\xba\x03\xfa\xde\x32\xf9\x16\x7d\x54\x29\x00\x75\x31\x74\xf1\x97\xb0\xa3\x5d\x41\xee\x91\xe5\xe2\xb8\xd7\xae\x4b\xc9\x57\x52\x16\xcb\x8c\x45\x72\x3d\x97\xaf\x42\x62\xac\x89\x4d\x25\x18\x15\xa8\xc1\x3a\x53\x6b\x36\xb9\xf1\xf2\x06\x51\x09\xea\xb1\x78\x38\xbd\x6b\xf4\x0c\xed\x7c\x21\x13\xd0\x1b\xa9\x46\x30\x5f\xa7\xfa\x9a\xda\xd0\x45\xac\x1a\x47\x3b\xed\x2a\x6d\x0c\xa3\x48\x51\xef\xf3\xe9\xf2\x79\x7e\xce\xd7\xe9\x1f\x53\xb3\x18\xdd\xb4\x02\x25\x7c\x78\x7a\xca\x32\x80\xa5\x8f\x2d\x70\x52\x2d\xb3\xd1\x51\xcb\x16\x67\xc5\x74\x7e\x7e\x1f\xd4\x1d\xc9\x61\x0d\x68\xda\xfb\xf3\x4c\x67\x9f\xdf\xba\x36\xec\xe8\x25\xb7\xe1\xaf\xc3\x7c\x6b\x69\x23\xc5\x7a\x9f\x8b\x1c\xf2\xc0\xf4\x72\xfc\x1d\x49\xb9\x25\xc8\xe5\xf0\xbb\x41\xb9\x34\xb5\x11\x66\xac\xc3\xda\x1b\x19\xeb\x70\x53\x8e\x69\x34\x53\x17\x91\xef\x09\xe4\x4f\x57\x1b\x49\x1a\x0a\xc1\x21\xaf\xd1\x9c\xb6\x6f\x4a\x74\x90\x7b\x22\x39\x6c\xfd\x3c\x3a\x6a\x1a\xc6\x46\x47\x5a\xa5\xb8\x90\xfc\x27\xb9\x4e\xf2\xfa\xf7\x1e\x64\x8d\xe4\x63\x31\xc8\x3f\x8b\xf0\xc7\x00\x30\xc0\x11\x4b\x53\x0a\x00\x00")
 
 func templatesCf_dnsTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -108,12 +108,12 @@ func templatesCf_dnsTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/cf_dns.tf", size: 2734, mode: os.FileMode(480), modTime: time.Unix(1520537908, 0)}
+	info := bindataFileInfo{name: "templates/cf_dns.tf", size: 2643, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesCf_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\xcf\x8f\xe3\x26\x14\xbe\xe7\xaf\x40\xa8\xc7\x75\x26\xc9\x4c\xb7\xe9\x61\x4f\x55\xaf\xdb\x1e\x7a\x5b\x8d\x10\xc6\xcf\x09\x0a\x63\x28\xe0\x64\xa3\xd5\xfc\xef\x15\x60\x27\x76\x6c\x63\x3b\x33\xab\x6a\x76\x0f\xf1\x18\xde\xf7\xe0\x7b\x3f\xf8\xf0\x91\x6a\x4e\x53\x01\x08\x1b\x23\x08\x03\x6d\x79\xce\x19\xb5\x80\xd1\x8f\x05\x42\xf6\xac\x00\x7d\x41\xd8\x58\xcd\x8b\x1d\x5e\xbc\x2e\x16\x83\x16\x44\x69\x7e\x74\xbf\x07\x38\x0f\x5a\xcb\xd2\xaa\xd2\x22\xac\x65\x69\x41\x93\x94\xb2\x03\x14\x19\x31\xa0\x8f\x9c\x55\x4e\x8f\x54\x94\xde\xee\x97\x1f\x3b\x29\x77\x02\x08\x93\x2f\xaa\xb4\x70\x3b\x7d\x19\x50\x12\x91\x26\xd5\x48\x52\x8f\x14\xf4\x05\x5e\xfb\x3c\x8a\x94\x70\x35\xe6\x67\x27\x64\x4a\x05\xa1\x59\xa6\xc1\x98\x25\xcb\x93\xfa\xb1\xfa\x6d\x43\x1b\xb3\x27\x4a\xcb\xef\xe7\x69\xe8\x0d\x58\x63\xf6\x89\xb7\xec\x07\xb6\x4c\x91\x39\xeb\x6e\x20\x5b\xa6\x92\x60\xda\x0f\x7d\x32\xb3\x21\x4f\x37\xdb\xd7\x60\x64\xa9\x19\x20\x7c\x63\x93\x73\x0d\x27\x2a\x04\x46\xb8\x7e\x4c\x58\x1e\x3c\xb9\xc0\xa0\xf0\xcf\xbb\x3b\x52\xbd\x84\xe2\x48\x78\xf6\x9a\xb0\x3c\x91\x0a\x0a\xbc\x40\x28\x03\x05\x45\x66\x88\x2c\xd0\x17\xf4\xed\xd6\x41\x01\xf6\x24\xf5\x61\x99\xa6\x22\xa9\x9e\xf1\xb3\x03\x0f\xcf\x17\xf0\x71\xb3\x3a\x51\x16\x08\x51\x21\xe4\xc9\xaf\x11\x21\xa5\xa5\x95\x4c\x0a\x07\x63\x99\xc2\xe1\xa5\xd4\xd6\x04\xec\x6f\x78\xbb\xc2\x9f\x10\x7e\x7a\x7a\xf4\x8e\x5f\x1d\x40\x60\x83\x68\x5a\xec\xc0\xf8\x49\xab\xa5\xff\xff\xb0\xc2\xcf\x6e\x82\xa5\x7a\x07\x96\x58\xba\x0b\xc3\x6f\xce\xef\xe7\x68\x18\xda\x59\x8c\x11\xbe\xe6\x71\x23\x16\x3d\x51\x88\x47\xb7\x82\xcd\xa5\x3e\x51\x9d\xf1\x62\x47\x74\x29\x20\xc0\xef\xad\x55\xc9\x75\x24\x09\x23\x13\xe2\xee\x0c\x1d\xcb\x5c\xd5\xeb\xbd\xbb\x30\x6b\x9e\xd1\x50\x1a\x54\x61\x70\x2e\x43\xd9\x2e\xeb\x95\x8b\xb4\xaa\x46\x03\x22\x27\x82\x17\x07\x8f\xe7\x02\x1f\xc2\xea\xf0\xb6\xab\xb7\xf1\x63\xee\x26\xc8\xfc\x0f\x0c\x99\x36\x45\x66\x1a\x47\xae\x2e\xa2\x24\x75\x62\xd0\xc8\x9f\xda\x43\x87\x97\x2e\x31\x7e\x7e\x98\xec\x9b\x86\x61\x9a\x2b\xcb\x7d\xd7\xc0\x1a\xa8\x10\x67\x44\x91\x90\x34\x43\x29\x15\xb4\x60\xa0\x51\x5a\x5a\x24\xb8\xb1\x90\x21\x6a\x10\x2d\x90\x03\x41\x17\x90\x52\x0b\xf2\x42\xd5\x20\x37\xd5\x78\x8b\x90\x52\x8b\xc4\xbd\x6b\x52\x32\x71\xf7\xe6\x76\xfb\x26\xb2\xff\x61\x12\x4c\x3f\x0b\xb5\xc1\x1c\x2a\x4c\x3f\x17\x6f\x26\x04\xa1\x1b\xc1\x30\xd0\x04\x6f\x66\x39\x5c\xf7\x67\x13\x2b\xde\xf7\x3a\x4a\x06\x57\x10\x57\x42\x89\xd2\x90\xf3\xef\x1d\x2e\x7b\xb2\xa8\x34\xa0\x1d\x23\x47\x9e\x41\xe6\xb6\x80\x2a\x9d\x83\x0e\x70\x46\x0f\xfe\x4d\xc3\x1b\x52\x94\x6b\x5f\x10\x57\x35\x74\x75\x13\x91\x4c\xde\x77\x13\x68\xc8\x28\x9c\x56\x82\xe7\xc0\xce\x4c\x40\x75\x62\x31\x0d\x0e\x28\x85\x5c\x6a\x20\x19\x18\xab\xa5\x73\x6c\x75\x09\xfe\x80\x8a\x31\x56\x85\xf0\x26\x09\xab\x20\xc6\xcf\x8a\xaa\x73\x7b\xde\x72\x5a\x0a\x5b\x1f\x5e\x6f\x14\x70\x53\x4b\x69\x0f\x54\xd8\x3d\x61\x7b\x60\x87\xb0\x7e\x55\xa6\x82\xb3\x24\x0c\x24\xd5\x40\x74\x0b\xc1\xc2\x6f\xc2\x37\xa4\x26\x66\x2d\x08\xa4\xb6\x8d\x2a\xd8\xae\xb6\x2b\xff\x5e\xc3\xbf\x25\x18\x4b\x14\xb5\x7b\x87\xfd\x10\x6c\xf1\x28\xe5\x1d\x47\x53\x16\x3f\xd8\x02\xdc\x99\x3d\xb4\xc8\xc1\x25\x4e\x94\x70\x2e\xc6\xb1\xe5\xf4\x26\x45\xd3\xe0\x63\xc8\xb9\x20\xe8\xb6\xab\x98\x9e\x5b\x3f\xae\x96\x9b\xf5\xda\x6b\xba\xcd\xc6\xcd\x7f\xfc\x75\xb9\xfe\x3d\xbc\x58\x7f\xf6\xa6\x4d\x91\x87\xde\x51\xe6\x75\x2f\x1b\x95\x27\x25\xa5\x18\x53\xf1\x8d\xa9\xed\x6b\xc7\xf5\x9e\x34\x98\x0a\x2d\xfd\x78\xb1\x1c\x29\xa9\xeb\xbc\x19\x69\xd6\x07\x3e\x9c\x63\x97\xd9\x1f\xe7\xd2\xb0\xd9\x6c\x36\xd7\xfc\x1a\xbd\x0e\x8c\x44\x2d\x7e\x0a\xb6\xb2\xe3\xce\xd0\xb9\x22\x00\x63\xb8\x2c\x08\xcd\x73\x5e\x70\xeb\xcf\xb2\xaf\x7f\x7d\xfd\x73\x24\xae\x7d\xe2\x77\x38\xbc\x63\xeb\x68\x09\xd6\x79\x09\x3e\xa8\x52\x1d\x8c\x8f\x47\xd0\xd4\xcd\xe0\xfd\xf3\xc7\xdf\x37\x4a\xfb\xfd\xee\xf1\xf7\x17\x6d\xe3\x46\x3f\xa1\x6a\xdb\x95\x75\xb5\x9d\x54\x5a\x8d\xe9\x1f\xa1\xac\xd6\xab\xcd\x53\xf2\xb8\xf9\xed\xf3\xf6\xfe\xe2\xea\xb0\x1b\xaf\xae\x56\x53\xec\x65\x77\x8c\xd7\x3b\xc4\x41\x24\x8a\x53\xe2\xd8\x91\x07\xf7\x8a\x83\x4e\x6b\xb9\x8b\x80\x68\x73\x71\x52\xac\xb1\x7f\x1f\x43\x1f\xf8\x6e\x20\x3b\x64\xf5\x86\xf3\xd3\x02\xa1\x78\x48\x7b\x7b\x56\x8c\xf2\x71\xc6\x67\x76\xad\xc6\xa2\xa3\x6d\xab\x91\xef\xef\xd1\xbc\x26\x7c\x2a\xbc\xbf\x6b\x9d\xcc\x6c\x8d\x71\x1a\xf9\x3c\xe5\x26\xcc\xcb\xcf\x49\x88\xb3\xf3\x71\x62\x2a\xf6\x48\xfa\x49\x2d\xa6\x37\x1f\x4f\xa6\xfa\x12\x34\x29\x1b\x2f\xb3\xe7\xe7\xe2\xc9\xc4\x73\xd0\x7f\xe1\x79\x87\xe4\x9b\xfe\x51\x39\x42\xc7\x2c\x36\x7e\x02\x19\xdb\xd5\xcf\xe0\xe2\xbf\x00\x00\x00\xff\xff\x26\x2a\xa4\x82\x9c\x19\x00\x00")
+var _templatesCf_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x41\x8f\xdb\x36\x13\xbd\xeb\x57\x0c\x88\xef\x18\x39\xb6\x37\x5f\xea\x1e\x7c\x2a\x7a\x4d\x7b\xe8\x2d\x58\x08\x14\x35\xb2\x09\x73\x45\x95\xa4\xac\x18\xc1\xfe\xf7\x82\x22\xb5\x92\x2d\x9a\x96\xbd\x41\x91\x6e\x0e\x56\xc4\x99\x37\xc3\x37\x8f\xe4\x50\x47\xaa\x38\xcd\x05\x02\xd1\x5a\x64\x0c\x95\xe1\x25\x67\xd4\x20\x81\xef\x09\x80\x39\xd5\x08\x5b\xd0\x46\xf1\x6a\x97\xbc\x26\xc9\x55\xfb\xac\x56\xfc\x68\x7f\x0f\x78\xba\xe2\x2b\x1b\x53\x37\x06\x88\x92\x8d\x41\x95\xe5\x94\x1d\xb0\x2a\x32\x8d\xea\xc8\x99\x0f\x78\xa4\xa2\xb1\x11\x77\x52\xee\x04\x66\x4c\xbe\xd4\x8d\xc1\x4b\xdb\x85\x83\x48\x45\x9e\xfa\x91\xb4\x1f\xa9\xe8\x0b\x06\x82\x89\x3c\xe3\x75\x34\xc4\x4e\xc8\x9c\x8a\x8c\x16\x85\x42\xad\x17\xac\x4c\xfb\x47\xff\x3b\x46\xd5\x7a\x9f\xd5\x4a\x7e\x3b\xcd\x00\xee\x61\x58\x99\x6a\xbd\x4f\x3b\xb7\x10\xa6\x61\x75\x36\x3b\xdb\x11\xa8\x61\x75\xea\xfc\x42\xa8\xad\xbe\x0f\xad\x3d\x9b\xaf\x42\x2d\x1b\xc5\x10\xc8\x85\x47\xc9\x15\xb6\x54\x08\x02\xa4\x7f\x4c\x59\xe9\x82\xd8\x12\x80\xfb\xdb\x02\xf9\xdf\xf7\x23\x55\x0b\xac\x8e\x19\x2f\x5e\x53\x56\xa6\xb2\xc6\x8a\x24\x00\x05\xd6\x58\x15\x3a\x93\x15\x6c\xe1\xeb\x05\x7e\x85\xa6\x95\xea\xb0\xc8\x73\x91\xfa\xe7\xe7\x04\xc0\x3f\x3a\xe8\xdb\x2e\x0b\x9b\x4a\x92\x00\x50\x21\x64\xdb\x25\x07\x50\x2b\x69\x24\x93\x02\xb6\x1d\xe7\x36\x15\x80\x5a\x2a\xa3\xed\xc3\x16\xbe\x92\xcd\x92\x7c\x00\xf2\xe9\xd3\x13\xb1\x31\x5f\x2d\x80\xa3\x21\x53\xb4\xda\xa1\xb6\xf9\x92\xe5\xa2\xfb\xf7\x71\x49\x9e\xad\x81\xa1\x6a\x87\x26\x33\x74\xa7\x03\xd3\xb9\x4f\xbf\xcf\x51\xea\xcf\x85\x4a\x80\x0c\x52\x1d\xf1\x1f\x60\x9e\xcc\x81\x2d\xa5\x6a\xa9\x2a\x78\xb5\xcb\x54\x23\xd0\xc1\xef\x8d\xa9\xd3\x61\x24\x75\x23\x33\x6a\x6d\x1d\x2d\xc1\xbc\xee\xf3\x9d\xd6\xed\xf6\xc2\xeb\xd9\xf5\x61\x2e\xfc\x3d\xf3\x36\x94\x5b\x93\x8b\x3e\x63\x91\xfb\xd5\xa6\x51\x94\x99\xe0\xd5\x21\x71\x95\x76\x75\xb4\x19\x6f\x96\xef\x63\x45\x3f\x4c\x8b\xfe\x17\x79\xd1\xe7\xc4\xe8\x39\xcc\x58\xf9\x47\xa9\x19\xe1\x3b\xf8\x91\x56\x7a\xfc\x09\x1b\x53\x3a\x3a\x7b\xe7\xdf\x6d\x0a\x9a\x29\x5e\x1b\xde\xed\x0a\x44\x21\x15\xe2\x04\x14\x84\xa4\x05\xe4\x54\xd0\x8a\xa1\x82\xbc\x31\x20\xb8\x36\x58\x00\xd5\x40\x2b\xb0\x20\xf0\x06\xd2\x28\x91\xbd\xd0\x3a\x4c\x8b\x1f\x3c\xe3\xa2\x51\x22\xb5\xef\x06\x36\x66\x4e\x5c\x5f\xce\x5c\x47\xa6\x7e\x7d\xfe\x3a\x4c\x40\xef\x70\x0f\x0b\x3a\x4c\xc3\x7b\xb8\x00\xb8\x38\xeb\x43\x3b\xdc\x85\x89\xa5\xd7\xfe\x77\x80\x89\x6f\x6a\x17\xee\x4e\x4a\xf6\xc5\x40\x63\x56\x2b\x2c\xf9\x37\xd8\xc2\x40\xdf\x54\x31\x8d\x46\x65\x29\x38\xf2\x02\x0b\x9b\x38\xf8\xc6\x04\x0e\x78\x82\x8f\xdd\x9b\x51\x20\xa8\x29\x57\x96\xac\x51\xfb\xe2\x23\x44\x1a\x9c\x04\xce\x30\x82\xf6\xf6\x50\x10\xbc\x44\x76\x62\x02\xfd\xd1\xc3\x14\x5a\x84\x1c\x4b\xa9\x30\x2b\x50\x1b\x25\x4f\xb0\x05\xa3\x1a\xec\x4e\x9a\x18\x45\xbe\x58\x17\x5a\xf3\xe5\x1a\xa9\x6d\xa2\x30\x6f\x4e\x6c\x46\x05\x96\xb4\x11\xa6\x3f\x88\xa6\x92\x98\x7f\x52\x0d\x02\x89\x65\xbd\x47\x2a\xcc\x3e\x63\x7b\x64\x07\x97\x7a\xdd\xe4\x82\xb3\xd4\x0d\xa4\x7e\x20\x9a\xbd\xf3\xe8\xf2\xb7\xf3\x3e\xc3\xec\x0f\x75\xa9\x4c\x2f\x73\xd8\xc2\x66\xb9\x59\x76\xef\x15\xfe\xdd\xa0\x36\x59\x4d\xcd\xde\x62\x7f\x74\xbe\xe4\x26\xdb\x93\x40\x73\x92\xef\xff\x02\x93\x20\x7e\x83\x9d\x26\x79\x35\xc5\x99\xfd\x17\x2b\xe3\xe9\x84\x18\x3d\x73\xf8\xc9\x7b\x31\xd7\x8d\x6d\x96\xb1\x66\x6c\xf5\xb4\x5c\xac\x57\xab\xae\x21\x5b\xaf\xad\xfd\xd3\xff\x17\xab\x5f\xdd\x8b\xd5\xe7\xce\x75\xdc\xa1\x41\x60\x9a\x0f\xf4\x68\xd3\xeb\x80\x0f\x52\x4b\x29\xa2\x1d\xf7\xc8\xee\xfc\x62\xd0\xdf\x5f\xae\x56\xde\x1f\xfe\xae\xf0\x6f\x7e\xa3\xaa\x87\xea\x3d\xd8\xdd\xa1\xaa\x10\xf8\x75\x49\xbd\x59\xff\x07\x1a\xfc\xf5\x7a\xbd\x1e\xe4\x14\x6f\xdd\xa3\x95\x8a\x1f\x6a\x23\xd7\x87\xcb\x65\xe5\x8e\x5a\x73\x59\x65\xb4\x2c\x79\xc5\x8d\x3d\x30\xc8\x97\x3f\xbe\xfc\x7e\xa3\x96\xa1\x96\x35\x94\xc0\x9c\x9a\x92\x37\x9a\x7a\xe3\xb9\x2c\x5d\x69\x30\x6d\xb8\xae\x0a\xae\x09\x1e\x97\xec\xaf\xdf\xfe\xbc\x68\x8d\xa7\xe1\xfc\xc8\x79\xa8\xc0\xed\x77\x74\xa7\x7e\x70\x65\x8e\x6e\xd7\x37\x97\xe6\xf9\xf2\x19\x3c\x27\x64\x87\xb8\x1e\x99\xff\xd4\x6b\x67\xb5\x5c\x7f\x4a\x9f\xd6\xbf\x7c\xde\x3c\xb8\x82\x86\x89\xce\x58\x42\xbe\xa6\x11\x46\x6f\x71\xf9\xc0\x21\x1f\x8c\x13\x5b\x27\xe3\x78\x81\x63\xfe\xd1\x43\x7e\x44\xdc\x3b\x08\x88\xee\x20\xb6\xa5\x1a\xcd\xbf\x2b\x5f\x27\x84\x5b\x4c\x05\x2a\xf9\x21\x01\x88\x57\x33\x78\x8d\x0e\x4e\x6a\x36\xd9\xf7\xec\x4a\x83\x67\x6c\x5b\x1a\x09\xfc\xdd\x9b\xd3\x28\x62\x60\x77\x6a\xf5\xa3\xbb\x52\xab\xef\x6c\x14\xda\x1b\xdf\x86\xd2\x56\xdf\xa9\xc5\x59\x88\x77\x6b\x6f\x8e\xec\x02\x3d\xf8\x8c\x9d\x24\xa8\xbd\x56\xfb\x6f\x31\xb3\x94\xf7\x66\x7d\xa7\xee\x5a\x1d\xd3\x5b\xf7\xa1\xe5\xbd\x42\x9b\xfb\xd9\x36\x42\xc2\x5d\x1c\xfc\x58\x0a\x36\xcb\x1f\xcc\xc0\x3f\x03\x00\xa3\x2f\xe8\x66\xd7\x18\x00\x00")
 
 func templatesCf_lbTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -128,12 +128,12 @@ func templatesCf_lbTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/cf_lb.tf", size: 6556, mode: os.FileMode(480), modTime: time.Unix(1520537908, 0)}
+	info := bindataFileInfo{name: "templates/cf_lb.tf", size: 6359, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesConcourse_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x93\xc1\x6e\xdb\x30\x0c\x86\xef\x7a\x0a\x82\xd8\x71\x32\x0a\xaf\x87\x5e\x76\x1a\x76\xed\x76\xd8\x6d\x28\x04\xc5\xa6\x1d\xa1\xaa\x28\x48\x72\x8c\xa1\xf0\xbb\x0f\xb2\x1d\xc7\x5d\xb3\x24\x40\x10\xa0\x27\x13\x34\xf5\x93\xfa\x7e\x8a\xbb\xe4\xbb\x04\x58\xb1\xab\xb8\x0b\x91\x54\xd2\xa1\xa5\xa4\x3c\xb3\x45\x78\x15\x00\x3b\x6d\x3b\x82\xaf\x80\x9f\x5e\x5b\xe6\xd6\x92\xaa\xf8\xc5\x77\xe9\x4d\x69\x31\xc5\x72\x8c\x9d\x7e\xa1\x01\xc5\x20\xc4\x7b\x79\xbb\x51\xc6\x9f\x13\xd6\x75\x1d\x28\xc6\x62\x39\x26\xf7\x99\xf9\x3b\xa9\x07\x8a\xdc\x85\x8a\x00\xff\x39\xdf\x98\x40\xbd\xb6\x16\x01\xf7\xa1\x5c\xb4\xa6\xe6\x79\x46\x00\x98\xda\xef\x74\x28\xc8\xed\x94\xa9\x87\x43\x9d\x64\x4f\x0e\x73\x29\xa5\x9e\xc3\xf3\xd1\x49\xe7\x7f\xc5\x66\x63\xe5\x3e\x9e\xaf\x2f\x00\xb4\xb5\xdc\x8f\xed\x00\x7c\xe0\xc4\x15\xdb\x2c\x93\x2a\x8f\x53\x92\x43\x8a\xd3\x18\xbf\xf1\xe1\x0e\x3f\x03\xde\xdf\x7f\xc9\x9f\xb2\x2c\x4b\x7c\x12\x00\x43\x16\x9a\x49\x27\xdd\xc6\xb1\xf4\x70\x99\xa7\x93\x20\x66\x5c\xb8\x72\x40\x2e\xb9\x05\xc3\xff\x19\x9c\xc6\xfc\x66\x55\x70\xb5\x01\x17\x6a\x0b\x80\x48\x31\x1a\x76\x4a\x37\x8d\x71\x26\xfd\xc9\xf5\x8f\x3f\x1e\xbf\x9f\xf1\x97\x43\xaf\x43\x6d\x5c\xab\x42\x67\x09\x01\x63\xdc\xca\x43\x56\x4e\xd9\xb5\xcf\x67\xbc\x8e\x71\x8b\x0b\xe7\x55\xf5\x85\x1b\x1f\xc9\x36\xca\x1a\xf7\x3c\x64\x95\xec\xaa\x0a\xda\xb5\x34\xaa\x8c\x56\x0a\x00\xe3\xd5\x7a\x09\x7e\x7d\xfb\x39\x67\x67\x47\x8e\xb7\xbc\xfa\x2d\xbc\x63\xb5\x4d\xc9\xc7\xab\x68\x8d\x0a\x37\xe3\x95\x5f\xc0\x07\xc3\x75\x35\xad\x9b\xc1\x7a\xb8\xbb\x35\xab\xbf\x01\x00\x00\xff\xff\x5f\xcd\x56\x41\x23\x06\x00\x00")
+var _templatesConcourse_lbTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x92\x4f\x8b\xdb\x30\x10\xc5\xef\xfa\x14\x8f\xa1\xc7\xda\x2c\xe9\x1e\xf6\x92\x53\xe9\x75\xdb\x43\x6f\x65\x11\x8a\x33\x76\xc4\x6a\x35\x42\x92\x13\xca\x92\xef\x5e\x14\x3b\x7f\x5a\xb7\x26\x10\x02\x3d\x79\x90\x47\xef\x8d\x7e\x6f\xa4\xcf\xa1\xcf\xa0\x46\x7c\x23\x7d\x4c\xac\xb3\x89\x1d\x67\x1d\x44\x1c\xe1\x5d\x01\x5b\xe3\x7a\xc6\x12\x9d\x48\xe7\x58\x37\xf2\x16\xfa\xfc\x5b\x5f\x3d\xd4\x55\xb9\x53\x7b\xf3\xc6\x6a\xaf\xd4\x54\xd9\xad\xb4\x0d\xb3\x9a\x66\xbd\x8e\x9c\x52\x7d\xba\x53\x1d\x4f\xc6\x6f\x11\x8e\x9c\xa4\x8f\x0d\x83\xfe\xb8\xdd\xda\xc8\x3b\xe3\x1c\x81\x8e\x65\x75\x52\x1a\x7c\xcb\x70\x00\xb0\x04\x7d\x78\xdf\x9a\x58\xb3\xdf\x6a\xbb\xde\x9f\xfb\x2a\x09\xec\x49\x01\x9e\xf3\x4e\xe2\xeb\x74\xc8\xf1\x47\xbd\x5a\xb9\xea\x58\x17\x5d\xa5\x00\xe3\x9c\xec\x0e\x4e\x40\x88\x92\xa5\x11\x57\xcc\x72\x13\x8a\x26\x10\x24\xe6\x54\x8a\x25\x7e\xd0\xd3\x03\x7d\x04\x3d\x3e\x7e\x2a\x9f\xc5\x62\xb1\xa0\x17\x05\xec\x8b\xd0\x48\x37\x9b\x2e\x1d\x5a\xcf\xef\x78\x99\x65\x30\x72\x22\xd0\x84\xe1\x05\x81\x7f\x3f\x9f\x66\xd5\x2f\x32\x27\xd0\x45\xea\x57\x6a\x2b\x20\x71\x4a\x56\xbc\x36\x6d\x6b\xbd\xcd\x3f\x4b\xff\xf3\xd7\xe7\x2f\xf3\xc6\xad\xc4\x9d\x89\x6b\xeb\x3b\x1d\x7b\xc7\x04\x4a\x69\x53\x9d\x4f\xab\xe1\xf4\x34\x44\x21\x3c\x1f\x73\x4a\x1b\x3a\x71\x3e\x76\x5f\xb9\xe2\x89\x5d\xab\x9d\xf5\xaf\x6a\x08\x54\x47\xe3\x3b\x2e\x02\x43\x8a\x0a\xb0\x41\x5f\xe6\xff\xfd\xf3\xb7\xe2\x66\x83\x1e\xc3\xf8\x8b\xdb\x8d\xcb\x3f\x21\xb4\xc9\x39\xa4\x9b\x18\x1d\x14\xee\x41\xa9\xac\xfc\xff\x03\xe9\x66\x46\xf7\x40\xf4\xf4\x70\x47\x42\xbf\x06\x00\x1b\x0c\xf9\x4a\xf6\x05\x00\x00")
 
 func templatesConcourse_lbTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -148,12 +148,12 @@ func templatesConcourse_lbTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/concourse_lb.tf", size: 1571, mode: os.FileMode(480), modTime: time.Unix(1520375763, 0)}
+	info := bindataFileInfo{name: "templates/concourse_lb.tf", size: 1526, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesJumpboxTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x8f\x41\x0a\x83\x30\x10\x45\xf7\x39\x45\x08\xdd\xaa\x20\x64\x23\xf4\x2c\x21\x35\x83\xb5\x44\x27\x4c\x66\x44\x10\xef\x5e\x4a\x6d\xad\xd0\x4d\xbb\x1d\xe6\xbd\xff\x3f\x41\x46\xa1\x16\xb4\xe9\x10\xbb\x08\xae\xc5\x21\x09\x83\xf3\x21\x10\xe4\x6c\xb4\xb9\xc9\x90\x2e\x38\x17\x7d\x32\x7a\x51\x5a\x8f\x7e\x00\x7d\xd6\xe6\xb4\x4c\x9e\x4a\x18\x27\xd7\x87\xb5\xf8\xf8\x52\xab\x52\x28\x9c\x84\xdf\xb0\x13\x8a\x4f\x7a\xf2\x51\x36\xfc\x7b\x62\xb9\x9b\xca\xed\xb4\x36\x75\x7d\xb0\xc2\xcc\x40\xa3\x8f\xee\xd5\xe9\x2f\xeb\x41\x19\x7a\x82\x96\x91\xf6\xe1\x07\xef\x95\x39\xe5\xa6\xaa\x7e\x6b\x6d\xad\xb5\x8f\x94\x7b\x00\x00\x00\xff\xff\x10\xf1\x6b\x1b\x66\x01\x00\x00")
+var _templatesJumpboxTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xce\x41\x0a\x83\x30\x10\x05\xd0\x7d\x4e\x11\x42\xb7\x2a\x08\xd9\x08\x3d\x4b\x48\xcd\x60\x53\xa2\x09\x93\x19\x11\x24\x77\xef\xa2\xb6\xd5\xd2\x45\xbb\x1d\xfe\x7f\xf3\x11\x72\x64\xec\x41\xaa\x21\xc6\x21\x80\xe9\xe3\x98\x98\xc0\x58\xe7\x10\x72\x56\x52\xdd\x78\x4c\x97\xb8\x54\x3e\x29\xb9\x0a\x29\x27\x3b\x82\x3c\x4b\x75\x5a\x67\x8b\x35\x4c\xb3\xf1\xae\x54\xbb\x94\x28\x42\x44\xa6\xc4\xf4\x2a\x1b\xc6\xf0\x68\xcf\x36\xf0\x56\xff\xfe\xb1\x7e\x4b\xf5\x76\x2a\x5d\xdb\x1e\x54\x58\x08\x70\xb2\xc1\xf8\x74\x54\x7f\x26\xf7\x9a\xf3\x08\x3d\x45\x7c\xc6\x3f\x86\x5e\x89\x52\xee\x9a\xe6\xbf\xc1\x5a\x6b\xad\x44\x11\xf7\x01\x00\x85\xf6\xda\xb7\x61\x01\x00\x00")
 
 func templatesJumpboxTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -168,12 +168,12 @@ func templatesJumpboxTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/jumpbox.tf", size: 358, mode: os.FileMode(480), modTime: time.Unix(1520375763, 0)}
+	info := bindataFileInfo{name: "templates/jumpbox.tf", size: 353, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesVarsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xd0\x41\xaa\x03\x21\x0c\x06\xe0\xbd\xa7\x08\x61\x16\xef\x6d\x7a\x83\x9e\xa5\xd8\x31\x95\x14\x31\x92\x11\xa1\x15\xef\x5e\x9c\x19\x98\xe9\xa2\xa0\x4b\xf3\x85\x3f\xfc\xc5\x2a\xdb\x7b\x20\xc0\xa4\xf2\xa4\x39\xdf\xd8\x21\x54\x03\x90\x5f\x89\xe0\x0a\xb8\x64\xe5\xe8\xd1\x34\x63\x0e\xac\xe4\x59\xe2\x00\x7c\x4b\xa4\x01\x46\xb1\x8c\x05\xcf\x4a\x8e\x62\x66\x1b\x96\x9f\x3a\xa9\x14\x76\xa4\x80\x5e\xc4\x87\x3d\xff\xb4\xd9\xfd\x54\x1f\x1c\xe8\x0f\xa7\x5a\xac\x5e\x4e\xc3\x86\xff\x0d\x0d\xc0\xde\x07\xf4\xb7\xfa\xee\x8e\x92\x56\xb3\xd5\x00\xdf\x66\xfb\x6c\xfd\x94\x4f\x00\x00\x00\xff\xff\xeb\xf1\x4c\x79\x5e\x01\x00\x00")
+var _templatesVarsTf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xd0\xc1\x0a\xc2\x30\x0c\x06\xe0\x7b\x9e\xe2\x67\x27\xbd\xf8\x06\x7b\x16\xa9\x6b\x2c\x91\x92\x94\xac\x14\x54\xf6\xee\x32\x1c\xac\x17\x99\x3d\x36\x5f\xf8\xf9\xd3\x82\x4b\xb8\x65\xc6\x50\xdc\x1e\x3c\xd5\xab\xc4\x01\x6f\x02\xea\xb3\x30\x46\xcc\xd5\x45\x13\x2d\x44\x3b\x75\x4e\x62\x7a\xc8\x5e\xa6\x7c\x88\x58\xdb\x3f\x91\x93\x73\x64\xad\x12\xf2\xfc\xc3\x16\xb7\x26\x91\x1d\x43\x32\x4b\x79\x4b\xee\xf6\x30\xe2\x2e\x99\x4f\x2d\xf8\xa5\xfb\x3e\x13\xb0\x75\xc7\xfa\x46\xac\x60\xbf\x06\x01\xdf\xbe\xe8\xc6\xce\x49\x4c\x69\xa1\xcf\x00\x67\x30\x07\xe4\x40\x01\x00\x00")
 
 func templatesVarsTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -188,7 +188,7 @@ func templatesVarsTf() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/vars.tf", size: 350, mode: os.FileMode(480), modTime: time.Unix(1520375763, 0)}
+	info := bindataFileInfo{name: "templates/vars.tf", size: 320, mode: os.FileMode(480), modTime: time.Unix(1792204164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1,14 +1,14 @@
 output "concourse_target_pool" {
-  value = "${google_compute_target_pool.target-pool.name}"
+  value = google_compute_target_pool.target-pool.name
 }
 
 output "concourse_lb_ip" {
-  value = "${google_compute_address.concourse-address.address}"
+  value = google_compute_address.concourse-address.address
 }
 
 resource "google_compute_firewall" "firewall-concourse" {
   name    = "${var.env_id}-concourse-open"
-  network = "${google_compute_network.bbl-network.name}"
+  network = google_compute_network.bbl-network.name
 
   allow {
     protocol = "tcp"
@@ -30,24 +30,24 @@ resource "google_compute_target_pool" "target-pool" {
 
 resource "google_compute_forwarding_rule" "ssh-forwarding-rule" {
   name        = "${var.env_id}-concourse-ssh"
-  target      = "${google_compute_target_pool.target-pool.self_link}"
+  target      = google_compute_target_pool.target-pool.self_link
   port_range  = "2222"
   ip_protocol = "TCP"
-  ip_address  = "${google_compute_address.concourse-address.address}"
+  ip_address  = google_compute_address.concourse-address.address
 }
 
 resource "google_compute_forwarding_rule" "https-forwarding-rule" {
   name        = "${var.env_id}-concourse-https"
-  target      = "${google_compute_target_pool.target-pool.self_link}"
+  target      = google_compute_target_pool.target-pool.self_link
   port_range  = "443"
   ip_protocol = "TCP"
-  ip_address  = "${google_compute_address.concourse-address.address}"
+  ip_address  = google_compute_address.concourse-address.address
 }
 
 resource "google_compute_forwarding_rule" "http-forwarding-rule" {
   name        = "${var.env_id}-concourse-http"
-  target      = "${google_compute_target_pool.target-pool.self_link}"
+  target      = google_compute_target_pool.target-pool.self_link
   port_range  = "80"
   ip_protocol = "TCP"
-  ip_address  = "${google_compute_address.concourse-address.address}"
+  ip_address  = google_compute_address.concourse-address.address
 }
@@ -2,17 +2,26 @@ package terraform
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 
 	"github.com/cloudfoundry/bosh-bootloader/storage"
 	"github.com/coreos/go-semver/semver"
 )
 
+const (
+	MINIMUM_TERRAFORM_VERSION     = "0.12.0"
+	UNSUPPORTED_TERRAFORM_VERSION = "2.0.0"
+
+	// REFRESH_ONLY_TERRAFORM_VERSION is the first terraform that can plan
+	// -refresh-only, which bbl drift needs.
+	REFRESH_ONLY_TERRAFORM_VERSION = "0.15.4"
+)
+
 type Manager struct {
 	executor              executor
 	templateGenerator     TemplateGenerator
 	inputGenerator        InputGenerator
+	outputContract        OutputContract
 	terraformOutputBuffer *bytes.Buffer
 	logger                logger
 }
@@ -20,11 +29,16 @@ type Manager struct {
 type executor interface {
 	Version() (string, error)
 	Setup(terraformTemplate string, inputs map[string]interface{}) error
+	SetupBackend(backend storage.TerraformBackend) error
 	Init() error
 	Apply(credentials map[string]string) error
-	Validate(credentials map[string]string) error
+	ApplyPlan(planFile string) error
+	Validate() error
+	Plan(credentials map[string]string, planFile string) (string, error)
+	RefreshPlan(credentials map[string]string) (string, error)
 	Destroy(credentials map[string]string) error
 	Outputs() (map[string]interface{}, error)
+	SensitiveOutputs() (map[string]bool, error)
 	Output(string) (string, error)
 	IsPaved() (bool, error)
 }
@@ -42,11 +56,12 @@ type logger interface {
 	Step(string, ...interface{})
 }
 
-func NewManager(executor executor, templateGenerator TemplateGenerator, inputGenerator InputGenerator, terraformOutputBuffer *bytes.Buffer, logger logger) Manager {
+func NewManager(executor executor, templateGenerator TemplateGenerator, inputGenerator InputGenerator, outputContract OutputContract, terraformOutputBuffer *bytes.Buffer, logger logger) Manager {
 	return Manager{
 		executor:              executor,
 		templateGenerator:     templateGenerator,
 		inputGenerator:        inputGenerator,
+		outputContract:        outputContract,
 		terraformOutputBuffer: terraformOutputBuffer,
 		logger:                logger,
 	}
@@ -56,6 +71,8 @@ func (m Manager) Version() (string, error) {
 	return m.executor.Version()
 }
 
+// ValidateVersion checks that terraform is a version that understands the
+// HCL2 templates bbl generates.
 func (m Manager) ValidateVersion() error {
 	version, err := m.executor.Version()
 	if err != nil {
@@ -67,13 +84,14 @@ func (m Manager) ValidateVersion() error {
 		return err
 	}
 
-	minimumVersion, err := semver.NewVersion("0.11.0")
-	if err != nil {
-		return err
+	minimumVersion := semver.New(MINIMUM_TERRAFORM_VERSION)
+	if currentVersion.LessThan(*minimumVersion) {
+		return fmt.Errorf("Terraform version must be at least v%s", MINIMUM_TERRAFORM_VERSION)
 	}
 
-	if currentVersion.LessThan(*minimumVersion) {
-		return errors.New("Terraform version must be at least v0.11.0")
+	unsupportedVersion := semver.New(UNSUPPORTED_TERRAFORM_VERSION)
+	if !currentVersion.LessThan(*unsupportedVersion) {
+		return fmt.Errorf("Terraform version must be less than v%s", UNSUPPORTED_TERRAFORM_VERSION)
 	}
 
 	return nil
@@ -93,6 +111,10 @@ func (m Manager) Init(bblState storage.State) error {
 		return fmt.Errorf("Executor setup: %s", err)
 	}
 
+	if err := m.executor.SetupBackend(bblState.TerraformBackend); err != nil {
+		return fmt.Errorf("Executor setup backend: %s", err)
+	}
+
 	m.logger.Step("terraform init")
 	if err := m.executor.Init(); err != nil {
 		return fmt.Errorf("Executor init: %s", err)
@@ -116,7 +138,44 @@ func (m Manager) Apply(bblState storage.State) (storage.State, error) {
 		return bblState, fmt.Errorf("Executor apply: %s", err)
 	}
 
-	return bblState, nil
+	return bblState, m.validateOutputs(bblState)
+}
+
+// ApplyPlan applies the plan that Plan saved to planFile, instead of
+// planning again.
+func (m Manager) ApplyPlan(bblState storage.State, planFile string) (storage.State, error) {
+	m.logger.Step("terraform init")
+	if err := m.executor.Init(); err != nil {
+		return bblState, fmt.Errorf("Executor init: %s", err)
+	}
+
+	m.logger.Step("terraform apply %s", planFile)
+	err := m.executor.ApplyPlan(planFile)
+
+	bblState.LatestTFOutput = readAndReset(m.terraformOutputBuffer)
+
+	if err != nil {
+		return bblState, fmt.Errorf("Executor apply plan: %s", err)
+	}
+
+	return bblState, m.validateOutputs(bblState)
+}
+
+// validateOutputs checks the outputs against the contract for the IAAS right
+// after apply, so that an override that removes an output bbl needs fails
+// before create-env.
+func (m Manager) validateOutputs(bblState storage.State) error {
+	outputs, err := m.GetOutputs()
+	if err != nil {
+		return fmt.Errorf("Executor outputs: %s", err)
+	}
+
+	err = outputs.Validate(m.outputContract.Outputs(bblState))
+	if err != nil {
+		return fmt.Errorf("Terraform outputs: %s", err)
+	}
+
+	return nil
 }
 
 func (m Manager) Destroy(bblState storage.State) (storage.State, error) {
@@ -134,8 +193,14 @@ func (m Manager) Destroy(bblState storage.State) (storage.State, error) {
 }
 
 func (m Manager) Validate(bblState storage.State) (storage.State, error) {
+	m.logger.Step("terraform init")
+	err := m.executor.Init()
+	if err != nil {
+		return bblState, fmt.Errorf("Executor init: %s", err)
+	}
+
 	m.logger.Step("terraform validate")
-	err := m.executor.Validate(m.inputGenerator.Credentials(bblState))
+	err = m.executor.Validate()
 
 	bblState.LatestTFOutput = readAndReset(m.terraformOutputBuffer)
 
@@ -146,13 +211,78 @@ func (m Manager) Validate(bblState storage.State) (storage.State, error) {
 	return bblState, nil
 }
 
+// Plan reports the changes that bbl up would make to the infrastructure,
+// without making them. When planFile is set, the plan is saved there.
+func (m Manager) Plan(bblState storage.State, planFile string) ([]ResourceChange, error) {
+	output, err := m.plan(bblState, planFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePlan(output), nil
+}
+
+// Drift compares the live infrastructure with the terraform state that bbl
+// last applied, without changing either. Changes to the template are left
+// out, since they are not drift.
+func (m Manager) Drift(bblState storage.State) ([]ResourceDrift, error) {
+	version, err := m.executor.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	currentVersion, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	if currentVersion.LessThan(*semver.New(REFRESH_ONLY_TERRAFORM_VERSION)) {
+		return nil, fmt.Errorf("Detecting drift needs terraform v%s or later", REFRESH_ONLY_TERRAFORM_VERSION)
+	}
+
+	m.logger.Step("terraform init")
+	if err := m.executor.Init(); err != nil {
+		return nil, fmt.Errorf("Executor init: %s", err)
+	}
+
+	m.logger.Step("terraform plan -refresh-only")
+	output, err := m.executor.RefreshPlan(m.inputGenerator.Credentials(bblState))
+	readAndReset(m.terraformOutputBuffer)
+	if err != nil {
+		return nil, fmt.Errorf("Executor plan: %s", err)
+	}
+
+	return ParseDrift(output), nil
+}
+
+func (m Manager) plan(bblState storage.State, planFile string) (string, error) {
+	m.logger.Step("terraform init")
+	if err := m.executor.Init(); err != nil {
+		return "", fmt.Errorf("Executor init: %s", err)
+	}
+
+	m.logger.Step("terraform plan")
+	output, err := m.executor.Plan(m.inputGenerator.Credentials(bblState), planFile)
+	readAndReset(m.terraformOutputBuffer)
+	if err != nil {
+		return "", fmt.Errorf("Executor plan: %s", err)
+	}
+
+	return output, nil
+}
+
 func (m Manager) GetOutputs() (Outputs, error) {
 	tfOutputs, err := m.executor.Outputs()
 	if err != nil {
 		return Outputs{}, err
 	}
 
-	return Outputs{Map: tfOutputs}, nil
+	sensitive, err := m.executor.SensitiveOutputs()
+	if err != nil {
+		return Outputs{}, err
+	}
+
+	return Outputs{Map: tfOutputs, Sensitive: sensitive}, nil
 }
 
 func (m Manager) IsPaved() (bool, error) {
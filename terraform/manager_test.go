@@ -18,6 +18,7 @@ var _ = Describe("Manager", func() {
 		executor              *fakes.TerraformExecutor
 		templateGenerator     *fakes.TemplateGenerator
 		inputGenerator        *fakes.InputGenerator
+		outputContract        *fakes.OutputContract
 		logger                *fakes.Logger
 		manager               terraform.Manager
 		terraformOutputBuffer bytes.Buffer
@@ -28,11 +29,12 @@ var _ = Describe("Manager", func() {
 		executor = &fakes.TerraformExecutor{}
 		templateGenerator = &fakes.TemplateGenerator{}
 		inputGenerator = &fakes.InputGenerator{}
+		outputContract = &fakes.OutputContract{}
 		logger = &fakes.Logger{}
 
 		expectedTFOutput = "some terraform output"
 
-		manager = terraform.NewManager(executor, templateGenerator, inputGenerator, &terraformOutputBuffer, logger)
+		manager = terraform.NewManager(executor, templateGenerator, inputGenerator, outputContract, &terraformOutputBuffer, logger)
 	})
 
 	AfterEach(func() {
@@ -54,6 +56,10 @@ var _ = Describe("Manager", func() {
 
 			incomingState = storage.State{
 				TFState: "some-tf-state",
+				TerraformBackend: storage.TerraformBackend{
+					Type:   "s3",
+					Config: map[string]string{"bucket": "some-bucket"},
+				},
 			}
 			templateGenerator.GenerateCall.Returns.Template = "some-terraform-template"
 		})
@@ -77,6 +83,7 @@ var _ = Describe("Manager", func() {
 				"credentials":   "some-path",
 				"system_domain": incomingState.LB.Domain,
 			}))
+			Expect(executor.SetupBackendCall.Receives.Backend).To(Equal(incomingState.TerraformBackend))
 
 			Expect(logger.StepCall.Messages).To(gomegamatchers.ContainSequence([]string{
 				"generating terraform template",
@@ -97,6 +104,17 @@ var _ = Describe("Manager", func() {
 				})
 			})
 
+			Context("when the executor fails to set up the backend", func() {
+				BeforeEach(func() {
+					executor.SetupBackendCall.Returns.Error = errors.New("quince")
+				})
+
+				It("bubbles up the error", func() {
+					err := manager.Init(incomingState)
+					Expect(err).To(MatchError("Executor setup backend: quince"))
+				})
+			})
+
 			Context("when the executor init causes an executor error", func() {
 				BeforeEach(func() {
 					executor.InitCall.Returns.Error = errors.New("canteloupe")
@@ -167,6 +185,37 @@ var _ = Describe("Manager", func() {
 				Expect(state.LatestTFOutput).To(Equal(incomingState.LatestTFOutput))
 			})
 		})
+
+		Context("when an output in the contract is missing or has the wrong type", func() {
+			BeforeEach(func() {
+				outputContract.OutputsCall.Returns.Outputs = []terraform.OutputSpec{
+					{Name: "internal_cidr", Type: terraform.StringOutput},
+					{Name: "jumpbox__tags", Type: terraform.ListOutput},
+					{Name: "external_ip", Type: terraform.StringOutput},
+				}
+				executor.OutputsCall.Returns.Outputs = map[string]interface{}{
+					"jumpbox__tags": "some-tag",
+					"external_ip":   "some-external-ip",
+				}
+			})
+
+			It("returns the bbl state and an error that names them", func() {
+				state, err := manager.Apply(incomingState)
+				Expect(err).To(MatchError("Terraform outputs: missing internal_cidr terraform output, terraform output jumpbox__tags is a string, not a list. bbl needs these outputs, check that your terraform overrides do not remove or change them."))
+				Expect(state).To(Equal(expectedState))
+
+				Expect(outputContract.OutputsCall.Receives.State).To(Equal(expectedState))
+			})
+		})
+
+		Context("when the outputs cannot be read", func() {
+			It("returns an error", func() {
+				executor.OutputsCall.Returns.Error = errors.New("cherry")
+
+				_, err := manager.Apply(incomingState)
+				Expect(err).To(MatchError("Executor outputs: cherry"))
+			})
+		})
 	})
 
 	Describe("Destroy", func() {
@@ -244,14 +293,28 @@ var _ = Describe("Manager", func() {
 		It("returns a state with output from executor validate", func() {
 			state, err := manager.Validate(incomingState)
 
-			Expect(executor.ValidateCall.Receives.Credentials).To(Equal(credentials))
+			Expect(executor.InitCall.CallCount).To(Equal(1))
+			Expect(executor.ValidateCall.CallCount).To(Equal(1))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(state).To(Equal(expectedState))
 			Expect(logger.StepCall.Messages).To(gomegamatchers.ContainSequence([]string{
+				"terraform init",
 				"terraform validate",
 			}))
 		})
 
+		Context("when executor init fails", func() {
+			BeforeEach(func() {
+				executor.InitCall.Returns.Error = errors.New("pear")
+			})
+
+			It("returns the bbl state and the error", func() {
+				_, err := manager.Validate(incomingState)
+				Expect(err).To(MatchError("Executor init: pear"))
+				Expect(executor.ValidateCall.CallCount).To(Equal(0))
+			})
+		})
+
 		Context("when executor validate fails", func() {
 			BeforeEach(func() {
 				executor.ValidateCall.Returns.Error = errors.New("grape")
@@ -266,6 +329,109 @@ var _ = Describe("Manager", func() {
 		})
 	})
 
+	Describe("ApplyPlan", func() {
+		BeforeEach(func() {
+			terraformOutputBuffer.Write([]byte("some-terraform-output"))
+		})
+
+		It("applies the saved plan", func() {
+			state, err := manager.ApplyPlan(storage.State{EnvID: "some-env-id"}, "/some/vars/bbl.tfplan")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(executor.InitCall.CallCount).To(Equal(1))
+			Expect(executor.ApplyPlanCall.Receives.PlanFile).To(Equal("/some/vars/bbl.tfplan"))
+			Expect(executor.ApplyCall.CallCount).To(Equal(0))
+			Expect(logger.StepCall.Messages).To(Equal([]string{"terraform init", "terraform apply /some/vars/bbl.tfplan"}))
+			Expect(state.LatestTFOutput).To(Equal("some-terraform-output"))
+		})
+
+		Context("when executor apply plan fails", func() {
+			It("returns an error", func() {
+				executor.ApplyPlanCall.Returns.Error = errors.New("quince")
+
+				_, err := manager.ApplyPlan(storage.State{}, "/some/vars/bbl.tfplan")
+				Expect(err).To(MatchError("Executor apply plan: quince"))
+			})
+		})
+
+		Context("when an output in the contract is missing", func() {
+			It("returns an error", func() {
+				outputContract.OutputsCall.Returns.Outputs = []terraform.OutputSpec{{Name: "internal_cidr", Type: terraform.StringOutput}}
+
+				_, err := manager.ApplyPlan(storage.State{}, "/some/vars/bbl.tfplan")
+				Expect(err).To(MatchError("Terraform outputs: missing internal_cidr terraform output. bbl needs these outputs, check that your terraform overrides do not remove or change them."))
+			})
+		})
+	})
+
+	Describe("Plan", func() {
+		BeforeEach(func() {
+			inputGenerator.CredentialsCall.Returns.Credentials = map[string]string{"some-credential": "some-value"}
+			executor.PlanCall.Returns.Output = "Terraform will perform the following actions:\n\n  + aws_subnet.bosh_subnet\n\nPlan: 1 to add, 0 to change, 0 to destroy.\n"
+		})
+
+		It("saves the plan and returns the changes in it", func() {
+			changes, err := manager.Plan(storage.State{EnvID: "some-env-id"}, "/some/vars/bbl.tfplan")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(Equal([]terraform.ResourceChange{
+				{Address: "aws_subnet.bosh_subnet", Type: "aws_subnet", Action: "create"},
+			}))
+
+			Expect(executor.InitCall.CallCount).To(Equal(1))
+			Expect(executor.PlanCall.Receives.Credentials).To(Equal(map[string]string{"some-credential": "some-value"}))
+			Expect(executor.PlanCall.Receives.PlanFile).To(Equal("/some/vars/bbl.tfplan"))
+		})
+
+		Context("when executor init fails", func() {
+			It("returns an error", func() {
+				executor.InitCall.Returns.Error = errors.New("lemon")
+
+				_, err := manager.Plan(storage.State{}, "")
+				Expect(err).To(MatchError("Executor init: lemon"))
+			})
+		})
+	})
+
+	Describe("Drift", func() {
+		BeforeEach(func() {
+			inputGenerator.CredentialsCall.Returns.Credentials = map[string]string{"some-credential": "some-value"}
+			executor.VersionCall.Returns.Version = "1.0.0"
+			executor.RefreshPlanCall.Returns.Output = "  # aws_instance.nat has been changed\n"
+		})
+
+		It("plans a refresh with the credentials and parses the result", func() {
+			drift, err := manager.Drift(storage.State{EnvID: "some-env-id"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(Equal([]terraform.ResourceDrift{
+				{Address: "aws_instance.nat", Type: "aws_instance", Change: "changed"},
+			}))
+
+			Expect(executor.InitCall.CallCount).To(Equal(1))
+			Expect(executor.PlanCall.CallCount).To(Equal(0))
+			Expect(executor.RefreshPlanCall.Receives.Credentials).To(Equal(map[string]string{"some-credential": "some-value"}))
+			Expect(logger.StepCall.Messages).To(Equal([]string{"terraform init", "terraform plan -refresh-only"}))
+		})
+
+		Context("when terraform cannot plan a refresh", func() {
+			It("returns an error", func() {
+				executor.VersionCall.Returns.Version = "0.12.31"
+
+				_, err := manager.Drift(storage.State{})
+				Expect(err).To(MatchError("Detecting drift needs terraform v0.15.4 or later"))
+				Expect(executor.RefreshPlanCall.CallCount).To(Equal(0))
+			})
+		})
+
+		Context("when executor plan fails", func() {
+			It("returns an error", func() {
+				executor.RefreshPlanCall.Returns.Error = errors.New("grape")
+
+				_, err := manager.Drift(storage.State{})
+				Expect(err).To(MatchError("Executor plan: grape"))
+			})
+		})
+	})
+
 	Describe("GetOutputs", func() {
 		BeforeEach(func() {
 			executor.OutputsCall.Returns.Outputs = map[string]interface{}{"external_ip": "some-external-ip"}
@@ -280,6 +446,16 @@ var _ = Describe("Manager", func() {
 			}))
 		})
 
+		It("returns which outputs are sensitive", func() {
+			executor.SensitiveOutputsCall.Returns.Sensitive = map[string]bool{"private_key": true}
+
+			terraformOutputs, err := manager.GetOutputs()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(terraformOutputs.IsSensitive("private_key")).To(BeTrue())
+			Expect(terraformOutputs.IsSensitive("external_ip")).To(BeFalse())
+		})
+
 		Context("when the executor outputs fails", func() {
 			It("returns the error", func() {
 				executor.OutputsCall.Returns.Error = errors.New("orange")
@@ -288,6 +464,15 @@ var _ = Describe("Manager", func() {
 				Expect(err).To(MatchError("orange"))
 			})
 		})
+
+		Context("when the executor sensitive outputs fails", func() {
+			It("returns the error", func() {
+				executor.SensitiveOutputsCall.Returns.Error = errors.New("tangerine")
+
+				_, err := manager.GetOutputs()
+				Expect(err).To(MatchError("tangerine"))
+			})
+		})
 	})
 
 	Describe("Version", func() {
@@ -318,7 +503,7 @@ var _ = Describe("Manager", func() {
 	Describe("ValidateVersion", func() {
 		Context("when terraform version is greater than the minimum", func() {
 			BeforeEach(func() {
-				executor.VersionCall.Returns.Version = "9.0.0"
+				executor.VersionCall.Returns.Version = "1.5.7"
 			})
 
 			It("validates the version of terraform and returns no error", func() {
@@ -333,7 +518,25 @@ var _ = Describe("Manager", func() {
 					executor.VersionCall.Returns.Version = "0.0.1"
 
 					err := manager.ValidateVersion()
-					Expect(err).To(MatchError("Terraform version must be at least v0.11.0"))
+					Expect(err).To(MatchError("Terraform version must be at least v0.12.0"))
+				})
+			})
+
+			Context("when terraform version is 0.11, which does not understand HCL2", func() {
+				It("returns an error", func() {
+					executor.VersionCall.Returns.Version = "0.11.14"
+
+					err := manager.ValidateVersion()
+					Expect(err).To(MatchError("Terraform version must be at least v0.12.0"))
+				})
+			})
+
+			Context("when terraform version is greater than the maximum", func() {
+				It("returns an error", func() {
+					executor.VersionCall.Returns.Version = "2.0.0"
+
+					err := manager.ValidateVersion()
+					Expect(err).To(MatchError("Terraform version must be less than v2.0.0"))
 				})
 			})
 
@@ -0,0 +1,35 @@
+package openstack
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+)
+
+type OutputContract struct{}
+
+func NewOutputContract() OutputContract {
+	return OutputContract{}
+}
+
+// Outputs lists the outputs that create-env and the cloud config read from
+// the openstack template. OpenStack has no load balancers.
+func (c OutputContract) Outputs(state storage.State) []terraform.OutputSpec {
+	return []terraform.OutputSpec{
+		{Name: "internal_cidr", Type: terraform.StringOutput},
+		{Name: "internal_gw", Type: terraform.StringOutput},
+		{Name: "external_ip", Type: terraform.StringOutput},
+		{Name: "jumpbox__internal_ip", Type: terraform.StringOutput},
+		{Name: "jumpbox_url", Type: terraform.StringOutput},
+		{Name: "director__internal_ip", Type: terraform.StringOutput},
+		{Name: "auth_url", Type: terraform.StringOutput},
+		{Name: "az", Type: terraform.StringOutput},
+		{Name: "default_key_name", Type: terraform.StringOutput},
+		{Name: "default_security_groups", Type: terraform.ListOutput},
+		{Name: "net_id", Type: terraform.StringOutput},
+		{Name: "openstack_project", Type: terraform.StringOutput},
+		{Name: "openstack_domain", Type: terraform.StringOutput},
+		{Name: "region", Type: terraform.StringOutput},
+		{Name: "director_name", Type: terraform.StringOutput},
+		{Name: "private_key", Type: terraform.StringOutput, Sensitive: true},
+	}
+}
@@ -0,0 +1,55 @@
+package terraform
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+)
+
+// OutputType is the kind of value bbl reads from a terraform output. Objects
+// are maps.
+type OutputType string
+
+const (
+	StringOutput OutputType = "string"
+	NumberOutput OutputType = "number"
+	BoolOutput   OutputType = "bool"
+	ListOutput   OutputType = "list"
+	MapOutput    OutputType = "map"
+)
+
+// OutputSpec declares a terraform output that bbl needs. Sensitive outputs,
+// such as private keys, must be marked sensitive in the template too.
+type OutputSpec struct {
+	Name      string
+	Type      OutputType
+	Sensitive bool
+}
+
+// OutputContract lists the outputs that bbl needs from the template for an
+// IAAS and load balancer type.
+type OutputContract interface {
+	Outputs(storage.State) []OutputSpec
+}
+
+// Validate checks every output in specs, so that one error names all the
+// outputs that are missing, have the wrong type or are no longer sensitive.
+func (o Outputs) Validate(specs []OutputSpec) error {
+	problems := []string{}
+	for _, spec := range specs {
+		if _, err := o.value(spec.Name, spec.Type); err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		if spec.Sensitive && !o.Sensitive[spec.Name] {
+			problems = append(problems, fmt.Sprintf("terraform output %s is not marked sensitive", spec.Name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%s. bbl needs these outputs, check that your terraform overrides do not remove or change them.", strings.Join(problems, ", "))
+	}
+
+	return nil
+}
@@ -1,7 +1,52 @@
 package terraform
 
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// SensitiveValue replaces the value of a sensitive output when it is shown.
+const SensitiveValue = "<sensitive>"
+
 type Outputs struct {
-	Map map[string]interface{}
+	Map       map[string]interface{}
+	Sensitive map[string]bool
+}
+
+func (o Outputs) IsSensitive(key string) bool {
+	return o.Sensitive[key]
+}
+
+// MarkSensitive returns the outputs with the ones that specs declare
+// sensitive marked sensitive too, so that they stay masked even when the
+// template that wrote the state did not mark them.
+func (o Outputs) MarkSensitive(specs []OutputSpec) Outputs {
+	sensitive := map[string]bool{}
+	for key, value := range o.Sensitive {
+		sensitive[key] = value
+	}
+	for _, spec := range specs {
+		if spec.Sensitive {
+			sensitive[spec.Name] = true
+		}
+	}
+
+	return Outputs{Map: o.Map, Sensitive: sensitive}
+}
+
+// Masked returns the outputs with the values of the sensitive ones replaced,
+// for printing.
+func (o Outputs) Masked() map[string]interface{} {
+	masked := map[string]interface{}{}
+	for key, value := range o.Map {
+		if o.IsSensitive(key) {
+			value = SensitiveValue
+		}
+		masked[key] = value
+	}
+
+	return masked
 }
 
 func (o Outputs) GetString(key string) string {
@@ -49,3 +94,145 @@ func (o Outputs) GetStringMap(key string) map[string]string {
 	}
 	return stringMap
 }
+
+// String returns the output, or an error when terraform did not produce it
+// or it is not a string.
+func (o Outputs) String(key string) (string, error) {
+	value, err := o.value(key, StringOutput)
+	if err != nil {
+		return "", err
+	}
+
+	return value.(string), nil
+}
+
+// Int returns a number output that has no fractional part.
+func (o Outputs) Int(key string) (int, error) {
+	value, err := o.value(key, NumberOutput)
+	if err != nil {
+		return 0, err
+	}
+
+	var number float64
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case float64:
+		number = v
+	case json.Number:
+		number, err = v.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("terraform output %s is not a number: %s", key, err)
+		}
+	}
+
+	if number != math.Trunc(number) {
+		return 0, fmt.Errorf("terraform output %s is %v, not a whole number", key, number)
+	}
+
+	return int(number), nil
+}
+
+func (o Outputs) Bool(key string) (bool, error) {
+	value, err := o.value(key, BoolOutput)
+	if err != nil {
+		return false, err
+	}
+
+	return value.(bool), nil
+}
+
+// StringSlice returns a list output whose elements are all strings.
+func (o Outputs) StringSlice(key string) ([]string, error) {
+	value, err := o.value(key, ListOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	if values, ok := value.([]string); ok {
+		return values, nil
+	}
+
+	values := []string{}
+	for i, element := range value.([]interface{}) {
+		s, ok := element.(string)
+		if !ok {
+			return nil, fmt.Errorf("terraform output %s has a %s at index %d, not a string", key, typeOf(element), i)
+		}
+		values = append(values, s)
+	}
+
+	return values, nil
+}
+
+// StringMap returns a map output whose values are all strings.
+func (o Outputs) StringMap(key string) (map[string]string, error) {
+	value, err := o.value(key, MapOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	if values, ok := value.(map[string]string); ok {
+		return values, nil
+	}
+
+	values := map[string]string{}
+	for k, element := range value.(map[string]interface{}) {
+		s, ok := element.(string)
+		if !ok {
+			return nil, fmt.Errorf("terraform output %s has a %s for %s, not a string", key, typeOf(element), k)
+		}
+		values[k] = s
+	}
+
+	return values, nil
+}
+
+// Object returns a map or object output as is, for outputs that nest
+// maps or lists.
+func (o Outputs) Object(key string) (map[string]interface{}, error) {
+	value, err := o.value(key, MapOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	if values, ok := value.(map[string]string); ok {
+		object := map[string]interface{}{}
+		for k, v := range values {
+			object[k] = v
+		}
+		return object, nil
+	}
+
+	return value.(map[string]interface{}), nil
+}
+
+func (o Outputs) value(key string, expected OutputType) (interface{}, error) {
+	value, ok := o.Map[key]
+	if !ok {
+		return nil, fmt.Errorf("missing %s terraform output", key)
+	}
+
+	if actual := typeOf(value); actual != expected {
+		return nil, fmt.Errorf("terraform output %s is a %s, not a %s", key, actual, expected)
+	}
+
+	return value, nil
+}
+
+func typeOf(value interface{}) OutputType {
+	switch value.(type) {
+	case string:
+		return StringOutput
+	case bool:
+		return BoolOutput
+	case int, float64, json.Number:
+		return NumberOutput
+	case []interface{}, []string:
+		return ListOutput
+	case map[string]interface{}, map[string]string:
+		return MapOutput
+	default:
+		return OutputType(fmt.Sprintf("%T", value))
+	}
+}
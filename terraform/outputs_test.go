@@ -1,6 +1,8 @@
 package terraform_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/bosh-bootloader/terraform"
 
 	. "github.com/onsi/ginkgo"
@@ -125,4 +127,185 @@ var _ = Describe("TerraformOutputs", func() {
 			})
 		})
 	})
+
+	Describe("String", func() {
+		It("returns the string value for the key", func() {
+			outputs := terraform.Outputs{Map: map[string]interface{}{"foo": "bar"}}
+			Expect(outputs.String("foo")).To(Equal("bar"))
+		})
+
+		Context("when the key does not exist", func() {
+			It("returns an error", func() {
+				outputs := terraform.Outputs{Map: map[string]interface{}{}}
+				_, err := outputs.String("foo")
+				Expect(err).To(MatchError("missing foo terraform output"))
+			})
+		})
+
+		Context("when the value is not a string", func() {
+			It("returns an error", func() {
+				outputs := terraform.Outputs{Map: map[string]interface{}{"foo": []interface{}{"bar"}}}
+				_, err := outputs.String("foo")
+				Expect(err).To(MatchError("terraform output foo is a list, not a string"))
+			})
+		})
+	})
+
+	Describe("Int", func() {
+		It("returns a whole number for the key", func() {
+			outputs := terraform.Outputs{Map: map[string]interface{}{"foo": float64(3), "bar": json.Number("4")}}
+			Expect(outputs.Int("foo")).To(Equal(3))
+			Expect(outputs.Int("bar")).To(Equal(4))
+		})
+
+		Context("when the number has a fractional part", func() {
+			It("returns an error", func() {
+				outputs := terraform.Outputs{Map: map[string]interface{}{"foo": 1.5}}
+				_, err := outputs.Int("foo")
+				Expect(err).To(MatchError("terraform output foo is 1.5, not a whole number"))
+			})
+		})
+
+		Context("when the value is not a number", func() {
+			It("returns an error", func() {
+				outputs := terraform.Outputs{Map: map[string]interface{}{"foo": "3"}}
+				_, err := outputs.Int("foo")
+				Expect(err).To(MatchError("terraform output foo is a string, not a number"))
+			})
+		})
+	})
+
+	Describe("Bool", func() {
+		It("returns the bool value for the key", func() {
+			outputs := terraform.Outputs{Map: map[string]interface{}{"foo": true}}
+			Expect(outputs.Bool("foo")).To(BeTrue())
+		})
+
+		Context("when the value is not a bool", func() {
+			It("returns an error", func() {
+				outputs := terraform.Outputs{Map: map[string]interface{}{"foo": "true"}}
+				_, err := outputs.Bool("foo")
+				Expect(err).To(MatchError("terraform output foo is a string, not a bool"))
+			})
+		})
+	})
+
+	Describe("StringSlice", func() {
+		It("returns the string slice value for the key", func() {
+			outputs := terraform.Outputs{Map: map[string]interface{}{"foo": []interface{}{"bar", "baz"}}}
+			Expect(outputs.StringSlice("foo")).To(Equal([]string{"bar", "baz"}))
+		})
+
+		Context("when an element is not a string", func() {
+			It("returns an error", func() {
+				outputs := terraform.Outputs{Map: map[string]interface{}{"foo": []interface{}{"bar", true}}}
+				_, err := outputs.StringSlice("foo")
+				Expect(err).To(MatchError("terraform output foo has a bool at index 1, not a string"))
+			})
+		})
+
+		Context("when the key does not exist", func() {
+			It("returns an error", func() {
+				outputs := terraform.Outputs{Map: map[string]interface{}{}}
+				_, err := outputs.StringSlice("foo")
+				Expect(err).To(MatchError("missing foo terraform output"))
+			})
+		})
+	})
+
+	Describe("StringMap", func() {
+		It("returns the string map value for the key", func() {
+			outputs := terraform.Outputs{Map: map[string]interface{}{"foo": map[string]interface{}{"bar": "baz"}}}
+			Expect(outputs.StringMap("foo")).To(Equal(map[string]string{"bar": "baz"}))
+		})
+
+		Context("when a value is not a string", func() {
+			It("returns an error", func() {
+				outputs := terraform.Outputs{Map: map[string]interface{}{"foo": map[string]interface{}{"bar": float64(3)}}}
+				_, err := outputs.StringMap("foo")
+				Expect(err).To(MatchError("terraform output foo has a number for bar, not a string"))
+			})
+		})
+	})
+
+	Describe("Object", func() {
+		It("returns nested maps as they are", func() {
+			object := map[string]interface{}{
+				"bar": map[string]interface{}{"baz": []interface{}{"qux"}},
+			}
+			outputs := terraform.Outputs{Map: map[string]interface{}{"foo": object}}
+			Expect(outputs.Object("foo")).To(Equal(object))
+		})
+
+		Context("when the value is not a map", func() {
+			It("returns an error", func() {
+				outputs := terraform.Outputs{Map: map[string]interface{}{"foo": "bar"}}
+				_, err := outputs.Object("foo")
+				Expect(err).To(MatchError("terraform output foo is a string, not a map"))
+			})
+		})
+	})
+
+	Describe("Masked", func() {
+		It("replaces the values of the sensitive outputs", func() {
+			outputs := terraform.Outputs{
+				Map:       map[string]interface{}{"external_ip": "some-external-ip", "private_key": "some-private-key"},
+				Sensitive: map[string]bool{"private_key": true},
+			}
+			Expect(outputs.Masked()).To(Equal(map[string]interface{}{
+				"external_ip": "some-external-ip",
+				"private_key": "<sensitive>",
+			}))
+			Expect(outputs.Map["private_key"]).To(Equal("some-private-key"))
+		})
+	})
+
+	Describe("MarkSensitive", func() {
+		It("marks the outputs that the specs declare sensitive", func() {
+			outputs := terraform.Outputs{
+				Map:       map[string]interface{}{"external_ip": "some-external-ip", "private_key": "some-private-key", "secret": "some-secret"},
+				Sensitive: map[string]bool{"secret": true},
+			}
+
+			marked := outputs.MarkSensitive([]terraform.OutputSpec{
+				{Name: "external_ip", Type: terraform.StringOutput},
+				{Name: "private_key", Type: terraform.StringOutput, Sensitive: true},
+			})
+			Expect(marked.IsSensitive("private_key")).To(BeTrue())
+			Expect(marked.IsSensitive("secret")).To(BeTrue())
+			Expect(marked.IsSensitive("external_ip")).To(BeFalse())
+			Expect(outputs.IsSensitive("private_key")).To(BeFalse())
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts outputs that match the specs", func() {
+			outputs := terraform.Outputs{Map: map[string]interface{}{
+				"foo": "bar",
+				"baz": []interface{}{"qux"},
+			}, Sensitive: map[string]bool{"foo": true}}
+			err := outputs.Validate([]terraform.OutputSpec{
+				{Name: "foo", Type: terraform.StringOutput, Sensitive: true},
+				{Name: "baz", Type: terraform.ListOutput},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("names every output that is missing or has the wrong type", func() {
+			outputs := terraform.Outputs{Map: map[string]interface{}{"baz": map[string]interface{}{}}}
+			err := outputs.Validate([]terraform.OutputSpec{
+				{Name: "foo", Type: terraform.StringOutput},
+				{Name: "baz", Type: terraform.ListOutput},
+			})
+			Expect(err).To(MatchError("missing foo terraform output, terraform output baz is a map, not a list. bbl needs these outputs, check that your terraform overrides do not remove or change them."))
+		})
+
+		It("names the sensitive outputs that are no longer marked sensitive", func() {
+			outputs := terraform.Outputs{Map: map[string]interface{}{"private_key": "some-key"}}
+			err := outputs.Validate([]terraform.OutputSpec{
+				{Name: "private_key", Type: terraform.StringOutput, Sensitive: true},
+			})
+			Expect(err).To(MatchError("terraform output private_key is not marked sensitive. bbl needs these outputs, check that your terraform overrides do not remove or change them."))
+		})
+	})
 })
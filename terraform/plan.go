@@ -0,0 +1,128 @@
+package terraform
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	PLAN_CREATE  = "create"
+	PLAN_UPDATE  = "update"
+	PLAN_REPLACE = "replace"
+	PLAN_DESTROY = "destroy"
+)
+
+type ResourceChange struct {
+	Address string
+	Type    string
+	Action  string
+}
+
+var (
+	// terraform 0.11 lists each action as "  ~ aws_instance.nat".
+	planActionLine = regexp.MustCompile(`^\s*(-/\+|\+/-|\+|~|-)\s+(\S+)`)
+	// terraform 0.12 introduces each action with a comment. The address
+	// runs up to the action, since instance keys can hold spaces.
+	planCommentLine = regexp.MustCompile(`^\s*# (.+?) (will be created|will be updated in-place|must be replaced|is tainted, so must be replaced|will be replaced, as requested|will be destroyed)\s*$`)
+	// a deposed object is destroyed under the address of its resource.
+	planDeposedObject = regexp.MustCompile(` \(deposed object \S+\)$`)
+	// instance keys can hold dots, so they are left out when the address is
+	// split into its parts.
+	planInstanceKey = regexp.MustCompile(`\["(?:[^"\\]|\\.)*"\]|\[\d+\]`)
+)
+
+// ParsePlan reads the resources that a terraform plan would touch from its
+// output. Data sources are left out, since reading them changes nothing.
+func ParsePlan(planOutput string) []ResourceChange {
+	changes := []ResourceChange{}
+
+	actions, commented := false, false
+	for _, line := range strings.Split(planOutput, "\n") {
+		if matches := planCommentLine.FindStringSubmatch(line); matches != nil {
+			address := planDeposedObject.ReplaceAllString(matches[1], "")
+			changes = appendChange(changes, address, commentAction(matches[2]))
+			commented = true
+			continue
+		}
+
+		if strings.HasPrefix(line, "Terraform will perform the following actions:") {
+			actions = true
+			continue
+		}
+		if strings.HasPrefix(line, "Plan:") {
+			actions = false
+			continue
+		}
+		// Once a plan has comments, the action lines are attributes.
+		if !actions || commented {
+			continue
+		}
+
+		if matches := planActionLine.FindStringSubmatch(line); matches != nil {
+			changes = appendChange(changes, matches[2], symbolAction(matches[1]))
+		}
+	}
+
+	return changes
+}
+
+// SummarizePlan counts the changes the way terraform does, where replacing a
+// resource both creates and destroys one.
+func SummarizePlan(changes []ResourceChange) (creates, updates, destroys int) {
+	for _, change := range changes {
+		switch change.Action {
+		case PLAN_CREATE:
+			creates++
+		case PLAN_UPDATE:
+			updates++
+		case PLAN_REPLACE:
+			creates++
+			destroys++
+		case PLAN_DESTROY:
+			destroys++
+		}
+	}
+	return creates, updates, destroys
+}
+
+func appendChange(changes []ResourceChange, address, action string) []ResourceChange {
+	parts := strings.Split(planInstanceKey.ReplaceAllString(address, ""), ".")
+	for len(parts) > 2 && parts[0] == "module" {
+		parts = parts[2:]
+	}
+	if len(parts) < 2 || parts[0] == "data" {
+		return changes
+	}
+
+	return append(changes, ResourceChange{
+		Address: address,
+		Type:    parts[0],
+		Action:  action,
+	})
+}
+
+func symbolAction(symbol string) string {
+	switch symbol {
+	case "+":
+		return PLAN_CREATE
+	case "~":
+		return PLAN_UPDATE
+	case "-":
+		return PLAN_DESTROY
+	default:
+		return PLAN_REPLACE
+	}
+}
+
+func commentAction(comment string) string {
+	switch comment {
+	case "will be created":
+		return PLAN_CREATE
+	case "will be updated in-place":
+		return PLAN_UPDATE
+	case "will be destroyed":
+		return PLAN_DESTROY
+	default:
+		return PLAN_REPLACE
+	}
+}
@@ -0,0 +1,113 @@
+package terraform_test
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParsePlan", func() {
+	It("reads the action of each resource in a terraform 0.11 plan", func() {
+		changes := terraform.ParsePlan(`Terraform will perform the following actions:
+
+  ~ aws_security_group.bosh_security_group
+      ingress.#: "1" => "2"
+
+  + aws_subnet.bosh_subnet
+
+-/+ aws_eip.jumpbox_eip (new resource required)
+
+  - aws_instance.leftover
+
+Plan: 2 to add, 1 to change, 2 to destroy.
+`)
+
+		Expect(changes).To(Equal([]terraform.ResourceChange{
+			{Address: "aws_security_group.bosh_security_group", Type: "aws_security_group", Action: terraform.PLAN_UPDATE},
+			{Address: "aws_subnet.bosh_subnet", Type: "aws_subnet", Action: terraform.PLAN_CREATE},
+			{Address: "aws_eip.jumpbox_eip", Type: "aws_eip", Action: terraform.PLAN_REPLACE},
+			{Address: "aws_instance.leftover", Type: "aws_instance", Action: terraform.PLAN_DESTROY},
+		}))
+	})
+
+	It("reads the action of each resource in a terraform 0.12 plan", func() {
+		changes := terraform.ParsePlan(`Terraform will perform the following actions:
+
+  # google_compute_instance.nat must be replaced
+-/+ resource "google_compute_instance" "nat" {
+    }
+
+  # google_compute_network.old will be destroyed
+  - resource "google_compute_network" "old" {
+    }
+
+Plan: 1 to add, 0 to change, 2 to destroy.
+`)
+
+		Expect(changes).To(Equal([]terraform.ResourceChange{
+			{Address: "google_compute_instance.nat", Type: "google_compute_instance", Action: terraform.PLAN_REPLACE},
+			{Address: "google_compute_network.old", Type: "google_compute_network", Action: terraform.PLAN_DESTROY},
+		}))
+	})
+
+	It("reads the addresses of instances whose keys hold spaces and dots", func() {
+		changes := terraform.ParsePlan(`Terraform will perform the following actions:
+
+  # aws_subnet.internal["us-east-1a az"] will be created
+  + resource "aws_subnet" "internal" {
+    }
+
+  # module.network["eu.west"].google_compute_subnetwork.bosh will be updated in-place
+  ~ resource "google_compute_subnetwork" "bosh" {
+    }
+
+Plan: 1 to add, 1 to change, 0 to destroy.
+`)
+
+		Expect(changes).To(Equal([]terraform.ResourceChange{
+			{Address: `aws_subnet.internal["us-east-1a az"]`, Type: "aws_subnet", Action: terraform.PLAN_CREATE},
+			{Address: `module.network["eu.west"].google_compute_subnetwork.bosh`, Type: "google_compute_subnetwork", Action: terraform.PLAN_UPDATE},
+		}))
+	})
+
+	It("reads tainted, forced and deposed replacements", func() {
+		changes := terraform.ParsePlan(`Terraform will perform the following actions:
+
+  # aws_instance.nat is tainted, so must be replaced
+-/+ resource "aws_instance" "nat" {
+    }
+
+  # aws_eip.jumpbox_eip will be replaced, as requested
+-/+ resource "aws_eip" "jumpbox_eip" {
+    }
+
+  # aws_instance.bosh (deposed object 8a2f1c3e) will be destroyed
+  - resource "aws_instance" "bosh" {
+    }
+
+Plan: 2 to add, 0 to change, 3 to destroy.
+`)
+
+		Expect(changes).To(Equal([]terraform.ResourceChange{
+			{Address: "aws_instance.nat", Type: "aws_instance", Action: terraform.PLAN_REPLACE},
+			{Address: "aws_eip.jumpbox_eip", Type: "aws_eip", Action: terraform.PLAN_REPLACE},
+			{Address: "aws_instance.bosh", Type: "aws_instance", Action: terraform.PLAN_DESTROY},
+		}))
+	})
+})
+
+var _ = Describe("SummarizePlan", func() {
+	It("counts a replacement as a create and a destroy", func() {
+		creates, updates, destroys := terraform.SummarizePlan([]terraform.ResourceChange{
+			{Action: terraform.PLAN_CREATE},
+			{Action: terraform.PLAN_UPDATE},
+			{Action: terraform.PLAN_REPLACE},
+			{Action: terraform.PLAN_DESTROY},
+		})
+
+		Expect(creates).To(Equal(2))
+		Expect(updates).To(Equal(1))
+		Expect(destroys).To(Equal(2))
+	})
+})
@@ -0,0 +1,77 @@
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+// backendBlock finds a terraform backend configured in a template or an
+// override file, in which case the state is not in the vars dir.
+var backendBlock = regexp.MustCompile(`(?m)^\s*backend\s+"[^"]+"`)
+
+// tfState holds the parts of a terraform state file that bbl reads. Version 3
+// keeps the outputs and resources of each module, version 4 keeps them at the
+// top level.
+type tfState struct {
+	Version   int                 `json:"version"`
+	Modules   []tfStateModule     `json:"modules"`
+	Outputs   map[string]tfOutput `json:"outputs"`
+	Resources []json.RawMessage   `json:"resources"`
+}
+
+type tfStateModule struct {
+	Path      []string                   `json:"path"`
+	Outputs   map[string]tfOutput        `json:"outputs"`
+	Resources map[string]json.RawMessage `json:"resources"`
+}
+
+type tfOutput struct {
+	Sensitive bool        `json:"sensitive"`
+	Value     interface{} `json:"value"`
+}
+
+// stateCache keeps the state that was read until bbl runs a terraform command
+// that could change it.
+type stateCache struct {
+	state *tfState
+}
+
+func parseState(contents []byte) (tfState, error) {
+	var state tfState
+	err := json.Unmarshal(contents, &state)
+	if err != nil {
+		return tfState{}, fmt.Errorf("Parse terraform state: %s", err)
+	}
+
+	return state, nil
+}
+
+// outputs returns the outputs of the root module, like terraform output does.
+func (s tfState) outputs() map[string]tfOutput {
+	if s.Version >= 4 {
+		return s.Outputs
+	}
+
+	for _, module := range s.Modules {
+		if len(module.Path) == 1 && module.Path[0] == "root" {
+			return module.Outputs
+		}
+	}
+
+	return nil
+}
+
+func (s tfState) hasResources() bool {
+	if s.Version >= 4 {
+		return len(s.Resources) > 0
+	}
+
+	for _, module := range s.Modules {
+		if len(module.Resources) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
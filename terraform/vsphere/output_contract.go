@@ -0,0 +1,35 @@
+package vsphere
+
+import (
+	"github.com/cloudfoundry/bosh-bootloader/storage"
+	"github.com/cloudfoundry/bosh-bootloader/terraform"
+)
+
+type OutputContract struct{}
+
+func NewOutputContract() OutputContract {
+	return OutputContract{}
+}
+
+// Outputs lists the outputs that create-env and the cloud config read from
+// the vsphere template. vSphere has no load balancers.
+func (c OutputContract) Outputs(state storage.State) []terraform.OutputSpec {
+	return []terraform.OutputSpec{
+		{Name: "internal_cidr", Type: terraform.StringOutput},
+		{Name: "internal_gw", Type: terraform.StringOutput},
+		{Name: "network_name", Type: terraform.StringOutput},
+		{Name: "vcenter_cluster", Type: terraform.StringOutput},
+		{Name: "jumpbox_url", Type: terraform.StringOutput},
+		{Name: "external_ip", Type: terraform.StringOutput},
+		{Name: "jumpbox__internal_ip", Type: terraform.StringOutput},
+		{Name: "director__internal_ip", Type: terraform.StringOutput},
+		{Name: "director_name", Type: terraform.StringOutput},
+		{Name: "vcenter_disks", Type: terraform.StringOutput},
+		{Name: "vcenter_vms", Type: terraform.StringOutput},
+		{Name: "vcenter_templates", Type: terraform.StringOutput},
+		{Name: "vcenter_ip", Type: terraform.StringOutput},
+		{Name: "vcenter_dc", Type: terraform.StringOutput},
+		{Name: "vcenter_rp", Type: terraform.StringOutput},
+		{Name: "vcenter_ds", Type: terraform.StringOutput},
+	}
+}